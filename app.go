@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	services "dece/internal/application/services/enrollment"
+	healthSvc "dece/internal/application/services/health"
 	managementSvc "dece/internal/application/services/management"
 	notificationsSvc "dece/internal/application/services/notifications"
 	searchSvc "dece/internal/application/services/search"
@@ -11,6 +12,7 @@ import (
 	telegramSync "dece/internal/application/services/sync"
 	system "dece/internal/application/services/system"
 	tracking "dece/internal/application/services/tracking"
+	transferSvc "dece/internal/application/services/transfer"
 )
 
 type App struct {
@@ -24,9 +26,11 @@ type App struct {
 	maintenanceService  *system.MaintenanceService
 	templateService     *managementSvc.TemplateService
 	userService         *security.UserService
+	transferService     *transferSvc.TransferService
+	healthService       *healthSvc.HealthService
 }
 
-func NewApp(enrollmentService *services.EnrollmentService, trackingService *tracking.TrackingService, notificationsService *notificationsSvc.NotificationsService, telegramSyncService *telegramSync.TelegramSyncService, studentService *studentSvc.StudentService, searchService *searchSvc.SearchService, maintenanceService *system.MaintenanceService, templateService *managementSvc.TemplateService, userService *security.UserService) *App {
+func NewApp(enrollmentService *services.EnrollmentService, trackingService *tracking.TrackingService, notificationsService *notificationsSvc.NotificationsService, telegramSyncService *telegramSync.TelegramSyncService, studentService *studentSvc.StudentService, searchService *searchSvc.SearchService, maintenanceService *system.MaintenanceService, templateService *managementSvc.TemplateService, userService *security.UserService, transferService *transferSvc.TransferService, healthService *healthSvc.HealthService) *App {
 	return &App{
 		enrollmentService:   enrollmentService,
 		trackingService:     trackingService,
@@ -37,6 +41,8 @@ func NewApp(enrollmentService *services.EnrollmentService, trackingService *trac
 		maintenanceService:  maintenanceService,
 		templateService:     templateService,
 		userService:         userService,
+		transferService:     transferService,
+		healthService:       healthService,
 	}
 }
 
@@ -49,6 +55,8 @@ func (a *App) startup(ctx context.Context) {
 	a.maintenanceService.SetContext(ctx)
 	a.templateService.SetContext(ctx)
 	a.userService.SetContext(ctx)
+	a.transferService.SetContext(ctx)
+	a.healthService.SetContext(ctx)
 	if a.notificationsSvc != nil {
 		a.notificationsSvc.SetContext(ctx)
 		a.notificationsSvc.StartScheduler()
@@ -25,6 +25,9 @@ import CoursesPage from './pages/faculty/Courses';
 import StudentsPage from './pages/student/Student';
 import StudentFormPage from './pages/student/StudentFormPage';
 import StudentModifications from './pages/student/StudentModifications';
+import StudentVaccination from './pages/student/StudentVaccination';
+import StudentIncidents from './pages/student/StudentIncidents';
+import StudentConsents from './pages/student/StudentConsents';
 
 import EnrollmentManager from './pages/Enrollment/EnrollmentManager';
 
@@ -38,6 +41,7 @@ import ReporteEstadistico from './pages/Reports/ReporteEstadistico';
 import ReporteNominaVulnerabilidad from './pages/Reports/ReporteNominaVulnerabilidad';
 import ReporteBitacoraGestion from './pages/Reports/ReporteBitacoraGestion';
 import ReporteDerivaciones from './pages/Reports/ReporteDerivaciones';
+import ReporteResidencias from './pages/Reports/ReporteResidencias';
 
 import UpdateNotification from './components/UpdateNotification';
 
@@ -77,6 +81,9 @@ const MainLayout = () => {
             <Route path="/estudiantes/editar/:id" element={<StudentFormPage />} />
             <Route path="/estudiantes/ficha-dece" element={<EnrollmentManager />} />
             <Route path="/estudiantes/modificaciones" element={<StudentModifications />} />
+            <Route path="/estudiantes/vacunacion" element={<StudentVaccination />} />
+            <Route path="/estudiantes/incidentes" element={<StudentIncidents />} />
+            <Route path="/estudiantes/consentimientos" element={<StudentConsents />} />
 
             <Route path="/dece" element={<DisciplineManagerPage />} />
 
@@ -91,6 +98,7 @@ const MainLayout = () => {
             <Route path="/reportes/nomina-vulnerabilidad" element={<ReporteNominaVulnerabilidad />} />
             <Route path="/reportes/bitacora-gestion" element={<ReporteBitacoraGestion />} />
             <Route path="/reportes/derivaciones" element={<ReporteDerivaciones />} />
+            <Route path="/reportes/residencias" element={<ReporteResidencias />} />
           </Routes>
         </div>
       </main>
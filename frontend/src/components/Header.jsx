@@ -5,7 +5,7 @@ import { useNotifications } from '../context/NotificationsContext';
 import { useTutorial } from '../context/TutorialContext';
 import { useNavigate } from 'react-router-dom';
 import GlobalSearch from './GlobalSearch';
-import { ObtenerFotoPerfilBase64 } from '../../wailsjs/go/services/UserService';
+import { ObtenerMiniaturaPerfilBase64 } from '../../wailsjs/go/services/UserService';
 
 const Header = () => {
   const { user, lockScreen, logout } = useScreenLock();
@@ -20,7 +20,7 @@ const Header = () => {
     const loadPhoto = async () => {
       if (user?.id) {
         try {
-          const base64 = await ObtenerFotoPerfilBase64(user.id);
+          const base64 = await ObtenerMiniaturaPerfilBase64(user.id);
           if (base64) setProfilePhoto(base64);
         } catch { }
       }
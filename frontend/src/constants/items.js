@@ -82,6 +82,21 @@ export const menuOptions = [
                 title: "Modificaciones",
                 path: "/estudiantes/modificaciones",
                 icon: "Archive"
+            },
+            {
+                title: "Vacunación y Controles",
+                path: "/estudiantes/vacunacion",
+                icon: "Activity"
+            },
+            {
+                title: "Enfermería e Incidentes",
+                path: "/estudiantes/incidentes",
+                icon: "ClipboardList"
+            },
+            {
+                title: "Consentimientos",
+                path: "/estudiantes/consentimientos",
+                icon: "FileSignature"
             }
         ]
     },
@@ -136,6 +151,11 @@ export const menuOptions = [
                 title: "Derivaciones",
                 path: "/reportes/derivaciones",
                 icon: "Building2"
+            },
+            {
+                title: "Residencias por Sector",
+                path: "/reportes/residencias",
+                icon: "MapPin"
             }
         ]
     },
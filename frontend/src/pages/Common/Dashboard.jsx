@@ -1,7 +1,7 @@
 import React, { useState, useEffect } from 'react';
 import {
   Users, AlertCircle, Calendar, ShieldAlert,
-  TrendingUp, Activity, Clock, FileText
+  TrendingUp, Activity, Clock, FileText, GraduationCap
 } from 'lucide-react';
 import { toast } from 'sonner';
 import { GetDashboardData } from '../../../wailsjs/go/dashboard/DashboardService';
@@ -94,7 +94,7 @@ const Dashboard = () => {
         </div>
       </div>
 
-      <div className="grid grid-cols-1 md:grid-cols-2 lg:grid-cols-4 gap-6 mb-8">
+      <div className="grid grid-cols-1 md:grid-cols-2 lg:grid-cols-5 gap-6 mb-8">
         <KPICard
           title="Estudiantes Activos"
           value={data.kpi?.total_estudiantes || 0}
@@ -123,6 +123,13 @@ const Dashboard = () => {
           color="bg-rose-500"
           subtitle="Llamados de atención"
         />
+        <KPICard
+          title="Extraedad"
+          value={data.kpi?.extraedad || 0}
+          icon={GraduationCap}
+          color="bg-teal-500"
+          subtitle={data.extraedad_por_curso?.length ? `Más casos: ${data.extraedad_por_curso[0].curso}` : "Sobre la edad del nivel"}
+        />
       </div>
 
       <div className="grid grid-cols-1 lg:grid-cols-3 gap-8">
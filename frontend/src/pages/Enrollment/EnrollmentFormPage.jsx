@@ -7,6 +7,7 @@ import { ListarMaterias } from '../../../wailsjs/go/academic/SubjectService';
 import { ObtenerPeriodoActivo } from '../../../wailsjs/go/academic/YearService';
 import { PreviewModal } from './EnrollmentUI';
 import { AcademicTab, PhysicalTab, HealthTab, SocialTab, GenderTab } from './EnrollmentTabs';
+import GrowthHistory from './GrowthHistory';
 
 export default function EnrollmentFormPage({ studentId, studentGender = 'M', onBack }) {
     const [activeTab, setActiveTab] = useState('academico');
@@ -18,9 +19,10 @@ export default function EnrollmentFormPage({ studentId, studentGender = 'M', onB
 
     const [formData, setFormData] = useState({
         id: 0, estudiante_id: studentId, curso_id: 0, es_repetidor: false, direccion_actual: '', ruta_croquis: '', ruta_consentimiento: '',
+        ubicacion: { parroquia: '', barrio_recinto: '', latitud: '', longitud: '' },
         antropometria: { peso: 0, talla: 0, tipo_sangre: 'O+' },
         historial_academico: { es_nuevo_estudiante: false, institucion_anterior: '', provincia_anterior: '', canton_anterior: '', ha_repetido_anio: false, detalle_anio_repetido: '', materias_favoritas: [], materias_menos_gustan: [] },
-        datos_salud: { tiene_eval_psicopedagogica: false, ruta_eval_psicopedagogica: '', tiene_discapacidad: false, detalle_discapacidad: '', ha_sufrido_accidente: false, detalle_accidente: '', tiene_alergias: false, detalle_alergia: '', tiene_cirugias: false, detalle_cirugia: '', tiene_enfermedad: false, detalle_enfermedad: '' },
+        datos_salud: { tiene_eval_psicopedagogica: false, ruta_eval_psicopedagogica: '', tiene_discapacidad: false, detalle_discapacidad: '', ha_sufrido_accidente: false, detalle_accidente: '', tiene_alergias: false, detalle_alergia: '', tiene_cirugias: false, detalle_cirugia: '', tiene_enfermedad: false, detalle_enfermedad: '', alergias: [], condiciones_cronicas: [], medicaciones: [], contactos_emergencia: [], seguro_medico: { tiene_seguro: false, aseguradora: '', numero_poliza: '', telefono: '' } },
         datos_sociales: { actividades: [], practica_actividad: false },
         condicion_genero: {
             esta_embarazada: false, meses_embarazo: 0, lleva_control: false, es_alto_riesgo: false, tipo_apoyo_institucion: '', nombre_padre_bebe: '', viven_juntos_padres: false,
@@ -56,6 +58,12 @@ export default function EnrollmentFormPage({ studentId, studentGender = 'M', onB
                             curso_id: data.curso_id,
                             es_repetidor: data.es_repetidor,
                             direccion_actual: data.direccion_actual,
+                            ubicacion: {
+                                parroquia: data.ubicacion?.parroquia || '',
+                                barrio_recinto: data.ubicacion?.barrio_recinto || '',
+                                latitud: data.ubicacion?.latitud ?? '',
+                                longitud: data.ubicacion?.longitud ?? ''
+                            },
                             ruta_croquis: data.ruta_croquis,
                             ruta_consentimiento: data.ruta_consentimiento,
                             antropometria: { ...prev.antropometria, ...(data.antropometria || {}) },
@@ -118,6 +126,11 @@ export default function EnrollmentFormPage({ studentId, studentGender = 'M', onB
                 curso_id: Number(formData.curso_id),
                 estudiante_id: studentId,
                 antropometria: { ...formData.antropometria, peso: Number(formData.antropometria.peso), talla: Number(formData.antropometria.talla) },
+                ubicacion: {
+                    ...formData.ubicacion,
+                    latitud: formData.ubicacion.latitud === '' ? null : Number(formData.ubicacion.latitud),
+                    longitud: formData.ubicacion.longitud === '' ? null : Number(formData.ubicacion.longitud)
+                },
                 condicion_genero: {
                     ...formData.condicion_genero,
                     meses_embarazo: Number(formData.condicion_genero.meses_embarazo || 0),
@@ -163,9 +176,14 @@ export default function EnrollmentFormPage({ studentId, studentGender = 'M', onB
                 </div>
                 <div className="bg-white rounded-xl shadow-sm border border-slate-200 p-8 min-h-150">
                     {activeTab === 'academico' && <AcademicTab data={formData} courses={courses} onChange={(field, val) => updateRoot(field, val)} onFileSelect={handleFileSelect} onPreview={handlePreview} />}
-                    {activeTab === 'fisico' && <PhysicalTab data={formData.antropometria} onChange={(field, val) => updateField('antropometria', field, val)} />}
+                    {activeTab === 'fisico' && (
+                        <div className="space-y-6">
+                            <PhysicalTab data={formData.antropometria} onChange={(field, val) => updateField('antropometria', field, val)} />
+                            <GrowthHistory studentId={studentId} matriculaId={formData.id} />
+                        </div>
+                    )}
                     {activeTab === 'salud' && <HealthTab data={formData.datos_salud} onChange={(field, val) => updateField('datos_salud', field, val)} onFileSelect={handleFileSelect} onPreview={handlePreview} />}
-                    {activeTab === 'social' && <SocialTab history={formData.historial_academico} social={formData.datos_sociales} rutaCroquis={formData.ruta_croquis} subjectsList={subjectsList} onChangeHistory={(field, val) => updateField('historial_academico', field, val)} onChangeSocial={(field, val) => updateField('datos_sociales', field, val)} onFileSelect={handleFileSelect} onPreview={handlePreview} />}
+                    {activeTab === 'social' && <SocialTab history={formData.historial_academico} social={formData.datos_sociales} rutaCroquis={formData.ruta_croquis} direccion={formData.direccion_actual} ubicacion={formData.ubicacion} onChangeDireccion={(val) => updateRoot('direccion_actual', val)} onChangeUbicacion={(field, val) => updateField('ubicacion', field, val)} subjectsList={subjectsList} onChangeHistory={(field, val) => updateField('historial_academico', field, val)} onChangeSocial={(field, val) => updateField('datos_sociales', field, val)} onFileSelect={handleFileSelect} onPreview={handlePreview} />}
                     {activeTab === 'genero' && <GenderTab gender={studentGender} data={formData.condicion_genero} onChange={(newData) => updateSection('condicion_genero', newData)} />}
                 </div>
             </div>
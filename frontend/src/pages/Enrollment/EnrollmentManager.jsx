@@ -1,7 +1,7 @@
 import React, { useState, useEffect } from 'react';
 import { toast } from 'sonner';
 import { Search, User, ArrowRight, Loader2, GraduationCap, FileText } from 'lucide-react';
-import { BuscarEstudiantes, ObtenerEstudiante, ObtenerFotoBase64 } from '../../../wailsjs/go/services/StudentService';
+import { BuscarEstudiantes, ObtenerEstudiante, ObtenerMiniaturaBase64 } from '../../../wailsjs/go/services/StudentService';
 import EnrollmentFormPage from './EnrollmentFormPage';
 
 const StudentCard = ({ student, onSelect }) => {
@@ -10,7 +10,7 @@ const StudentCard = ({ student, onSelect }) => {
     useEffect(() => {
         let isMounted = true;
         if (student.ruta_foto) {
-            ObtenerFotoBase64(student.id).then(b64 => {
+            ObtenerMiniaturaBase64(student.id).then(b64 => {
                 if (isMounted && b64) setPhoto(b64);
             }).catch(() => { });
         }
@@ -2,11 +2,11 @@ import React, { useMemo } from 'react';
 import {
     Activity, HeartPulse, Users, Baby, FileText, UserCheck, School,
     MapPin, AlertCircle, Info, Calculator, CheckCircle2, User, Calendar,
-    AlertTriangle
+    AlertTriangle, Phone
 } from 'lucide-react';
 import {
     SectionTitle, InputGroup, BaseInput,
-    BaseSelect, FileUploader, TagManager, PartnerSearch
+    BaseSelect, FileUploader, TagManager, PartnerSearch, ListEditor
 } from './EnrollmentUI';
 import { toast } from 'sonner';
 
@@ -299,6 +299,83 @@ export const HealthTab = ({ data, onChange, onFileSelect, onPreview }) => (
             </div>
         </div>
 
+        <div className="bg-white rounded-xl shadow-sm border border-slate-200 p-6">
+            <SectionTitle title="Datos para Emergencias" icon={Phone} />
+            <p className="text-xs text-slate-500 -mt-3 mb-6">Se imprimen en la tarjeta de emergencia y en la nómina de salud del curso.</p>
+
+            <div className="grid grid-cols-1 lg:grid-cols-2 gap-8">
+                <ListEditor
+                    title="Alergias"
+                    items={data.alergias}
+                    emptyItem={{ alergeno: '', severidad: '', reaccion: '', actuacion: '' }}
+                    fields={[
+                        { key: 'alergeno', label: 'Alérgeno (p. ej. penicilina)' },
+                        { key: 'severidad', label: 'Severidad', type: 'select', options: ['Leve', 'Moderada', 'Grave', 'Anafilaxia'] },
+                        { key: 'reaccion', label: 'Reacción' },
+                        { key: 'actuacion', label: 'Qué hacer' },
+                    ]}
+                    onChange={(v) => onChange('alergias', v)}
+                />
+                <ListEditor
+                    title="Condiciones Crónicas"
+                    items={data.condiciones_cronicas}
+                    emptyItem={{ nombre: '', actuacion: '' }}
+                    fields={[
+                        { key: 'nombre', label: 'Condición (p. ej. asma)' },
+                        { key: 'actuacion', label: 'Qué hacer en una crisis' },
+                    ]}
+                    onChange={(v) => onChange('condiciones_cronicas', v)}
+                />
+                <ListEditor
+                    title="Medicación Actual"
+                    items={data.medicaciones}
+                    emptyItem={{ medicamento: '', dosis: '', frecuencia: '', en_institucion: false }}
+                    fields={[
+                        { key: 'medicamento', label: 'Medicamento' },
+                        { key: 'dosis', label: 'Dosis' },
+                        { key: 'frecuencia', label: 'Frecuencia' },
+                        { key: 'en_institucion', label: 'Se administra en la institución', type: 'checkbox' },
+                    ]}
+                    onChange={(v) => onChange('medicaciones', v)}
+                />
+                <ListEditor
+                    title="Contactos de Emergencia"
+                    items={data.contactos_emergencia}
+                    emptyItem={{ nombres: '', parentesco: '', telefono: '' }}
+                    fields={[
+                        { key: 'nombres', label: 'Nombres' },
+                        { key: 'parentesco', label: 'Parentesco' },
+                        { key: 'telefono', label: 'Teléfono' },
+                    ]}
+                    onChange={(v) => onChange('contactos_emergencia', v)}
+                />
+            </div>
+
+            <div className="mt-8 pt-6 border-t border-slate-100">
+                <label className="flex items-center gap-3 cursor-pointer">
+                    <input
+                        type="checkbox"
+                        checked={!!data.seguro_medico?.tiene_seguro}
+                        onChange={(e) => onChange('seguro_medico', { ...(data.seguro_medico || {}), tiene_seguro: e.target.checked })}
+                        className="w-5 h-5 text-indigo-600 rounded focus:ring-indigo-500 border-slate-300"
+                    />
+                    <span className="font-bold text-sm text-slate-700">Cuenta con seguro médico</span>
+                </label>
+                {data.seguro_medico?.tiene_seguro && (
+                    <div className="grid grid-cols-1 sm:grid-cols-3 gap-3 mt-4 pl-8 animate-in fade-in">
+                        {[['aseguradora', 'Aseguradora'], ['numero_poliza', 'N.º de póliza'], ['telefono', 'Teléfono de la aseguradora']].map(([key, label]) => (
+                            <BaseInput
+                                key={key}
+                                value={data.seguro_medico[key] || ''}
+                                placeholder={label}
+                                onChange={(e) => onChange('seguro_medico', { ...data.seguro_medico, [key]: e.target.value })}
+                            />
+                        ))}
+                    </div>
+                )}
+            </div>
+        </div>
+
         <div className="bg-white rounded-xl shadow-sm border border-slate-200 overflow-hidden">
             <div className="p-6 border-b border-slate-100 bg-slate-50/30">
                 <div className="flex items-center gap-2">
@@ -344,7 +421,7 @@ export const HealthTab = ({ data, onChange, onFileSelect, onPreview }) => (
     </div>
 );
 
-export const SocialTab = ({ history, social, rutaCroquis, subjectsList, onChangeHistory, onChangeSocial, onFileSelect, onPreview }) => (
+export const SocialTab = ({ history, social, rutaCroquis, direccion, ubicacion, subjectsList, onChangeHistory, onChangeSocial, onChangeDireccion, onChangeUbicacion, onFileSelect, onPreview }) => (
     <div className="animate-in fade-in slide-in-from-bottom-4 duration-500">
         <div className="bg-white rounded-xl shadow-sm border border-slate-200 p-6 mb-6">
             <SectionTitle title="Antecedentes Educativos" icon={Users} />
@@ -441,6 +518,25 @@ export const SocialTab = ({ history, social, rutaCroquis, subjectsList, onChange
                                 <MapPin className="w-4 h-4 text-slate-500" />
                                 <h4 className="text-xs font-bold text-slate-500 uppercase tracking-wide">Ubicación Domiciliaria</h4>
                             </div>
+                            <div className="grid grid-cols-2 gap-4 mb-4">
+                                <div className="col-span-2">
+                                    <InputGroup label="Dirección">
+                                        <BaseInput value={direccion} onChange={(e) => onChangeDireccion(e.target.value)} placeholder="Calle principal, número y referencia..." className="bg-white" />
+                                    </InputGroup>
+                                </div>
+                                <InputGroup label="Parroquia">
+                                    <BaseInput value={ubicacion.parroquia} onChange={(e) => onChangeUbicacion('parroquia', e.target.value)} className="bg-white" />
+                                </InputGroup>
+                                <InputGroup label="Barrio / Recinto">
+                                    <BaseInput value={ubicacion.barrio_recinto} onChange={(e) => onChangeUbicacion('barrio_recinto', e.target.value)} className="bg-white" />
+                                </InputGroup>
+                                <InputGroup label="Latitud (opcional)">
+                                    <BaseInput type="number" step="any" value={ubicacion.latitud} onChange={(e) => onChangeUbicacion('latitud', e.target.value)} placeholder="Ej: -0.180653" className="bg-white" />
+                                </InputGroup>
+                                <InputGroup label="Longitud (opcional)">
+                                    <BaseInput type="number" step="any" value={ubicacion.longitud} onChange={(e) => onChangeUbicacion('longitud', e.target.value)} placeholder="Ej: -78.467834" className="bg-white" />
+                                </InputGroup>
+                            </div>
                             <div className="bg-white p-4 border-2 border-dashed border-slate-200 rounded-xl hover:bg-slate-50 transition-colors">
                                 <FileUploader
                                     label="Subir Croquis de la Vivienda"
@@ -254,4 +254,78 @@ export const PartnerSearch = ({ onSelect }) => {
             )}
         </div>
     )
-}
\ No newline at end of file
+}
+// Lista editable de registros con los mismos campos (alergias, medicaciones, contactos...)
+export const ListEditor = ({ title, items, fields, emptyItem, onChange, addLabel = 'Agregar' }) => {
+    const rows = items || [];
+
+    const updateRow = (index, key, value) => {
+        onChange(rows.map((row, i) => (i === index ? { ...row, [key]: value } : row)));
+    };
+
+    return (
+        <div className="space-y-3">
+            <div className="flex items-center justify-between">
+                <h4 className="text-xs font-bold text-slate-500 uppercase tracking-wide">{title}</h4>
+                <button
+                    type="button"
+                    onClick={() => onChange([...rows, { ...emptyItem }])}
+                    className="flex items-center gap-1 px-2.5 py-1 text-xs font-bold text-indigo-600 bg-indigo-50 border border-indigo-100 rounded-lg hover:bg-indigo-100 transition-colors"
+                >
+                    <Plus className="w-3.5 h-3.5" /> {addLabel}
+                </button>
+            </div>
+
+            {rows.length === 0 && (
+                <p className="text-xs text-slate-400 italic">Sin registros.</p>
+            )}
+
+            {rows.map((row, index) => (
+                <div key={index} className="flex items-start gap-2 p-3 bg-slate-50/50 border border-slate-200 rounded-xl">
+                    <div className="flex-1 grid grid-cols-1 sm:grid-cols-2 gap-2">
+                        {fields.map(field => {
+                            if (field.type === 'select') {
+                                return (
+                                    <BaseSelect key={field.key} value={row[field.key] || ''} onChange={(e) => updateRow(index, field.key, e.target.value)}>
+                                        <option value="">{field.label}</option>
+                                        {field.options.map(o => <option key={o} value={o}>{o}</option>)}
+                                    </BaseSelect>
+                                );
+                            }
+                            if (field.type === 'checkbox') {
+                                return (
+                                    <label key={field.key} className="flex items-center gap-2 text-xs font-medium text-slate-600 px-1">
+                                        <input
+                                            type="checkbox"
+                                            checked={!!row[field.key]}
+                                            onChange={(e) => updateRow(index, field.key, e.target.checked)}
+                                            className="w-4 h-4 text-indigo-600 rounded border-slate-300"
+                                        />
+                                        {field.label}
+                                    </label>
+                                );
+                            }
+                            return (
+                                <BaseInput
+                                    key={field.key}
+                                    value={row[field.key] || ''}
+                                    placeholder={field.label}
+                                    onChange={(e) => updateRow(index, field.key, e.target.value)}
+                                    className={field.wide ? 'sm:col-span-2' : ''}
+                                />
+                            );
+                        })}
+                    </div>
+                    <button
+                        type="button"
+                        onClick={() => onChange(rows.filter((_, i) => i !== index))}
+                        className="p-1.5 text-slate-400 hover:text-red-600 hover:bg-red-50 rounded-lg transition-colors"
+                        title="Quitar"
+                    >
+                        <Trash2 className="w-4 h-4" />
+                    </button>
+                </div>
+            ))}
+        </div>
+    );
+};
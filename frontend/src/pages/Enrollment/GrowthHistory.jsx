@@ -0,0 +1,159 @@
+import React, { useState, useEffect } from 'react';
+import { toast } from 'sonner';
+import { TrendingUp, Plus, Trash2, Loader2 } from 'lucide-react';
+import { RegistrarMedicion, ObtenerMediciones, EliminarMedicion } from '../../../wailsjs/go/services/HealthService';
+import { SectionTitle, InputGroup, BaseInput } from './EnrollmentUI';
+
+const estiloClasificacion = (clasificacion) => {
+    switch (clasificacion) {
+        case 'Normal':
+            return 'bg-emerald-50 text-emerald-700 border-emerald-200';
+        case 'Sin referencia':
+            return 'bg-slate-50 text-slate-500 border-slate-200';
+        case 'Riesgo de sobrepeso':
+        case 'Sobrepeso':
+        case 'Delgadez':
+        case 'Emaciación':
+            return 'bg-orange-50 text-orange-700 border-orange-200';
+        default:
+            return 'bg-red-50 text-red-700 border-red-200';
+    }
+};
+
+const hoy = () => new Date().toISOString().slice(0, 10);
+
+export default function GrowthHistory({ studentId, matriculaId }) {
+    const [mediciones, setMediciones] = useState([]);
+    const [isLoading, setIsLoading] = useState(false);
+    const [isSaving, setIsSaving] = useState(false);
+    const [form, setForm] = useState({ fecha: hoy(), peso_kg: '', talla_cm: '', observacion: '' });
+
+    const cargar = async () => {
+        setIsLoading(true);
+        try {
+            const data = await ObtenerMediciones(studentId);
+            setMediciones(data || []);
+        } catch (error) {
+            toast.error('Error al cargar las mediciones: ' + error);
+        } finally {
+            setIsLoading(false);
+        }
+    };
+
+    useEffect(() => {
+        if (studentId > 0) cargar();
+    }, [studentId]);
+
+    const handleRegistrar = async () => {
+        setIsSaving(true);
+        try {
+            const medicion = await RegistrarMedicion({
+                matricula_id: matriculaId,
+                fecha: form.fecha,
+                peso_kg: parseFloat(form.peso_kg) || 0,
+                talla_cm: parseFloat(form.talla_cm) || 0,
+                observacion: form.observacion
+            });
+            toast.success(`Medición registrada: ${medicion.clasificacion}`);
+            setForm({ fecha: hoy(), peso_kg: '', talla_cm: '', observacion: '' });
+            cargar();
+        } catch (error) {
+            toast.error(String(error));
+        } finally {
+            setIsSaving(false);
+        }
+    };
+
+    const handleEliminar = async (id) => {
+        try {
+            await EliminarMedicion(id);
+            toast.success('Medición eliminada');
+            cargar();
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    return (
+        <div className="bg-white rounded-xl shadow-sm border border-slate-200 p-6">
+            <SectionTitle title="Historial de Crecimiento (IMC para la edad - OMS)" icon={TrendingUp} />
+
+            {matriculaId > 0 ? (
+                <div className="grid grid-cols-1 sm:grid-cols-5 gap-3 mt-6 items-end">
+                    <InputGroup label="Fecha">
+                        <BaseInput type="date" value={form.fecha} max={hoy()} onChange={(e) => setForm({ ...form, fecha: e.target.value })} />
+                    </InputGroup>
+                    <InputGroup label="Peso (kg)">
+                        <BaseInput type="number" step="0.1" value={form.peso_kg} onChange={(e) => setForm({ ...form, peso_kg: e.target.value })} placeholder="0.0" />
+                    </InputGroup>
+                    <InputGroup label="Talla (cm)">
+                        <BaseInput type="number" step="0.1" value={form.talla_cm} onChange={(e) => setForm({ ...form, talla_cm: e.target.value })} placeholder="0.0" />
+                    </InputGroup>
+                    <InputGroup label="Observación">
+                        <BaseInput value={form.observacion} onChange={(e) => setForm({ ...form, observacion: e.target.value })} placeholder="Opcional" />
+                    </InputGroup>
+                    <button
+                        type="button"
+                        onClick={handleRegistrar}
+                        disabled={isSaving || !form.peso_kg || !form.talla_cm}
+                        className="flex items-center justify-center gap-2 h-11 px-4 bg-blue-600 text-white rounded-lg hover:bg-blue-700 transition-all text-sm font-bold disabled:opacity-50"
+                    >
+                        {isSaving ? <Loader2 className="w-4 h-4 animate-spin" /> : <Plus className="w-4 h-4" />} Registrar
+                    </button>
+                </div>
+            ) : (
+                <p className="mt-4 text-xs text-slate-400 italic">Guarde la matrícula para registrar mediciones.</p>
+            )}
+
+            <div className="mt-6 overflow-x-auto">
+                {isLoading ? (
+                    <div className="flex justify-center py-6"><Loader2 className="w-5 h-5 animate-spin text-slate-400" /></div>
+                ) : mediciones.length === 0 ? (
+                    <p className="text-xs text-slate-400 italic">Sin mediciones registradas.</p>
+                ) : (
+                    <table className="w-full text-sm">
+                        <thead>
+                            <tr className="text-left text-xs font-bold text-slate-500 uppercase border-b border-slate-200">
+                                <th className="py-2 pr-3">Fecha</th>
+                                <th className="py-2 pr-3">Periodo</th>
+                                <th className="py-2 pr-3">Edad</th>
+                                <th className="py-2 pr-3 text-right">Peso</th>
+                                <th className="py-2 pr-3 text-right">Talla</th>
+                                <th className="py-2 pr-3 text-right">IMC</th>
+                                <th className="py-2 pr-3 text-right">Puntaje z</th>
+                                <th className="py-2 pr-3">Clasificación</th>
+                                <th className="py-2"></th>
+                            </tr>
+                        </thead>
+                        <tbody>
+                            {[...mediciones].reverse().map(m => (
+                                <tr key={m.id} className="border-b border-slate-100" title={m.observacion}>
+                                    <td className="py-2 pr-3 font-medium text-slate-700">{m.fecha}</td>
+                                    <td className="py-2 pr-3 text-slate-500">{m.periodo_lectivo} · {m.curso}</td>
+                                    <td className="py-2 pr-3 text-slate-500">{m.edad}</td>
+                                    <td className="py-2 pr-3 text-right">{m.peso_kg.toFixed(1)}</td>
+                                    <td className="py-2 pr-3 text-right">{m.talla_cm.toFixed(1)}</td>
+                                    <td className="py-2 pr-3 text-right font-bold">{m.imc.toFixed(1)}</td>
+                                    <td className="py-2 pr-3 text-right">{m.zscore_imc === null || m.zscore_imc === undefined ? '-' : m.zscore_imc.toFixed(2)}</td>
+                                    <td className="py-2 pr-3">
+                                        <span className={`px-2 py-0.5 text-xs font-bold rounded-full border ${estiloClasificacion(m.clasificacion)}`}>{m.clasificacion}</span>
+                                    </td>
+                                    <td className="py-2 text-right">
+                                        <button
+                                            type="button"
+                                            onClick={() => handleEliminar(m.id)}
+                                            className="p-1.5 text-slate-400 hover:text-red-600 hover:bg-red-50 rounded-lg transition-all"
+                                            title="Eliminar medición"
+                                        >
+                                            <Trash2 className="w-4 h-4" />
+                                        </button>
+                                    </td>
+                                </tr>
+                            ))}
+                        </tbody>
+                    </table>
+                )}
+            </div>
+        </div>
+    );
+}
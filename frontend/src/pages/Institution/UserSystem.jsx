@@ -3,7 +3,7 @@ import {
     CheckCircle2, XCircle, Loader2, X, UserCog, Edit, Camera, User
 } from 'lucide-react';
 import { toast } from 'sonner';
-import { ListarUsuarios, CambiarMiClave, ActualizarUsuario, SubirFotoPerfil, ObtenerFotoPerfilBase64 } from "../../../wailsjs/go/services/UserService";
+import { ListarUsuarios, CambiarMiClave, ActualizarUsuario, SubirFotoPerfil, ObtenerFotoPerfilBase64, ObtenerMiniaturaPerfilBase64 } from "../../../wailsjs/go/services/UserService";
 import { useState, useEffect } from 'react';
 
 const UserSystem = () => {
@@ -30,7 +30,7 @@ const UserSystem = () => {
             for (const user of (data || [])) {
                 if (user.foto_perfil) {
                     try {
-                        const base64 = await ObtenerFotoPerfilBase64(user.id);
+                        const base64 = await ObtenerMiniaturaPerfilBase64(user.id);
                         if (base64) photos[user.id] = base64;
                     } catch { }
                 }
@@ -1,11 +1,11 @@
 import React, { useState, useEffect } from 'react';
 import {
     Search, FileText, User, Users, ShieldAlert,
-    AlertTriangle, Download, Loader2, Printer, FileWarning
+    AlertTriangle, Download, Loader2, Printer, FileWarning, HeartPulse
 } from 'lucide-react';
 import { toast } from 'sonner';
-import { BuscarEstudiantes, ObtenerFotoBase64 } from '../../../wailsjs/go/services/StudentService';
-import { ObtenerDatosFichaEstudiantil, GenerarReporteFichaEstudiantil, AbrirUbicacionReporte } from '../../../wailsjs/go/reports/ReportService';
+import { BuscarEstudiantes, ObtenerMiniaturaBase64 } from '../../../wailsjs/go/services/StudentService';
+import { ObtenerDatosFichaEstudiantil, GenerarReporteFichaEstudiantil, GenerarTarjetaEmergenciaPDF, AbrirUbicacionReporte } from '../../../wailsjs/go/reports/ReportService';
 
 const SectionCard = ({ title, icon: Icon, children, className = "" }) => (
     <div className={`bg-white rounded-xl shadow-sm border border-slate-200 overflow-hidden ${className}`}>
@@ -97,7 +97,7 @@ const FichaEstudiantilReport = () => {
 
         // Load photo
         try {
-            const photo = await ObtenerFotoBase64(student.id);
+            const photo = await ObtenerMiniaturaBase64(student.id);
             if (photo) setStudentPhoto(photo);
         } catch (e) {
             console.error("Error loading photo", e);
@@ -137,6 +137,23 @@ const FichaEstudiantilReport = () => {
         );
     };
 
+    const handleExportEmergencyCard = async () => {
+        if (!selectedStudent) return;
+
+        toast.promise(
+            async () => {
+                const path = await GenerarTarjetaEmergenciaPDF(selectedStudent.id);
+                if (path) await AbrirUbicacionReporte(path);
+                return path;
+            },
+            {
+                loading: 'Generando tarjeta de emergencia...',
+                success: 'Tarjeta de emergencia generada',
+                error: (err) => `Error: ${err}`
+            }
+        );
+    };
+
     return (
         <div className="flex flex-col gap-6 animate-in fade-in duration-300 p-6 min-h-screen">
             <div className="bg-white rounded-xl shadow-sm p-5 border border-slate-200 flex flex-col sm:flex-row justify-between items-center gap-4">
@@ -159,6 +176,13 @@ const FichaEstudiantilReport = () => {
                             <Download className="w-4 h-4" />
                             <span>Descargar PDF</span>
                         </button>
+                        <button
+                            onClick={handleExportEmergencyCard}
+                            className="flex-1 sm:flex-none flex items-center justify-center gap-2 px-5 py-2.5 bg-white text-rose-600 border border-rose-200 rounded-lg hover:bg-rose-50 transition-all text-sm font-bold shadow-sm active:scale-95"
+                        >
+                            <HeartPulse className="w-4 h-4" />
+                            <span>Tarjeta de Emergencia</span>
+                        </button>
                     </div>
                 )}
             </div>
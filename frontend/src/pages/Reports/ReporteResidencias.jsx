@@ -0,0 +1,193 @@
+import React, { useState, useEffect } from 'react';
+import { MapPin, Download, Loader2, Search, Globe, Map as MapIcon, EyeOff } from 'lucide-react';
+import { toast } from 'sonner';
+import {
+    ObtenerResidencias, GenerarResidenciasPDF, ExportarResidenciasGeoJSON, ExportarResidenciasKML, AbrirUbicacionReporte
+} from '../../../wailsjs/go/reports/ReportService';
+import { ListarCursos } from '../../../wailsjs/go/services/CourseService';
+import { ObtenerPeriodoActivo } from '../../../wailsjs/go/academic/YearService';
+
+const SINPARROQUIA = 'Sin parroquia registrada';
+
+const TablaSectores = ({ titulo, filas, conBarrio }) => (
+    <div className="bg-white rounded-xl shadow-sm border border-slate-200 overflow-hidden">
+        <div className="bg-slate-50 px-6 py-4 border-b border-slate-200 font-bold text-slate-800">{titulo}</div>
+        {filas.length === 0 ? (
+            <div className="text-center py-10 text-slate-400 italic text-sm">Sin datos</div>
+        ) : (
+            <table className="w-full text-left text-sm">
+                <thead>
+                    <tr className="bg-slate-50 border-b border-slate-200 text-xs font-bold text-slate-500 uppercase tracking-wider">
+                        <th className="px-6 py-3">{conBarrio ? 'Barrio / Recinto' : 'Parroquia'}</th>
+                        <th className="px-6 py-3 text-right">Estudiantes</th>
+                        <th className="px-6 py-3 text-right">Con coordenadas</th>
+                        <th className="px-6 py-3 text-right">Casos abiertos</th>
+                    </tr>
+                </thead>
+                <tbody className="divide-y divide-slate-100">
+                    {filas.map(f => (
+                        <tr key={`${f.parroquia}|${f.barrio_recinto}`} className="hover:bg-slate-50/50">
+                            <td className={`px-6 py-3 font-medium ${f.parroquia === SINPARROQUIA ? 'text-slate-400 italic' : 'text-slate-700'}`}>
+                                {conBarrio ? <>{f.barrio_recinto} <span className="text-xs text-slate-400">({f.parroquia})</span></> : f.parroquia}
+                            </td>
+                            <td className="px-6 py-3 text-right text-slate-600">{f.estudiantes}</td>
+                            <td className="px-6 py-3 text-right text-slate-600">{f.con_coordenadas}</td>
+                            <td className={`px-6 py-3 text-right font-bold ${f.casos_abiertos > 0 ? 'text-rose-600' : 'text-slate-400'}`}>{f.casos_abiertos}</td>
+                        </tr>
+                    ))}
+                </tbody>
+            </table>
+        )}
+    </div>
+);
+
+const ReporteResidencias = () => {
+    const [courses, setCourses] = useState([]);
+    const [cursoId, setCursoId] = useState(0);
+    const [seudonimizar, setSeudonimizar] = useState(true);
+    const [reportData, setReportData] = useState(null);
+    const [loading, setLoading] = useState(false);
+    const [exporting, setExporting] = useState(false);
+
+    useEffect(() => {
+        ObtenerPeriodoActivo()
+            .then(periodo => periodo ? ListarCursos(periodo.id) : [])
+            .then(data => setCourses(data || []))
+            .catch(() => setCourses([]));
+    }, []);
+
+    const handleGenerate = async () => {
+        setLoading(true);
+        try {
+            setReportData(await ObtenerResidencias(0, Number(cursoId)));
+        } catch (error) {
+            toast.error("Error al obtener datos: " + error);
+        } finally {
+            setLoading(false);
+        }
+    };
+
+    const handlePDF = async () => {
+        toast.promise(
+            async () => {
+                const path = await GenerarResidenciasPDF(0, Number(cursoId));
+                if (path) await AbrirUbicacionReporte(path);
+                return path;
+            },
+            {
+                loading: 'Generando reporte de residencias...',
+                success: 'Reporte generado y guardado correctamente',
+                error: (err) => `Error al generar PDF: ${err}`
+            }
+        );
+    };
+
+    const handleExport = async (formato) => {
+        setExporting(true);
+        try {
+            const exportar = formato === 'kml' ? ExportarResidenciasKML : ExportarResidenciasGeoJSON;
+            const res = await exportar(0, Number(cursoId), seudonimizar);
+            if (res.exportados === 0) {
+                toast.warning('Ningún estudiante tiene coordenadas registradas; el archivo está vacío');
+            } else {
+                toast.success(`${res.exportados} viviendas exportadas${res.sin_coordenadas ? ` (${res.sin_coordenadas} sin coordenadas)` : ''}`);
+            }
+            await AbrirUbicacionReporte(res.ruta);
+        } catch (error) {
+            toast.error("Error al exportar: " + error);
+        } finally {
+            setExporting(false);
+        }
+    };
+
+    return (
+        <div className="flex flex-col gap-6 animate-in fade-in duration-300 p-6 min-h-screen pb-20">
+            <div className="bg-white rounded-xl shadow-sm p-5 border border-slate-200 flex flex-col sm:flex-row justify-between items-center gap-4">
+                <div className="flex items-center gap-4 w-full sm:w-auto">
+                    <div className="p-3 bg-indigo-50 rounded-xl border border-indigo-100 shadow-sm">
+                        <MapPin className="w-6 h-6 text-indigo-600" />
+                    </div>
+                    <div>
+                        <h1 className="text-xl font-bold text-slate-800 tracking-tight">Residencias por Sector</h1>
+                        <p className="text-slate-500 text-sm font-medium">Planificación de visitas domiciliarias y análisis de zonas de riesgo</p>
+                    </div>
+                </div>
+
+                {reportData && (
+                    <button
+                        onClick={handlePDF}
+                        className="flex items-center justify-center gap-2 px-5 py-2.5 bg-indigo-600 text-white rounded-lg hover:bg-indigo-700 transition-all text-sm font-bold shadow-md active:scale-95"
+                    >
+                        <Download className="w-4 h-4" />
+                        <span>Exportar PDF</span>
+                    </button>
+                )}
+            </div>
+
+            <div className="bg-white p-6 rounded-xl border border-slate-200 shadow-sm flex flex-col md:flex-row items-end gap-6">
+                <div className="flex-1 w-full">
+                    <label className="block text-sm font-semibold text-slate-700 mb-2">Curso (periodo activo)</label>
+                    <select
+                        value={cursoId}
+                        onChange={(e) => setCursoId(e.target.value)}
+                        className="w-full px-4 py-2.5 bg-slate-50 border border-slate-200 rounded-lg text-sm focus:outline-none focus:ring-2 focus:ring-indigo-500/20 focus:border-indigo-500 font-medium text-slate-700"
+                    >
+                        <option value={0}>Todos los cursos</option>
+                        {courses.map(c => <option key={c.id} value={c.id}>{c.nombre_completo}</option>)}
+                    </select>
+                </div>
+                <button
+                    onClick={handleGenerate}
+                    disabled={loading}
+                    className="flex items-center gap-2 px-6 py-2.5 bg-slate-800 text-white rounded-lg hover:bg-slate-900 text-sm font-bold disabled:opacity-50"
+                >
+                    {loading ? <Loader2 className="w-4 h-4 animate-spin" /> : <Search className="w-4 h-4" />}
+                    Consultar
+                </button>
+            </div>
+
+            <div className="bg-white p-6 rounded-xl border border-slate-200 shadow-sm flex flex-col md:flex-row md:items-center justify-between gap-4">
+                <div>
+                    <h3 className="font-bold text-slate-800">Exportar ubicaciones para SIG</h3>
+                    <p className="text-sm text-slate-500">Solo se incluyen los estudiantes con latitud y longitud registradas en la ficha.</p>
+                    <label className="mt-3 flex items-center gap-2 text-sm text-slate-700 cursor-pointer">
+                        <input type="checkbox" checked={seudonimizar} onChange={(e) => setSeudonimizar(e.target.checked)} className="w-4 h-4 rounded text-indigo-600" />
+                        <EyeOff className="w-4 h-4 text-slate-400" />
+                        Seudonimizar (sin nombre, cédula ni dirección; coordenadas redondeadas a ~100 m)
+                    </label>
+                </div>
+                <div className="flex gap-3">
+                    <button onClick={() => handleExport('geojson')} disabled={exporting} className="flex items-center gap-2 px-4 py-2.5 bg-white border border-slate-200 text-slate-700 rounded-lg hover:bg-slate-50 text-sm font-bold disabled:opacity-50">
+                        <Globe className="w-4 h-4" /> GeoJSON
+                    </button>
+                    <button onClick={() => handleExport('kml')} disabled={exporting} className="flex items-center gap-2 px-4 py-2.5 bg-white border border-slate-200 text-slate-700 rounded-lg hover:bg-slate-50 text-sm font-bold disabled:opacity-50">
+                        <MapIcon className="w-4 h-4" /> KML
+                    </button>
+                </div>
+            </div>
+
+            {reportData && (
+                <>
+                    <div className="grid grid-cols-1 md:grid-cols-3 gap-4">
+                        <div className="bg-white p-5 rounded-xl border border-slate-200 shadow-sm">
+                            <p className="text-xs font-bold text-slate-500 uppercase">Estudiantes</p>
+                            <p className="text-2xl font-bold text-slate-800">{reportData.total_estudiantes}</p>
+                        </div>
+                        <div className="bg-white p-5 rounded-xl border border-slate-200 shadow-sm">
+                            <p className="text-xs font-bold text-slate-500 uppercase">Con coordenadas</p>
+                            <p className="text-2xl font-bold text-emerald-600">{reportData.con_coordenadas}</p>
+                        </div>
+                        <div className="bg-white p-5 rounded-xl border border-slate-200 shadow-sm">
+                            <p className="text-xs font-bold text-slate-500 uppercase">Sin parroquia registrada</p>
+                            <p className="text-2xl font-bold text-amber-600">{reportData.sin_parroquia}</p>
+                        </div>
+                    </div>
+                    <TablaSectores titulo="Por parroquia" filas={reportData.por_parroquia || []} />
+                    <TablaSectores titulo="Por barrio o recinto" filas={reportData.por_barrio || []} conBarrio />
+                </>
+            )}
+        </div>
+    );
+};
+
+export default ReporteResidencias;
@@ -0,0 +1,256 @@
+import React, { useState, useEffect } from 'react';
+import { toast } from 'sonner';
+import Swal from 'sweetalert2';
+import {
+    X, Loader2, Plus, Trash2, GitBranch, MessageSquare, Users, ArrowRight, CheckCircle, RotateCcw
+} from 'lucide-react';
+import {
+    ObtenerBitacoraCaso, ListarCatalogosCaso, CambiarEstadoCaso, RegistrarIntervencion, EliminarIntervencion
+} from '../../../wailsjs/go/services/TrackingService';
+
+const hoy = () => new Date().toLocaleDateString('en-CA');
+
+const inputClass = "w-full px-3 py-2 bg-white border border-slate-200 rounded-lg text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none";
+
+export const estiloEstadoCaso = {
+    'Abierto': 'bg-red-50 text-red-600 border-red-100',
+    'En intervención': 'bg-orange-50 text-orange-600 border-orange-100',
+    'Derivado': 'bg-blue-50 text-blue-600 border-blue-100',
+    'En seguimiento': 'bg-amber-50 text-amber-600 border-amber-100',
+    'Cerrado': 'bg-slate-100 text-slate-600 border-slate-200',
+    'Reabierto': 'bg-purple-50 text-purple-600 border-purple-100'
+};
+
+const intervencionVacia = () => ({ fecha: hoy(), participantes: '', accion: '', acuerdos: '', proximos_pasos: '' });
+
+export default function CaseLog({ caso, onClose, onChanged }) {
+    const [bitacora, setBitacora] = useState(null);
+    const [catalogos, setCatalogos] = useState({ transiciones: {}, motivos_cierre: [] });
+    const [isLoading, setIsLoading] = useState(true);
+    const [form, setForm] = useState(null);
+    const [isSaving, setIsSaving] = useState(false);
+
+    const cargar = async () => {
+        try {
+            setBitacora(await ObtenerBitacoraCaso(caso.id));
+        } catch (error) {
+            toast.error(String(error));
+        } finally {
+            setIsLoading(false);
+        }
+    };
+
+    useEffect(() => {
+        ListarCatalogosCaso().then(setCatalogos).catch(() => { });
+        cargar();
+    }, [caso.id]);
+
+    const estado = bitacora?.estado || caso.estado;
+    const cerrado = estado === 'Cerrado';
+    const siguientes = catalogos.transiciones?.[estado] || catalogos.transiciones?.['Abierto'] || [];
+
+    const handleTransicion = async (nuevo) => {
+        const esCierre = nuevo === 'Cerrado';
+        const motivos = (catalogos.motivos_cierre || []).map(m => `<option value="${m}">${m}</option>`).join('');
+        const { value } = await Swal.fire({
+            title: `${estado} → ${nuevo}`,
+            html:
+                `<input id="swal-fecha" type="date" class="swal2-input" value="${hoy()}" max="${hoy()}">` +
+                `<textarea id="swal-nota" class="swal2-textarea" placeholder="Nota: por qué cambia el estado (obligatoria)"></textarea>` +
+                (esCierre
+                    ? `<select id="swal-motivo" class="swal2-select"><option value="">Motivo del cierre...</option>${motivos}</select>` +
+                    `<textarea id="swal-resumen" class="swal2-textarea" placeholder="Resumen del cierre: situación final y resultados"></textarea>`
+                    : ''),
+            showCancelButton: true,
+            confirmButtonText: esCierre ? 'Cerrar caso' : 'Cambiar estado',
+            cancelButtonText: 'Cancelar',
+            confirmButtonColor: esCierre ? '#475569' : '#4f46e5',
+            preConfirm: () => {
+                const datos = {
+                    fecha: document.getElementById('swal-fecha').value,
+                    nota: document.getElementById('swal-nota').value,
+                    motivo_cierre: esCierre ? document.getElementById('swal-motivo').value : '',
+                    resumen_cierre: esCierre ? document.getElementById('swal-resumen').value : ''
+                };
+                if (!datos.nota.trim()) {
+                    Swal.showValidationMessage('La nota es obligatoria');
+                    return false;
+                }
+                if (esCierre && (!datos.motivo_cierre || !datos.resumen_cierre.trim())) {
+                    Swal.showValidationMessage('Indique el motivo y el resumen del cierre');
+                    return false;
+                }
+                return datos;
+            }
+        });
+        if (!value) return;
+        try {
+            await CambiarEstadoCaso({ caso_id: caso.id, estado: nuevo, ...value });
+            toast.success(`Caso ${caso.codigo_caso}: ${nuevo}`);
+            await cargar();
+            onChanged?.();
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    const handleGuardarIntervencion = async (e) => {
+        e.preventDefault();
+        setIsSaving(true);
+        try {
+            await RegistrarIntervencion({ ...form, caso_id: caso.id });
+            toast.success('Intervención registrada');
+            setForm(null);
+            cargar();
+        } catch (error) {
+            toast.error(String(error));
+        } finally {
+            setIsSaving(false);
+        }
+    };
+
+    const handleEliminarIntervencion = async (iv) => {
+        const result = await Swal.fire({
+            title: '¿Eliminar intervención?',
+            text: 'Use esta opción solo para registros ingresados por error.',
+            icon: 'warning',
+            showCancelButton: true,
+            confirmButtonColor: '#d33',
+            confirmButtonText: 'Sí, eliminar',
+            cancelButtonText: 'Cancelar'
+        });
+        if (!result.isConfirmed) return;
+        try {
+            await EliminarIntervencion(iv.id);
+            toast.success('Intervención eliminada');
+            cargar();
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    // Cambios de estado e intervenciones se muestran en una sola línea de tiempo
+    const eventos = bitacora ? [
+        ...bitacora.cambios.map(c => ({ ...c, tipo: 'cambio', clave: `c${c.id}` })),
+        ...bitacora.intervenciones.map(i => ({ ...i, tipo: 'intervencion', clave: `i${i.id}` }))
+    ].sort((a, b) => a.fecha.localeCompare(b.fecha) || a.fecha_registro.localeCompare(b.fecha_registro)) : [];
+
+    return (
+        <div className="fixed inset-0 bg-slate-900/60 backdrop-blur-sm flex justify-center items-center z-50 p-4 animate-in fade-in duration-200">
+            <div className="bg-white rounded-2xl shadow-2xl w-full max-w-4xl max-h-[90vh] flex flex-col overflow-hidden border border-slate-200">
+                <div className="px-8 py-5 border-b border-slate-100 flex justify-between items-center shrink-0">
+                    <div>
+                        <h3 className="text-xl font-bold text-slate-800 flex items-center gap-2">
+                            <GitBranch className="w-6 h-6 text-indigo-600" /> Bitácora del caso {caso.codigo_caso}
+                        </h3>
+                        <div className="flex items-center gap-2 mt-1 ml-8">
+                            <span className={`px-2.5 py-0.5 text-xs font-semibold rounded-full border ${estiloEstadoCaso[estado] || estiloEstadoCaso['Abierto']}`}>{estado}</span>
+                            <span className="text-xs text-slate-500">{caso.tipo_caso}</span>
+                        </div>
+                    </div>
+                    <button onClick={onClose} className="p-2 hover:bg-slate-100 rounded-full text-slate-400 hover:text-slate-600"><X className="w-6 h-6" /></button>
+                </div>
+
+                <div className="px-8 py-4 border-b border-slate-100 bg-slate-50 flex flex-wrap items-center gap-2 shrink-0">
+                    <span className="text-xs font-bold text-slate-500 uppercase mr-2">Pasar a:</span>
+                    {siguientes.map(e => (
+                        <button key={e} onClick={() => handleTransicion(e)} className="flex items-center gap-1 px-3 py-1.5 bg-white border border-slate-200 rounded-lg text-xs font-bold text-slate-700 hover:border-indigo-300 hover:text-indigo-700">
+                            {e === 'Cerrado' ? <CheckCircle className="w-3 h-3" /> : e === 'Reabierto' ? <RotateCcw className="w-3 h-3" /> : <ArrowRight className="w-3 h-3" />} {e}
+                        </button>
+                    ))}
+                    {!cerrado && (
+                        <button onClick={() => setForm(intervencionVacia())} className="ml-auto flex items-center gap-1 px-3 py-1.5 bg-indigo-600 text-white rounded-lg text-xs font-bold hover:bg-indigo-700">
+                            <Plus className="w-3 h-3" /> Registrar intervención
+                        </button>
+                    )}
+                </div>
+
+                <div className="flex-1 overflow-y-auto p-8 space-y-4">
+                    {form && (
+                        <form onSubmit={handleGuardarIntervencion} className="border border-indigo-200 bg-indigo-50/40 rounded-xl p-5 space-y-3">
+                            <div className="grid grid-cols-1 md:grid-cols-3 gap-3">
+                                <div>
+                                    <label className="text-xs font-semibold text-slate-500">Fecha</label>
+                                    <input type="date" max={hoy()} className={inputClass} value={form.fecha} onChange={e => setForm({ ...form, fecha: e.target.value })} required />
+                                </div>
+                                <div className="md:col-span-2">
+                                    <label className="text-xs font-semibold text-slate-500">Participantes</label>
+                                    <input className={inputClass} placeholder="Estudiante, representante, tutor, psicóloga DECE..." value={form.participantes} onChange={e => setForm({ ...form, participantes: e.target.value })} required />
+                                </div>
+                            </div>
+                            <div>
+                                <label className="text-xs font-semibold text-slate-500">Acción realizada</label>
+                                <textarea rows={2} className={inputClass} value={form.accion} onChange={e => setForm({ ...form, accion: e.target.value })} required />
+                            </div>
+                            <div className="grid grid-cols-1 md:grid-cols-2 gap-3">
+                                <div>
+                                    <label className="text-xs font-semibold text-slate-500">Acuerdos</label>
+                                    <textarea rows={2} className={inputClass} value={form.acuerdos} onChange={e => setForm({ ...form, acuerdos: e.target.value })} />
+                                </div>
+                                <div>
+                                    <label className="text-xs font-semibold text-slate-500">Próximos pasos</label>
+                                    <textarea rows={2} className={inputClass} value={form.proximos_pasos} onChange={e => setForm({ ...form, proximos_pasos: e.target.value })} />
+                                </div>
+                            </div>
+                            <div className="flex justify-end gap-2">
+                                <button type="button" onClick={() => setForm(null)} className="px-4 py-2 text-sm font-semibold text-slate-600 hover:bg-slate-100 rounded-lg">Cancelar</button>
+                                <button type="submit" disabled={isSaving} className="flex items-center gap-2 px-4 py-2 bg-indigo-600 text-white rounded-lg text-sm font-bold hover:bg-indigo-700 disabled:opacity-50">
+                                    {isSaving && <Loader2 className="w-4 h-4 animate-spin" />} Guardar
+                                </button>
+                            </div>
+                        </form>
+                    )}
+
+                    {isLoading ? (
+                        <div className="flex justify-center py-10"><Loader2 className="w-6 h-6 animate-spin text-slate-400" /></div>
+                    ) : eventos.length === 0 ? (
+                        <p className="text-sm text-slate-400 italic text-center py-6">Sin registros en la bitácora.</p>
+                    ) : (
+                        <ol className="relative border-l-2 border-slate-200 ml-3 space-y-5">
+                            {eventos.map(ev => (
+                                <li key={ev.clave} className="ml-6">
+                                    <span className={`absolute -left-[11px] flex items-center justify-center w-5 h-5 rounded-full ring-4 ring-white ${ev.tipo === 'cambio' ? 'bg-indigo-100 text-indigo-600' : 'bg-emerald-100 text-emerald-600'}`}>
+                                        {ev.tipo === 'cambio' ? <GitBranch className="w-3 h-3" /> : <MessageSquare className="w-3 h-3" />}
+                                    </span>
+                                    {ev.tipo === 'cambio' ? (
+                                        <div className="text-sm">
+                                            <div className="flex flex-wrap items-center gap-2">
+                                                <span className="text-xs font-mono text-slate-500">{ev.fecha}</span>
+                                                {ev.estado_anterior && <span className="text-xs text-slate-500">{ev.estado_anterior} →</span>}
+                                                <span className={`px-2 py-0.5 text-xs font-semibold rounded-full border ${estiloEstadoCaso[ev.estado_nuevo] || ''}`}>{ev.estado_nuevo}</span>
+                                            </div>
+                                            <p className="text-slate-700 mt-1">{ev.nota}</p>
+                                            {ev.motivo_cierre && (
+                                                <div className="mt-2 p-3 bg-slate-50 border border-slate-200 rounded-lg">
+                                                    <p className="text-xs font-bold text-slate-600">Motivo del cierre: {ev.motivo_cierre}</p>
+                                                    <p className="text-sm text-slate-700 mt-1 whitespace-pre-line">{ev.resumen_cierre}</p>
+                                                </div>
+                                            )}
+                                            <p className="text-[11px] text-slate-400 mt-1">{ev.nombre_usuario || 'sistema'} · {ev.fecha_registro}</p>
+                                        </div>
+                                    ) : (
+                                        <div className="bg-white border border-slate-200 rounded-xl p-4 text-sm">
+                                            <div className="flex justify-between gap-2">
+                                                <div className="flex items-center gap-2">
+                                                    <span className="text-xs font-mono text-slate-500">{ev.fecha}</span>
+                                                    <span className="flex items-center gap-1 text-xs text-slate-600"><Users className="w-3 h-3" /> {ev.participantes}</span>
+                                                </div>
+                                                {!cerrado && (
+                                                    <button onClick={() => handleEliminarIntervencion(ev)} className="p-1 text-slate-300 hover:text-red-600" title="Eliminar"><Trash2 className="w-4 h-4" /></button>
+                                                )}
+                                            </div>
+                                            <p className="text-slate-800 mt-2 whitespace-pre-line">{ev.accion}</p>
+                                            {ev.acuerdos && <p className="text-slate-600 mt-2"><span className="font-semibold">Acuerdos:</span> {ev.acuerdos}</p>}
+                                            {ev.proximos_pasos && <p className="text-slate-600 mt-1"><span className="font-semibold">Próximos pasos:</span> {ev.proximos_pasos}</p>}
+                                            <p className="text-[11px] text-slate-400 mt-2">{ev.nombre_usuario || 'sistema'} · {ev.fecha_registro}</p>
+                                        </div>
+                                    )}
+                                </li>
+                            ))}
+                        </ol>
+                    )}
+                </div>
+            </div>
+        </div>
+    );
+}
@@ -1,24 +1,26 @@
 import React, { useState, useEffect } from 'react';
 import { toast } from 'sonner';
 import {
-    Search, User, Loader2, FileText, AlertCircle, ShieldAlert, Gavel, Shield
+    Search, User, Loader2, FileText, AlertCircle, ShieldAlert, Gavel, Shield, Baby
 } from 'lucide-react';
 
 import { BuscarEstudiantesActivos } from '../../../wailsjs/go/services/TrackingService';
-import { ObtenerFotoBase64 } from '../../../wailsjs/go/services/StudentService';
+import { ObtenerMiniaturaBase64 } from '../../../wailsjs/go/services/StudentService';
 import { ObtenerConfiguracion } from '../../../wailsjs/go/services/SecurityConfigService';
 import ModuleAuthGate from '../../components/ModuleAuthGate';
+import { useScreenLock } from '../../context/ScreenLockContext';
 
 import LlamadosAtencion from './Warning';
 import SensitiveManager from './SensitiveManager';
+import ProtocolManager from './ProtocolManager';
 
-const StudentResultCard = ({ student, onAction }) => {
+const StudentResultCard = ({ student, onAction, verProtocolos }) => {
     const [photo, setPhoto] = useState(null);
 
     useEffect(() => {
         let isMounted = true;
         if (student.id) {
-            ObtenerFotoBase64(student.id).then(b64 => {
+            ObtenerMiniaturaBase64(student.id).then(b64 => {
                 if (isMounted && b64) setPhoto(b64);
             }).catch(() => {
             });
@@ -76,6 +78,15 @@ const StudentResultCard = ({ student, onAction }) => {
                     <ShieldAlert className="w-4 h-4" />
                     Caso Sensible
                 </button>
+                {verProtocolos && (
+                    <button
+                        onClick={(e) => { e.stopPropagation(); onAction('protocol', student); }}
+                        className="flex-1 sm:flex-none flex items-center justify-center gap-2 px-4 py-2 bg-pink-50 text-pink-700 text-xs font-bold rounded-lg border border-pink-100 hover:bg-pink-100 hover:border-pink-200 transition-colors whitespace-nowrap shadow-sm"
+                    >
+                        <Baby className="w-4 h-4" />
+                        Protocolo
+                    </button>
+                )}
             </div>
         </div>
     );
@@ -91,6 +102,10 @@ export default function DisciplineManagerPage() {
     const [selectedStudentName, setSelectedStudentName] = useState('');
     const [activeView, setActiveView] = useState(null);
 
+    // Los protocolos de maternidad y paternidad son solo para la coordinación del DECE
+    const { user } = useScreenLock();
+    const verProtocolos = user?.rol === 'admin';
+
     // ── Control de acceso ──
     const [requiresAuth, setRequiresAuth] = useState(null); // null = cargando, true/false
     const [isAuthenticated, setIsAuthenticated] = useState(false);
@@ -157,6 +172,11 @@ export default function DisciplineManagerPage() {
             setSelectedStudentId(student.id);
             setSelectedStudentName(`${student.apellidos} ${student.nombres}`);
             setActiveView('sensitive');
+        } else if (type === 'protocol') {
+            setSelectedStudentId(student.id);
+            setSelectedMatriculaId(student.matricula_id || null);
+            setSelectedStudentName(`${student.apellidos} ${student.nombres}`);
+            setActiveView('protocol');
         }
     };
 
@@ -201,6 +221,15 @@ export default function DisciplineManagerPage() {
                         onBack={handleBackToSearch}
                     />
                 </div>
+            ) : activeView === 'protocol' || activeView === 'protocols' ? (
+                <div className="animate-in slide-in-from-right duration-300 h-full">
+                    <ProtocolManager
+                        studentId={activeView === 'protocol' ? selectedStudentId : null}
+                        studentName={selectedStudentName}
+                        matriculaId={selectedMatriculaId}
+                        onBack={handleBackToSearch}
+                    />
+                </div>
             ) : (
                 <div className="mx-auto w-full flex flex-col gap-6">
 
@@ -215,12 +244,23 @@ export default function DisciplineManagerPage() {
                             </div>
                         </div>
 
-                        {requiresAuth && (
-                            <div className="flex items-center gap-1.5 px-3 py-1.5 bg-purple-50 text-purple-600 rounded-lg border border-purple-100 text-xs font-bold">
-                                <Shield className="w-3.5 h-3.5" />
-                                Módulo protegido
-                            </div>
-                        )}
+                        <div className="flex items-center gap-2">
+                            {verProtocolos && (
+                                <button
+                                    onClick={() => setActiveView('protocols')}
+                                    className="flex items-center gap-1.5 px-3 py-1.5 bg-pink-50 text-pink-700 rounded-lg border border-pink-100 text-xs font-bold hover:bg-pink-100 transition-colors"
+                                >
+                                    <Baby className="w-3.5 h-3.5" />
+                                    Protocolos de maternidad
+                                </button>
+                            )}
+                            {requiresAuth && (
+                                <div className="flex items-center gap-1.5 px-3 py-1.5 bg-purple-50 text-purple-600 rounded-lg border border-purple-100 text-xs font-bold">
+                                    <Shield className="w-3.5 h-3.5" />
+                                    Módulo protegido
+                                </div>
+                            )}
+                        </div>
                     </div>
 
                     <div
@@ -288,6 +328,7 @@ export default function DisciplineManagerPage() {
                                                 key={est.id}
                                                 student={est}
                                                 onAction={handleAction}
+                                                verProtocolos={verProtocolos}
                                             />
                                         ))}
                                     </div>
@@ -0,0 +1,539 @@
+import React, { useState, useEffect } from 'react';
+import { toast } from 'sonner';
+import Swal from 'sweetalert2';
+import {
+    ArrowLeft, Baby, Plus, Loader2, CheckCircle, AlertTriangle, Calendar, Clock,
+    MessageSquare, Trash2, Lock, FileText, X, Save, Edit2
+} from 'lucide-react';
+
+import {
+    ListarCatalogosProtocolo, ListarProtocolos, ListarProtocolosEstudiante, ObtenerProtocolo,
+    AbrirProtocolo, CerrarProtocolo, GuardarHito, CumplirHito, EliminarHito,
+    RegistrarContacto, EliminarContacto
+} from '../../../wailsjs/go/services/ProtocolService';
+import { GenerarInformeProtocolosPDF, AbrirUbicacionReporte } from '../../../wailsjs/go/reports/ReportService';
+
+const hoy = () => new Date().toISOString().split('T')[0];
+
+const estiloHito = {
+    'Cumplido': 'bg-emerald-50 text-emerald-700 border-emerald-100',
+    'Vencido': 'bg-red-50 text-red-700 border-red-100',
+    'Próximo': 'bg-amber-50 text-amber-700 border-amber-100',
+    'Programado': 'bg-slate-50 text-slate-600 border-slate-200',
+};
+
+const contactoInicial = (protocoloId) => ({
+    protocolo_id: protocoloId,
+    hito_id: 0,
+    cumple_hito: false,
+    fecha: hoy(),
+    modalidad: '',
+    participantes: '',
+    resumen: '',
+    acuerdos: '',
+});
+
+const ProtocolCard = ({ protocolo, onOpen }) => (
+    <button
+        onClick={() => onOpen(protocolo.id)}
+        className="w-full text-left bg-white rounded-xl border border-slate-200 p-4 hover:shadow-md hover:border-slate-300 transition-all flex flex-col sm:flex-row sm:items-center gap-3"
+    >
+        <div className="flex-1 min-w-0">
+            <div className="flex flex-wrap items-center gap-2">
+                <h4 className="font-bold text-slate-800 truncate">{protocolo.estudiante}</h4>
+                <span className="text-xs font-bold text-pink-700 bg-pink-50 px-2 py-0.5 rounded border border-pink-100">{protocolo.tipo}</span>
+                <span className={`text-xs font-bold px-2 py-0.5 rounded border ${protocolo.estado === 'Abierto' ? 'bg-blue-50 text-blue-700 border-blue-100' : 'bg-slate-100 text-slate-500 border-slate-200'}`}>
+                    {protocolo.estado}
+                </span>
+            </div>
+            <p className="text-xs text-slate-500 mt-1">
+                {protocolo.curso} · {protocolo.periodo_lectivo} · Abierto el {protocolo.fecha_apertura}
+                {protocolo.origen && ` (${protocolo.origen})`}
+            </p>
+        </div>
+        <div className="flex flex-wrap gap-2 text-xs shrink-0">
+            <span className="px-2 py-1 rounded bg-slate-50 border border-slate-200 text-slate-600 font-medium">
+                Hitos {protocolo.hitos_cumplidos}/{protocolo.hitos_total}
+            </span>
+            {protocolo.hitos_vencidos > 0 && (
+                <span className="px-2 py-1 rounded bg-red-50 border border-red-100 text-red-700 font-bold flex items-center gap-1">
+                    <AlertTriangle className="w-3 h-3" /> {protocolo.hitos_vencidos} vencido(s)
+                </span>
+            )}
+            {protocolo.estado === 'Abierto' && protocolo.proximo_hito && (
+                <span className="px-2 py-1 rounded bg-amber-50 border border-amber-100 text-amber-700 font-medium">
+                    {protocolo.proximo_hito}: {protocolo.fecha_proximo_hito}
+                </span>
+            )}
+        </div>
+    </button>
+);
+
+export default function ProtocolManager({ studentId, studentName, matriculaId, onBack }) {
+    const [catalogos, setCatalogos] = useState({ tipos: [], tipos_hito: [], modalidades: [] });
+    const [protocolos, setProtocolos] = useState([]);
+    const [filtroEstado, setFiltroEstado] = useState('Abierto');
+    const [isLoading, setIsLoading] = useState(false);
+
+    const [detalle, setDetalle] = useState(null);
+    const [contacto, setContacto] = useState(null);
+    const [hitoForm, setHitoForm] = useState(null);
+    const [isSubmitting, setIsSubmitting] = useState(false);
+
+    useEffect(() => {
+        ListarCatalogosProtocolo().then(setCatalogos).catch(() => { });
+    }, []);
+
+    useEffect(() => {
+        cargarProtocolos();
+    }, [studentId, filtroEstado]);
+
+    const cargarProtocolos = async () => {
+        setIsLoading(true);
+        try {
+            const data = studentId
+                ? await ListarProtocolosEstudiante(studentId)
+                : await ListarProtocolos(filtroEstado);
+            setProtocolos(data || []);
+        } catch (error) {
+            toast.error("Error al cargar protocolos: " + error);
+        } finally {
+            setIsLoading(false);
+        }
+    };
+
+    const abrirDetalle = async (id) => {
+        try {
+            setDetalle(await ObtenerProtocolo(id));
+        } catch (error) {
+            toast.error("Error: " + error);
+        }
+    };
+
+    const refrescar = async () => {
+        if (detalle) await abrirDetalle(detalle.id);
+        cargarProtocolos();
+    };
+
+    const handleAbrir = async () => {
+        if (!matriculaId) {
+            toast.error("El estudiante no tiene una matrícula activa en el periodo vigente.");
+            return;
+        }
+        const opciones = Object.fromEntries(catalogos.tipos.map(t => [t, t]));
+        const { value: tipo } = await Swal.fire({
+            title: 'Abrir protocolo',
+            text: studentName,
+            input: 'select',
+            inputOptions: opciones,
+            inputPlaceholder: 'Seleccione el tipo',
+            showCancelButton: true,
+            confirmButtonText: 'Abrir',
+            cancelButtonText: 'Cancelar',
+            inputValidator: (value) => !value && 'Seleccione el tipo de protocolo',
+        });
+        if (!tipo) return;
+        try {
+            const nuevo = await AbrirProtocolo(matriculaId, tipo);
+            toast.success("Protocolo abierto con su plan de seguimiento");
+            setDetalle(nuevo);
+            cargarProtocolos();
+        } catch (error) {
+            toast.error("Error: " + error);
+        }
+    };
+
+    const handleCerrar = async () => {
+        const { value: motivo } = await Swal.fire({
+            title: 'Cerrar protocolo',
+            input: 'textarea',
+            inputLabel: 'Motivo del cierre',
+            inputPlaceholder: 'Ej: Culminó el periodo de lactancia',
+            showCancelButton: true,
+            confirmButtonText: 'Cerrar protocolo',
+            cancelButtonText: 'Cancelar',
+            inputValidator: (value) => !value?.trim() && 'El motivo es obligatorio',
+        });
+        if (!motivo) return;
+        try {
+            await CerrarProtocolo(detalle.id, motivo);
+            toast.success("Protocolo cerrado");
+            refrescar();
+        } catch (error) {
+            toast.error("Error: " + error);
+        }
+    };
+
+    const handleCumplir = async (hito) => {
+        const { value } = await Swal.fire({
+            title: 'Marcar como cumplido',
+            html: `<p class="text-sm mb-2">${hito.tipo} (${hito.fecha_programada})</p>` +
+                `<input id="swal-fecha" type="date" class="swal2-input" value="${hoy()}">` +
+                `<textarea id="swal-obs" class="swal2-textarea" placeholder="Observación (opcional)"></textarea>`,
+            showCancelButton: true,
+            confirmButtonText: 'Guardar',
+            cancelButtonText: 'Cancelar',
+            preConfirm: () => ({
+                fecha: document.getElementById('swal-fecha').value,
+                observacion: document.getElementById('swal-obs').value,
+            }),
+        });
+        if (!value) return;
+        try {
+            await CumplirHito(hito.id, value.fecha, value.observacion);
+            toast.success("Hito cumplido");
+            refrescar();
+        } catch (error) {
+            toast.error("Error: " + error);
+        }
+    };
+
+    const handleEliminarHito = async (hito) => {
+        const result = await Swal.fire({
+            title: '¿Eliminar hito?',
+            text: `${hito.tipo} (${hito.fecha_programada})`,
+            icon: 'warning',
+            showCancelButton: true,
+            confirmButtonText: 'Sí, eliminar',
+            cancelButtonText: 'Cancelar',
+            reverseButtons: true,
+        });
+        if (!result.isConfirmed) return;
+        try {
+            await EliminarHito(hito.id);
+            toast.success("Hito eliminado");
+            refrescar();
+        } catch (error) {
+            toast.error("Error: " + error);
+        }
+    };
+
+    const handleGuardarHito = async (e) => {
+        e.preventDefault();
+        setIsSubmitting(true);
+        try {
+            await GuardarHito(hitoForm);
+            toast.success(hitoForm.id ? "Hito reprogramado" : "Hito agregado al plan");
+            setHitoForm(null);
+            refrescar();
+        } catch (error) {
+            toast.error("Error: " + error);
+        } finally {
+            setIsSubmitting(false);
+        }
+    };
+
+    const handleGuardarContacto = async (e) => {
+        e.preventDefault();
+        setIsSubmitting(true);
+        try {
+            await RegistrarContacto({ ...contacto, hito_id: Number(contacto.hito_id) || 0 });
+            toast.success("Contacto registrado");
+            setContacto(null);
+            refrescar();
+        } catch (error) {
+            toast.error("Error: " + error);
+        } finally {
+            setIsSubmitting(false);
+        }
+    };
+
+    const handleEliminarContacto = async (c) => {
+        const result = await Swal.fire({
+            title: '¿Eliminar contacto?',
+            text: c.hito ? 'El hito que cumplió este contacto volverá a quedar pendiente.' : 'Esta acción no se puede deshacer.',
+            icon: 'warning',
+            showCancelButton: true,
+            confirmButtonText: 'Sí, eliminar',
+            cancelButtonText: 'Cancelar',
+            reverseButtons: true,
+        });
+        if (!result.isConfirmed) return;
+        try {
+            await EliminarContacto(c.id);
+            toast.success("Contacto eliminado");
+            refrescar();
+        } catch (error) {
+            toast.error("Error: " + error);
+        }
+    };
+
+    const handleInforme = async () => {
+        toast.promise(
+            async () => {
+                const path = await GenerarInformeProtocolosPDF(0);
+                if (path) await AbrirUbicacionReporte(path);
+                return path;
+            },
+            {
+                loading: 'Generando informe confidencial...',
+                success: 'Informe generado correctamente',
+                error: (err) => `${err}`,
+            }
+        );
+    };
+
+    const abierto = detalle?.estado === 'Abierto';
+    const hitosPendientes = (detalle?.hitos || []).filter(h => !h.fecha_cumplida);
+
+    return (
+        <div className="mx-auto w-full flex flex-col gap-6">
+            <div className="bg-white rounded-xl shadow-sm p-4 border border-slate-200 flex flex-col sm:flex-row justify-between items-center gap-4">
+                <div className="flex items-center gap-4 w-full sm:w-auto">
+                    <button
+                        onClick={detalle ? () => setDetalle(null) : onBack}
+                        className="p-2 rounded-lg border border-slate-200 text-slate-500 hover:bg-slate-50 transition-colors"
+                    >
+                        <ArrowLeft className="w-5 h-5" />
+                    </button>
+                    <div className="p-3 bg-pink-50 rounded-xl border border-pink-100 shadow-sm">
+                        <Baby className="w-6 h-6 text-pink-600" />
+                    </div>
+                    <div>
+                        <h1 className="text-xl font-bold text-slate-800 tracking-tight">
+                            {detalle ? `${detalle.tipo}: ${detalle.estudiante}` : 'Protocolos de Embarazo, Maternidad y Paternidad'}
+                        </h1>
+                        <p className="text-sm text-slate-500 font-medium flex items-center gap-1">
+                            <Lock className="w-3.5 h-3.5" />
+                            {detalle ? `${detalle.curso} · Abierto el ${detalle.fecha_apertura}` : (studentName || 'Información confidencial')}
+                        </p>
+                    </div>
+                </div>
+                <div className="flex gap-2">
+                    {!detalle && studentId && (
+                        <button onClick={handleAbrir} className="flex items-center gap-2 px-4 py-2 bg-pink-600 text-white text-sm font-bold rounded-lg hover:bg-pink-700 transition-colors shadow-sm">
+                            <Plus className="w-4 h-4" /> Abrir protocolo
+                        </button>
+                    )}
+                    {!detalle && !studentId && (
+                        <button onClick={handleInforme} className="flex items-center gap-2 px-4 py-2 bg-white text-slate-700 text-sm font-bold rounded-lg border border-slate-200 hover:bg-slate-50 transition-colors shadow-sm">
+                            <FileText className="w-4 h-4" /> Informe confidencial
+                        </button>
+                    )}
+                    {detalle && abierto && (
+                        <>
+                            <button onClick={() => setContacto(contactoInicial(detalle.id))} className="flex items-center gap-2 px-4 py-2 bg-pink-600 text-white text-sm font-bold rounded-lg hover:bg-pink-700 transition-colors shadow-sm">
+                                <MessageSquare className="w-4 h-4" /> Registrar contacto
+                            </button>
+                            <button onClick={handleCerrar} className="flex items-center gap-2 px-4 py-2 bg-white text-slate-700 text-sm font-bold rounded-lg border border-slate-200 hover:bg-slate-50 transition-colors shadow-sm">
+                                <Lock className="w-4 h-4" /> Cerrar
+                            </button>
+                        </>
+                    )}
+                </div>
+            </div>
+
+            {!detalle ? (
+                <div className="bg-white rounded-xl shadow-sm border border-slate-200 p-4 space-y-4 min-h-[50vh]">
+                    {!studentId && (
+                        <div className="flex gap-2">
+                            {[['Abierto', 'Abiertos'], ['Cerrado', 'Cerrados'], ['', 'Todos']].map(([valor, etiqueta]) => (
+                                <button
+                                    key={etiqueta}
+                                    onClick={() => setFiltroEstado(valor)}
+                                    className={`px-3 py-1.5 text-xs font-bold rounded-lg border transition-colors ${filtroEstado === valor ? 'bg-pink-50 text-pink-700 border-pink-200' : 'bg-white text-slate-500 border-slate-200 hover:bg-slate-50'}`}
+                                >
+                                    {etiqueta}
+                                </button>
+                            ))}
+                        </div>
+                    )}
+                    {isLoading ? (
+                        <div className="flex justify-center py-12"><Loader2 className="w-6 h-6 animate-spin text-pink-500" /></div>
+                    ) : protocolos.length === 0 ? (
+                        <div className="text-center py-12 bg-slate-50 rounded-xl border border-dashed border-slate-200">
+                            <Baby className="w-10 h-10 text-slate-300 mx-auto mb-2" />
+                            <p className="text-slate-600 font-medium">No hay protocolos registrados</p>
+                            <p className="text-xs text-slate-400 mt-1">Se abren al guardar en la ficha un embarazo, una maternidad o una paternidad</p>
+                        </div>
+                    ) : (
+                        <div className="space-y-3">
+                            {protocolos.map(p => <ProtocolCard key={p.id} protocolo={p} onOpen={abrirDetalle} />)}
+                        </div>
+                    )}
+                </div>
+            ) : (
+                <div className="grid grid-cols-1 lg:grid-cols-2 gap-6">
+                    <div className="bg-white rounded-xl shadow-sm border border-slate-200 overflow-hidden">
+                        <div className="bg-slate-50 px-4 py-3 border-b border-slate-200 flex justify-between items-center">
+                            <h3 className="font-bold text-slate-800 flex items-center gap-2"><Calendar className="w-4 h-4" /> Plan de seguimiento</h3>
+                            {abierto && (
+                                <button
+                                    onClick={() => setHitoForm({ id: 0, protocolo_id: detalle.id, tipo: '', descripcion: '', fecha_programada: hoy() })}
+                                    className="flex items-center gap-1 text-xs font-bold text-pink-700 hover:text-pink-800"
+                                >
+                                    <Plus className="w-3.5 h-3.5" /> Agregar hito
+                                </button>
+                            )}
+                        </div>
+                        {!abierto && (
+                            <div className="px-4 py-3 bg-slate-50 border-b border-slate-200 text-xs text-slate-600">
+                                Cerrado el {detalle.fecha_cierre}: {detalle.motivo_cierre}
+                            </div>
+                        )}
+                        <div className="divide-y divide-slate-100">
+                            {detalle.hitos.map(h => (
+                                <div key={h.id} className="px-4 py-3 flex items-start gap-3">
+                                    <div className="flex-1 min-w-0">
+                                        <div className="flex flex-wrap items-center gap-2">
+                                            <span className="font-semibold text-sm text-slate-800">{h.tipo}</span>
+                                            <span className={`text-[11px] font-bold px-2 py-0.5 rounded border ${estiloHito[h.estado] || estiloHito.Programado}`}>{h.estado}</span>
+                                        </div>
+                                        <p className="text-xs text-slate-500 mt-0.5">{h.descripcion}</p>
+                                        <p className="text-xs text-slate-400 mt-0.5 flex items-center gap-1">
+                                            <Clock className="w-3 h-3" /> {h.fecha_programada}
+                                            {h.fecha_cumplida && ` · Cumplido el ${h.fecha_cumplida}`}
+                                        </p>
+                                        {h.observacion && <p className="text-xs text-slate-600 mt-1 italic">{h.observacion}</p>}
+                                    </div>
+                                    {abierto && !h.fecha_cumplida && (
+                                        <div className="flex gap-1 shrink-0">
+                                            <button onClick={() => handleCumplir(h)} title="Marcar cumplido" className="p-1.5 rounded text-emerald-600 hover:bg-emerald-50"><CheckCircle className="w-4 h-4" /></button>
+                                            <button onClick={() => setHitoForm({ id: h.id, protocolo_id: detalle.id, tipo: h.tipo, descripcion: h.descripcion, fecha_programada: h.fecha_programada })} title="Reprogramar" className="p-1.5 rounded text-slate-500 hover:bg-slate-100"><Edit2 className="w-4 h-4" /></button>
+                                            <button onClick={() => handleEliminarHito(h)} title="Eliminar" className="p-1.5 rounded text-red-500 hover:bg-red-50"><Trash2 className="w-4 h-4" /></button>
+                                        </div>
+                                    )}
+                                </div>
+                            ))}
+                            {detalle.hitos.length === 0 && <p className="px-4 py-6 text-sm text-slate-400 text-center">Sin hitos programados</p>}
+                        </div>
+                    </div>
+
+                    <div className="bg-white rounded-xl shadow-sm border border-slate-200 overflow-hidden">
+                        <div className="bg-slate-50 px-4 py-3 border-b border-slate-200">
+                            <h3 className="font-bold text-slate-800 flex items-center gap-2"><MessageSquare className="w-4 h-4" /> Contactos ({detalle.contactos.length})</h3>
+                        </div>
+                        <div className="divide-y divide-slate-100">
+                            {detalle.contactos.map(c => (
+                                <div key={c.id} className="px-4 py-3">
+                                    <div className="flex justify-between items-start gap-2">
+                                        <div>
+                                            <p className="text-sm font-semibold text-slate-800">{c.modalidad}</p>
+                                            <p className="text-xs text-slate-400">{c.fecha} · {c.nombre_usuario}</p>
+                                        </div>
+                                        <button onClick={() => handleEliminarContacto(c)} title="Eliminar" className="p-1.5 rounded text-red-500 hover:bg-red-50 shrink-0"><Trash2 className="w-4 h-4" /></button>
+                                    </div>
+                                    {c.participantes && <p className="text-xs text-slate-500 mt-1">Participantes: {c.participantes}</p>}
+                                    <p className="text-sm text-slate-700 mt-1 whitespace-pre-line">{c.resumen}</p>
+                                    {c.acuerdos && <p className="text-xs text-slate-600 mt-1"><span className="font-bold">Acuerdos:</span> {c.acuerdos}</p>}
+                                    {c.hito && <p className="text-xs text-emerald-700 mt-1 flex items-center gap-1"><CheckCircle className="w-3 h-3" /> Cumplió: {c.hito}</p>}
+                                </div>
+                            ))}
+                            {detalle.contactos.length === 0 && <p className="px-4 py-6 text-sm text-slate-400 text-center">Sin contactos registrados</p>}
+                        </div>
+                    </div>
+                </div>
+            )}
+
+            {hitoForm && (
+                <div className="fixed inset-0 bg-black/40 flex items-center justify-center z-50 p-4">
+                    <form onSubmit={handleGuardarHito} className="bg-white rounded-xl shadow-xl w-full max-w-md p-6 space-y-4">
+                        <div className="flex justify-between items-center">
+                            <h3 className="font-bold text-slate-800">{hitoForm.id ? 'Reprogramar hito' : 'Agregar hito'}</h3>
+                            <button type="button" onClick={() => setHitoForm(null)}><X className="w-5 h-5 text-slate-400" /></button>
+                        </div>
+                        <select
+                            className="w-full border border-slate-200 rounded-lg px-3 py-2 text-sm"
+                            value={hitoForm.tipo}
+                            onChange={e => setHitoForm({ ...hitoForm, tipo: e.target.value })}
+                            disabled={hitoForm.id !== 0}
+                            required
+                        >
+                            <option value="">Tipo de hito</option>
+                            {catalogos.tipos_hito.map(t => <option key={t} value={t}>{t}</option>)}
+                        </select>
+                        <input
+                            type="date"
+                            className="w-full border border-slate-200 rounded-lg px-3 py-2 text-sm"
+                            value={hitoForm.fecha_programada}
+                            onChange={e => setHitoForm({ ...hitoForm, fecha_programada: e.target.value })}
+                            required
+                        />
+                        <textarea
+                            className="w-full border border-slate-200 rounded-lg px-3 py-2 text-sm"
+                            rows={3}
+                            placeholder="Descripción"
+                            value={hitoForm.descripcion}
+                            onChange={e => setHitoForm({ ...hitoForm, descripcion: e.target.value })}
+                        />
+                        <button type="submit" disabled={isSubmitting} className="w-full flex items-center justify-center gap-2 px-4 py-2 bg-pink-600 text-white text-sm font-bold rounded-lg hover:bg-pink-700 disabled:opacity-50">
+                            {isSubmitting ? <Loader2 className="w-4 h-4 animate-spin" /> : <Save className="w-4 h-4" />} Guardar
+                        </button>
+                    </form>
+                </div>
+            )}
+
+            {contacto && (
+                <div className="fixed inset-0 bg-black/40 flex items-center justify-center z-50 p-4">
+                    <form onSubmit={handleGuardarContacto} className="bg-white rounded-xl shadow-xl w-full max-w-lg p-6 space-y-4">
+                        <div className="flex justify-between items-center">
+                            <h3 className="font-bold text-slate-800">Registrar contacto</h3>
+                            <button type="button" onClick={() => setContacto(null)}><X className="w-5 h-5 text-slate-400" /></button>
+                        </div>
+                        <div className="grid grid-cols-2 gap-3">
+                            <input
+                                type="date"
+                                className="border border-slate-200 rounded-lg px-3 py-2 text-sm"
+                                value={contacto.fecha}
+                                max={hoy()}
+                                onChange={e => setContacto({ ...contacto, fecha: e.target.value })}
+                                required
+                            />
+                            <select
+                                className="border border-slate-200 rounded-lg px-3 py-2 text-sm"
+                                value={contacto.modalidad}
+                                onChange={e => setContacto({ ...contacto, modalidad: e.target.value })}
+                                required
+                            >
+                                <option value="">Modalidad</option>
+                                {catalogos.modalidades.map(m => <option key={m} value={m}>{m}</option>)}
+                            </select>
+                        </div>
+                        <input
+                            className="w-full border border-slate-200 rounded-lg px-3 py-2 text-sm"
+                            placeholder="Participantes"
+                            value={contacto.participantes}
+                            onChange={e => setContacto({ ...contacto, participantes: e.target.value })}
+                        />
+                        <textarea
+                            className="w-full border border-slate-200 rounded-lg px-3 py-2 text-sm"
+                            rows={3}
+                            placeholder="Resumen del contacto"
+                            value={contacto.resumen}
+                            onChange={e => setContacto({ ...contacto, resumen: e.target.value })}
+                            required
+                        />
+                        <textarea
+                            className="w-full border border-slate-200 rounded-lg px-3 py-2 text-sm"
+                            rows={2}
+                            placeholder="Acuerdos"
+                            value={contacto.acuerdos}
+                            onChange={e => setContacto({ ...contacto, acuerdos: e.target.value })}
+                        />
+                        <select
+                            className="w-full border border-slate-200 rounded-lg px-3 py-2 text-sm"
+                            value={contacto.hito_id}
+                            onChange={e => setContacto({ ...contacto, hito_id: e.target.value, cumple_hito: e.target.value !== '0' && contacto.cumple_hito })}
+                        >
+                            <option value={0}>Sin hito relacionado</option>
+                            {hitosPendientes.map(h => <option key={h.id} value={h.id}>{h.tipo} ({h.fecha_programada})</option>)}
+                        </select>
+                        {Number(contacto.hito_id) > 0 && (
+                            <label className="flex items-center gap-2 text-sm text-slate-700">
+                                <input
+                                    type="checkbox"
+                                    checked={contacto.cumple_hito}
+                                    onChange={e => setContacto({ ...contacto, cumple_hito: e.target.checked })}
+                                />
+                                Este contacto cumple el hito
+                            </label>
+                        )}
+                        <button type="submit" disabled={isSubmitting} className="w-full flex items-center justify-center gap-2 px-4 py-2 bg-pink-600 text-white text-sm font-bold rounded-lg hover:bg-pink-700 disabled:opacity-50">
+                            {isSubmitting ? <Loader2 className="w-4 h-4 animate-spin" /> : <Save className="w-4 h-4" />} Guardar
+                        </button>
+                    </form>
+                </div>
+            )}
+        </div>
+    );
+}
@@ -4,8 +4,9 @@ import Swal from 'sweetalert2';
 import {
     ShieldAlert, Plus, Save, X, FileText, UploadCloud,
     Eye, Calendar, Building, Activity, Paperclip, Loader2, ArrowLeft,
-    User, Edit2, CheckCircle, AlertTriangle, Trash2, Shield
+    User, Edit2, CheckCircle, AlertTriangle, Trash2, Shield, GitBranch
 } from 'lucide-react';
+import CaseLog, { estiloEstadoCaso } from './CaseLog';
 
 import {
     ListarCasos, CrearCaso, SubirEvidenciaCaso, SeleccionarArchivo, LeerArchivoParaVista
@@ -19,6 +20,7 @@ export default function SensitiveManager({ studentId, studentName, onBack }) {
 
     const [previewData, setPreviewData] = useState(null);
     const [isPreviewOpen, setIsPreviewOpen] = useState(false);
+    const [casoBitacora, setCasoBitacora] = useState(null);
 
     const initialForm = {
         id: 0,
@@ -252,15 +254,15 @@ export default function SensitiveManager({ studentId, studentName, onBack }) {
                                         )}
                                     </td>
                                     <td className="px-6 py-4">
-                                        <span className={`px-2.5 py-1 text-xs font-semibold rounded-full flex items-center gap-1 w-fit border ${caso.estado === 'Abierto' ? 'bg-red-50 text-red-600 border-red-100' :
-                                            caso.estado === 'Cerrado' ? 'bg-slate-100 text-slate-600 border-slate-200' :
-                                                'bg-amber-50 text-amber-600 border-amber-100'
-                                            }`}>
+                                        <span className={`px-2.5 py-1 text-xs font-semibold rounded-full flex items-center gap-1 w-fit border ${estiloEstadoCaso[caso.estado] || estiloEstadoCaso['Abierto']}`}>
                                             {caso.estado === 'Abierto' ? <Activity className="w-3 h-3" /> :
                                                 caso.estado === 'Cerrado' ? <CheckCircle className="w-3 h-3" /> :
                                                     <AlertTriangle className="w-3 h-3" />}
                                             {caso.estado}
                                         </span>
+                                        {caso.estado === 'Cerrado' && caso.motivo_cierre && (
+                                            <p className="text-[11px] text-slate-400 mt-1" title={caso.resumen_cierre}>{caso.fecha_cierre} · {caso.motivo_cierre}</p>
+                                        )}
                                     </td>
                                     <td className="px-6 py-4 text-sm text-slate-700">
                                         <div className="flex items-center gap-1 text-slate-500">
@@ -268,8 +270,11 @@ export default function SensitiveManager({ studentId, studentName, onBack }) {
                                             {caso.total_evidencias} docs
                                         </div>
                                     </td>
-                                    <td className="px-6 py-4 text-right">
-                                        <button onClick={() => openEditModal(caso)} className="p-2 text-slate-400 hover:text-indigo-600 bg-slate-50 hover:bg-indigo-50 rounded-lg transition-colors">
+                                    <td className="px-6 py-4 text-right space-x-2">
+                                        <button onClick={() => setCasoBitacora(caso)} title="Estado y bitácora" className="p-2 text-slate-400 hover:text-indigo-600 bg-slate-50 hover:bg-indigo-50 rounded-lg transition-colors">
+                                            <GitBranch className="w-4 h-4" />
+                                        </button>
+                                        <button onClick={() => openEditModal(caso)} title="Editar" className="p-2 text-slate-400 hover:text-indigo-600 bg-slate-50 hover:bg-indigo-50 rounded-lg transition-colors">
                                             <Edit2 className="w-4 h-4" />
                                         </button>
                                     </td>
@@ -326,17 +331,9 @@ export default function SensitiveManager({ studentId, studentName, onBack }) {
 
                                                 <div>
                                                     <label className="block text-sm font-semibold text-slate-700 mb-2">Estado Actual</label>
-                                                    <div className="relative">
-                                                        <select
-                                                            value={formData.estado}
-                                                            onChange={e => updateField('estado', e.target.value)}
-                                                            className="w-full px-4 py-2 bg-slate-50 border border-slate-200 rounded-lg text-sm outline-none focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 transition-all appearance-none"
-                                                        >
-                                                            <option value="Abierto">Abierto (En seguimiento)</option>
-                                                            <option value="Derivado">Derivado (Entidad Externa)</option>
-                                                            <option value="Cerrado">Cerrado (Resuelto)</option>
-                                                        </select>
-                                                        <Activity className="absolute right-3 top-2.5 w-4 h-4 text-slate-400 pointer-events-none" />
+                                                    <div className="flex items-center gap-2 py-2">
+                                                        <span className={`px-2.5 py-1 text-xs font-semibold rounded-full border ${estiloEstadoCaso[formData.estado] || estiloEstadoCaso['Abierto']}`}>{formData.estado}</span>
+                                                        <span className="text-xs text-slate-400">Se cambia desde la bitácora del caso</span>
                                                     </div>
                                                 </div>
                                             </div>
@@ -495,6 +492,10 @@ export default function SensitiveManager({ studentId, studentName, onBack }) {
                 </div>
             )}
 
+            {casoBitacora && (
+                <CaseLog caso={casoBitacora} onClose={() => setCasoBitacora(null)} onChanged={cargarCasos} />
+            )}
+
             {isPreviewOpen && previewData && (
                 <div className="fixed inset-0 bg-slate-900/95 backdrop-blur-sm flex justify-center items-center z-60 p-4 animate-in fade-in duration-300">
                     <div className="bg-white rounded-xl shadow-2xl w-full max-w-6xl h-[90vh] flex flex-col overflow-hidden relative">
@@ -21,7 +21,10 @@ export default function LevelsPage() {
     const [formData, setFormData] = useState({
         nombre: '',
         nombre_completo: '',
-        orden: ''
+        orden: '',
+        edad_minima: '',
+        edad_maxima: '',
+        capacidad_por_curso: ''
     });
 
     useEffect(() => {
@@ -43,7 +46,8 @@ export default function LevelsPage() {
 
     const handleInputChange = (e) => {
         const { name, value } = e.target;
-        const finalValue = name === 'orden' ? (value === '' ? '' : parseInt(value)) : value;
+        const numerico = ['orden', 'edad_minima', 'edad_maxima', 'capacidad_por_curso'].includes(name);
+        const finalValue = numerico ? (value === '' ? '' : parseInt(value)) : value;
         setFormData(prev => ({ ...prev, [name]: finalValue }));
     };
 
@@ -55,7 +59,10 @@ export default function LevelsPage() {
         setFormData({
             nombre: '',
             nombre_completo: '',
-            orden: maxOrder + 1
+            orden: maxOrder + 1,
+            edad_minima: '',
+            edad_maxima: '',
+            capacidad_por_curso: ''
         });
         setIsCreateModalOpen(true);
     };
@@ -66,14 +73,17 @@ export default function LevelsPage() {
         setFormData({
             nombre: level.nombre,
             nombre_completo: level.nombre_completo,
-            orden: level.orden
+            orden: level.orden,
+            edad_minima: level.edad_minima || '',
+            edad_maxima: level.edad_maxima || '',
+            capacidad_por_curso: level.capacidad_por_curso || ''
         });
         setIsCreateModalOpen(true);
     };
 
     const closeModal = () => {
         setIsCreateModalOpen(false);
-        setFormData({ nombre: '', nombre_completo: '', orden: '' });
+        setFormData({ nombre: '', nombre_completo: '', orden: '', edad_minima: '', edad_maxima: '', capacidad_por_curso: '' });
         setIsEditMode(false);
         setEditingId(null);
     };
@@ -90,7 +100,10 @@ export default function LevelsPage() {
             const payload = {
                 nombre: formData.nombre,
                 nombre_completo: formData.nombre_completo,
-                orden: parseInt(formData.orden)
+                orden: parseInt(formData.orden),
+                edad_minima: parseInt(formData.edad_minima) || 0,
+                edad_maxima: parseInt(formData.edad_maxima) || 0,
+                capacidad_por_curso: parseInt(formData.capacidad_por_curso) || 0
             };
 
             if (isEditMode && editingId) {
@@ -191,13 +204,14 @@ export default function LevelsPage() {
                                     <th className="px-6 py-4 text-xs font-bold text-slate-500 uppercase tracking-wider w-24 text-center">Orden</th>
                                     <th className="px-6 py-4 text-xs font-bold text-slate-500 uppercase tracking-wider">Nombre Corto</th>
                                     <th className="px-6 py-4 text-xs font-bold text-slate-500 uppercase tracking-wider">Nombre Completo</th>
+                                    <th className="px-6 py-4 text-xs font-bold text-slate-500 uppercase tracking-wider text-center">Edad Esperada</th>
                                     <th className="px-6 py-4 text-xs font-bold text-slate-500 uppercase tracking-wider text-right">Acciones</th>
                                 </tr>
                             </thead>
                             <tbody className="divide-y divide-slate-100">
                                 {isLoading ? (
                                     <tr>
-                                        <td colSpan="4" className="px-6 py-20 text-center text-slate-400">
+                                        <td colSpan="5" className="px-6 py-20 text-center text-slate-400">
                                             <div className="flex flex-col items-center gap-3">
                                                 <Loader2 className="w-8 h-8 animate-spin text-purple-500" />
                                                 <span className="text-sm font-medium">Cargando niveles...</span>
@@ -206,7 +220,7 @@ export default function LevelsPage() {
                                     </tr>
                                 ) : filteredLevels.length === 0 ? (
                                     <tr>
-                                        <td colSpan="4" className="px-6 py-16 text-center text-slate-400">
+                                        <td colSpan="5" className="px-6 py-16 text-center text-slate-400">
                                             <p className="font-medium">No se encontraron niveles registrados</p>
                                             <p className="text-xs mt-1">Define la estructura académica creando niveles</p>
                                         </td>
@@ -227,6 +241,9 @@ export default function LevelsPage() {
                                             <td className="px-6 py-4 text-sm text-slate-600">
                                                 {level.nombre_completo || '-'}
                                             </td>
+                                            <td className="px-6 py-4 text-center text-sm text-slate-600">
+                                                {level.edad_maxima > 0 ? `${level.edad_minima} - ${level.edad_maxima} años` : <span className="text-amber-600 text-xs font-medium">Sin configurar</span>}
+                                            </td>
                                             <td className="px-6 py-4 text-right">
                                                 <div className="flex items-center justify-end gap-2">
                                                     <button
@@ -321,6 +338,47 @@ export default function LevelsPage() {
                                 />
                             </div>
 
+                            <div className="space-y-1.5">
+                                <label className="text-xs font-bold text-slate-500 uppercase tracking-wide">Edad Esperada (años cumplidos)</label>
+                                <div className="grid grid-cols-2 gap-3">
+                                    <input
+                                        type="number"
+                                        name="edad_minima"
+                                        min="3"
+                                        max="30"
+                                        placeholder="Mínima"
+                                        className="w-full px-4 py-2.5 border rounded-xl text-sm focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500 transition-all bg-white border-slate-200 text-slate-800 placeholder:text-slate-400"
+                                        value={formData.edad_minima}
+                                        onChange={handleInputChange}
+                                    />
+                                    <input
+                                        type="number"
+                                        name="edad_maxima"
+                                        min="3"
+                                        max="30"
+                                        placeholder="Máxima"
+                                        className="w-full px-4 py-2.5 border rounded-xl text-sm focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500 transition-all bg-white border-slate-200 text-slate-800 placeholder:text-slate-400"
+                                        value={formData.edad_maxima}
+                                        onChange={handleInputChange}
+                                    />
+                                </div>
+                                <p className="text-[10px] text-slate-400">Los estudiantes mayores que la edad máxima se reportan con extraedad.</p>
+                            </div>
+
+                            <div className="space-y-1.5">
+                                <label className="text-xs font-bold text-slate-500 uppercase tracking-wide">Capacidad por Curso</label>
+                                <input
+                                    type="number"
+                                    name="capacidad_por_curso"
+                                    min="0"
+                                    placeholder="Sin límite"
+                                    className="w-full px-4 py-2.5 border rounded-xl text-sm focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500 transition-all bg-white border-slate-200 text-slate-800 placeholder:text-slate-400"
+                                    value={formData.capacidad_por_curso}
+                                    onChange={handleInputChange}
+                                />
+                                <p className="text-[10px] text-slate-400">Cupo máximo de cada paralelo del nivel, salvo que el curso defina el suyo.</p>
+                            </div>
+
                             <div className="pt-2 flex gap-3">
                                 <button
                                     type="button"
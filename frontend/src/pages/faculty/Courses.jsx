@@ -5,13 +5,14 @@ import {
     School, Plus, Search, User, BookOpen,
     X, Save, Loader2, GraduationCap, Calendar,
     Trash2,
-    ChevronLeft, ChevronRight, ChevronsLeft, ChevronsRight, Edit3
+    ChevronLeft, ChevronRight, ChevronsLeft, ChevronsRight, Edit3, HeartPulse, Scale, Syringe, FileSignature
 } from 'lucide-react';
 
 import { ListarCursos, CrearCurso, ActualizarCurso, EliminarCurso, GenerarCursosMasivos } from '../../../wailsjs/go/services/CourseService';
 import { ListarNiveles } from '../../../wailsjs/go/academic/LevelService';
 import { ListarDocentes } from '../../../wailsjs/go/services/TeacherService';
 import { ObtenerPeriodoActivo } from '../../../wailsjs/go/academic/YearService';
+import { GenerarNominaSaludCursoPDF, GenerarReporteNutricionalCursoPDF, GenerarResumenNutricionalPDF, GenerarPendientesVacunacionCursoPDF, GenerarConsentimientosPendientesCursoPDF, AbrirUbicacionReporte } from '../../../wailsjs/go/reports/ReportService';
 import DistributivoView from './TeachingLoad';
 
 export default function CoursesPage() {
@@ -33,7 +34,8 @@ export default function CoursesPage() {
         nivel_id: '',
         paralelo: '',
         jornada: 'Matutina',
-        tutor_id: ''
+        tutor_id: '',
+        capacidad: ''
     });
 
     const [viewMode, setViewMode] = useState('list');
@@ -101,7 +103,8 @@ export default function CoursesPage() {
             nivel_id: '',
             paralelo: '',
             jornada: 'Matutina',
-            tutor_id: ''
+            tutor_id: '',
+            capacidad: ''
         });
         setIsCreateModalOpen(true);
     };
@@ -113,11 +116,70 @@ export default function CoursesPage() {
             nivel_id: course.nivel_id,
             paralelo: course.paralelo,
             jornada: course.jornada,
-            tutor_id: course.tutor_id || ''
+            tutor_id: course.tutor_id || '',
+            capacidad: course.capacidad || ''
         });
         setIsCreateModalOpen(true);
     };
 
+    const handleNominaSalud = async (course) => {
+        try {
+            const path = await GenerarNominaSaludCursoPDF(course.id);
+            toast.success('Nómina de salud generada', {
+                action: {
+                    label: 'Abrir',
+                    onClick: () => AbrirUbicacionReporte(path)
+                }
+            });
+        } catch (error) {
+            toast.error('Error al generar la nómina de salud: ' + error);
+        }
+    };
+
+    const handleReporteNutricional = async (course) => {
+        try {
+            const path = course
+                ? await GenerarReporteNutricionalCursoPDF(course.id, '')
+                : await GenerarResumenNutricionalPDF('');
+            toast.success(course ? 'Reporte nutricional generado' : 'Resumen nutricional generado', {
+                action: {
+                    label: 'Abrir',
+                    onClick: () => AbrirUbicacionReporte(path)
+                }
+            });
+        } catch (error) {
+            toast.error('Error al generar el reporte nutricional: ' + error);
+        }
+    };
+
+    const handlePendientesVacunacion = async (course) => {
+        try {
+            const path = await GenerarPendientesVacunacionCursoPDF(course.id);
+            toast.success('Lista de vacunas y controles pendientes generada', {
+                action: {
+                    label: 'Abrir',
+                    onClick: () => AbrirUbicacionReporte(path)
+                }
+            });
+        } catch (error) {
+            toast.error('Error al generar la lista de pendientes: ' + error);
+        }
+    };
+
+    const handleConsentimientosPendientes = async (course) => {
+        try {
+            const path = await GenerarConsentimientosPendientesCursoPDF(course.id);
+            toast.success('Lista de consentimientos pendientes generada', {
+                action: {
+                    label: 'Abrir',
+                    onClick: () => AbrirUbicacionReporte(path)
+                }
+            });
+        } catch (error) {
+            toast.error('Error al generar la lista de consentimientos: ' + error);
+        }
+    };
+
     const handleDelete = async (course, e) => {
         if (e) e.stopPropagation();
 
@@ -181,7 +243,7 @@ export default function CoursesPage() {
 
     const closeModal = () => {
         setIsCreateModalOpen(false);
-        setFormData({ nivel_id: '', paralelo: '', jornada: 'Matutina', tutor_id: '' });
+        setFormData({ nivel_id: '', paralelo: '', jornada: 'Matutina', tutor_id: '', capacidad: '' });
         setIsEditMode(false);
         setEditingId(null);
     };
@@ -214,7 +276,8 @@ export default function CoursesPage() {
                 nivel_id: parseInt(formData.nivel_id),
                 paralelo: formData.paralelo.toUpperCase(),
                 jornada: formData.jornada,
-                tutor_id: formData.tutor_id ? parseInt(formData.tutor_id) : null
+                tutor_id: formData.tutor_id ? parseInt(formData.tutor_id) : null,
+                capacidad: parseInt(formData.capacidad) || 0
             };
 
             if (isEditMode && editingId) {
@@ -288,6 +351,15 @@ export default function CoursesPage() {
                     </div>
 
                     <div className="flex gap-3 w-full sm:w-auto">
+                        <button
+                            onClick={() => handleReporteNutricional(null)}
+                            disabled={!activePeriod}
+                            className="w-full sm:w-auto flex items-center justify-center gap-2 px-4 py-2.5 bg-white text-slate-700 border border-slate-200 rounded-lg hover:bg-slate-50 transition-all text-sm font-bold active:scale-95 disabled:opacity-50 disabled:cursor-not-allowed"
+                            title="Estado nutricional de todos los cursos del periodo"
+                        >
+                            <Scale className="w-4 h-4" />
+                            <span className="hidden md:inline">Resumen Nutricional</span>
+                        </button>
                         <button
                             onClick={handleGenerarMasivo}
                             disabled={!activePeriod}
@@ -431,6 +503,38 @@ export default function CoursesPage() {
                                                     >
                                                         <BookOpen className="w-4 h-4" />
                                                     </button>
+
+                                                    <button
+                                                        onClick={() => handleNominaSalud(course)}
+                                                        className="p-2 text-slate-400 hover:text-rose-600 hover:bg-rose-50 rounded-lg transition-all"
+                                                        title="Nómina de salud para emergencias"
+                                                    >
+                                                        <HeartPulse className="w-4 h-4" />
+                                                    </button>
+
+                                                    <button
+                                                        onClick={() => handleReporteNutricional(course)}
+                                                        className="p-2 text-slate-400 hover:text-amber-600 hover:bg-amber-50 rounded-lg transition-all"
+                                                        title="Reporte nutricional (IMC para la edad)"
+                                                    >
+                                                        <Scale className="w-4 h-4" />
+                                                    </button>
+
+                                                    <button
+                                                        onClick={() => handlePendientesVacunacion(course)}
+                                                        className="p-2 text-slate-400 hover:text-teal-600 hover:bg-teal-50 rounded-lg transition-all"
+                                                        title="Vacunas y controles pendientes"
+                                                    >
+                                                        <Syringe className="w-4 h-4" />
+                                                    </button>
+
+                                                    <button
+                                                        onClick={() => handleConsentimientosPendientes(course)}
+                                                        className="p-2 text-slate-400 hover:text-sky-600 hover:bg-sky-50 rounded-lg transition-all"
+                                                        title="Consentimientos informados pendientes"
+                                                    >
+                                                        <FileSignature className="w-4 h-4" />
+                                                    </button>
                                                 </div>
                                             </td>
                                         </tr>
@@ -583,6 +687,19 @@ export default function CoursesPage() {
                                 </select>
                             </div>
 
+                            <div className="space-y-1.5">
+                                <label className="text-xs font-bold text-slate-500 uppercase tracking-wide">Capacidad</label>
+                                <input
+                                    type="number"
+                                    min="0"
+                                    placeholder="La del nivel"
+                                    className="w-full px-4 py-2.5 bg-white border border-slate-200 rounded-xl text-sm text-slate-700 focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500 transition-all"
+                                    value={formData.capacidad}
+                                    onChange={(e) => handleInputChange({ target: { name: 'capacidad', value: e.target.value } })}
+                                />
+                                <p className="text-[10px] text-slate-400">Vacío usa la capacidad por curso configurada en el nivel.</p>
+                            </div>
+
                             <div className="pt-4 flex gap-3">
                                 <button
                                     type="button"
@@ -0,0 +1,122 @@
+import React, { useState, useEffect } from 'react';
+import { toast } from 'sonner';
+import Swal from 'sweetalert2';
+import { X, History, Loader2, RotateCcw } from 'lucide-react';
+import { ListarLotesImportacion, RevertirImportacion } from '../../../wailsjs/go/services/StudentService';
+
+const ESTILO_ESTADO = {
+    Aplicado: 'bg-green-50 text-green-700 border-green-100',
+    Previsualizado: 'bg-amber-50 text-amber-700 border-amber-100',
+    Revertido: 'bg-slate-100 text-slate-500 border-slate-200'
+};
+
+// Historial de importaciones: cada lote aplicado se puede revertir completo mientras sus
+// estudiantes y matrículas no tengan registros posteriores.
+export default function ImportBatches({ onClose, onReverted }) {
+    const [lotes, setLotes] = useState([]);
+    const [isLoading, setIsLoading] = useState(true);
+    const [revirtiendo, setRevirtiendo] = useState(0);
+
+    const cargar = async () => {
+        try {
+            setLotes(await ListarLotesImportacion() || []);
+        } catch (err) {
+            toast.error(String(err));
+        } finally {
+            setIsLoading(false);
+        }
+    };
+
+    useEffect(() => { cargar(); }, []);
+
+    const handleRevertir = async (lote) => {
+        const result = await Swal.fire({
+            title: `¿Revertir la importación #${lote.id}?`,
+            text: 'Se eliminarán los estudiantes y matrículas que creó y se restaurarán los datos que actualizó.',
+            icon: 'warning',
+            showCancelButton: true,
+            confirmButtonColor: '#d33',
+            confirmButtonText: 'Sí, revertir',
+            cancelButtonText: 'Cancelar'
+        });
+        if (!result.isConfirmed) return;
+
+        setRevirtiendo(lote.id);
+        try {
+            const res = await RevertirImportacion(lote.id);
+            toast.success(`Importación revertida: ${res.estudiantes_eliminados} estudiante(s) eliminados, ${res.estudiantes_restaurados} restaurados, ${res.matriculas_eliminadas} matrícula(s) eliminadas.`);
+            (res.advertencias || []).forEach(a => toast.warning(a));
+            cargar();
+            onReverted?.();
+        } catch (err) {
+            toast.error(String(err));
+        } finally {
+            setRevirtiendo(0);
+        }
+    };
+
+    return (
+        <div className="fixed inset-0 z-50 flex items-center justify-center p-4 bg-slate-900/40 backdrop-blur-sm animate-in fade-in">
+            <div className="bg-white rounded-2xl shadow-2xl w-full max-w-4xl max-h-[85vh] flex flex-col border border-slate-200">
+                <div className="px-6 py-5 border-b border-slate-100 flex items-center justify-between">
+                    <h3 className="font-bold text-lg text-slate-800 flex items-center gap-2">
+                        <History className="w-5 h-5 text-slate-600" /> Historial de importaciones
+                    </h3>
+                    <button onClick={onClose} className="p-1.5 rounded-full hover:bg-slate-100 text-slate-400 hover:text-slate-600">
+                        <X className="w-5 h-5" />
+                    </button>
+                </div>
+
+                <div className="flex-1 overflow-y-auto">
+                    {isLoading ? (
+                        <div className="flex justify-center py-12"><Loader2 className="w-6 h-6 animate-spin text-slate-400" /></div>
+                    ) : lotes.length === 0 ? (
+                        <p className="text-center py-12 text-slate-400 italic text-sm">No hay importaciones registradas.</p>
+                    ) : (
+                        <table className="w-full text-sm">
+                            <thead className="bg-slate-50 border-b border-slate-200">
+                                <tr className="text-left text-xs font-bold text-slate-500 uppercase">
+                                    <th className="px-6 py-3">#</th>
+                                    <th className="px-6 py-3">Archivo</th>
+                                    <th className="px-6 py-3">Estado</th>
+                                    <th className="px-6 py-3 text-right">Creados</th>
+                                    <th className="px-6 py-3 text-right">Actualizados</th>
+                                    <th className="px-6 py-3 text-right">Matriculados</th>
+                                    <th className="px-6 py-3 text-right">Acción</th>
+                                </tr>
+                            </thead>
+                            <tbody className="divide-y divide-slate-100">
+                                {lotes.map(l => (
+                                    <tr key={l.id} className="hover:bg-slate-50/50">
+                                        <td className="px-6 py-3 font-mono text-xs text-slate-500">{l.id}</td>
+                                        <td className="px-6 py-3">
+                                            <p className="text-slate-800 font-medium truncate max-w-xs" title={l.archivo}>{l.archivo}</p>
+                                            <p className="text-[11px] text-slate-400">{l.fecha_reversion ? `Revertido ${l.fecha_reversion}` : l.fecha_aplicacion ? `Aplicado ${l.fecha_aplicacion}` : `Vista previa ${l.fecha_creacion}`}</p>
+                                        </td>
+                                        <td className="px-6 py-3">
+                                            <span className={`px-2 py-0.5 rounded-full text-xs font-semibold border ${ESTILO_ESTADO[l.estado] || ''}`}>{l.estado}</span>
+                                        </td>
+                                        <td className="px-6 py-3 text-right text-slate-600">{l.creados}</td>
+                                        <td className="px-6 py-3 text-right text-slate-600">{l.actualizados}</td>
+                                        <td className="px-6 py-3 text-right text-slate-600">{l.matriculados}</td>
+                                        <td className="px-6 py-3 text-right">
+                                            {l.estado === 'Aplicado' && (
+                                                <button
+                                                    onClick={() => handleRevertir(l)}
+                                                    disabled={revirtiendo > 0}
+                                                    className="inline-flex items-center gap-1 px-3 py-1.5 border border-red-200 text-red-600 rounded-lg text-xs font-bold hover:bg-red-50 disabled:opacity-50"
+                                                >
+                                                    {revirtiendo === l.id ? <Loader2 className="w-3 h-3 animate-spin" /> : <RotateCcw className="w-3 h-3" />} Revertir
+                                                </button>
+                                            )}
+                                        </td>
+                                    </tr>
+                                ))}
+                            </tbody>
+                        </table>
+                    )}
+                </div>
+            </div>
+        </div>
+    );
+}
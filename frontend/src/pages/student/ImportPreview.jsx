@@ -0,0 +1,162 @@
+import React, { useState } from 'react';
+import { X, Upload, Loader2, FileText, AlertTriangle } from 'lucide-react';
+
+const ACCIONES = {
+    crear: { etiqueta: 'Nuevo', estilo: 'bg-green-50 text-green-700 border-green-100' },
+    actualizar: { etiqueta: 'Actualiza', estilo: 'bg-blue-50 text-blue-700 border-blue-100' },
+    sin_cambios: { etiqueta: 'Sin cambios', estilo: 'bg-slate-50 text-slate-500 border-slate-200' },
+    error: { etiqueta: 'Error', estilo: 'bg-red-50 text-red-700 border-red-100' }
+};
+
+const FILTROS = [
+    { id: 'todas', etiqueta: 'Todas' },
+    { id: 'crear', etiqueta: 'Nuevos' },
+    { id: 'actualizar', etiqueta: 'Actualizaciones' },
+    { id: 'error', etiqueta: 'Errores' }
+];
+
+const Cambios = ({ titulo, cambios }) => (
+    <div className="mt-1 space-y-0.5">
+        {titulo && <p className="text-[11px] font-bold text-slate-400 uppercase">{titulo}</p>}
+        {cambios.map(c => (
+            <p key={c.campo} className="text-xs text-slate-600">
+                <span className="font-semibold">{c.campo}:</span>{' '}
+                <span className="line-through text-slate-400">{c.anterior || 'vacío'}</span>{' → '}
+                <span className="text-slate-800">{c.nuevo || 'vacío'}</span>
+            </p>
+        ))}
+    </div>
+);
+
+// Vista previa de un lote de importación: muestra qué se creará, actualizará y matriculará
+// antes de aplicar nada.
+export default function ImportPreview({ preview, onConfirm, onDiscard }) {
+    const [filtro, setFiltro] = useState('todas');
+    const [isWorking, setIsWorking] = useState(false);
+
+    const filas = (preview.filas || []).filter(f => filtro === 'todas' || f.accion === filtro);
+
+    const ejecutar = async (accion) => {
+        setIsWorking(true);
+        try {
+            await accion();
+        } finally {
+            setIsWorking(false);
+        }
+    };
+
+    const resumen = [
+        { etiqueta: 'Filas', valor: preview.total_filas, estilo: 'text-slate-800' },
+        { etiqueta: 'Nuevos', valor: preview.creaciones, estilo: 'text-green-700' },
+        { etiqueta: 'Actualizaciones', valor: preview.actualizaciones, estilo: 'text-blue-700' },
+        { etiqueta: 'Sin cambios', valor: preview.sin_cambios, estilo: 'text-slate-500' },
+        { etiqueta: 'Matrículas', valor: preview.matriculas, estilo: 'text-purple-700' },
+        { etiqueta: 'Errores', valor: preview.errores, estilo: preview.errores > 0 ? 'text-red-700' : 'text-slate-400' }
+    ];
+
+    return (
+        <div className="fixed inset-0 z-50 flex items-center justify-center p-4 bg-slate-900/40 backdrop-blur-sm animate-in fade-in">
+            <div className="bg-white rounded-2xl shadow-2xl w-full max-w-5xl max-h-[90vh] flex flex-col border border-slate-200">
+                <div className="px-6 py-5 border-b border-slate-100 flex items-center justify-between">
+                    <div>
+                        <h3 className="font-bold text-lg text-slate-800 flex items-center gap-2">
+                            <FileText className="w-5 h-5 text-green-600" /> Vista previa de la importación
+                        </h3>
+                        <p className="text-xs text-slate-500 mt-1 ml-7">{preview.archivo} · aún no se ha modificado ningún registro</p>
+                    </div>
+                    <button onClick={() => ejecutar(onDiscard)} disabled={isWorking} className="p-1.5 rounded-full hover:bg-slate-100 text-slate-400 hover:text-slate-600">
+                        <X className="w-5 h-5" />
+                    </button>
+                </div>
+
+                <div className="px-6 py-4 grid grid-cols-3 md:grid-cols-6 gap-3 border-b border-slate-100">
+                    {resumen.map(r => (
+                        <div key={r.etiqueta} className="bg-slate-50 rounded-xl p-3 border border-slate-200 text-center">
+                            <div className={`text-xl font-bold ${r.estilo}`}>{r.valor}</div>
+                            <div className="text-[11px] font-medium text-slate-500 mt-0.5">{r.etiqueta}</div>
+                        </div>
+                    ))}
+                </div>
+
+                {(preview.sin_cupo > 0 || preview.omitidos > 0) && (
+                    <div className="mx-6 mt-4 p-3 bg-amber-50 border border-amber-100 rounded-xl text-xs text-amber-800 flex items-start gap-2">
+                        <AlertTriangle className="w-4 h-4 shrink-0" />
+                        <span>
+                            {preview.sin_cupo > 0 && `${preview.sin_cupo} estudiante(s) no se matricularán porque el curso se llena (capacidad ${preview.capacidad_curso}). `}
+                            {preview.omitidos > 0 && `${preview.omitidos} fila(s) sin cédula se omitirán.`}
+                        </span>
+                    </div>
+                )}
+
+                <div className="px-6 pt-4 flex gap-2">
+                    {FILTROS.map(f => (
+                        <button
+                            key={f.id}
+                            onClick={() => setFiltro(f.id)}
+                            className={`px-3 py-1.5 rounded-lg text-xs font-bold border ${filtro === f.id ? 'bg-slate-800 text-white border-slate-800' : 'bg-white text-slate-600 border-slate-200 hover:bg-slate-50'}`}
+                        >
+                            {f.etiqueta}
+                        </button>
+                    ))}
+                </div>
+
+                <div className="flex-1 overflow-y-auto px-6 py-4">
+                    <table className="w-full text-sm">
+                        <thead className="sticky top-0 bg-white">
+                            <tr className="text-left text-xs font-bold text-slate-500 uppercase border-b border-slate-200">
+                                <th className="py-2 pr-3">Fila</th>
+                                <th className="py-2 pr-3">Cédula</th>
+                                <th className="py-2 pr-3">Estudiante</th>
+                                <th className="py-2 pr-3">Acción</th>
+                                <th className="py-2">Detalle</th>
+                            </tr>
+                        </thead>
+                        <tbody className="divide-y divide-slate-100">
+                            {filas.length === 0 ? (
+                                <tr><td colSpan="5" className="py-8 text-center text-slate-400 italic">Sin filas en este filtro</td></tr>
+                            ) : filas.map(f => {
+                                const accion = ACCIONES[f.accion] || ACCIONES.sin_cambios;
+                                return (
+                                    <tr key={`${f.hoja}-${f.fila}`} className="align-top">
+                                        <td className="py-2 pr-3 text-xs text-slate-500 whitespace-nowrap">{f.hoja ? `${f.hoja} · ` : ''}{f.fila}</td>
+                                        <td className="py-2 pr-3 font-mono text-xs text-slate-700">{f.cedula}</td>
+                                        <td className="py-2 pr-3 text-slate-800">{f.apellidos} {f.nombres}</td>
+                                        <td className="py-2 pr-3">
+                                            <span className={`px-2 py-0.5 rounded-full text-xs font-semibold border ${accion.estilo}`}>{accion.etiqueta}</span>
+                                            {f.matricular && <span className="ml-1 px-2 py-0.5 rounded-full text-xs font-semibold border bg-purple-50 text-purple-700 border-purple-100">Matricula</span>}
+                                        </td>
+                                        <td className="py-2">
+                                            {f.error && <p className="text-xs text-red-600">{f.error}</p>}
+                                            {f.observacion && <p className="text-xs text-amber-700">{f.observacion}</p>}
+                                            {f.cambios?.length > 0 && <Cambios cambios={f.cambios} />}
+                                            {f.cambios_familiar?.length > 0 && <Cambios titulo="Representante" cambios={f.cambios_familiar} />}
+                                            {f.direccion_anterior && <Cambios cambios={[{ campo: 'Dirección de la matrícula', anterior: f.direccion_anterior, nuevo: f.direccion }]} />}
+                                        </td>
+                                    </tr>
+                                );
+                            })}
+                        </tbody>
+                    </table>
+                </div>
+
+                <div className="px-6 py-4 border-t border-slate-100 flex gap-3 justify-end">
+                    <button
+                        onClick={() => ejecutar(onDiscard)}
+                        disabled={isWorking}
+                        className="px-5 py-2.5 border border-slate-200 text-slate-600 font-bold rounded-xl hover:bg-slate-50 text-sm disabled:opacity-50"
+                    >
+                        Descartar
+                    </button>
+                    <button
+                        onClick={() => ejecutar(onConfirm)}
+                        disabled={isWorking || (preview.creaciones + preview.actualizaciones + preview.matriculas === 0)}
+                        className="px-5 py-2.5 bg-green-600 text-white font-bold rounded-xl hover:bg-green-700 shadow-md text-sm flex items-center gap-2 disabled:opacity-50"
+                    >
+                        {isWorking ? <Loader2 className="w-4 h-4 animate-spin" /> : <Upload className="w-4 h-4" />}
+                        Aplicar importación
+                    </button>
+                </div>
+            </div>
+        </div>
+    );
+}
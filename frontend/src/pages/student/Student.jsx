@@ -5,16 +5,21 @@ import {
     Search, Plus, User, Edit3, Users,
     ChevronLeft, ChevronRight, Upload,
     CheckCircle2, AlertTriangle, XCircle, X, RefreshCw,
-    FileText, Loader2
+    FileText, Loader2, History
 } from 'lucide-react';
 import { EventsOn } from '../../../wailsjs/runtime/runtime';
 
-import { BuscarEstudiantes, ObtenerFotoBase64, ImportarEstudiantes } from '../../../wailsjs/go/services/StudentService';
+import {
+    ConsultarEstudiantes, ObtenerMiniaturaBase64, PrevisualizarImportacionConPerfil, ConfirmarImportacion,
+    DescartarImportacion, ListarPerfilesImportacion
+} from '../../../wailsjs/go/services/StudentService';
 import { ListarCursos } from '../../../wailsjs/go/services/CourseService';
 import { ObtenerPeriodoActivo } from '../../../wailsjs/go/academic/YearService';
 import {
     ListarPlantillas, ObtenerDatosCertificado, GenerarCertificado
 } from '../../../wailsjs/go/services/TemplateService';
+import ImportPreview from './ImportPreview';
+import ImportBatches from './ImportBatches';
 
 export default function StudentsPage() {
     const navigate = useNavigate();
@@ -39,6 +44,8 @@ function StudentList({ onCreate, onEdit }) {
     const [query, setQuery] = useState('');
     const [currentPage, setCurrentPage] = useState(1);
     const [itemsPerPage, setItemsPerPage] = useState(5);
+    const [totalStudents, setTotalStudents] = useState(0);
+    const [totalPages, setTotalPages] = useState(1);
     const [imageCache, setImageCache] = useState({});
     const [importProgress, setImportProgress] = useState(null);
     const [importResult, setImportResult] = useState(null);
@@ -49,6 +56,10 @@ function StudentList({ onCreate, onEdit }) {
     const [selectedCourseID, setSelectedCourseID] = useState("");
     const [isLoadingCourses, setIsLoadingCourses] = useState(false);
     const [activePeriodName, setActivePeriodName] = useState("");
+    const [importProfiles, setImportProfiles] = useState([]);
+    const [selectedProfileID, setSelectedProfileID] = useState("");
+    const [importPreview, setImportPreview] = useState(null);
+    const [isBatchesOpen, setIsBatchesOpen] = useState(false);
 
     // Certificate Modal State
     const [isCertModalOpen, setIsCertModalOpen] = useState(false);
@@ -81,17 +92,26 @@ function StudentList({ onCreate, onEdit }) {
         return false;
     };
 
-    const search = async (q) => {
+    const search = async (q = query, page = currentPage, size = itemsPerPage) => {
         try {
-            const data = await BuscarEstudiantes(q);
-            setStudents(data || []);
-            setCurrentPage(1);
+            const result = await ConsultarEstudiantes({
+                pagina: page,
+                tamano_pagina: size,
+                busqueda: q,
+                ordenar_por: '',
+                descendente: false,
+                filtros: [],
+            });
+            const data = result?.items || [];
+            setStudents(data);
+            setTotalStudents(result?.paginacion?.total || 0);
+            setTotalPages(Math.max(1, result?.paginacion?.total_paginas || 1));
             try {
                 if (data && Array.isArray(data)) {
                     data.forEach((s) => {
                         if (s.ruta_foto && isLocalPath(s.ruta_foto)) {
                             if (!imageCache[s.id]) {
-                                ObtenerFotoBase64(s.id).then((b64) => {
+                                ObtenerMiniaturaBase64(s.id).then((b64) => {
                                     if (b64) setImageCache(prev => ({ ...prev, [s.id]: b64 }));
                                 }).catch(() => { });
                             }
@@ -105,18 +125,14 @@ function StudentList({ onCreate, onEdit }) {
         }
     };
 
-    useEffect(() => { search(''); }, []);
+    // La paginación y la búsqueda se resuelven en el backend
+    useEffect(() => { search(query, currentPage, itemsPerPage); }, [query, currentPage, itemsPerPage]);
 
     const handleSearchChange = (e) => {
-        const val = e.target.value;
-        setQuery(val);
-        search(val);
+        setQuery(e.target.value);
+        setCurrentPage(1);
     };
 
-    const indexOfLastItem = currentPage * itemsPerPage;
-    const indexOfFirstItem = indexOfLastItem - itemsPerPage;
-    const currentItems = students.slice(indexOfFirstItem, indexOfLastItem);
-    const totalPages = Math.ceil(students.length / itemsPerPage);
     const paginate = (pageNumber) => setCurrentPage(pageNumber);
 
     const openImportModal = async () => {
@@ -132,6 +148,8 @@ function StudentList({ onCreate, onEdit }) {
                 setAvailableCourses([]);
             }
             setSelectedCourseID(""); // Reset selection
+            setImportProfiles(await ListarPerfilesImportacion() || []);
+            setSelectedProfileID("");
             setIsImportModalOpen(true);
         } catch (err) {
             console.error(err);
@@ -141,22 +159,33 @@ function StudentList({ onCreate, onEdit }) {
         }
     };
 
-    const handleImport = async (courseID = 0) => {
-        setImportProgress({ current: 0, total: 100, creados: 0, actualizados: 0, errores: 0 });
+    const handleImport = async (courseID = 0, profileID = 0) => {
         setImportResult(null);
         try {
             // Pass courseID to backend (0 means no enrollment)
-            const result = await ImportarEstudiantes(courseID);
-            if (!result) {
+            const preview = await PrevisualizarImportacionConPerfil(courseID, profileID);
+            if (!preview) {
                 // Usuario canceló el diálogo de archivo
                 toast.info("Importación cancelada.");
-                setImportProgress(null);
                 return;
             }
+            setImportPreview(preview);
+        } catch (err) {
+            console.error(err);
+            toast.error(String(err) || "Error al leer el archivo de estudiantes");
+        }
+    };
+
+    const handleConfirmImport = async () => {
+        const preview = importPreview;
+        setImportPreview(null);
+        setImportProgress({ current: 0, total: 100, creados: 0, actualizados: 0, errores: 0 });
+        try {
+            const result = await ConfirmarImportacion(preview.lote_id);
 
             setImportProgress(null);
             setImportResult(result);
-            search(query);
+            search();
 
             if (result.errores && result.errores.length > 0) {
                 toast.warning(`Importación completada con ${result.errores.length} error(es).`);
@@ -172,6 +201,16 @@ function StudentList({ onCreate, onEdit }) {
         }
     };
 
+    const handleDiscardImport = async () => {
+        try {
+            await DescartarImportacion(importPreview.lote_id);
+            toast.info("Vista previa descartada, no se aplicó ningún cambio.");
+        } catch (err) {
+            toast.error(String(err));
+        }
+        setImportPreview(null);
+    };
+
     const closeImportResult = () => {
         setImportResult(null);
     };
@@ -258,6 +297,13 @@ function StudentList({ onCreate, onEdit }) {
                     </div>
                 </div>
                 <div className="flex gap-3 w-full sm:w-auto">
+                    <button
+                        onClick={() => setIsBatchesOpen(true)}
+                        title="Historial de importaciones"
+                        className="flex items-center justify-center gap-2 px-4 py-2.5 bg-white border border-slate-200 text-slate-600 rounded-lg hover:bg-slate-50 transition-all text-sm font-bold"
+                    >
+                        <History size={18} />
+                    </button>
                     <button
                         onClick={openImportModal}
                         className="flex-1 sm:flex-none flex items-center justify-center gap-2 px-5 py-2.5 bg-green-600 text-white rounded-lg hover:bg-green-700 transition-all text-sm font-bold shadow-md hover:shadow-green-200 active:scale-95"
@@ -285,7 +331,7 @@ function StudentList({ onCreate, onEdit }) {
                     />
                 </div>
                 <div className="flex items-center gap-2 text-sm text-slate-600">
-                    <span className="font-medium text-slate-500 mr-2">Total: {students.length}</span>
+                    <span className="font-medium text-slate-500 mr-2">Total: {totalStudents}</span>
                     <span>Mostrar</span>
                     <select
                         value={itemsPerPage}
@@ -323,7 +369,7 @@ function StudentList({ onCreate, onEdit }) {
                                     </td>
                                 </tr>
                             ) : (
-                                currentItems.map((st) => (
+                                students.map((st) => (
                                     <tr key={st.id} className="hover:bg-purple-50/30 transition-colors">
                                         <td className="px-6 py-4">
                                             <div className="w-10 h-10 rounded-full bg-slate-200 overflow-hidden border border-slate-200 shadow-sm">
@@ -449,6 +495,24 @@ function StudentList({ onCreate, onEdit }) {
                                 )}
                             </div>
 
+                            {importProfiles.length > 0 && (
+                                <div className="space-y-2">
+                                    <label className="text-xs font-bold text-slate-500 uppercase tracking-wide">
+                                        Formato del Archivo
+                                    </label>
+                                    <select
+                                        className="w-full px-4 py-3 bg-white border border-slate-200 rounded-xl text-sm text-slate-700 focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500 transition-all appearance-none cursor-pointer"
+                                        value={selectedProfileID}
+                                        onChange={(e) => setSelectedProfileID(e.target.value)}
+                                    >
+                                        <option value="">Detección automática de columnas</option>
+                                        {importProfiles.map(p => (
+                                            <option key={p.id} value={p.id}>{p.nombre}</option>
+                                        ))}
+                                    </select>
+                                </div>
+                            )}
+
                             <div className="pt-4 flex gap-3">
                                 <button
                                     onClick={() => setIsImportModalOpen(false)}
@@ -459,7 +523,7 @@ function StudentList({ onCreate, onEdit }) {
                                 <button
                                     onClick={() => {
                                         setIsImportModalOpen(false);
-                                        handleImport(selectedCourseID ? parseInt(selectedCourseID) : 0);
+                                        handleImport(selectedCourseID ? parseInt(selectedCourseID) : 0, selectedProfileID ? parseInt(selectedProfileID) : 0);
                                     }}
                                     className="flex-1 px-4 py-2.5 bg-green-600 text-white font-bold rounded-xl hover:bg-green-700 shadow-md shadow-green-200 transition-all text-sm flex items-center justify-center gap-2 active:scale-95"
                                 >
@@ -472,6 +536,14 @@ function StudentList({ onCreate, onEdit }) {
                 </div>
             )}
 
+            {importPreview && (
+                <ImportPreview preview={importPreview} onConfirm={handleConfirmImport} onDiscard={handleDiscardImport} />
+            )}
+
+            {isBatchesOpen && (
+                <ImportBatches onClose={() => setIsBatchesOpen(false)} onReverted={search} />
+            )}
+
             {/* Modal de progreso */}
             {importProgress && !importResult && (
                 <div className="fixed inset-0 bg-black/60 backdrop-blur-sm flex items-center justify-center z-100">
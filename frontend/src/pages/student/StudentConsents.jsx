@@ -0,0 +1,408 @@
+import React, { useState, useEffect } from 'react';
+import {
+    Search, Loader2, Plus, Trash2, Pencil, FileText, Eye, X, AlertTriangle, FileSignature, Ban, Upload, CheckCircle
+} from 'lucide-react';
+import { toast } from 'sonner';
+import Swal from 'sweetalert2';
+import { BuscarEstudiantes, ObtenerEstudiante } from '../../../wailsjs/go/services/StudentService';
+import { SeleccionarArchivo, LeerArchivoParaVista } from '../../../wailsjs/go/services/TrackingService';
+import {
+    ListarCatalogosConsentimiento, ListarConsentimientos, ObtenerEstadoConsentimientos, GuardarConsentimiento,
+    RevocarConsentimiento, EliminarConsentimiento, SubirDocumentoConsentimiento
+} from '../../../wailsjs/go/services/ConsentService';
+
+const hoy = () => new Date().toLocaleDateString('en-CA');
+
+const inputClass = "w-full h-10 px-3 bg-white border border-slate-200 rounded-lg text-sm focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500";
+
+const estiloEstado = {
+    'Vigente': 'bg-emerald-50 text-emerald-700 border-emerald-200',
+    'Por vencer': 'bg-amber-50 text-amber-700 border-amber-200',
+    'Vencido': 'bg-red-50 text-red-700 border-red-200',
+    'Revocado': 'bg-slate-100 text-slate-600 border-slate-300',
+    'Sin consentimiento': 'bg-red-50 text-red-700 border-red-200'
+};
+
+const formularioVacio = (estudianteId) => ({
+    id: 0, estudiante_id: estudianteId, familiar_id: 0, tipo: '', alcance: '', fecha_firma: hoy(), fecha_vencimiento: ''
+});
+
+const StudentConsents = () => {
+    const [catalogos, setCatalogos] = useState({ tipos: [], bloqueantes: [], derivaciones_obligatorias: [] });
+    const [query, setQuery] = useState('');
+    const [students, setStudents] = useState([]);
+    const [isSearching, setIsSearching] = useState(false);
+    const [selected, setSelected] = useState(null);
+    const [familiares, setFamiliares] = useState([]);
+    const [estados, setEstados] = useState([]);
+    const [consentimientos, setConsentimientos] = useState([]);
+    const [isLoading, setIsLoading] = useState(false);
+    const [form, setForm] = useState(null);
+    const [isSaving, setIsSaving] = useState(false);
+    const [previewData, setPreviewData] = useState(null);
+
+    useEffect(() => {
+        ListarCatalogosConsentimiento().then(setCatalogos).catch(err => toast.error(String(err)));
+    }, []);
+
+    const cargarConsentimientos = async (estudianteId) => {
+        setIsLoading(true);
+        try {
+            const [lista, resumen] = await Promise.all([
+                ListarConsentimientos(estudianteId),
+                ObtenerEstadoConsentimientos(estudianteId)
+            ]);
+            setConsentimientos(lista || []);
+            setEstados(resumen || []);
+        } catch (error) {
+            toast.error(String(error));
+        } finally {
+            setIsLoading(false);
+        }
+    };
+
+    const handleSearch = async (e) => {
+        const val = e.target.value;
+        setQuery(val);
+        if (val.length > 2) {
+            setIsSearching(true);
+            try {
+                const results = await BuscarEstudiantes(val);
+                setStudents(results || []);
+            } catch (error) {
+                console.error("Error searching:", error);
+            } finally {
+                setIsSearching(false);
+            }
+        } else {
+            setStudents([]);
+        }
+    };
+
+    const handleSelect = async (student) => {
+        setSelected(student);
+        setStudents([]);
+        setQuery('');
+        setForm(null);
+        try {
+            const est = await ObtenerEstudiante(student.id);
+            setFamiliares((est?.familiares || []).filter(f => !f.fallecido));
+        } catch (error) {
+            setFamiliares([]);
+        }
+        cargarConsentimientos(student.id);
+    };
+
+    const nuevoConsentimiento = (tipo = '') => {
+        const representante = familiares.find(f => f.es_representante_legal);
+        setForm({ ...formularioVacio(selected.id), tipo, familiar_id: representante ? representante.id : 0 });
+    };
+
+    const editarConsentimiento = (c) => {
+        setForm({
+            id: c.id, estudiante_id: c.estudiante_id, familiar_id: c.familiar_id, tipo: c.tipo, alcance: c.alcance,
+            fecha_firma: c.fecha_firma, fecha_vencimiento: c.fecha_vencimiento
+        });
+    };
+
+    const handleGuardar = async (e) => {
+        e.preventDefault();
+        setIsSaving(true);
+        try {
+            await GuardarConsentimiento({ ...form, familiar_id: parseInt(form.familiar_id) || 0 });
+            toast.success(form.id ? 'Consentimiento actualizado' : 'Consentimiento registrado');
+            setForm(null);
+            cargarConsentimientos(selected.id);
+        } catch (error) {
+            toast.error(String(error));
+        } finally {
+            setIsSaving(false);
+        }
+    };
+
+    const handleRevocar = async (c) => {
+        const { value } = await Swal.fire({
+            title: 'Revocar consentimiento',
+            html: `<p class="text-sm mb-2">${c.tipo} firmado por ${c.firmante_nombre}</p>` +
+                `<input id="swal-fecha" type="date" class="swal2-input" value="${hoy()}">` +
+                `<textarea id="swal-motivo" class="swal2-textarea" placeholder="Motivo de la revocación"></textarea>`,
+            showCancelButton: true,
+            confirmButtonColor: '#d33',
+            confirmButtonText: 'Revocar',
+            cancelButtonText: 'Cancelar',
+            preConfirm: () => {
+                const motivo = document.getElementById('swal-motivo').value;
+                if (!motivo.trim()) {
+                    Swal.showValidationMessage('Indique el motivo');
+                    return false;
+                }
+                return { fecha: document.getElementById('swal-fecha').value, motivo };
+            }
+        });
+        if (!value) return;
+        try {
+            await RevocarConsentimiento(c.id, value.fecha, value.motivo);
+            toast.success('Consentimiento revocado');
+            cargarConsentimientos(selected.id);
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    const handleEliminar = async (c) => {
+        const result = await Swal.fire({
+            title: '¿Eliminar registro?',
+            text: 'Use esta opción solo para registros ingresados por error. Para retirar una autorización válida, revóquela.',
+            icon: 'warning',
+            showCancelButton: true,
+            confirmButtonColor: '#d33',
+            confirmButtonText: 'Sí, eliminar',
+            cancelButtonText: 'Cancelar'
+        });
+        if (!result.isConfirmed) return;
+        try {
+            await EliminarConsentimiento(c.id);
+            toast.success('Registro eliminado');
+            cargarConsentimientos(selected.id);
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    const handleSubirDocumento = async (c) => {
+        try {
+            const path = await SeleccionarArchivo('pdf');
+            if (!path) return;
+            await SubirDocumentoConsentimiento(c.id, path);
+            toast.success('Documento firmado guardado');
+            cargarConsentimientos(selected.id);
+        } catch (error) {
+            toast.error("Error al subir: " + error);
+        }
+    };
+
+    const handlePreview = async (ruta) => {
+        try {
+            setPreviewData(await LeerArchivoParaVista(ruta));
+        } catch (error) {
+            toast.error("No se pudo leer el archivo");
+        }
+    };
+
+    const esDerivacion = form?.tipo === 'Derivación externa';
+
+    return (
+        <div className="p-6 min-h-full w-full bg-slate-50/50 font-sans">
+            <div className="space-y-6">
+
+                <div className="bg-white rounded-2xl shadow-sm border border-slate-200 p-8 flex items-center gap-5">
+                    <div className="p-4 bg-sky-50 rounded-2xl border border-sky-100 text-sky-600 shadow-sm">
+                        <FileSignature className="w-8 h-8" />
+                    </div>
+                    <div>
+                        <h1 className="text-2xl font-bold text-slate-800 tracking-tight">Consentimientos Informados</h1>
+                        <p className="text-slate-500 mt-1">Autorizaciones firmadas por el representante: tratamiento de datos, uso de imagen, evaluaciones y derivaciones</p>
+                    </div>
+                </div>
+
+                <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6 flex flex-wrap items-end gap-6">
+                    <div className="flex-1 min-w-[280px] max-w-xl relative">
+                        <label className="block text-sm font-medium text-slate-700 mb-2">Buscar Estudiante</label>
+                        <div className="relative">
+                            <Search className="absolute left-3 top-1/2 -translate-y-1/2 w-5 h-5 text-slate-400" />
+                            <input
+                                type="text"
+                                value={query}
+                                onChange={handleSearch}
+                                placeholder="Cédula, apellidos o nombres..."
+                                className="w-full pl-10 pr-4 py-3 bg-slate-50 border border-slate-200 rounded-xl focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500"
+                            />
+                            {isSearching && <Loader2 className="absolute right-3 top-1/2 -translate-y-1/2 w-5 h-5 text-purple-500 animate-spin" />}
+                        </div>
+                        {students.length > 0 && (
+                            <div className="absolute z-20 mt-1 w-full bg-white border border-slate-200 rounded-xl shadow-lg max-h-72 overflow-y-auto">
+                                {students.map(s => (
+                                    <button key={s.id} onClick={() => handleSelect(s)} className="w-full text-left px-4 py-2.5 hover:bg-slate-50 border-b border-slate-100">
+                                        <div className="font-semibold text-slate-800 text-sm">{s.apellidos} {s.nombres}</div>
+                                        <div className="text-xs text-slate-500">{s.cedula}{s.edad ? ` · ${s.edad} años` : ''}</div>
+                                    </button>
+                                ))}
+                            </div>
+                        )}
+                    </div>
+                    {selected && (
+                        <div className="flex items-center gap-3">
+                            <div>
+                                <div className="font-bold text-slate-800">{selected.apellidos} {selected.nombres}</div>
+                                <div className="text-xs text-slate-500">{selected.cedula}</div>
+                            </div>
+                            <button onClick={() => nuevoConsentimiento()} className="flex items-center gap-2 h-10 px-4 bg-sky-600 text-white rounded-lg hover:bg-sky-700 text-sm font-bold"><Plus className="w-4 h-4" /> Registrar consentimiento</button>
+                        </div>
+                    )}
+                </div>
+
+                {!selected ? (
+                    <div className="bg-white rounded-xl border border-dashed border-slate-200 p-10 text-center text-sm text-slate-400">
+                        Busque un estudiante para ver y registrar sus consentimientos.
+                    </div>
+                ) : (
+                    <>
+                        <div className="grid grid-cols-1 md:grid-cols-2 xl:grid-cols-4 gap-4">
+                            {estados.map(e => (
+                                <div key={e.tipo} className="bg-white rounded-xl border border-slate-200 shadow-sm p-4 flex flex-col gap-2">
+                                    <div className="flex items-start justify-between gap-2">
+                                        <span className="font-semibold text-slate-800 text-sm">{e.tipo}</span>
+                                        <span className={`px-2 py-0.5 text-xs font-bold border rounded-full whitespace-nowrap ${estiloEstado[e.estado] || ''}`}>{e.estado}</span>
+                                    </div>
+                                    {e.firmante && <p className="text-xs text-slate-500">Firmado por {e.firmante}{e.fecha_vencimiento ? ` · vence ${e.fecha_vencimiento}` : ''}</p>}
+                                    {e.mensaje && (
+                                        <p className={`text-xs flex items-start gap-1 ${e.bloquea ? 'text-red-700' : 'text-amber-700'}`}>
+                                            <AlertTriangle className="w-3 h-3 mt-0.5 shrink-0" />
+                                            {e.bloquea ? `${e.mensaje}. Sin él no se permite la acción.` : `${e.mensaje}.`}
+                                        </p>
+                                    )}
+                                    {e.estado !== 'Vigente' && (
+                                        <button onClick={() => nuevoConsentimiento(e.tipo)} className="self-start text-xs font-bold text-sky-700 hover:text-sky-800 flex items-center gap-1 mt-auto">
+                                            <Plus className="w-3 h-3" /> Registrar
+                                        </button>
+                                    )}
+                                </div>
+                            ))}
+                        </div>
+
+                        {form && (
+                            <form onSubmit={handleGuardar} className="bg-white rounded-xl border border-slate-200 shadow-sm p-6 space-y-5">
+                                <div className="flex items-center justify-between">
+                                    <h2 className="font-bold text-slate-800">{form.id ? 'Editar consentimiento' : 'Nuevo consentimiento'}</h2>
+                                    <button type="button" onClick={() => setForm(null)} className="p-1 text-slate-400 hover:text-slate-600"><X className="w-4 h-4" /></button>
+                                </div>
+
+                                <div className="grid grid-cols-1 md:grid-cols-4 gap-3">
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Tipo</label>
+                                        <select className={inputClass} value={form.tipo} onChange={e => setForm({ ...form, tipo: e.target.value })} required>
+                                            <option value="">Seleccione...</option>
+                                            {catalogos.tipos.map(t => <option key={t} value={t}>{t}</option>)}
+                                        </select>
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Firmante</label>
+                                        <select className={inputClass} value={form.familiar_id} onChange={e => setForm({ ...form, familiar_id: parseInt(e.target.value) || 0 })}>
+                                            <option value={0}>El propio estudiante (mayor de edad)</option>
+                                            {familiares.map(f => (
+                                                <option key={f.id} value={f.id}>{f.nombres_completos} ({f.parentesco}{f.es_representante_legal ? ', representante' : ''})</option>
+                                            ))}
+                                        </select>
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Fecha de firma</label>
+                                        <input type="date" max={hoy()} className={inputClass} value={form.fecha_firma} onChange={e => setForm({ ...form, fecha_firma: e.target.value })} required />
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Vence (vacío: hasta que se revoque)</label>
+                                        <input type="date" min={form.fecha_firma} className={inputClass} value={form.fecha_vencimiento} onChange={e => setForm({ ...form, fecha_vencimiento: e.target.value })} />
+                                    </div>
+                                </div>
+                                <div>
+                                    <label className="text-xs font-semibold text-slate-500">Alcance</label>
+                                    <textarea
+                                        rows={2}
+                                        className={`${inputClass} h-auto py-2`}
+                                        placeholder={esDerivacion ? 'Entidad a la que se autoriza derivar y motivo' : 'Qué se autoriza y con qué límites'}
+                                        value={form.alcance}
+                                        onChange={e => setForm({ ...form, alcance: e.target.value })}
+                                    />
+                                    {esDerivacion && catalogos.derivaciones_obligatorias.length > 0 && (
+                                        <p className="text-xs text-slate-500 mt-1">
+                                            No se requiere para derivar a {catalogos.derivaciones_obligatorias.join(' ni a ')}, que son obligatorias por ley.
+                                        </p>
+                                    )}
+                                </div>
+
+                                <div className="flex justify-end">
+                                    <button type="submit" disabled={isSaving} className="flex items-center gap-2 h-10 px-5 bg-sky-600 text-white rounded-lg hover:bg-sky-700 text-sm font-bold disabled:opacity-50">
+                                        {isSaving && <Loader2 className="w-4 h-4 animate-spin" />} Guardar
+                                    </button>
+                                </div>
+                            </form>
+                        )}
+
+                        <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6">
+                            <h2 className="font-bold text-slate-800 mb-4">Historial de consentimientos</h2>
+                            {isLoading ? (
+                                <div className="flex justify-center py-10"><Loader2 className="w-6 h-6 animate-spin text-slate-400" /></div>
+                            ) : consentimientos.length === 0 ? (
+                                <p className="text-sm text-slate-400 italic text-center py-6">No hay consentimientos registrados.</p>
+                            ) : (
+                                <div className="space-y-3">
+                                    {consentimientos.map(c => (
+                                        <div key={c.id} className="border border-slate-200 rounded-lg p-4">
+                                            <div className="flex flex-wrap items-start justify-between gap-3">
+                                                <div>
+                                                    <div className="flex items-center gap-2">
+                                                        <span className={`px-2 py-0.5 text-xs font-bold border rounded-full ${estiloEstado[c.estado] || ''}`}>{c.estado}</span>
+                                                        <span className="font-semibold text-slate-800">{c.tipo}</span>
+                                                        <span className="text-xs text-slate-500">Firmado el {c.fecha_firma}{c.fecha_vencimiento ? ` · vence ${c.fecha_vencimiento}` : ''}</span>
+                                                    </div>
+                                                    <div className="text-sm text-slate-600 mt-1">
+                                                        {c.firmante_nombre}{c.firmante_cedula ? ` (${c.firmante_cedula})` : ''} · {c.firmante_parentesco}
+                                                    </div>
+                                                    {c.alcance && <p className="text-sm text-slate-700 mt-2">{c.alcance}</p>}
+                                                    {c.fecha_revocacion && (
+                                                        <p className="text-xs text-slate-600 mt-2 flex items-center gap-1"><Ban className="w-3 h-3" /> Revocado el {c.fecha_revocacion}: {c.motivo_revocacion}</p>
+                                                    )}
+                                                    <div className="flex flex-wrap gap-3 mt-2 text-xs">
+                                                        {c.ruta_documento ? (
+                                                            <button onClick={() => handlePreview(c.ruta_documento)} className="flex items-center gap-1 text-emerald-700 hover:underline"><CheckCircle className="w-3 h-3" /> Documento firmado <Eye className="w-3 h-3" /></button>
+                                                        ) : (
+                                                            <span className="flex items-center gap-1 text-orange-600"><AlertTriangle className="w-3 h-3" /> Sin documento escaneado</span>
+                                                        )}
+                                                        <span className="text-slate-400">Registrado por {c.nombre_usuario || 'sistema'} el {c.fecha_registro}</span>
+                                                    </div>
+                                                </div>
+                                                <div className="flex gap-1">
+                                                    <button onClick={() => handleSubirDocumento(c)} className="p-2 text-slate-400 hover:text-slate-700" title={c.ruta_documento ? 'Reemplazar documento' : 'Subir documento firmado'}>
+                                                        {c.ruta_documento ? <FileText className="w-4 h-4" /> : <Upload className="w-4 h-4" />}
+                                                    </button>
+                                                    {!c.fecha_revocacion && (
+                                                        <>
+                                                            <button onClick={() => editarConsentimiento(c)} className="p-2 text-slate-400 hover:text-blue-600" title="Editar"><Pencil className="w-4 h-4" /></button>
+                                                            <button onClick={() => handleRevocar(c)} className="p-2 text-slate-400 hover:text-orange-600" title="Revocar"><Ban className="w-4 h-4" /></button>
+                                                        </>
+                                                    )}
+                                                    <button onClick={() => handleEliminar(c)} className="p-2 text-slate-400 hover:text-red-600" title="Eliminar"><Trash2 className="w-4 h-4" /></button>
+                                                </div>
+                                            </div>
+                                        </div>
+                                    ))}
+                                </div>
+                            )}
+                        </div>
+                    </>
+                )}
+            </div>
+
+            {previewData && (
+                <div className="fixed inset-0 bg-slate-900/95 backdrop-blur-sm flex justify-center items-center z-60 p-4">
+                    <div className="bg-white rounded-xl shadow-2xl w-full max-w-6xl h-[90vh] flex flex-col overflow-hidden relative">
+                        <div className="bg-slate-900 text-white px-5 py-3 flex justify-between items-center shadow-md shrink-0">
+                            <span className="font-bold text-sm flex items-center gap-2 text-slate-200">
+                                <FileText className="w-4 h-4 text-indigo-400" /> Consentimiento Firmado
+                            </span>
+                            <button onClick={() => setPreviewData(null)} className="p-1.5 hover:bg-white/10 rounded-full transition-colors"><X className="w-5 h-5" /></button>
+                        </div>
+                        <div className="flex-1 bg-slate-100 relative">
+                            {previewData.startsWith('data:image') ? (
+                                <img src={previewData} alt="Vista previa" className="w-full h-full object-contain" />
+                            ) : (
+                                <iframe src={previewData} className="w-full h-full border-0" title="PDF Preview" />
+                            )}
+                        </div>
+                    </div>
+                </div>
+            )}
+        </div>
+    );
+};
+
+export default StudentConsents;
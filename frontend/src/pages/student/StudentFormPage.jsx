@@ -390,21 +390,23 @@ export default function StudentFormPage() {
                         reader.readAsDataURL(tempPhotoFile);
                     });
                     try {
-                        const newPath = await GuardarFotoBase64(savedStudent.id, b64, tempPhotoFile.name);
-                        if (newPath) {
-                            setOriginalRuta(newPath);
-                            setFormData(prev => ({ ...prev, ruta_foto: newPath }));
+                        const foto = await GuardarFotoBase64(savedStudent.id, b64, tempPhotoFile.name);
+                        if (foto?.ruta) {
+                            setOriginalRuta(foto.ruta);
+                            setFormData(prev => ({ ...prev, ruta_foto: foto.ruta }));
                         }
+                        if (foto?.advertencia) toast.warning(foto.advertencia);
                     } catch (e) {
                         console.error('Error subiendo foto base64', e);
                     }
                 } else if (tempPhotoPath && !tempPhotoPath.startsWith('blob:')) {
                     try {
-                        const newPath = await GuardarFoto(savedStudent.id, tempPhotoPath);
-                        if (newPath) {
-                            setOriginalRuta(newPath);
-                            setFormData(prev => ({ ...prev, ruta_foto: newPath }));
+                        const foto = await GuardarFoto(savedStudent.id, tempPhotoPath);
+                        if (foto?.ruta) {
+                            setOriginalRuta(foto.ruta);
+                            setFormData(prev => ({ ...prev, ruta_foto: foto.ruta }));
                         }
+                        if (foto?.advertencia) toast.warning(foto.advertencia);
                     } catch (e) {
                         console.error('Error subiendo foto por path', e);
                     }
@@ -0,0 +1,533 @@
+import React, { useState, useEffect } from 'react';
+import {
+    Search, Loader2, Plus, Trash2, Pencil, FileText, Image, Eye, X, AlertTriangle, BarChart, ClipboardList, Phone, Hospital
+} from 'lucide-react';
+import { toast } from 'sonner';
+import Swal from 'sweetalert2';
+import {
+    BuscarEstudiantesActivos, ListarLlamados, SeleccionarArchivo, LeerArchivoParaVista
+} from '../../../wailsjs/go/services/TrackingService';
+import {
+    ListarCatalogosIncidente, GuardarIncidente, ListarIncidentes, ListarIncidentesPorFecha, EliminarIncidente,
+    SubirAdjuntoIncidente, EliminarAdjuntoIncidente, ObtenerEstadisticaIncidentes
+} from '../../../wailsjs/go/services/HealthService';
+import { ListarPeriodos } from '../../../wailsjs/go/academic/YearService';
+
+const hoy = () => new Date().toLocaleDateString('en-CA');
+const horaActual = () => new Date().toTimeString().slice(0, 5);
+const inicioMes = () => hoy().slice(0, 8) + '01';
+
+const inputClass = "w-full h-10 px-3 bg-white border border-slate-200 rounded-lg text-sm focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500";
+
+const estiloGravedad = {
+    'Leve': 'bg-emerald-50 text-emerald-700 border-emerald-200',
+    'Moderada': 'bg-amber-50 text-amber-700 border-amber-200',
+    'Grave': 'bg-red-50 text-red-700 border-red-200'
+};
+
+const formularioVacio = () => ({
+    id: 0, matricula_id: 0, llamado_atencion_id: 0, fecha: hoy(), hora: horaActual(), lugar: '', tipo: '', gravedad: 'Leve',
+    descripcion: '', primeros_auxilios: '', atendido_por: '', representante_notificado: false, medio_notificacion: '',
+    hora_notificacion: '', persona_notificada: '', derivado_centro_salud: false, centro_salud: '', traslado: ''
+});
+
+const StudentIncidents = () => {
+    const [tab, setTab] = useState('registro');
+    const [catalogos, setCatalogos] = useState({ tipos: [], gravedades: [], lugares: [], medios_notificacion: [] });
+    const [query, setQuery] = useState('');
+    const [students, setStudents] = useState([]);
+    const [isSearching, setIsSearching] = useState(false);
+    const [selected, setSelected] = useState(null);
+    const [llamados, setLlamados] = useState([]);
+    const [incidentes, setIncidentes] = useState([]);
+    const [rango, setRango] = useState({ desde: inicioMes(), hasta: hoy() });
+    const [isLoading, setIsLoading] = useState(false);
+    const [form, setForm] = useState(null);
+    const [isSaving, setIsSaving] = useState(false);
+    const [previewData, setPreviewData] = useState(null);
+
+    const [periodos, setPeriodos] = useState([]);
+    const [periodoId, setPeriodoId] = useState(0);
+    const [estadistica, setEstadistica] = useState(null);
+
+    useEffect(() => {
+        ListarCatalogosIncidente().then(setCatalogos).catch(err => toast.error(String(err)));
+        ListarPeriodos().then(p => setPeriodos(p || [])).catch(() => setPeriodos([]));
+    }, []);
+
+    const cargarIncidentes = async () => {
+        setIsLoading(true);
+        try {
+            const data = selected
+                ? await ListarIncidentes(selected.id)
+                : await ListarIncidentesPorFecha(rango.desde, rango.hasta);
+            setIncidentes(data || []);
+        } catch (error) {
+            toast.error(String(error));
+        } finally {
+            setIsLoading(false);
+        }
+    };
+
+    useEffect(() => { if (tab === 'registro') cargarIncidentes(); }, [selected, rango, tab]);
+
+    const cargarEstadistica = async () => {
+        try {
+            setEstadistica(await ObtenerEstadisticaIncidentes(parseInt(periodoId) || 0));
+        } catch (error) {
+            setEstadistica(null);
+            toast.error(String(error));
+        }
+    };
+
+    useEffect(() => { if (tab === 'estadistica') cargarEstadistica(); }, [periodoId, tab]);
+
+    const handleSearch = async (e) => {
+        const val = e.target.value;
+        setQuery(val);
+        if (val.length > 2) {
+            setIsSearching(true);
+            try {
+                const results = await BuscarEstudiantesActivos(val);
+                setStudents(results || []);
+            } catch (error) {
+                console.error("Error searching:", error);
+            } finally {
+                setIsSearching(false);
+            }
+        } else {
+            setStudents([]);
+        }
+    };
+
+    const handleSelect = async (student) => {
+        setSelected(student);
+        setStudents([]);
+        setQuery('');
+        setForm(null);
+        try {
+            setLlamados((await ListarLlamados(student.matricula_id)) || []);
+        } catch (error) {
+            setLlamados([]);
+        }
+    };
+
+    const nuevoIncidente = () => {
+        setForm({ ...formularioVacio(), matricula_id: selected.matricula_id });
+    };
+
+    const editarIncidente = async (inc) => {
+        if (!selected || selected.id !== inc.estudiante_id) {
+            await handleSelect({ id: inc.estudiante_id, matricula_id: inc.matricula_id, cedula: inc.cedula, apellidos: inc.estudiante, nombres: '', curso: inc.curso });
+        }
+        const { adjuntos, estudiante_id, cedula, estudiante, curso, periodo_lectivo, motivo_llamado, nombre_usuario, fecha_registro, ...datos } = inc;
+        setForm(datos);
+    };
+
+    const handleGuardar = async (e) => {
+        e.preventDefault();
+        setIsSaving(true);
+        try {
+            await GuardarIncidente({ ...form, llamado_atencion_id: parseInt(form.llamado_atencion_id) || 0 });
+            toast.success(form.id ? 'Incidente actualizado' : 'Incidente registrado');
+            setForm(null);
+            cargarIncidentes();
+        } catch (error) {
+            toast.error(String(error));
+        } finally {
+            setIsSaving(false);
+        }
+    };
+
+    const handleEliminar = async (inc) => {
+        const result = await Swal.fire({
+            title: '¿Eliminar incidente?',
+            text: 'Los adjuntos se conservan en el historial de documentos.',
+            icon: 'warning',
+            showCancelButton: true,
+            confirmButtonColor: '#d33',
+            confirmButtonText: 'Sí, eliminar',
+            cancelButtonText: 'Cancelar'
+        });
+        if (!result.isConfirmed) return;
+        try {
+            await EliminarIncidente(inc.id);
+            toast.success('Incidente eliminado');
+            cargarIncidentes();
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    const handleSubirAdjunto = async (inc, tipo) => {
+        try {
+            const path = await SeleccionarArchivo(tipo);
+            if (!path) return;
+
+            const { value: nombre } = await Swal.fire({
+                title: 'Nombre del adjunto',
+                input: 'text',
+                inputPlaceholder: tipo === 'pdf' ? 'Ej: Certificado médico' : 'Ej: Foto de la lesión',
+                showCancelButton: true,
+                confirmButtonText: 'Subir',
+                cancelButtonText: 'Cancelar'
+            });
+            if (nombre === undefined) return;
+
+            await SubirAdjuntoIncidente(inc.id, path, nombre);
+            toast.success('Adjunto guardado');
+            cargarIncidentes();
+        } catch (error) {
+            toast.error("Error al subir: " + error);
+        }
+    };
+
+    const handleEliminarAdjunto = async (inc, ruta) => {
+        const result = await Swal.fire({
+            title: '¿Quitar adjunto?',
+            text: 'El archivo se conserva en el historial de documentos.',
+            icon: 'warning',
+            showCancelButton: true,
+            confirmButtonText: 'Sí, quitar',
+            cancelButtonText: 'Cancelar'
+        });
+        if (!result.isConfirmed) return;
+        try {
+            await EliminarAdjuntoIncidente(inc.id, ruta);
+            cargarIncidentes();
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    const handlePreview = async (ruta) => {
+        try {
+            setPreviewData(await LeerArchivoParaVista(ruta));
+        } catch (error) {
+            toast.error("No se pudo leer el archivo");
+        }
+    };
+
+    const maxMes = estadistica ? Math.max(1, ...estadistica.meses.map(m => m.total)) : 1;
+
+    return (
+        <div className="p-6 min-h-full w-full bg-slate-50/50 font-sans">
+            <div className="space-y-6">
+
+                <div className="bg-white rounded-2xl shadow-sm border border-slate-200 p-8 flex flex-col md:flex-row items-center justify-between gap-6">
+                    <div className="flex items-center gap-5">
+                        <div className="p-4 bg-red-50 rounded-2xl border border-red-100 text-red-600 shadow-sm">
+                            <ClipboardList className="w-8 h-8" />
+                        </div>
+                        <div>
+                            <h1 className="text-2xl font-bold text-slate-800 tracking-tight">Enfermería e Incidentes</h1>
+                            <p className="text-slate-500 mt-1">Registro de accidentes, primeros auxilios, notificación al representante y derivaciones</p>
+                        </div>
+                    </div>
+                    <button
+                        onClick={() => setTab(tab === 'registro' ? 'estadistica' : 'registro')}
+                        className="flex items-center gap-2 px-4 py-2.5 bg-white border border-slate-200 text-slate-700 rounded-xl hover:bg-slate-50 text-sm font-semibold"
+                    >
+                        {tab === 'registro' ? <><BarChart className="w-4 h-4" /> Estadística mensual</> : <><ClipboardList className="w-4 h-4" /> Registro</>}
+                    </button>
+                </div>
+
+                {tab === 'registro' ? (
+                    <>
+                        <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6 flex flex-wrap items-end gap-6">
+                            <div className="flex-1 min-w-[280px] max-w-xl relative">
+                                <label className="block text-sm font-medium text-slate-700 mb-2">Buscar Estudiante Activo</label>
+                                <div className="relative">
+                                    <Search className="absolute left-3 top-1/2 -translate-y-1/2 w-5 h-5 text-slate-400" />
+                                    <input
+                                        type="text"
+                                        value={query}
+                                        onChange={handleSearch}
+                                        placeholder="Cédula, apellidos o nombres..."
+                                        className="w-full pl-10 pr-4 py-3 bg-slate-50 border border-slate-200 rounded-xl focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500"
+                                    />
+                                    {isSearching && <Loader2 className="absolute right-3 top-1/2 -translate-y-1/2 w-5 h-5 text-purple-500 animate-spin" />}
+                                </div>
+                                {students.length > 0 && (
+                                    <div className="absolute z-20 mt-1 w-full bg-white border border-slate-200 rounded-xl shadow-lg max-h-72 overflow-y-auto">
+                                        {students.map(s => (
+                                            <button key={s.matricula_id} onClick={() => handleSelect(s)} className="w-full text-left px-4 py-2.5 hover:bg-slate-50 border-b border-slate-100">
+                                                <div className="font-semibold text-slate-800 text-sm">{s.apellidos} {s.nombres}</div>
+                                                <div className="text-xs text-slate-500">{s.cedula} · {s.curso}</div>
+                                            </button>
+                                        ))}
+                                    </div>
+                                )}
+                            </div>
+                            {selected ? (
+                                <div className="flex items-center gap-3">
+                                    <div>
+                                        <div className="font-bold text-slate-800">{selected.apellidos} {selected.nombres}</div>
+                                        <div className="text-xs text-slate-500">{selected.cedula} · {selected.curso}</div>
+                                    </div>
+                                    <button onClick={nuevoIncidente} className="flex items-center gap-2 h-10 px-4 bg-red-600 text-white rounded-lg hover:bg-red-700 text-sm font-bold"><Plus className="w-4 h-4" /> Nuevo incidente</button>
+                                    <button onClick={() => { setSelected(null); setForm(null); }} className="p-2 text-slate-400 hover:text-slate-600" title="Ver todos"><X className="w-4 h-4" /></button>
+                                </div>
+                            ) : (
+                                <div className="flex items-end gap-3">
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Desde</label>
+                                        <input type="date" className={inputClass} value={rango.desde} onChange={e => setRango({ ...rango, desde: e.target.value })} />
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Hasta</label>
+                                        <input type="date" className={inputClass} value={rango.hasta} onChange={e => setRango({ ...rango, hasta: e.target.value })} />
+                                    </div>
+                                </div>
+                            )}
+                        </div>
+
+                        {form && (
+                            <form onSubmit={handleGuardar} className="bg-white rounded-xl border border-slate-200 shadow-sm p-6 space-y-5">
+                                <div className="flex items-center justify-between">
+                                    <h2 className="font-bold text-slate-800">{form.id ? 'Editar incidente' : 'Nuevo incidente'}</h2>
+                                    <button type="button" onClick={() => setForm(null)} className="p-1 text-slate-400 hover:text-slate-600"><X className="w-4 h-4" /></button>
+                                </div>
+
+                                <div className="grid grid-cols-1 md:grid-cols-5 gap-3">
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Fecha</label>
+                                        <input type="date" max={hoy()} className={inputClass} value={form.fecha} onChange={e => setForm({ ...form, fecha: e.target.value })} required />
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Hora</label>
+                                        <input type="time" className={inputClass} value={form.hora} onChange={e => setForm({ ...form, hora: e.target.value })} required />
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Lugar</label>
+                                        <input list="lugares-incidente" className={inputClass} value={form.lugar} onChange={e => setForm({ ...form, lugar: e.target.value })} required />
+                                        <datalist id="lugares-incidente">
+                                            {catalogos.lugares.map(l => <option key={l} value={l} />)}
+                                        </datalist>
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Tipo</label>
+                                        <select className={inputClass} value={form.tipo} onChange={e => setForm({ ...form, tipo: e.target.value })} required>
+                                            <option value="">Seleccione...</option>
+                                            {catalogos.tipos.map(t => <option key={t} value={t}>{t}</option>)}
+                                        </select>
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Gravedad</label>
+                                        <select className={inputClass} value={form.gravedad} onChange={e => setForm({ ...form, gravedad: e.target.value })} required>
+                                            {catalogos.gravedades.map(g => <option key={g} value={g}>{g}</option>)}
+                                        </select>
+                                    </div>
+                                </div>
+
+                                <div className="grid grid-cols-1 md:grid-cols-2 gap-3">
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Descripción de lo ocurrido</label>
+                                        <textarea rows={3} className={`${inputClass} h-auto py-2`} value={form.descripcion} onChange={e => setForm({ ...form, descripcion: e.target.value })} required />
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Primeros auxilios brindados</label>
+                                        <textarea rows={3} className={`${inputClass} h-auto py-2`} value={form.primeros_auxilios} onChange={e => setForm({ ...form, primeros_auxilios: e.target.value })} />
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Atendido por</label>
+                                        <input className={inputClass} value={form.atendido_por} onChange={e => setForm({ ...form, atendido_por: e.target.value })} />
+                                    </div>
+                                    <div>
+                                        <label className="text-xs font-semibold text-slate-500">Llamado de atención relacionado</label>
+                                        <select className={inputClass} value={form.llamado_atencion_id || ''} onChange={e => setForm({ ...form, llamado_atencion_id: parseInt(e.target.value) || 0 })}>
+                                            <option value="">Ninguno</option>
+                                            {llamados.map(l => <option key={l.id} value={l.id}>{l.fecha} · {l.motivo}</option>)}
+                                        </select>
+                                    </div>
+                                </div>
+
+                                <div className="grid grid-cols-1 md:grid-cols-2 gap-4">
+                                    <div className="p-4 rounded-lg border border-slate-200 space-y-3">
+                                        <label className="flex items-center gap-2 text-sm font-semibold text-slate-700">
+                                            <input type="checkbox" checked={form.representante_notificado} onChange={e => setForm({ ...form, representante_notificado: e.target.checked })} />
+                                            <Phone className="w-4 h-4 text-slate-400" /> Se notificó al representante
+                                        </label>
+                                        {form.representante_notificado && (
+                                            <div className="grid grid-cols-3 gap-2">
+                                                <select className={inputClass} value={form.medio_notificacion} onChange={e => setForm({ ...form, medio_notificacion: e.target.value })}>
+                                                    <option value="">Medio...</option>
+                                                    {catalogos.medios_notificacion.map(m => <option key={m} value={m}>{m}</option>)}
+                                                </select>
+                                                <input type="time" className={inputClass} value={form.hora_notificacion} onChange={e => setForm({ ...form, hora_notificacion: e.target.value })} />
+                                                <input className={inputClass} placeholder="Persona notificada" value={form.persona_notificada} onChange={e => setForm({ ...form, persona_notificada: e.target.value })} />
+                                            </div>
+                                        )}
+                                    </div>
+                                    <div className="p-4 rounded-lg border border-slate-200 space-y-3">
+                                        <label className="flex items-center gap-2 text-sm font-semibold text-slate-700">
+                                            <input type="checkbox" checked={form.derivado_centro_salud} onChange={e => setForm({ ...form, derivado_centro_salud: e.target.checked })} />
+                                            <Hospital className="w-4 h-4 text-slate-400" /> Derivado a un centro de salud
+                                        </label>
+                                        {form.derivado_centro_salud && (
+                                            <div className="grid grid-cols-2 gap-2">
+                                                <input className={inputClass} placeholder="Centro de salud" value={form.centro_salud} onChange={e => setForm({ ...form, centro_salud: e.target.value })} required />
+                                                <input className={inputClass} placeholder="Traslado (ambulancia, representante...)" value={form.traslado} onChange={e => setForm({ ...form, traslado: e.target.value })} />
+                                            </div>
+                                        )}
+                                    </div>
+                                </div>
+
+                                <div className="flex justify-end">
+                                    <button type="submit" disabled={isSaving} className="flex items-center gap-2 h-10 px-5 bg-red-600 text-white rounded-lg hover:bg-red-700 text-sm font-bold disabled:opacity-50">
+                                        {isSaving && <Loader2 className="w-4 h-4 animate-spin" />} Guardar
+                                    </button>
+                                </div>
+                            </form>
+                        )}
+
+                        <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6">
+                            <h2 className="font-bold text-slate-800 mb-4">
+                                {selected ? 'Incidentes del estudiante' : `Incidentes del ${rango.desde} al ${rango.hasta}`}
+                            </h2>
+                            {isLoading ? (
+                                <div className="flex justify-center py-10"><Loader2 className="w-6 h-6 animate-spin text-slate-400" /></div>
+                            ) : incidentes.length === 0 ? (
+                                <p className="text-sm text-slate-400 italic text-center py-6">No hay incidentes registrados.</p>
+                            ) : (
+                                <div className="space-y-3">
+                                    {incidentes.map(inc => (
+                                        <div key={inc.id} className="border border-slate-200 rounded-lg p-4">
+                                            <div className="flex flex-wrap items-start justify-between gap-3">
+                                                <div>
+                                                    <div className="flex items-center gap-2">
+                                                        <span className={`px-2 py-0.5 text-xs font-bold border rounded-full ${estiloGravedad[inc.gravedad] || ''}`}>{inc.gravedad}</span>
+                                                        <span className="font-semibold text-slate-800">{inc.tipo}</span>
+                                                        <span className="text-xs text-slate-500">{inc.fecha} {inc.hora} · {inc.lugar}</span>
+                                                    </div>
+                                                    {!selected && <div className="text-sm text-slate-600 mt-1">{inc.estudiante} · {inc.curso}</div>}
+                                                    <p className="text-sm text-slate-700 mt-2">{inc.descripcion}</p>
+                                                    {inc.primeros_auxilios && <p className="text-xs text-slate-500 mt-1"><b>Primeros auxilios:</b> {inc.primeros_auxilios}{inc.atendido_por ? ` (${inc.atendido_por})` : ''}</p>}
+                                                    <div className="flex flex-wrap gap-3 mt-2 text-xs">
+                                                        {inc.representante_notificado ? (
+                                                            <span className="text-emerald-700">Representante notificado{inc.medio_notificacion ? ` por ${inc.medio_notificacion.toLowerCase()}` : ''}{inc.hora_notificacion ? ` a las ${inc.hora_notificacion}` : ''}</span>
+                                                        ) : (
+                                                            <span className="flex items-center gap-1 text-orange-600"><AlertTriangle className="w-3 h-3" /> Representante sin notificar</span>
+                                                        )}
+                                                        {inc.derivado_centro_salud && <span className="text-red-700">Derivado a {inc.centro_salud}</span>}
+                                                        {inc.motivo_llamado && <span className="text-purple-700">Llamado de atención: {inc.motivo_llamado}</span>}
+                                                    </div>
+                                                </div>
+                                                <div className="flex gap-1">
+                                                    <button onClick={() => handleSubirAdjunto(inc, 'pdf')} className="p-2 text-slate-400 hover:text-slate-700" title="Adjuntar PDF"><FileText className="w-4 h-4" /></button>
+                                                    <button onClick={() => handleSubirAdjunto(inc, 'imagen')} className="p-2 text-slate-400 hover:text-slate-700" title="Adjuntar foto"><Image className="w-4 h-4" /></button>
+                                                    <button onClick={() => editarIncidente(inc)} className="p-2 text-slate-400 hover:text-blue-600" title="Editar"><Pencil className="w-4 h-4" /></button>
+                                                    <button onClick={() => handleEliminar(inc)} className="p-2 text-slate-400 hover:text-red-600" title="Eliminar"><Trash2 className="w-4 h-4" /></button>
+                                                </div>
+                                            </div>
+                                            {inc.adjuntos.length > 0 && (
+                                                <div className="flex flex-wrap gap-2 mt-3">
+                                                    {inc.adjuntos.map(a => (
+                                                        <span key={a.ruta} className="flex items-center gap-1 pl-2 pr-1 py-1 bg-slate-50 border border-slate-200 rounded-md text-xs text-slate-600">
+                                                            {a.nombre}
+                                                            <button onClick={() => handlePreview(a.ruta)} className="p-0.5 hover:text-blue-600"><Eye className="w-3 h-3" /></button>
+                                                            <button onClick={() => handleEliminarAdjunto(inc, a.ruta)} className="p-0.5 hover:text-red-600"><X className="w-3 h-3" /></button>
+                                                        </span>
+                                                    ))}
+                                                </div>
+                                            )}
+                                            <div className="text-[11px] text-slate-400 mt-2">Registrado por {inc.nombre_usuario || '-'} el {inc.fecha_registro}</div>
+                                        </div>
+                                    ))}
+                                </div>
+                            )}
+                        </div>
+                    </>
+                ) : (
+                    <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6 space-y-6">
+                        <div className="flex flex-wrap items-end justify-between gap-4">
+                            <div>
+                                <label className="text-xs font-semibold text-slate-500">Periodo lectivo</label>
+                                <select className={inputClass} value={periodoId} onChange={e => setPeriodoId(e.target.value)}>
+                                    <option value={0}>Periodo activo</option>
+                                    {periodos.map(p => <option key={p.id} value={p.id}>{p.nombre}</option>)}
+                                </select>
+                            </div>
+                            {estadistica && (
+                                <p className="text-sm text-slate-600">
+                                    {estadistica.periodo_lectivo} · Total: <b>{estadistica.total}</b> · Notificados: <b>{estadistica.notificados}</b> · Derivados: <b>{estadistica.derivados}</b> · Con llamado de atención: <b>{estadistica.con_disciplina}</b>
+                                </p>
+                            )}
+                        </div>
+
+                        {estadistica && (
+                            <>
+                                <table className="w-full text-sm">
+                                    <thead>
+                                        <tr className="text-left text-xs font-bold text-slate-500 uppercase border-b border-slate-200">
+                                            <th className="py-2 pr-3">Mes</th>
+                                            <th className="py-2 pr-3 w-1/3"></th>
+                                            <th className="py-2 pr-3 text-right">Total</th>
+                                            <th className="py-2 pr-3 text-right">Graves</th>
+                                            <th className="py-2 pr-3 text-right">Notificados</th>
+                                            <th className="py-2 pr-3 text-right">Derivados</th>
+                                            <th className="py-2">Por tipo</th>
+                                        </tr>
+                                    </thead>
+                                    <tbody>
+                                        {estadistica.meses.map(m => (
+                                            <tr key={m.mes} className="border-b border-slate-100">
+                                                <td className="py-2 pr-3 font-medium text-slate-700">{m.nombre}</td>
+                                                <td className="py-2 pr-3">
+                                                    <div className="h-2 rounded-full bg-red-400" style={{ width: `${(m.total / maxMes) * 100}%` }} />
+                                                </td>
+                                                <td className="py-2 pr-3 text-right font-bold">{m.total}</td>
+                                                <td className="py-2 pr-3 text-right">{m.graves}</td>
+                                                <td className="py-2 pr-3 text-right">{m.notificados}</td>
+                                                <td className="py-2 pr-3 text-right">{m.derivados}</td>
+                                                <td className="py-2 text-xs text-slate-500">{m.por_tipo.map(t => `${t.nombre}: ${t.total}`).join(' · ')}</td>
+                                            </tr>
+                                        ))}
+                                    </tbody>
+                                </table>
+
+                                <div className="grid grid-cols-1 md:grid-cols-3 gap-4">
+                                    {[['Por tipo', estadistica.por_tipo], ['Por gravedad', estadistica.por_gravedad], ['Por lugar', estadistica.por_lugar]].map(([titulo, conteos]) => (
+                                        <div key={titulo} className="border border-slate-200 rounded-lg p-4">
+                                            <h3 className="font-bold text-slate-700 text-sm mb-2">{titulo}</h3>
+                                            {conteos.length === 0 ? (
+                                                <p className="text-xs text-slate-400 italic">Sin datos</p>
+                                            ) : conteos.map(c => (
+                                                <div key={c.nombre} className="flex justify-between text-sm py-1 border-b border-slate-50">
+                                                    <span className="text-slate-600">{c.nombre}</span>
+                                                    <span className="font-bold text-slate-800">{c.total}</span>
+                                                </div>
+                                            ))}
+                                        </div>
+                                    ))}
+                                </div>
+                            </>
+                        )}
+                    </div>
+                )}
+            </div>
+
+            {previewData && (
+                <div className="fixed inset-0 bg-slate-900/95 backdrop-blur-sm flex justify-center items-center z-60 p-4">
+                    <div className="bg-white rounded-xl shadow-2xl w-full max-w-6xl h-[90vh] flex flex-col overflow-hidden relative">
+                        <div className="bg-slate-900 text-white px-5 py-3 flex justify-between items-center shadow-md shrink-0">
+                            <span className="font-bold text-sm flex items-center gap-2 text-slate-200">
+                                <FileText className="w-4 h-4 text-indigo-400" /> Vista Previa del Adjunto
+                            </span>
+                            <button onClick={() => setPreviewData(null)} className="p-1.5 hover:bg-white/10 rounded-full transition-colors"><X className="w-5 h-5" /></button>
+                        </div>
+                        <div className="flex-1 bg-slate-100 relative">
+                            {previewData.startsWith('data:image') ? (
+                                <img src={previewData} alt="Vista previa" className="w-full h-full object-contain" />
+                            ) : (
+                                <iframe src={previewData} className="w-full h-full border-0" title="PDF Preview" />
+                            )}
+                        </div>
+                    </div>
+                </div>
+            )}
+        </div>
+    );
+};
+
+export default StudentIncidents;
@@ -17,7 +17,7 @@ const StudentModifications = () => {
     const [isSaving, setIsSaving] = useState(false);
 
     const [formData, setFormData] = useState({
-        fecha: new Date().toISOString().split('T')[0],
+        fecha: new Date().toLocaleDateString('en-CA'),
         motivo: '',
         nuevaInstitucion: '',
         provinciaDestino: '',
@@ -46,7 +46,7 @@ const StudentModifications = () => {
         setSelectedStudent(student);
         setFormData(prev => ({
             ...prev,
-            fecha: new Date().toISOString().split('T')[0],
+            fecha: new Date().toLocaleDateString('en-CA'),
             motivo: '',
             nuevaInstitucion: '',
             provinciaDestino: '',
@@ -0,0 +1,510 @@
+import React, { useState, useEffect } from 'react';
+import {
+    Search, Syringe, Stethoscope, Upload, Loader2, Plus, Trash2, CheckCircle2, AlertTriangle, Settings, X
+} from 'lucide-react';
+import { toast } from 'sonner';
+import Swal from 'sweetalert2';
+import { BuscarEstudiantesActivos } from '../../../wailsjs/go/services/TrackingService';
+import {
+    ObtenerCarnetVacunacion, RegistrarDosis, EliminarDosis, RegistrarControl, EliminarControl,
+    ListarVacunas, GuardarVacuna, EliminarVacuna, ListarEsquema, GuardarEsquemaDosis, EliminarEsquemaDosis,
+    ListarTiposControl, GuardarTipoControl, EliminarTipoControl, ImportarSaludExcel
+} from '../../../wailsjs/go/services/HealthService';
+
+const hoy = () => new Date().toLocaleDateString('en-CA');
+
+const inputClass = "w-full h-10 px-3 bg-white border border-slate-200 rounded-lg text-sm focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500";
+
+const estiloEstado = {
+    'Aplicada': 'bg-emerald-50 text-emerald-700 border-emerald-200',
+    'Pendiente': 'bg-red-50 text-red-700 border-red-200',
+    'Próxima': 'bg-slate-50 text-slate-500 border-slate-200'
+};
+
+const textoEdadMeses = (meses) => {
+    if (meses < 0) return '-';
+    if (meses < 24) return `${meses} meses`;
+    return `${Math.floor(meses / 12)} años${meses % 12 ? ` ${meses % 12} m` : ''}`;
+};
+
+const confirmarEliminacion = async (texto) => {
+    const result = await Swal.fire({
+        title: '¿Eliminar registro?',
+        text: texto,
+        icon: 'warning',
+        showCancelButton: true,
+        confirmButtonColor: '#d33',
+        confirmButtonText: 'Sí, eliminar',
+        cancelButtonText: 'Cancelar'
+    });
+    return result.isConfirmed;
+};
+
+const StudentVaccination = () => {
+    const [tab, setTab] = useState('carnet');
+    const [query, setQuery] = useState('');
+    const [students, setStudents] = useState([]);
+    const [isSearching, setIsSearching] = useState(false);
+    const [selected, setSelected] = useState(null);
+    const [carnet, setCarnet] = useState(null);
+    const [isLoading, setIsLoading] = useState(false);
+    const [isImporting, setIsImporting] = useState(false);
+    const [importResult, setImportResult] = useState(null);
+
+    const [vacunas, setVacunas] = useState([]);
+    const [esquema, setEsquema] = useState([]);
+    const [tiposControl, setTiposControl] = useState([]);
+
+    const [dosisForm, setDosisForm] = useState({ vacuna_id: '', numero_dosis: 1, fecha: hoy(), lote: '', establecimiento: '' });
+    const [controlForm, setControlForm] = useState({ tipo_control_id: '', fecha: hoy(), profesional: '', establecimiento: '', resultado: '' });
+    const [vacunaForm, setVacunaForm] = useState({ id: 0, nombre: '', alias: '', descripcion: '', activa: true });
+    const [esquemaForm, setEsquemaForm] = useState({ id: 0, vacuna_id: '', numero_dosis: 1, edad_meses: 0, sexo: '', descripcion: '' });
+    const [controlTipoForm, setControlTipoForm] = useState({ id: 0, nombre: '', alias: '', periodicidad_meses: 12, activo: true });
+
+    const cargarCatalogos = async () => {
+        try {
+            const [v, e, t] = await Promise.all([ListarVacunas(), ListarEsquema(), ListarTiposControl()]);
+            setVacunas(v || []);
+            setEsquema(e || []);
+            setTiposControl(t || []);
+        } catch (error) {
+            toast.error('Error al cargar el catálogo: ' + error);
+        }
+    };
+
+    useEffect(() => { cargarCatalogos(); }, []);
+
+    const cargarCarnet = async (estudianteId) => {
+        setIsLoading(true);
+        try {
+            setCarnet(await ObtenerCarnetVacunacion(estudianteId));
+        } catch (error) {
+            toast.error(String(error));
+        } finally {
+            setIsLoading(false);
+        }
+    };
+
+    const handleSearch = async (e) => {
+        const val = e.target.value;
+        setQuery(val);
+        if (val.length > 2) {
+            setIsSearching(true);
+            try {
+                const results = await BuscarEstudiantesActivos(val);
+                setStudents(results || []);
+            } catch (error) {
+                console.error("Error searching:", error);
+            } finally {
+                setIsSearching(false);
+            }
+        } else {
+            setStudents([]);
+        }
+    };
+
+    const handleSelect = (student) => {
+        setSelected(student);
+        setStudents([]);
+        setQuery('');
+        cargarCarnet(student.id);
+    };
+
+    const handleImportar = async () => {
+        setIsImporting(true);
+        try {
+            const resultado = await ImportarSaludExcel();
+            if (!resultado) return;
+            setImportResult(resultado);
+            toast.success(`Importación: ${resultado.dosis_registradas} dosis y ${resultado.controles_registrados} controles registrados`);
+            if (selected) cargarCarnet(selected.id);
+        } catch (error) {
+            toast.error(String(error));
+        } finally {
+            setIsImporting(false);
+        }
+    };
+
+    const handleRegistrarDosis = async (e) => {
+        e.preventDefault();
+        try {
+            await RegistrarDosis({
+                estudiante_id: selected.id,
+                vacuna_id: parseInt(dosisForm.vacuna_id),
+                numero_dosis: parseInt(dosisForm.numero_dosis) || 0,
+                fecha: dosisForm.fecha,
+                lote: dosisForm.lote,
+                establecimiento: dosisForm.establecimiento,
+                observacion: ''
+            });
+            toast.success('Dosis registrada');
+            setDosisForm({ ...dosisForm, lote: '' });
+            cargarCarnet(selected.id);
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    const handleRegistrarControl = async (e) => {
+        e.preventDefault();
+        try {
+            await RegistrarControl({
+                estudiante_id: selected.id,
+                tipo_control_id: parseInt(controlForm.tipo_control_id),
+                fecha: controlForm.fecha,
+                profesional: controlForm.profesional,
+                establecimiento: controlForm.establecimiento,
+                resultado: controlForm.resultado,
+                observacion: ''
+            });
+            toast.success('Control registrado');
+            setControlForm({ ...controlForm, resultado: '' });
+            cargarCarnet(selected.id);
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    const eliminar = async (accion, texto) => {
+        if (!(await confirmarEliminacion(texto))) return;
+        try {
+            await accion();
+            toast.success('Registro eliminado');
+            if (selected) cargarCarnet(selected.id);
+            cargarCatalogos();
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    const guardarCatalogo = async (accion, reset) => {
+        try {
+            await accion();
+            toast.success('Catálogo actualizado');
+            reset();
+            cargarCatalogos();
+        } catch (error) {
+            toast.error(String(error));
+        }
+    };
+
+    const prepararDosis = (d) => {
+        setDosisForm({ ...dosisForm, vacuna_id: String(d.vacuna_id), numero_dosis: d.numero_dosis });
+    };
+
+    return (
+        <div className="p-6 min-h-full w-full bg-slate-50/50 font-sans">
+            <div className="space-y-6">
+
+                <div className="bg-white rounded-2xl shadow-sm border border-slate-200 p-8 flex flex-col md:flex-row items-center justify-between gap-6">
+                    <div className="flex items-center gap-5">
+                        <div className="p-4 bg-emerald-50 rounded-2xl border border-emerald-100 text-emerald-600 shadow-sm">
+                            <Syringe className="w-8 h-8" />
+                        </div>
+                        <div>
+                            <h1 className="text-2xl font-bold text-slate-800 tracking-tight">Vacunación y Controles Médicos</h1>
+                            <p className="text-slate-500 mt-1">Carnet por estudiante, esquema por edad e importación de brigadas de salud</p>
+                        </div>
+                    </div>
+                    <div className="flex gap-2">
+                        <button
+                            onClick={() => setTab(tab === 'carnet' ? 'catalogo' : 'carnet')}
+                            className="flex items-center gap-2 px-4 py-2.5 bg-white border border-slate-200 text-slate-700 rounded-xl hover:bg-slate-50 text-sm font-semibold"
+                        >
+                            {tab === 'carnet' ? <><Settings className="w-4 h-4" /> Esquema y catálogo</> : <><Syringe className="w-4 h-4" /> Carnet</>}
+                        </button>
+                        <button
+                            onClick={handleImportar}
+                            disabled={isImporting}
+                            className="flex items-center gap-2 px-4 py-2.5 bg-emerald-600 text-white rounded-xl hover:bg-emerald-700 text-sm font-semibold disabled:opacity-50"
+                        >
+                            {isImporting ? <Loader2 className="w-4 h-4 animate-spin" /> : <Upload className="w-4 h-4" />} Importar brigada
+                        </button>
+                    </div>
+                </div>
+
+                {importResult && (
+                    <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6">
+                        <div className="flex items-center justify-between">
+                            <h2 className="font-bold text-slate-800">Resultado de la importación: {importResult.archivo}</h2>
+                            <button onClick={() => setImportResult(null)} className="p-1 text-slate-400 hover:text-slate-600"><X className="w-4 h-4" /></button>
+                        </div>
+                        <p className="text-sm text-slate-600 mt-2">
+                            Filas leídas: <b>{importResult.filas_leidas}</b> · Dosis: <b>{importResult.dosis_registradas}</b> · Controles: <b>{importResult.controles_registrados}</b> · Ya registradas: <b>{importResult.duplicadas}</b> · Con errores: <b>{importResult.errores.length}</b>
+                        </p>
+                        {importResult.errores.length > 0 && (
+                            <div className="mt-3 max-h-48 overflow-y-auto text-xs">
+                                {importResult.errores.map((err, i) => (
+                                    <div key={i} className="flex gap-2 py-1 border-b border-slate-100 text-slate-600">
+                                        <span className="font-mono text-slate-400">{err.hoja} fila {err.fila}</span>
+                                        <span className="font-mono">{err.cedula}</span>
+                                        <span className="text-red-600">{err.mensaje}</span>
+                                    </div>
+                                ))}
+                            </div>
+                        )}
+                    </div>
+                )}
+
+                {tab === 'carnet' ? (
+                    <>
+                        <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6">
+                            <div className="max-w-xl relative">
+                                <label className="block text-sm font-medium text-slate-700 mb-2">Buscar Estudiante Activo</label>
+                                <div className="relative">
+                                    <Search className="absolute left-3 top-1/2 -translate-y-1/2 w-5 h-5 text-slate-400" />
+                                    <input
+                                        type="text"
+                                        value={query}
+                                        onChange={handleSearch}
+                                        placeholder="Cédula, apellidos o nombres..."
+                                        className="w-full pl-10 pr-4 py-3 bg-slate-50 border border-slate-200 rounded-xl focus:outline-none focus:ring-2 focus:ring-purple-500/20 focus:border-purple-500"
+                                    />
+                                    {isSearching && <Loader2 className="absolute right-3 top-1/2 -translate-y-1/2 w-5 h-5 text-purple-500 animate-spin" />}
+                                </div>
+                                {students.length > 0 && (
+                                    <div className="absolute z-20 mt-1 w-full bg-white border border-slate-200 rounded-xl shadow-lg max-h-72 overflow-y-auto">
+                                        {students.map(s => (
+                                            <button key={s.matricula_id} onClick={() => handleSelect(s)} className="w-full text-left px-4 py-2.5 hover:bg-slate-50 border-b border-slate-100">
+                                                <div className="font-semibold text-slate-800 text-sm">{s.apellidos} {s.nombres}</div>
+                                                <div className="text-xs text-slate-500">{s.cedula} · {s.curso}</div>
+                                            </button>
+                                        ))}
+                                    </div>
+                                )}
+                            </div>
+                        </div>
+
+                        {isLoading && <div className="flex justify-center py-10"><Loader2 className="w-6 h-6 animate-spin text-slate-400" /></div>}
+
+                        {carnet && !isLoading && (
+                            <div className="space-y-6">
+                                <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6 flex flex-wrap items-center justify-between gap-4">
+                                    <div>
+                                        <h2 className="text-lg font-bold text-slate-800">{carnet.estudiante}</h2>
+                                        <p className="text-sm text-slate-500">{carnet.cedula} · Nacimiento: {carnet.fecha_nacimiento || '-'} · Edad: {carnet.edad || '-'}</p>
+                                    </div>
+                                    {carnet.completo ? (
+                                        <span className="flex items-center gap-2 px-3 py-1.5 bg-emerald-50 text-emerald-700 border border-emerald-200 rounded-full text-sm font-bold"><CheckCircle2 className="w-4 h-4" /> Esquema y controles al día</span>
+                                    ) : (
+                                        <span className="flex items-center gap-2 px-3 py-1.5 bg-red-50 text-red-700 border border-red-200 rounded-full text-sm font-bold"><AlertTriangle className="w-4 h-4" /> {carnet.dosis_pendientes} dosis y {carnet.controles_pendientes} controles pendientes</span>
+                                    )}
+                                    {carnet.advertencia && <p className="w-full text-xs text-orange-600 italic">{carnet.advertencia}</p>}
+                                </div>
+
+                                <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6">
+                                    <h3 className="flex items-center gap-2 font-bold text-slate-800 mb-4"><Syringe className="w-5 h-5 text-emerald-600" /> Vacunas</h3>
+                                    <form onSubmit={handleRegistrarDosis} className="grid grid-cols-1 md:grid-cols-6 gap-3 items-end mb-5">
+                                        <div className="md:col-span-2">
+                                            <label className="text-xs font-semibold text-slate-500">Vacuna</label>
+                                            <select className={inputClass} value={dosisForm.vacuna_id} onChange={e => setDosisForm({ ...dosisForm, vacuna_id: e.target.value })} required>
+                                                <option value="">Seleccione...</option>
+                                                {vacunas.filter(v => v.activa).map(v => <option key={v.id} value={v.id}>{v.nombre}</option>)}
+                                            </select>
+                                        </div>
+                                        <div>
+                                            <label className="text-xs font-semibold text-slate-500">Dosis N°</label>
+                                            <input type="number" min="1" className={inputClass} value={dosisForm.numero_dosis} onChange={e => setDosisForm({ ...dosisForm, numero_dosis: e.target.value })} />
+                                        </div>
+                                        <div>
+                                            <label className="text-xs font-semibold text-slate-500">Fecha</label>
+                                            <input type="date" max={hoy()} className={inputClass} value={dosisForm.fecha} onChange={e => setDosisForm({ ...dosisForm, fecha: e.target.value })} required />
+                                        </div>
+                                        <div>
+                                            <label className="text-xs font-semibold text-slate-500">Lote</label>
+                                            <input className={inputClass} value={dosisForm.lote} onChange={e => setDosisForm({ ...dosisForm, lote: e.target.value })} />
+                                        </div>
+                                        <button type="submit" className="flex items-center justify-center gap-2 h-10 px-4 bg-emerald-600 text-white rounded-lg hover:bg-emerald-700 text-sm font-bold"><Plus className="w-4 h-4" /> Registrar</button>
+                                    </form>
+
+                                    <table className="w-full text-sm">
+                                        <thead>
+                                            <tr className="text-left text-xs font-bold text-slate-500 uppercase border-b border-slate-200">
+                                                <th className="py-2 pr-3">Vacuna</th>
+                                                <th className="py-2 pr-3">Dosis</th>
+                                                <th className="py-2 pr-3">Edad</th>
+                                                <th className="py-2 pr-3">Estado</th>
+                                                <th className="py-2 pr-3">Fecha</th>
+                                                <th className="py-2 pr-3">Lote / Origen</th>
+                                                <th className="py-2"></th>
+                                            </tr>
+                                        </thead>
+                                        <tbody>
+                                            {carnet.dosis.map((d, i) => (
+                                                <tr key={i} className="border-b border-slate-100">
+                                                    <td className="py-2 pr-3 font-medium text-slate-700">{d.vacuna}</td>
+                                                    <td className="py-2 pr-3 text-slate-600">{d.numero_dosis}{d.descripcion ? ` · ${d.descripcion}` : ''}</td>
+                                                    <td className="py-2 pr-3 text-slate-500">{textoEdadMeses(d.edad_meses)}</td>
+                                                    <td className="py-2 pr-3"><span className={`px-2 py-0.5 text-xs font-bold rounded-full border ${estiloEstado[d.estado]}`}>{d.estado}</span></td>
+                                                    <td className="py-2 pr-3 text-slate-600">{d.fecha || '-'}</td>
+                                                    <td className="py-2 pr-3 text-xs text-slate-500">{[d.lote, d.origen].filter(Boolean).join(' · ') || '-'}</td>
+                                                    <td className="py-2 text-right">
+                                                        {d.dosis_id > 0 ? (
+                                                            <button onClick={() => eliminar(() => EliminarDosis(d.dosis_id), `${d.vacuna} dosis ${d.numero_dosis}`)} className="p-1.5 text-slate-400 hover:text-red-600 hover:bg-red-50 rounded-lg" title="Eliminar dosis"><Trash2 className="w-4 h-4" /></button>
+                                                        ) : (
+                                                            <button onClick={() => prepararDosis(d)} className="p-1.5 text-slate-400 hover:text-emerald-600 hover:bg-emerald-50 rounded-lg" title="Registrar esta dosis"><Plus className="w-4 h-4" /></button>
+                                                        )}
+                                                    </td>
+                                                </tr>
+                                            ))}
+                                        </tbody>
+                                    </table>
+                                </div>
+
+                                <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6">
+                                    <h3 className="flex items-center gap-2 font-bold text-slate-800 mb-4"><Stethoscope className="w-5 h-5 text-blue-600" /> Controles médicos</h3>
+                                    <div className="flex flex-wrap gap-2 mb-4">
+                                        {carnet.estado_controles.map(c => (
+                                            <span key={c.tipo_control_id} className={`px-3 py-1 text-xs font-bold rounded-full border ${c.al_dia ? 'bg-emerald-50 text-emerald-700 border-emerald-200' : 'bg-red-50 text-red-700 border-red-200'}`}>
+                                                {c.tipo}: {c.ultima_fecha || 'sin registro'}{c.al_dia ? '' : ' (pendiente)'}
+                                            </span>
+                                        ))}
+                                    </div>
+                                    <form onSubmit={handleRegistrarControl} className="grid grid-cols-1 md:grid-cols-6 gap-3 items-end mb-5">
+                                        <div className="md:col-span-2">
+                                            <label className="text-xs font-semibold text-slate-500">Control</label>
+                                            <select className={inputClass} value={controlForm.tipo_control_id} onChange={e => setControlForm({ ...controlForm, tipo_control_id: e.target.value })} required>
+                                                <option value="">Seleccione...</option>
+                                                {tiposControl.map(t => <option key={t.id} value={t.id}>{t.nombre}</option>)}
+                                            </select>
+                                        </div>
+                                        <div>
+                                            <label className="text-xs font-semibold text-slate-500">Fecha</label>
+                                            <input type="date" max={hoy()} className={inputClass} value={controlForm.fecha} onChange={e => setControlForm({ ...controlForm, fecha: e.target.value })} required />
+                                        </div>
+                                        <div>
+                                            <label className="text-xs font-semibold text-slate-500">Profesional</label>
+                                            <input className={inputClass} value={controlForm.profesional} onChange={e => setControlForm({ ...controlForm, profesional: e.target.value })} />
+                                        </div>
+                                        <div>
+                                            <label className="text-xs font-semibold text-slate-500">Resultado</label>
+                                            <input className={inputClass} value={controlForm.resultado} onChange={e => setControlForm({ ...controlForm, resultado: e.target.value })} />
+                                        </div>
+                                        <button type="submit" className="flex items-center justify-center gap-2 h-10 px-4 bg-blue-600 text-white rounded-lg hover:bg-blue-700 text-sm font-bold"><Plus className="w-4 h-4" /> Registrar</button>
+                                    </form>
+                                    {carnet.controles.length === 0 ? (
+                                        <p className="text-xs text-slate-400 italic">Sin controles registrados.</p>
+                                    ) : (
+                                        <table className="w-full text-sm">
+                                            <tbody>
+                                                {carnet.controles.map(c => (
+                                                    <tr key={c.id} className="border-b border-slate-100">
+                                                        <td className="py-2 pr-3 text-slate-600">{c.fecha}</td>
+                                                        <td className="py-2 pr-3 font-medium text-slate-700">{c.tipo}</td>
+                                                        <td className="py-2 pr-3 text-slate-500">{c.profesional || '-'}</td>
+                                                        <td className="py-2 pr-3 text-slate-500">{c.resultado || '-'}</td>
+                                                        <td className="py-2 pr-3 text-xs text-slate-400">{c.origen}</td>
+                                                        <td className="py-2 text-right">
+                                                            <button onClick={() => eliminar(() => EliminarControl(c.id), `${c.tipo} del ${c.fecha}`)} className="p-1.5 text-slate-400 hover:text-red-600 hover:bg-red-50 rounded-lg" title="Eliminar control"><Trash2 className="w-4 h-4" /></button>
+                                                        </td>
+                                                    </tr>
+                                                ))}
+                                            </tbody>
+                                        </table>
+                                    )}
+                                </div>
+                            </div>
+                        )}
+                    </>
+                ) : (
+                    <div className="grid grid-cols-1 xl:grid-cols-2 gap-6">
+                        <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6">
+                            <h3 className="font-bold text-slate-800 mb-4">Vacunas</h3>
+                            <div className="grid grid-cols-1 md:grid-cols-4 gap-2 items-end mb-4">
+                                <input className={inputClass} placeholder="Nombre" value={vacunaForm.nombre} onChange={e => setVacunaForm({ ...vacunaForm, nombre: e.target.value })} />
+                                <input className={`${inputClass} md:col-span-2`} placeholder="Alias en las hojas (separados por coma)" value={vacunaForm.alias} onChange={e => setVacunaForm({ ...vacunaForm, alias: e.target.value })} />
+                                <div className="flex gap-2 items-center">
+                                    <label className="flex items-center gap-1 text-xs text-slate-600"><input type="checkbox" checked={vacunaForm.activa} onChange={e => setVacunaForm({ ...vacunaForm, activa: e.target.checked })} /> Activa</label>
+                                    <button onClick={() => guardarCatalogo(() => GuardarVacuna(vacunaForm), () => setVacunaForm({ id: 0, nombre: '', alias: '', descripcion: '', activa: true }))} className="h-10 px-3 bg-purple-600 text-white rounded-lg text-sm font-bold">{vacunaForm.id ? 'Actualizar' : 'Agregar'}</button>
+                                </div>
+                            </div>
+                            <table className="w-full text-sm">
+                                <tbody>
+                                    {vacunas.map(v => (
+                                        <tr key={v.id} className="border-b border-slate-100">
+                                            <td className={`py-2 pr-3 font-medium cursor-pointer ${v.activa ? 'text-slate-700' : 'text-slate-400 line-through'}`} onClick={() => setVacunaForm(v)}>{v.nombre}</td>
+                                            <td className="py-2 pr-3 text-xs text-slate-500">{v.alias}</td>
+                                            <td className="py-2 text-right">
+                                                <button onClick={() => eliminar(() => EliminarVacuna(v.id), v.nombre)} className="p-1.5 text-slate-400 hover:text-red-600 rounded-lg"><Trash2 className="w-4 h-4" /></button>
+                                            </td>
+                                        </tr>
+                                    ))}
+                                </tbody>
+                            </table>
+
+                            <h3 className="font-bold text-slate-800 mt-8 mb-4">Controles médicos exigidos</h3>
+                            <div className="grid grid-cols-1 md:grid-cols-4 gap-2 items-end mb-4">
+                                <input className={inputClass} placeholder="Nombre" value={controlTipoForm.nombre} onChange={e => setControlTipoForm({ ...controlTipoForm, nombre: e.target.value })} />
+                                <input className={inputClass} placeholder="Alias" value={controlTipoForm.alias} onChange={e => setControlTipoForm({ ...controlTipoForm, alias: e.target.value })} />
+                                <input type="number" min="0" className={inputClass} title="Periodicidad en meses" value={controlTipoForm.periodicidad_meses} onChange={e => setControlTipoForm({ ...controlTipoForm, periodicidad_meses: parseInt(e.target.value) || 0 })} />
+                                <div className="flex gap-2 items-center">
+                                    <label className="flex items-center gap-1 text-xs text-slate-600"><input type="checkbox" checked={controlTipoForm.activo} onChange={e => setControlTipoForm({ ...controlTipoForm, activo: e.target.checked })} /> Exigido</label>
+                                    <button onClick={() => guardarCatalogo(() => GuardarTipoControl(controlTipoForm), () => setControlTipoForm({ id: 0, nombre: '', alias: '', periodicidad_meses: 12, activo: true }))} className="h-10 px-3 bg-purple-600 text-white rounded-lg text-sm font-bold">{controlTipoForm.id ? 'Actualizar' : 'Agregar'}</button>
+                                </div>
+                            </div>
+                            <table className="w-full text-sm">
+                                <tbody>
+                                    {tiposControl.map(t => (
+                                        <tr key={t.id} className="border-b border-slate-100">
+                                            <td className={`py-2 pr-3 font-medium cursor-pointer ${t.activo ? 'text-slate-700' : 'text-slate-400'}`} onClick={() => setControlTipoForm(t)}>{t.nombre}</td>
+                                            <td className="py-2 pr-3 text-xs text-slate-500">Cada {t.periodicidad_meses} meses{t.activo ? '' : ' · no exigido'}</td>
+                                            <td className="py-2 text-right">
+                                                <button onClick={() => eliminar(() => EliminarTipoControl(t.id), t.nombre)} className="p-1.5 text-slate-400 hover:text-red-600 rounded-lg"><Trash2 className="w-4 h-4" /></button>
+                                            </td>
+                                        </tr>
+                                    ))}
+                                </tbody>
+                            </table>
+                        </div>
+
+                        <div className="bg-white rounded-xl border border-slate-200 shadow-sm p-6">
+                            <h3 className="font-bold text-slate-800 mb-1">Esquema por edad</h3>
+                            <p className="text-xs text-slate-500 mb-4">Verifique que coincida con el esquema vigente del MSP. Las dosis cuya descripción incluye "Refuerzo" se reconocen como refuerzos al importar.</p>
+                            <div className="grid grid-cols-1 md:grid-cols-6 gap-2 items-end mb-4">
+                                <select className={`${inputClass} md:col-span-2`} value={esquemaForm.vacuna_id} onChange={e => setEsquemaForm({ ...esquemaForm, vacuna_id: e.target.value })}>
+                                    <option value="">Vacuna...</option>
+                                    {vacunas.map(v => <option key={v.id} value={v.id}>{v.nombre}</option>)}
+                                </select>
+                                <input type="number" min="1" className={inputClass} title="Número de dosis" value={esquemaForm.numero_dosis} onChange={e => setEsquemaForm({ ...esquemaForm, numero_dosis: parseInt(e.target.value) || 0 })} />
+                                <input type="number" min="0" className={inputClass} title="Edad en meses" value={esquemaForm.edad_meses} onChange={e => setEsquemaForm({ ...esquemaForm, edad_meses: parseInt(e.target.value) || 0 })} />
+                                <select className={inputClass} value={esquemaForm.sexo} onChange={e => setEsquemaForm({ ...esquemaForm, sexo: e.target.value })}>
+                                    <option value="">Todos</option>
+                                    <option value="F">Mujeres</option>
+                                    <option value="M">Hombres</option>
+                                </select>
+                                <button onClick={() => guardarCatalogo(() => GuardarEsquemaDosis({ ...esquemaForm, vacuna_id: parseInt(esquemaForm.vacuna_id) || 0 }), () => setEsquemaForm({ id: 0, vacuna_id: '', numero_dosis: 1, edad_meses: 0, sexo: '', descripcion: '' }))} className="h-10 px-3 bg-purple-600 text-white rounded-lg text-sm font-bold">{esquemaForm.id ? 'Actualizar' : 'Agregar'}</button>
+                                <input className={`${inputClass} md:col-span-6`} placeholder="Descripción (p. ej. Refuerzo 1, Dosis única)" value={esquemaForm.descripcion} onChange={e => setEsquemaForm({ ...esquemaForm, descripcion: e.target.value })} />
+                            </div>
+                            <table className="w-full text-sm">
+                                <thead>
+                                    <tr className="text-left text-xs font-bold text-slate-500 uppercase border-b border-slate-200">
+                                        <th className="py-2 pr-3">Edad</th>
+                                        <th className="py-2 pr-3">Vacuna</th>
+                                        <th className="py-2 pr-3">Dosis</th>
+                                        <th className="py-2 pr-3">Sexo</th>
+                                        <th className="py-2"></th>
+                                    </tr>
+                                </thead>
+                                <tbody>
+                                    {esquema.map(e => (
+                                        <tr key={e.id} className="border-b border-slate-100 cursor-pointer" onClick={() => setEsquemaForm({ ...e, vacuna_id: String(e.vacuna_id) })}>
+                                            <td className="py-2 pr-3 text-slate-600">{textoEdadMeses(e.edad_meses)}</td>
+                                            <td className="py-2 pr-3 font-medium text-slate-700">{e.vacuna?.nombre}</td>
+                                            <td className="py-2 pr-3 text-slate-600">{e.numero_dosis}{e.descripcion ? ` · ${e.descripcion}` : ''}</td>
+                                            <td className="py-2 pr-3 text-slate-500">{e.sexo || 'Todos'}</td>
+                                            <td className="py-2 text-right">
+                                                <button onClick={(ev) => { ev.stopPropagation(); eliminar(() => EliminarEsquemaDosis(e.id), `${e.vacuna?.nombre} dosis ${e.numero_dosis}`); }} className="p-1.5 text-slate-400 hover:text-red-600 rounded-lg"><Trash2 className="w-4 h-4" /></button>
+                                            </td>
+                                        </tr>
+                                    ))}
+                                </tbody>
+                            </table>
+                        </div>
+                    </div>
+                )}
+            </div>
+        </div>
+    );
+};
+
+export default StudentVaccination;
@@ -55,6 +55,9 @@ export namespace academic {
 	    nombre: string;
 	    nombre_completo: string;
 	    orden: number;
+	    edad_minima: number;
+	    edad_maxima: number;
+	    capacidad_por_curso: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new NivelEducativo(source);
@@ -66,6 +69,9 @@ export namespace academic {
 	        this.nombre = source["nombre"];
 	        this.nombre_completo = source["nombre_completo"];
 	        this.orden = source["orden"];
+	        this.edad_minima = source["edad_minima"];
+	        this.edad_maxima = source["edad_maxima"];
+	        this.capacidad_por_curso = source["capacidad_por_curso"];
 	    }
 	}
 	export class NivelEducativoDTO {
@@ -73,6 +79,9 @@ export namespace academic {
 	    nombre: string;
 	    nombre_completo: string;
 	    orden: number;
+	    edad_minima: number;
+	    edad_maxima: number;
+	    capacidad_por_curso: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new NivelEducativoDTO(source);
@@ -84,6 +93,9 @@ export namespace academic {
 	        this.nombre = source["nombre"];
 	        this.nombre_completo = source["nombre_completo"];
 	        this.orden = source["orden"];
+	        this.edad_minima = source["edad_minima"];
+	        this.edad_maxima = source["edad_maxima"];
+	        this.capacidad_por_curso = source["capacidad_por_curso"];
 	    }
 	}
 	export class PeriodoLectivo {
@@ -167,6 +179,18 @@ export namespace common {
 		    return a;
 		}
 	}
+	export class JSONMap___string_ {
+	    Data: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new JSONMap___string_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Data = source["Data"];
+	    }
+	}
 	export class JSONMap_dece_internal_domain_enrollment_Antropometria_ {
 	    Data: enrollment.Antropometria;
 	
@@ -317,6 +341,36 @@ export namespace common {
 		    return a;
 		}
 	}
+	export class JSONMap_dece_internal_domain_enrollment_UbicacionDomicilio_ {
+	    Data: enrollment.UbicacionDomicilio;
+	
+	    static createFrom(source: any = {}) {
+	        return new JSONMap_dece_internal_domain_enrollment_UbicacionDomicilio_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Data = this.convertValues(source["Data"], enrollment.UbicacionDomicilio);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class JSONMap_dece_internal_domain_management_AudienciaCapacitacion_ {
 	    Data: management.AudienciaCapacitacion;
 	
@@ -437,6 +491,36 @@ export namespace common {
 		    return a;
 		}
 	}
+	export class JSONMap_dece_internal_domain_student_DetalleFusion_ {
+	    Data: student.DetalleFusion;
+	
+	    static createFrom(source: any = {}) {
+	        return new JSONMap_dece_internal_domain_student_DetalleFusion_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Data = this.convertValues(source["Data"], student.DetalleFusion);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class JSONMap_dece_internal_domain_student_InfoNacionalidad_ {
 	    Data: student.InfoNacionalidad;
 	
@@ -509,6 +593,18 @@ export namespace common {
 	        this.Data = source["Data"];
 	    }
 	}
+	export class JSONMap_map_string_string_ {
+	    Data: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new JSONMap_map_string_string_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Data = source["Data"];
+	    }
+	}
 
 }
 
@@ -582,6 +678,20 @@ export namespace dashboard {
 	        this.cantidad_faltas = source["cantidad_faltas"];
 	    }
 	}
+	export class CursoExtraedadDTO {
+	    curso: string;
+	    cantidad: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CursoExtraedadDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso = source["curso"];
+	        this.cantidad = source["cantidad"];
+	    }
+	}
 	export class GeneroDTO {
 	    genero: string;
 	    cantidad: number;
@@ -601,6 +711,7 @@ export namespace dashboard {
 	    casos_abiertos: number;
 	    citas_pendientes: number;
 	    sanciones_mes: number;
+	    extraedad: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new KPIDashboardDTO(source);
@@ -612,12 +723,14 @@ export namespace dashboard {
 	        this.casos_abiertos = source["casos_abiertos"];
 	        this.citas_pendientes = source["citas_pendientes"];
 	        this.sanciones_mes = source["sanciones_mes"];
+	        this.extraedad = source["extraedad"];
 	    }
 	}
 	export class DashboardDataDTO {
 	    kpi: KPIDashboardDTO;
 	    citas_proximas: CitaProximaDTO[];
 	    cursos_conflictivos: CursoConflictivoDTO[];
+	    extraedad_por_curso: CursoExtraedadDTO[];
 	    casos_por_tipo: CasoPorTipoDTO[];
 	    estudiantes_genero: GeneroDTO[];
 	    actividad_reciente: ActividadDTO[];
@@ -631,6 +744,7 @@ export namespace dashboard {
 	        this.kpi = this.convertValues(source["kpi"], KPIDashboardDTO);
 	        this.citas_proximas = this.convertValues(source["citas_proximas"], CitaProximaDTO);
 	        this.cursos_conflictivos = this.convertValues(source["cursos_conflictivos"], CursoConflictivoDTO);
+	        this.extraedad_por_curso = this.convertValues(source["extraedad_por_curso"], CursoExtraedadDTO);
 	        this.casos_por_tipo = this.convertValues(source["casos_por_tipo"], CasoPorTipoDTO);
 	        this.estudiantes_genero = this.convertValues(source["estudiantes_genero"], GeneroDTO);
 	        this.actividad_reciente = this.convertValues(source["actividad_reciente"], ActividadDTO);
@@ -658,6 +772,67 @@ export namespace dashboard {
 
 }
 
+export namespace documents {
+	
+	export class IntegridadDocumentoDTO {
+	    version_id: number;
+	    integro: boolean;
+	    hash_esperado: string;
+	    hash_actual: string;
+	    detalle: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new IntegridadDocumentoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.version_id = source["version_id"];
+	        this.integro = source["integro"];
+	        this.hash_esperado = source["hash_esperado"];
+	        this.hash_actual = source["hash_actual"];
+	        this.detalle = source["detalle"];
+	    }
+	}
+	export class VersionDocumentoDTO {
+	    id: number;
+	    entidad: string;
+	    entidad_id: number;
+	    tipo_documento: string;
+	    version: number;
+	    vigente: boolean;
+	    nombre: string;
+	    tamano_bytes: number;
+	    hash_sha256: string;
+	    subido_por: string;
+	    fecha_subida: string;
+	    fecha_retiro: string;
+	    archivo_existe: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new VersionDocumentoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.entidad = source["entidad"];
+	        this.entidad_id = source["entidad_id"];
+	        this.tipo_documento = source["tipo_documento"];
+	        this.version = source["version"];
+	        this.vigente = source["vigente"];
+	        this.nombre = source["nombre"];
+	        this.tamano_bytes = source["tamano_bytes"];
+	        this.hash_sha256 = source["hash_sha256"];
+	        this.subido_por = source["subido_por"];
+	        this.fecha_subida = source["fecha_subida"];
+	        this.fecha_retiro = source["fecha_retiro"];
+	        this.archivo_existe = source["archivo_existe"];
+	    }
+	}
+
+}
+
 export namespace dto {
 	
 	export class NotificacionDTO {
@@ -917,6 +1092,24 @@ export namespace dtos {
 
 export namespace enrollment {
 	
+	export class Alergia {
+	    alergeno: string;
+	    severidad: string;
+	    reaccion: string;
+	    actuacion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Alergia(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.alergeno = source["alergeno"];
+	        this.severidad = source["severidad"];
+	        this.reaccion = source["reaccion"];
+	        this.actuacion = source["actuacion"];
+	    }
+	}
 	export class Antropometria {
 	    peso: number;
 	    talla: number;
@@ -933,6 +1126,180 @@ export namespace enrollment {
 	        this.tipo_sangre = source["tipo_sangre"];
 	    }
 	}
+	export class MovimientoBalanceoDTO {
+	    matricula_id: number;
+	    estudiante_id: number;
+	    estudiante: string;
+	    genero: string;
+	    es_nee: boolean;
+	    curso_origen_id: number;
+	    curso_origen: string;
+	    curso_destino_id: number;
+	    curso_destino: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MovimientoBalanceoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matricula_id = source["matricula_id"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.estudiante = source["estudiante"];
+	        this.genero = source["genero"];
+	        this.es_nee = source["es_nee"];
+	        this.curso_origen_id = source["curso_origen_id"];
+	        this.curso_origen = source["curso_origen"];
+	        this.curso_destino_id = source["curso_destino_id"];
+	        this.curso_destino = source["curso_destino"];
+	    }
+	}
+	export class AplicarBalanceoDTO {
+	    movimientos: MovimientoBalanceoDTO[];
+	    fecha: string;
+	    motivo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new AplicarBalanceoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.movimientos = this.convertValues(source["movimientos"], MovimientoBalanceoDTO);
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CambiarCursoDTO {
+	    matricula_id: number;
+	    curso_id: number;
+	    fecha: string;
+	    motivo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CambiarCursoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matricula_id = source["matricula_id"];
+	        this.curso_id = source["curso_id"];
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	    }
+	}
+	export class CambiarEstadoMatriculaDTO {
+	    matricula_id: number;
+	    estado: string;
+	    fecha: string;
+	    motivo: string;
+	    ruta_documento: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CambiarEstadoMatriculaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matricula_id = source["matricula_id"];
+	        this.estado = source["estado"];
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	        this.ruta_documento = source["ruta_documento"];
+	    }
+	}
+	export class CambioCampo {
+	    campo: string;
+	    anterior: any;
+	    nuevo: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new CambioCampo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.campo = source["campo"];
+	        this.anterior = source["anterior"];
+	        this.nuevo = source["nuevo"];
+	    }
+	}
+	export class CambioCursoDTO {
+	    id: number;
+	    matricula_id: number;
+	    curso_anterior: string;
+	    curso_nuevo: string;
+	    fecha: string;
+	    motivo: string;
+	    nombre_usuario: string;
+	    fecha_registro: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CambioCursoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.curso_anterior = source["curso_anterior"];
+	        this.curso_nuevo = source["curso_nuevo"];
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
+	    }
+	}
+	export class ComposicionCursoDTO {
+	    total: number;
+	    mujeres: number;
+	    hombres: number;
+	    nee: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ComposicionCursoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.total = source["total"];
+	        this.mujeres = source["mujeres"];
+	        this.hombres = source["hombres"];
+	        this.nee = source["nee"];
+	    }
+	}
+	export class CondicionCronica {
+	    nombre: string;
+	    actuacion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CondicionCronica(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nombre = source["nombre"];
+	        this.actuacion = source["actuacion"];
+	    }
+	}
 	export class InfoPadresPareja {
 	    nombres: string;
 	    apellidos: string;
@@ -1029,19 +1396,114 @@ export namespace enrollment {
 		    return a;
 		}
 	}
-	export class DatosSalud {
-	    tiene_eval_psicopedagogica: boolean;
-	    ruta_eval_psicopedagogica: string;
-	    tiene_discapacidad: boolean;
-	    detalle_discapacidad: string;
-	    ha_sufrido_accidente: boolean;
-	    detalle_accidente: string;
-	    tiene_alergias: boolean;
-	    detalle_alergia: string;
-	    tiene_cirugias: boolean;
-	    detalle_cirugia: string;
+	export class ContactoEmergencia {
+	    nombres: string;
+	    parentesco: string;
+	    telefono: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ContactoEmergencia(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nombres = source["nombres"];
+	        this.parentesco = source["parentesco"];
+	        this.telefono = source["telefono"];
+	    }
+	}
+	export class CursoBalanceoDTO {
+	    curso_id: number;
+	    curso: string;
+	    capacidad: number;
+	    actual: ComposicionCursoDTO;
+	    propuesto: ComposicionCursoDTO;
+	
+	    static createFrom(source: any = {}) {
+	        return new CursoBalanceoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso_id = source["curso_id"];
+	        this.curso = source["curso"];
+	        this.capacidad = source["capacidad"];
+	        this.actual = this.convertValues(source["actual"], ComposicionCursoDTO);
+	        this.propuesto = this.convertValues(source["propuesto"], ComposicionCursoDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SeguroMedico {
+	    tiene_seguro: boolean;
+	    aseguradora: string;
+	    numero_poliza: string;
+	    telefono: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SeguroMedico(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tiene_seguro = source["tiene_seguro"];
+	        this.aseguradora = source["aseguradora"];
+	        this.numero_poliza = source["numero_poliza"];
+	        this.telefono = source["telefono"];
+	    }
+	}
+	export class Medicacion {
+	    medicamento: string;
+	    dosis: string;
+	    frecuencia: string;
+	    en_institucion: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Medicacion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.medicamento = source["medicamento"];
+	        this.dosis = source["dosis"];
+	        this.frecuencia = source["frecuencia"];
+	        this.en_institucion = source["en_institucion"];
+	    }
+	}
+	export class DatosSalud {
+	    tiene_eval_psicopedagogica: boolean;
+	    ruta_eval_psicopedagogica: string;
+	    tiene_discapacidad: boolean;
+	    detalle_discapacidad: string;
+	    ha_sufrido_accidente: boolean;
+	    detalle_accidente: string;
+	    tiene_alergias: boolean;
+	    detalle_alergia: string;
+	    tiene_cirugias: boolean;
+	    detalle_cirugia: string;
 	    tiene_enfermedad: boolean;
 	    detalle_enfermedad: string;
+	    alergias: Alergia[];
+	    condiciones_cronicas: CondicionCronica[];
+	    medicaciones: Medicacion[];
+	    contactos_emergencia: ContactoEmergencia[];
+	    seguro_medico: SeguroMedico;
 	
 	    static createFrom(source: any = {}) {
 	        return new DatosSalud(source);
@@ -1061,7 +1523,30 @@ export namespace enrollment {
 	        this.detalle_cirugia = source["detalle_cirugia"];
 	        this.tiene_enfermedad = source["tiene_enfermedad"];
 	        this.detalle_enfermedad = source["detalle_enfermedad"];
+	        this.alergias = this.convertValues(source["alergias"], Alergia);
+	        this.condiciones_cronicas = this.convertValues(source["condiciones_cronicas"], CondicionCronica);
+	        this.medicaciones = this.convertValues(source["medicaciones"], Medicacion);
+	        this.contactos_emergencia = this.convertValues(source["contactos_emergencia"], ContactoEmergencia);
+	        this.seguro_medico = this.convertValues(source["seguro_medico"], SeguroMedico);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	export class DatosSociales {
 	    actividades: string[];
@@ -1135,6 +1620,68 @@ export namespace enrollment {
 		    return a;
 		}
 	}
+	export class FichaEnFechaDTO {
+	    matricula_id: number;
+	    fecha: string;
+	    antropometria: Antropometria;
+	    historial_academico: HistorialAcademico;
+	    datos_salud: DatosSalud;
+	    datos_sociales: DatosSociales;
+	    condicion_genero: CondicionGenero;
+	    versiones: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new FichaEnFechaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matricula_id = source["matricula_id"];
+	        this.fecha = source["fecha"];
+	        this.antropometria = this.convertValues(source["antropometria"], Antropometria);
+	        this.historial_academico = this.convertValues(source["historial_academico"], HistorialAcademico);
+	        this.datos_salud = this.convertValues(source["datos_salud"], DatosSalud);
+	        this.datos_sociales = this.convertValues(source["datos_sociales"], DatosSociales);
+	        this.condicion_genero = this.convertValues(source["condicion_genero"], CondicionGenero);
+	        this.versiones = source["versiones"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UbicacionDomicilio {
+	    parroquia: string;
+	    barrio_recinto: string;
+	    latitud?: number;
+	    longitud?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new UbicacionDomicilio(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.parroquia = source["parroquia"];
+	        this.barrio_recinto = source["barrio_recinto"];
+	        this.latitud = source["latitud"];
+	        this.longitud = source["longitud"];
+	    }
+	}
 	export class GuardarMatriculaDTO {
 	    id: number;
 	    estudiante_id: number;
@@ -1146,6 +1693,7 @@ export namespace enrollment {
 	    datos_sociales: DatosSociales;
 	    condicion_genero: CondicionGenero;
 	    direccion_actual: string;
+	    ubicacion: UbicacionDomicilio;
 	    ruta_croquis: string;
 	    ruta_consentimiento: string;
 	    estado: string;
@@ -1166,6 +1714,7 @@ export namespace enrollment {
 	        this.datos_sociales = this.convertValues(source["datos_sociales"], DatosSociales);
 	        this.condicion_genero = this.convertValues(source["condicion_genero"], CondicionGenero);
 	        this.direccion_actual = source["direccion_actual"];
+	        this.ubicacion = this.convertValues(source["ubicacion"], UbicacionDomicilio);
 	        this.ruta_croquis = source["ruta_croquis"];
 	        this.ruta_consentimiento = source["ruta_consentimiento"];
 	        this.estado = source["estado"];
@@ -1224,6 +1773,7 @@ export namespace enrollment {
 	    datos_sociales: common.JSONMap_dece_internal_domain_enrollment_DatosSociales_;
 	    condicion_genero: common.JSONMap_dece_internal_domain_enrollment_CondicionGenero_;
 	    direccion_actual: string;
+	    ubicacion: common.JSONMap_dece_internal_domain_enrollment_UbicacionDomicilio_;
 	    ruta_croquis: string;
 	    ruta_consentimiento: string;
 	    fecha_registro: string;
@@ -1247,6 +1797,7 @@ export namespace enrollment {
 	        this.datos_sociales = this.convertValues(source["datos_sociales"], common.JSONMap_dece_internal_domain_enrollment_DatosSociales_);
 	        this.condicion_genero = this.convertValues(source["condicion_genero"], common.JSONMap_dece_internal_domain_enrollment_CondicionGenero_);
 	        this.direccion_actual = source["direccion_actual"];
+	        this.ubicacion = this.convertValues(source["ubicacion"], common.JSONMap_dece_internal_domain_enrollment_UbicacionDomicilio_);
 	        this.ruta_croquis = source["ruta_croquis"];
 	        this.ruta_consentimiento = source["ruta_consentimiento"];
 	        this.fecha_registro = source["fecha_registro"];
@@ -1283,6 +1834,7 @@ export namespace enrollment {
 	    datos_sociales: DatosSociales;
 	    condicion_genero: CondicionGenero;
 	    direccion_actual: string;
+	    ubicacion: UbicacionDomicilio;
 	    ruta_croquis: string;
 	    ruta_consentimiento: string;
 	    estado: string;
@@ -1303,6 +1855,7 @@ export namespace enrollment {
 	        this.datos_sociales = this.convertValues(source["datos_sociales"], DatosSociales);
 	        this.condicion_genero = this.convertValues(source["condicion_genero"], CondicionGenero);
 	        this.direccion_actual = source["direccion_actual"];
+	        this.ubicacion = this.convertValues(source["ubicacion"], UbicacionDomicilio);
 	        this.ruta_croquis = source["ruta_croquis"];
 	        this.ruta_consentimiento = source["ruta_consentimiento"];
 	        this.estado = source["estado"];
@@ -1326,75 +1879,28 @@ export namespace enrollment {
 		    return a;
 		}
 	}
-
-}
-
-export namespace faculty {
-	
-	export class AsignarDocenteDTO {
-	    curso_id: number;
-	    materia_id: number;
-	    docente_id: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new AsignarDocenteDTO(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.curso_id = source["curso_id"];
-	        this.materia_id = source["materia_id"];
-	        this.docente_id = source["docente_id"];
-	    }
-	}
-	export class Docente {
-	    id: number;
-	    cedula: string;
-	    nombres_completos: string;
-	    telefono: string;
-	    correo: string;
-	    activo: boolean;
 	
-	    static createFrom(source: any = {}) {
-	        return new Docente(source);
-	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.cedula = source["cedula"];
-	        this.nombres_completos = source["nombres_completos"];
-	        this.telefono = source["telefono"];
-	        this.correo = source["correo"];
-	        this.activo = source["activo"];
-	    }
-	}
-	export class Curso {
-	    id: number;
-	    periodo_id: number;
+	export class PropuestaBalanceoDTO {
 	    nivel_id: number;
-	    tutor_id?: number;
-	    paralelo: string;
+	    nivel: string;
 	    jornada: string;
-	    periodo?: academic.PeriodoLectivo;
-	    nivel?: academic.NivelEducativo;
-	    tutor?: Docente;
+	    cursos: CursoBalanceoDTO[];
+	    movimientos: MovimientoBalanceoDTO[];
+	    advertencias: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new Curso(source);
+	        return new PropuestaBalanceoDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.periodo_id = source["periodo_id"];
 	        this.nivel_id = source["nivel_id"];
-	        this.tutor_id = source["tutor_id"];
-	        this.paralelo = source["paralelo"];
+	        this.nivel = source["nivel"];
 	        this.jornada = source["jornada"];
-	        this.periodo = this.convertValues(source["periodo"], academic.PeriodoLectivo);
-	        this.nivel = this.convertValues(source["nivel"], academic.NivelEducativo);
-	        this.tutor = this.convertValues(source["tutor"], Docente);
+	        this.cursos = this.convertValues(source["cursos"], CursoBalanceoDTO);
+	        this.movimientos = this.convertValues(source["movimientos"], MovimientoBalanceoDTO);
+	        this.advertencias = source["advertencias"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1415,271 +1921,290 @@ export namespace faculty {
 		    return a;
 		}
 	}
-	export class CursoResponseDTO {
+	export class Reincorporacion {
 	    id: number;
-	    nivel_nombre: string;
-	    paralelo: string;
-	    jornada: string;
-	    tutor_nombre: string;
-	    nombre_completo: string;
-	    nivel_id: number;
-	    tutor_id?: number;
+	    matricula_anterior_id: number;
+	    matricula_id: number;
+	    retiro_id?: number;
+	    curso_anterior_id: number;
+	    curso_id: number;
+	    estado_anterior: string;
+	    fecha: string;
+	    motivo: string;
+	    compromisos: string;
+	    ruta_documento: string;
+	    convocatoria_id?: number;
+	    usuario_id: number;
+	    nombre_usuario: string;
+	    fecha_registro: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new CursoResponseDTO(source);
+	        return new Reincorporacion(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.nivel_nombre = source["nivel_nombre"];
-	        this.paralelo = source["paralelo"];
-	        this.jornada = source["jornada"];
-	        this.tutor_nombre = source["tutor_nombre"];
-	        this.nombre_completo = source["nombre_completo"];
-	        this.nivel_id = source["nivel_id"];
-	        this.tutor_id = source["tutor_id"];
+	        this.matricula_anterior_id = source["matricula_anterior_id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.retiro_id = source["retiro_id"];
+	        this.curso_anterior_id = source["curso_anterior_id"];
+	        this.curso_id = source["curso_id"];
+	        this.estado_anterior = source["estado_anterior"];
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	        this.compromisos = source["compromisos"];
+	        this.ruta_documento = source["ruta_documento"];
+	        this.convocatoria_id = source["convocatoria_id"];
+	        this.usuario_id = source["usuario_id"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
 	    }
 	}
-	
-	export class DocenteDTO {
+	export class ReincorporacionDTO {
 	    id: number;
-	    cedula: string;
-	    nombres_completos: string;
-	    telefono: string;
-	    correo: string;
-	    activo: boolean;
+	    matricula_id: number;
+	    periodo_lectivo: string;
+	    curso_anterior: string;
+	    curso: string;
+	    estado_anterior: string;
+	    fecha_retiro: string;
+	    motivo_retiro: string;
+	    fecha: string;
+	    motivo: string;
+	    compromisos: string;
+	    ruta_documento: string;
+	    convocatoria_id?: number;
+	    fecha_seguimiento: string;
+	    nombre_usuario: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new DocenteDTO(source);
+	        return new ReincorporacionDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.cedula = source["cedula"];
-	        this.nombres_completos = source["nombres_completos"];
-	        this.telefono = source["telefono"];
-	        this.correo = source["correo"];
-	        this.activo = source["activo"];
+	        this.matricula_id = source["matricula_id"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.curso_anterior = source["curso_anterior"];
+	        this.curso = source["curso"];
+	        this.estado_anterior = source["estado_anterior"];
+	        this.fecha_retiro = source["fecha_retiro"];
+	        this.motivo_retiro = source["motivo_retiro"];
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	        this.compromisos = source["compromisos"];
+	        this.ruta_documento = source["ruta_documento"];
+	        this.convocatoria_id = source["convocatoria_id"];
+	        this.fecha_seguimiento = source["fecha_seguimiento"];
+	        this.nombre_usuario = source["nombre_usuario"];
 	    }
 	}
-	export class GuardarCursoDTO {
-	    id: number;
-	    periodo_id: number;
-	    nivel_id: number;
-	    paralelo: string;
-	    jornada: string;
-	    tutor_id?: number;
+	export class SeguimientoReincorporacionDTO {
+	    entidad: string;
+	    motivo: string;
+	    fecha_cita: string;
+	    dias_alerta: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GuardarCursoDTO(source);
+	        return new SeguimientoReincorporacionDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.periodo_id = source["periodo_id"];
-	        this.nivel_id = source["nivel_id"];
-	        this.paralelo = source["paralelo"];
-	        this.jornada = source["jornada"];
-	        this.tutor_id = source["tutor_id"];
+	        this.entidad = source["entidad"];
+	        this.motivo = source["motivo"];
+	        this.fecha_cita = source["fecha_cita"];
+	        this.dias_alerta = source["dias_alerta"];
 	    }
 	}
-	export class GuardarDocenteDTO {
-	    id: number;
-	    cedula: string;
-	    nombres_completos: string;
-	    telefono: string;
-	    correo: string;
+	export class ReincorporarEstudianteDTO {
+	    matricula_id: number;
+	    curso_id: number;
+	    fecha: string;
+	    motivo: string;
+	    compromisos: string;
+	    ruta_documento: string;
+	    seguimiento?: SeguimientoReincorporacionDTO;
 	
 	    static createFrom(source: any = {}) {
-	        return new GuardarDocenteDTO(source);
+	        return new ReincorporarEstudianteDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.cedula = source["cedula"];
-	        this.nombres_completos = source["nombres_completos"];
-	        this.telefono = source["telefono"];
-	        this.correo = source["correo"];
-	    }
-	}
-	export class ItemDistributivoDTO {
-	    materia_id: number;
-	    materia_nombre: string;
-	    area: string;
-	    docente_id?: number;
-	    docente_nombre: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new ItemDistributivoDTO(source);
+	        this.matricula_id = source["matricula_id"];
+	        this.curso_id = source["curso_id"];
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	        this.compromisos = source["compromisos"];
+	        this.ruta_documento = source["ruta_documento"];
+	        this.seguimiento = this.convertValues(source["seguimiento"], SeguimientoReincorporacionDTO);
 	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.materia_id = source["materia_id"];
-	        this.materia_nombre = source["materia_nombre"];
-	        this.area = source["area"];
-	        this.docente_id = source["docente_id"];
-	        this.docente_nombre = source["docente_nombre"];
-	    }
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-
-}
-
-export namespace main {
-	
-	export class UpdateCheckResult {
-	    available: boolean;
-	    version: string;
-	    current: string;
-	    error?: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new UpdateCheckResult(source);
-	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.available = source["available"];
-	        this.version = source["version"];
-	        this.current = source["current"];
-	        this.error = source["error"];
-	    }
-	}
-
-}
-
-export namespace management {
 	
-	export class ActualizarCitaDTO {
+	export class TransicionMatricula {
 	    id: number;
 	    matricula_id: number;
-	    entidad: string;
+	    estado_anterior: string;
+	    estado_nuevo: string;
+	    fecha: string;
 	    motivo: string;
-	    fecha_cita: string;
-	    dias_alerta: number;
+	    ruta_documento: string;
+	    usuario_id: number;
+	    nombre_usuario: string;
+	    fecha_registro: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ActualizarCitaDTO(source);
+	        return new TransicionMatricula(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
 	        this.matricula_id = source["matricula_id"];
-	        this.entidad = source["entidad"];
+	        this.estado_anterior = source["estado_anterior"];
+	        this.estado_nuevo = source["estado_nuevo"];
+	        this.fecha = source["fecha"];
 	        this.motivo = source["motivo"];
-	        this.fecha_cita = source["fecha_cita"];
-	        this.dias_alerta = source["dias_alerta"];
+	        this.ruta_documento = source["ruta_documento"];
+	        this.usuario_id = source["usuario_id"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
 	    }
 	}
-	export class AgendarCitaDTO {
-	    matricula_id: number;
-	    entidad: string;
-	    motivo: string;
-	    fecha_cita: string;
-	    dias_alerta: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new AgendarCitaDTO(source);
-	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.matricula_id = source["matricula_id"];
-	        this.entidad = source["entidad"];
-	        this.motivo = source["motivo"];
-	        this.fecha_cita = source["fecha_cita"];
-	        this.dias_alerta = source["dias_alerta"];
-	    }
-	}
-	export class AlertaDashboardDTO {
+	export class VersionFichaDTO {
 	    id: number;
-	    titulo: string;
-	    descripcion: string;
-	    fecha_hora: string;
-	    dias_restantes: number;
-	    nivel_urgencia: string;
-	    color: string;
+	    bloque: string;
+	    version: number;
+	    cambios: CambioCampo[];
+	    nombre_usuario: string;
+	    fecha_registro: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new AlertaDashboardDTO(source);
+	        return new VersionFichaDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.titulo = source["titulo"];
-	        this.descripcion = source["descripcion"];
-	        this.fecha_hora = source["fecha_hora"];
-	        this.dias_restantes = source["dias_restantes"];
-	        this.nivel_urgencia = source["nivel_urgencia"];
-	        this.color = source["color"];
+	        this.bloque = source["bloque"];
+	        this.version = source["version"];
+	        this.cambios = this.convertValues(source["cambios"], CambioCampo);
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class AudienciaCapacitacion {
-	    grupo_objetivo: string;
-	    jornada_docentes: string;
+
+}
+
+export namespace faculty {
+	
+	export class AsignarDocenteDTO {
 	    curso_id: number;
-	    cursos_ids: number[];
-	    grado_especifico: string;
-	    paralelo_especifico: string;
-	    cantidad_beneficiarios: number;
+	    materia_id: number;
+	    docente_id: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new AudienciaCapacitacion(source);
+	        return new AsignarDocenteDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.grupo_objetivo = source["grupo_objetivo"];
-	        this.jornada_docentes = source["jornada_docentes"];
 	        this.curso_id = source["curso_id"];
-	        this.cursos_ids = source["cursos_ids"];
-	        this.grado_especifico = source["grado_especifico"];
-	        this.paralelo_especifico = source["paralelo_especifico"];
-	        this.cantidad_beneficiarios = source["cantidad_beneficiarios"];
+	        this.materia_id = source["materia_id"];
+	        this.docente_id = source["docente_id"];
 	    }
 	}
-	export class AulaDTO {
+	export class Docente {
 	    id: number;
-	    nombre: string;
+	    cedula: string;
+	    nombres_completos: string;
+	    telefono: string;
+	    correo: string;
+	    activo: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new AulaDTO(source);
+	        return new Docente(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.nombre = source["nombre"];
+	        this.cedula = source["cedula"];
+	        this.nombres_completos = source["nombres_completos"];
+	        this.telefono = source["telefono"];
+	        this.correo = source["correo"];
+	        this.activo = source["activo"];
 	    }
 	}
-	export class Capacitacion {
+	export class Curso {
 	    id: number;
 	    periodo_id: number;
-	    tema: string;
-	    fecha: string;
-	    detalle_audiencia: common.JSONMap_dece_internal_domain_management_AudienciaCapacitacion_;
-	    ruta_evidencia: string;
-	    periodo: academic.PeriodoLectivo;
+	    nivel_id: number;
+	    tutor_id?: number;
+	    paralelo: string;
+	    jornada: string;
+	    capacidad: number;
+	    periodo?: academic.PeriodoLectivo;
+	    nivel?: academic.NivelEducativo;
+	    tutor?: Docente;
 	
 	    static createFrom(source: any = {}) {
-	        return new Capacitacion(source);
+	        return new Curso(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
 	        this.periodo_id = source["periodo_id"];
-	        this.tema = source["tema"];
-	        this.fecha = source["fecha"];
-	        this.detalle_audiencia = this.convertValues(source["detalle_audiencia"], common.JSONMap_dece_internal_domain_management_AudienciaCapacitacion_);
-	        this.ruta_evidencia = source["ruta_evidencia"];
+	        this.nivel_id = source["nivel_id"];
+	        this.tutor_id = source["tutor_id"];
+	        this.paralelo = source["paralelo"];
+	        this.jornada = source["jornada"];
+	        this.capacidad = source["capacidad"];
 	        this.periodo = this.convertValues(source["periodo"], academic.PeriodoLectivo);
+	        this.nivel = this.convertValues(source["nivel"], academic.NivelEducativo);
+	        this.tutor = this.convertValues(source["tutor"], Docente);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1700,116 +2225,105 @@ export namespace management {
 		    return a;
 		}
 	}
-	export class CapacitacionResumenDTO {
+	export class CursoResponseDTO {
 	    id: number;
-	    fecha: string;
-	    tema: string;
-	    grupo_objetivo: string;
-	    cantidad_beneficiarios: number;
-	    tiene_evidencia: boolean;
-	    ruta_evidencia: string;
+	    nivel_nombre: string;
+	    paralelo: string;
+	    jornada: string;
+	    tutor_nombre: string;
+	    nombre_completo: string;
+	    nivel_id: number;
+	    tutor_id?: number;
+	    capacidad: number;
+	    capacidad_efectiva: number;
+	    matriculados: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new CapacitacionResumenDTO(source);
+	        return new CursoResponseDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.fecha = source["fecha"];
-	        this.tema = source["tema"];
-	        this.grupo_objetivo = source["grupo_objetivo"];
-	        this.cantidad_beneficiarios = source["cantidad_beneficiarios"];
-	        this.tiene_evidencia = source["tiene_evidencia"];
-	        this.ruta_evidencia = source["ruta_evidencia"];
+	        this.nivel_nombre = source["nivel_nombre"];
+	        this.paralelo = source["paralelo"];
+	        this.jornada = source["jornada"];
+	        this.tutor_nombre = source["tutor_nombre"];
+	        this.nombre_completo = source["nombre_completo"];
+	        this.nivel_id = source["nivel_id"];
+	        this.tutor_id = source["tutor_id"];
+	        this.capacidad = source["capacidad"];
+	        this.capacidad_efectiva = source["capacidad_efectiva"];
+	        this.matriculados = source["matriculados"];
 	    }
 	}
-	export class CitaDetalleDTO {
-	    id: number;
-	    matricula_id: number;
-	    entidad: string;
-	    motivo: string;
-	    fecha_cita: string;
-	    dias_alerta: number;
-	    completada: boolean;
-	    curso: string;
-	    nombres: string;
-	    apellidos: string;
-	    nombre_completo: string;
+	export class CursosPaginadosDTO {
+	    items: CursoResponseDTO[];
+	    paginacion: query.PaginacionDTO;
 	
 	    static createFrom(source: any = {}) {
-	        return new CitaDetalleDTO(source);
+	        return new CursosPaginadosDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.matricula_id = source["matricula_id"];
-	        this.entidad = source["entidad"];
-	        this.motivo = source["motivo"];
-	        this.fecha_cita = source["fecha_cita"];
-	        this.dias_alerta = source["dias_alerta"];
-	        this.completada = source["completada"];
-	        this.curso = source["curso"];
-	        this.nombres = source["nombres"];
-	        this.apellidos = source["apellidos"];
-	        this.nombre_completo = source["nombre_completo"];
+	        this.items = this.convertValues(source["items"], CursoResponseDTO);
+	        this.paginacion = this.convertValues(source["paginacion"], query.PaginacionDTO);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class CitaResumenDTO {
+	
+	export class DocenteDTO {
 	    id: number;
-	    fecha_hora: string;
-	    entidad: string;
-	    motivo: string;
-	    estudiante_nombre: string;
-	    curso: string;
-	    completada: boolean;
-	    alerta: boolean;
+	    cedula: string;
+	    nombres_completos: string;
+	    telefono: string;
+	    correo: string;
+	    activo: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new CitaResumenDTO(source);
+	        return new DocenteDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.fecha_hora = source["fecha_hora"];
-	        this.entidad = source["entidad"];
-	        this.motivo = source["motivo"];
-	        this.estudiante_nombre = source["estudiante_nombre"];
-	        this.curso = source["curso"];
-	        this.completada = source["completada"];
-	        this.alerta = source["alerta"];
+	        this.cedula = source["cedula"];
+	        this.nombres_completos = source["nombres_completos"];
+	        this.telefono = source["telefono"];
+	        this.correo = source["correo"];
+	        this.activo = source["activo"];
 	    }
 	}
-	export class Convocatoria {
-	    id: number;
-	    matricula_id: number;
-	    entidad: string;
-	    motivo: string;
-	    fecha_cita: string;
-	    dias_alerta: number;
-	    cita_completada: boolean;
-	    telegram_synced: boolean;
-	    telegram_id: number;
-	    matricula: enrollment.Matricula;
+	export class DocentesPaginadosDTO {
+	    items: DocenteDTO[];
+	    paginacion: query.PaginacionDTO;
 	
 	    static createFrom(source: any = {}) {
-	        return new Convocatoria(source);
+	        return new DocentesPaginadosDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.matricula_id = source["matricula_id"];
-	        this.entidad = source["entidad"];
-	        this.motivo = source["motivo"];
-	        this.fecha_cita = source["fecha_cita"];
-	        this.dias_alerta = source["dias_alerta"];
-	        this.cita_completada = source["cita_completada"];
-	        this.telegram_synced = source["telegram_synced"];
-	        this.telegram_id = source["telegram_id"];
-	        this.matricula = this.convertValues(source["matricula"], enrollment.Matricula);
+	        this.items = this.convertValues(source["items"], DocenteDTO);
+	        this.paginacion = this.convertValues(source["paginacion"], query.PaginacionDTO);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1830,74 +2344,201 @@ export namespace management {
 		    return a;
 		}
 	}
-	export class FiltroCitasDTO {
-	    tipo: string;
-	    fecha_solo: string;
+	export class GuardarCursoDTO {
+	    id: number;
+	    periodo_id: number;
+	    nivel_id: number;
+	    paralelo: string;
+	    jornada: string;
+	    tutor_id?: number;
+	    capacidad: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new FiltroCitasDTO(source);
+	        return new GuardarCursoDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.tipo = source["tipo"];
-	        this.fecha_solo = source["fecha_solo"];
+	        this.id = source["id"];
+	        this.periodo_id = source["periodo_id"];
+	        this.nivel_id = source["nivel_id"];
+	        this.paralelo = source["paralelo"];
+	        this.jornada = source["jornada"];
+	        this.tutor_id = source["tutor_id"];
+	        this.capacidad = source["capacidad"];
 	    }
 	}
-	export class GuardarCapacitacionDTO {
+	export class GuardarDocenteDTO {
 	    id: number;
-	    tema: string;
+	    cedula: string;
+	    nombres_completos: string;
+	    telefono: string;
+	    correo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarDocenteDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.cedula = source["cedula"];
+	        this.nombres_completos = source["nombres_completos"];
+	        this.telefono = source["telefono"];
+	        this.correo = source["correo"];
+	    }
+	}
+	export class ItemDistributivoDTO {
+	    materia_id: number;
+	    materia_nombre: string;
+	    area: string;
+	    docente_id?: number;
+	    docente_nombre: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemDistributivoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.materia_id = source["materia_id"];
+	        this.materia_nombre = source["materia_nombre"];
+	        this.area = source["area"];
+	        this.docente_id = source["docente_id"];
+	        this.docente_nombre = source["docente_nombre"];
+	    }
+	}
+
+}
+
+export namespace health {
+	
+	export class AdjuntoIncidenteDTO {
+	    nombre: string;
+	    ruta: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new AdjuntoIncidenteDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nombre = source["nombre"];
+	        this.ruta = source["ruta"];
+	    }
+	}
+	export class ControlCarnetDTO {
+	    id: number;
+	    tipo_control_id: number;
+	    tipo: string;
 	    fecha: string;
-	    grupo_objetivo: string;
-	    jornada_docentes: string;
-	    curso_id: number;
-	    cursos_ids: number[];
-	    grado_especifico: string;
-	    paralelo_especifico: string;
-	    cantidad_beneficiarios: number;
+	    profesional: string;
+	    establecimiento: string;
+	    resultado: string;
+	    observacion: string;
+	    origen: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new GuardarCapacitacionDTO(source);
+	        return new ControlCarnetDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.tema = source["tema"];
+	        this.tipo_control_id = source["tipo_control_id"];
+	        this.tipo = source["tipo"];
 	        this.fecha = source["fecha"];
-	        this.grupo_objetivo = source["grupo_objetivo"];
-	        this.jornada_docentes = source["jornada_docentes"];
-	        this.curso_id = source["curso_id"];
-	        this.cursos_ids = source["cursos_ids"];
-	        this.grado_especifico = source["grado_especifico"];
-	        this.paralelo_especifico = source["paralelo_especifico"];
-	        this.cantidad_beneficiarios = source["cantidad_beneficiarios"];
+	        this.profesional = source["profesional"];
+	        this.establecimiento = source["establecimiento"];
+	        this.resultado = source["resultado"];
+	        this.observacion = source["observacion"];
+	        this.origen = source["origen"];
 	    }
 	}
-	export class Plantilla {
-	    id: number;
-	    nombre: string;
+	export class EstadoControlDTO {
+	    tipo_control_id: number;
+	    tipo: string;
+	    periodicidad_meses: number;
+	    ultima_fecha: string;
+	    al_dia: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstadoControlDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tipo_control_id = source["tipo_control_id"];
+	        this.tipo = source["tipo"];
+	        this.periodicidad_meses = source["periodicidad_meses"];
+	        this.ultima_fecha = source["ultima_fecha"];
+	        this.al_dia = source["al_dia"];
+	    }
+	}
+	export class DosisCarnetDTO {
+	    vacuna_id: number;
+	    vacuna: string;
+	    numero_dosis: number;
 	    descripcion: string;
-	    ruta_archivo: string;
-	    tags: common.JSONMap_dece_internal_domain_management_PlantillaTags_;
-	    incluye_firma: boolean;
-	    fecha_creacion: string;
-	    fecha_modificacion: string;
+	    edad_meses: number;
+	    estado: string;
+	    dosis_id: number;
+	    fecha: string;
+	    lote: string;
+	    establecimiento: string;
+	    origen: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new Plantilla(source);
+	        return new DosisCarnetDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.nombre = source["nombre"];
+	        this.vacuna_id = source["vacuna_id"];
+	        this.vacuna = source["vacuna"];
+	        this.numero_dosis = source["numero_dosis"];
 	        this.descripcion = source["descripcion"];
-	        this.ruta_archivo = source["ruta_archivo"];
-	        this.tags = this.convertValues(source["tags"], common.JSONMap_dece_internal_domain_management_PlantillaTags_);
-	        this.incluye_firma = source["incluye_firma"];
-	        this.fecha_creacion = source["fecha_creacion"];
-	        this.fecha_modificacion = source["fecha_modificacion"];
+	        this.edad_meses = source["edad_meses"];
+	        this.estado = source["estado"];
+	        this.dosis_id = source["dosis_id"];
+	        this.fecha = source["fecha"];
+	        this.lote = source["lote"];
+	        this.establecimiento = source["establecimiento"];
+	        this.origen = source["origen"];
+	    }
+	}
+	export class CarnetVacunacionDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    fecha_nacimiento: string;
+	    edad: string;
+	    dosis: DosisCarnetDTO[];
+	    dosis_pendientes: number;
+	    estado_controles: EstadoControlDTO[];
+	    controles_pendientes: number;
+	    controles: ControlCarnetDTO[];
+	    completo: boolean;
+	    advertencia: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CarnetVacunacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.edad = source["edad"];
+	        this.dosis = this.convertValues(source["dosis"], DosisCarnetDTO);
+	        this.dosis_pendientes = source["dosis_pendientes"];
+	        this.estado_controles = this.convertValues(source["estado_controles"], EstadoControlDTO);
+	        this.controles_pendientes = source["controles_pendientes"];
+	        this.controles = this.convertValues(source["controles"], ControlCarnetDTO);
+	        this.completo = source["completo"];
+	        this.advertencia = source["advertencia"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1918,75 +2559,93 @@ export namespace management {
 		    return a;
 		}
 	}
-	export class PlantillaTags {
-	    tags: string[];
-	    tag_labels?: Record<string, string>;
+	export class CatalogosIncidenteDTO {
+	    tipos: string[];
+	    gravedades: string[];
+	    lugares: string[];
+	    medios_notificacion: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new PlantillaTags(source);
+	        return new CatalogosIncidenteDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.tags = source["tags"];
-	        this.tag_labels = source["tag_labels"];
+	        this.tipos = source["tipos"];
+	        this.gravedades = source["gravedades"];
+	        this.lugares = source["lugares"];
+	        this.medios_notificacion = source["medios_notificacion"];
 	    }
 	}
-
-}
-
-export namespace notifications {
+	export class ConteoIncidenteDTO {
+	    nombre: string;
+	    total: number;
 	
-	export class CitaAlertaItem {
-	    convocatoria_id: number;
-	    fecha_cita: string;
-	    entidad: string;
-	    motivo: string;
-	    estudiante: string;
-	    curso: string;
+	    static createFrom(source: any = {}) {
+	        return new ConteoIncidenteDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nombre = source["nombre"];
+	        this.total = source["total"];
+	    }
+	}
+	
+	export class TipoControlMedico {
+	    id: number;
+	    nombre: string;
+	    alias: string;
+	    periodicidad_meses: number;
+	    activo: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new CitaAlertaItem(source);
+	        return new TipoControlMedico(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.convocatoria_id = source["convocatoria_id"];
-	        this.fecha_cita = source["fecha_cita"];
-	        this.entidad = source["entidad"];
-	        this.motivo = source["motivo"];
-	        this.estudiante = source["estudiante"];
-	        this.curso = source["curso"];
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.alias = source["alias"];
+	        this.periodicidad_meses = source["periodicidad_meses"];
+	        this.activo = source["activo"];
 	    }
 	}
-	export class Notificacion {
+	export class ControlMedico {
 	    id: number;
-	    tipo: string;
-	    rol_destino: string;
-	    fecha_programada: string;
-	    momento: string;
-	    titulo: string;
-	    mensaje: string;
-	    leida: boolean;
-	    metadata: common.JSONMap_dece_internal_domain_notifications_NotificacionMetadata_;
-	    fecha_creacion: time.Time;
+	    estudiante_id: number;
+	    tipo_control_id: number;
+	    fecha: string;
+	    profesional: string;
+	    establecimiento: string;
+	    resultado: string;
+	    observacion: string;
+	    origen: string;
+	    usuario_id: number;
+	    nombre_usuario: string;
+	    fecha_registro: string;
+	    tipo_control?: TipoControlMedico;
 	
 	    static createFrom(source: any = {}) {
-	        return new Notificacion(source);
+	        return new ControlMedico(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.tipo = source["tipo"];
-	        this.rol_destino = source["rol_destino"];
-	        this.fecha_programada = source["fecha_programada"];
-	        this.momento = source["momento"];
-	        this.titulo = source["titulo"];
-	        this.mensaje = source["mensaje"];
-	        this.leida = source["leida"];
-	        this.metadata = this.convertValues(source["metadata"], common.JSONMap_dece_internal_domain_notifications_NotificacionMetadata_);
-	        this.fecha_creacion = this.convertValues(source["fecha_creacion"], time.Time);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.tipo_control_id = source["tipo_control_id"];
+	        this.fecha = source["fecha"];
+	        this.profesional = source["profesional"];
+	        this.establecimiento = source["establecimiento"];
+	        this.resultado = source["resultado"];
+	        this.observacion = source["observacion"];
+	        this.origen = source["origen"];
+	        this.usuario_id = source["usuario_id"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
+	        this.tipo_control = this.convertValues(source["tipo_control"], TipoControlMedico);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2007,18 +2666,101 @@ export namespace notifications {
 		    return a;
 		}
 	}
-	export class NotificacionMetadata {
-	    total: number;
-	    items: CitaAlertaItem[];
+	
+	export class DosisVacuna {
+	    id: number;
+	    estudiante_id: number;
+	    vacuna_id: number;
+	    numero_dosis: number;
+	    fecha: string;
+	    lote: string;
+	    establecimiento: string;
+	    observacion: string;
+	    origen: string;
+	    usuario_id: number;
+	    nombre_usuario: string;
+	    fecha_registro: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new NotificacionMetadata(source);
+	        return new DosisVacuna(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.total = source["total"];
-	        this.items = this.convertValues(source["items"], CitaAlertaItem);
+	        this.id = source["id"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.vacuna_id = source["vacuna_id"];
+	        this.numero_dosis = source["numero_dosis"];
+	        this.fecha = source["fecha"];
+	        this.lote = source["lote"];
+	        this.establecimiento = source["establecimiento"];
+	        this.observacion = source["observacion"];
+	        this.origen = source["origen"];
+	        this.usuario_id = source["usuario_id"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
+	    }
+	}
+	export class ErrorImportacionSaludDTO {
+	    hoja: string;
+	    fila: number;
+	    cedula: string;
+	    mensaje: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ErrorImportacionSaludDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.hoja = source["hoja"];
+	        this.fila = source["fila"];
+	        this.cedula = source["cedula"];
+	        this.mensaje = source["mensaje"];
+	    }
+	}
+	export class Vacuna {
+	    id: number;
+	    nombre: string;
+	    alias: string;
+	    descripcion: string;
+	    activa: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Vacuna(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.alias = source["alias"];
+	        this.descripcion = source["descripcion"];
+	        this.activa = source["activa"];
+	    }
+	}
+	export class EsquemaVacuna {
+	    id: number;
+	    vacuna_id: number;
+	    numero_dosis: number;
+	    edad_meses: number;
+	    sexo: string;
+	    descripcion: string;
+	    vacuna?: Vacuna;
+	
+	    static createFrom(source: any = {}) {
+	        return new EsquemaVacuna(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.vacuna_id = source["vacuna_id"];
+	        this.numero_dosis = source["numero_dosis"];
+	        this.edad_meses = source["edad_meses"];
+	        this.sexo = source["sexo"];
+	        this.descripcion = source["descripcion"];
+	        this.vacuna = this.convertValues(source["vacuna"], Vacuna);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2039,57 +2781,78 @@ export namespace notifications {
 		    return a;
 		}
 	}
-
-}
-
-export namespace reports {
-	
-	export class BitacoraTallerDTO {
-	    fecha: string;
-	    tema: string;
-	    grupo: string;
-	    asistentes: number;
+	export class MesIncidentesDTO {
+	    mes: string;
+	    nombre: string;
+	    total: number;
+	    graves: number;
+	    notificados: number;
+	    derivados: number;
+	    por_tipo: ConteoIncidenteDTO[];
 	
 	    static createFrom(source: any = {}) {
-	        return new BitacoraTallerDTO(source);
+	        return new MesIncidentesDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.fecha = source["fecha"];
-	        this.tema = source["tema"];
-	        this.grupo = source["grupo"];
-	        this.asistentes = source["asistentes"];
-	    }
-	}
-	export class BitacoraKPIsDTO {
-	    citas_realizadas: number;
-	    talleres_dictados: number;
-	    personas_capacitadas: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new BitacoraKPIsDTO(source);
+	        this.mes = source["mes"];
+	        this.nombre = source["nombre"];
+	        this.total = source["total"];
+	        this.graves = source["graves"];
+	        this.notificados = source["notificados"];
+	        this.derivados = source["derivados"];
+	        this.por_tipo = this.convertValues(source["por_tipo"], ConteoIncidenteDTO);
 	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.citas_realizadas = source["citas_realizadas"];
-	        this.talleres_dictados = source["talleres_dictados"];
-	        this.personas_capacitadas = source["personas_capacitadas"];
-	    }
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class BitacoraGestionDTO {
-	    kpis: BitacoraKPIsDTO;
-	    talleres: BitacoraTallerDTO[];
+	export class EstadisticaIncidentesDTO {
+	    periodo_lectivo: string;
+	    desde: string;
+	    hasta: string;
+	    total: number;
+	    notificados: number;
+	    derivados: number;
+	    con_disciplina: number;
+	    meses: MesIncidentesDTO[];
+	    por_tipo: ConteoIncidenteDTO[];
+	    por_gravedad: ConteoIncidenteDTO[];
+	    por_lugar: ConteoIncidenteDTO[];
 	
 	    static createFrom(source: any = {}) {
-	        return new BitacoraGestionDTO(source);
+	        return new EstadisticaIncidentesDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kpis = this.convertValues(source["kpis"], BitacoraKPIsDTO);
-	        this.talleres = this.convertValues(source["talleres"], BitacoraTallerDTO);
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.desde = source["desde"];
+	        this.hasta = source["hasta"];
+	        this.total = source["total"];
+	        this.notificados = source["notificados"];
+	        this.derivados = source["derivados"];
+	        this.con_disciplina = source["con_disciplina"];
+	        this.meses = this.convertValues(source["meses"], MesIncidentesDTO);
+	        this.por_tipo = this.convertValues(source["por_tipo"], ConteoIncidenteDTO);
+	        this.por_gravedad = this.convertValues(source["por_gravedad"], ConteoIncidenteDTO);
+	        this.por_lugar = this.convertValues(source["por_lugar"], ConteoIncidenteDTO);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2111,111 +2874,176 @@ export namespace reports {
 		}
 	}
 	
-	
-	export class DatosCasoSensibleDTO {
-	    codigo_caso: string;
-	    fecha_deteccion: string;
-	    tipo_caso: string;
-	    estado: string;
-	    entidad_derivacion: string;
+	export class GuardarEsquemaDTO {
+	    id: number;
+	    vacuna_id: number;
+	    numero_dosis: number;
+	    edad_meses: number;
+	    sexo: string;
 	    descripcion: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new DatosCasoSensibleDTO(source);
+	        return new GuardarEsquemaDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.codigo_caso = source["codigo_caso"];
-	        this.fecha_deteccion = source["fecha_deteccion"];
-	        this.tipo_caso = source["tipo_caso"];
-	        this.estado = source["estado"];
-	        this.entidad_derivacion = source["entidad_derivacion"];
+	        this.id = source["id"];
+	        this.vacuna_id = source["vacuna_id"];
+	        this.numero_dosis = source["numero_dosis"];
+	        this.edad_meses = source["edad_meses"];
+	        this.sexo = source["sexo"];
 	        this.descripcion = source["descripcion"];
 	    }
 	}
-	export class DatosDisciplinaDTO {
+	export class GuardarIncidenteDTO {
+	    id: number;
+	    matricula_id: number;
+	    llamado_atencion_id: number;
 	    fecha: string;
-	    motivo: string;
-	    detalle_sancion: string;
-	    ruta_acta: string;
-	    estado: string;
-	    periodo_lectivo: string;
+	    hora: string;
+	    lugar: string;
+	    tipo: string;
+	    gravedad: string;
+	    descripcion: string;
+	    primeros_auxilios: string;
+	    atendido_por: string;
+	    representante_notificado: boolean;
+	    medio_notificacion: string;
+	    hora_notificacion: string;
+	    persona_notificada: string;
+	    derivado_centro_salud: boolean;
+	    centro_salud: string;
+	    traslado: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new DatosDisciplinaDTO(source);
+	        return new GuardarIncidenteDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.llamado_atencion_id = source["llamado_atencion_id"];
 	        this.fecha = source["fecha"];
-	        this.motivo = source["motivo"];
-	        this.detalle_sancion = source["detalle_sancion"];
-	        this.ruta_acta = source["ruta_acta"];
-	        this.estado = source["estado"];
-	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.hora = source["hora"];
+	        this.lugar = source["lugar"];
+	        this.tipo = source["tipo"];
+	        this.gravedad = source["gravedad"];
+	        this.descripcion = source["descripcion"];
+	        this.primeros_auxilios = source["primeros_auxilios"];
+	        this.atendido_por = source["atendido_por"];
+	        this.representante_notificado = source["representante_notificado"];
+	        this.medio_notificacion = source["medio_notificacion"];
+	        this.hora_notificacion = source["hora_notificacion"];
+	        this.persona_notificada = source["persona_notificada"];
+	        this.derivado_centro_salud = source["derivado_centro_salud"];
+	        this.centro_salud = source["centro_salud"];
+	        this.traslado = source["traslado"];
 	    }
 	}
-	export class DatosFamiliarDTO {
-	    nombres_completos: string;
-	    parentesco: string;
-	    telefono_personal: string;
-	    es_representante_legal: boolean;
-	    vive_con_estudiante: boolean;
-	    datos_extendidos: string;
+	export class GuardarTipoControlDTO {
+	    id: number;
+	    nombre: string;
+	    alias: string;
+	    periodicidad_meses: number;
+	    activo: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new DatosFamiliarDTO(source);
+	        return new GuardarTipoControlDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.nombres_completos = source["nombres_completos"];
-	        this.parentesco = source["parentesco"];
-	        this.telefono_personal = source["telefono_personal"];
-	        this.es_representante_legal = source["es_representante_legal"];
-	        this.vive_con_estudiante = source["vive_con_estudiante"];
-	        this.datos_extendidos = source["datos_extendidos"];
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.alias = source["alias"];
+	        this.periodicidad_meses = source["periodicidad_meses"];
+	        this.activo = source["activo"];
 	    }
 	}
-	export class DatosPersonalesDTO {
+	export class GuardarVacunaDTO {
 	    id: number;
-	    cedula: string;
-	    apellidos: string;
-	    nombres: string;
-	    fecha_nacimiento: string;
-	    genero_nacimiento: string;
-	    correo_electronico: string;
-	    ruta_foto: string;
-	    curso_actual: string;
-	    jornada: string;
-	    direccion_actual: string;
-	    info_nacionalidad: common.JSONMap_map_string_interface____;
-	    datos_salud: common.JSONMap_dece_internal_domain_enrollment_DatosSalud_;
-	    datos_sociales: common.JSONMap_dece_internal_domain_enrollment_DatosSociales_;
-	    antropometria: common.JSONMap_dece_internal_domain_enrollment_Antropometria_;
+	    nombre: string;
+	    alias: string;
+	    descripcion: string;
+	    activa: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new DatosPersonalesDTO(source);
+	        return new GuardarVacunaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.alias = source["alias"];
+	        this.descripcion = source["descripcion"];
+	        this.activa = source["activa"];
+	    }
+	}
+	export class IncidenteDTO {
+	    id: number;
+	    matricula_id: number;
+	    llamado_atencion_id: number;
+	    fecha: string;
+	    hora: string;
+	    lugar: string;
+	    tipo: string;
+	    gravedad: string;
+	    descripcion: string;
+	    primeros_auxilios: string;
+	    atendido_por: string;
+	    representante_notificado: boolean;
+	    medio_notificacion: string;
+	    hora_notificacion: string;
+	    persona_notificada: string;
+	    derivado_centro_salud: boolean;
+	    centro_salud: string;
+	    traslado: string;
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    curso: string;
+	    periodo_lectivo: string;
+	    motivo_llamado: string;
+	    adjuntos: AdjuntoIncidenteDTO[];
+	    nombre_usuario: string;
+	    fecha_registro: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new IncidenteDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.llamado_atencion_id = source["llamado_atencion_id"];
+	        this.fecha = source["fecha"];
+	        this.hora = source["hora"];
+	        this.lugar = source["lugar"];
+	        this.tipo = source["tipo"];
+	        this.gravedad = source["gravedad"];
+	        this.descripcion = source["descripcion"];
+	        this.primeros_auxilios = source["primeros_auxilios"];
+	        this.atendido_por = source["atendido_por"];
+	        this.representante_notificado = source["representante_notificado"];
+	        this.medio_notificacion = source["medio_notificacion"];
+	        this.hora_notificacion = source["hora_notificacion"];
+	        this.persona_notificada = source["persona_notificada"];
+	        this.derivado_centro_salud = source["derivado_centro_salud"];
+	        this.centro_salud = source["centro_salud"];
+	        this.traslado = source["traslado"];
+	        this.estudiante_id = source["estudiante_id"];
 	        this.cedula = source["cedula"];
-	        this.apellidos = source["apellidos"];
-	        this.nombres = source["nombres"];
-	        this.fecha_nacimiento = source["fecha_nacimiento"];
-	        this.genero_nacimiento = source["genero_nacimiento"];
-	        this.correo_electronico = source["correo_electronico"];
-	        this.ruta_foto = source["ruta_foto"];
-	        this.curso_actual = source["curso_actual"];
-	        this.jornada = source["jornada"];
-	        this.direccion_actual = source["direccion_actual"];
-	        this.info_nacionalidad = this.convertValues(source["info_nacionalidad"], common.JSONMap_map_string_interface____);
-	        this.datos_salud = this.convertValues(source["datos_salud"], common.JSONMap_dece_internal_domain_enrollment_DatosSalud_);
-	        this.datos_sociales = this.convertValues(source["datos_sociales"], common.JSONMap_dece_internal_domain_enrollment_DatosSociales_);
-	        this.antropometria = this.convertValues(source["antropometria"], common.JSONMap_dece_internal_domain_enrollment_Antropometria_);
+	        this.estudiante = source["estudiante"];
+	        this.curso = source["curso"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.motivo_llamado = source["motivo_llamado"];
+	        this.adjuntos = this.convertValues(source["adjuntos"], AdjuntoIncidenteDTO);
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2236,90 +3064,133 @@ export namespace reports {
 		    return a;
 		}
 	}
-	export class DerivacionDTO {
-	    codigo_caso: string;
-	    fecha_deteccion: string;
-	    cedula: string;
-	    estudiante: string;
+	export class MedicionDTO {
+	    id: number;
+	    matricula_id: number;
+	    periodo_lectivo: string;
 	    curso: string;
-	    tipo_caso: string;
-	    entidad_derivacion: string;
-	    estado: string;
+	    fecha: string;
+	    peso_kg: number;
+	    talla_cm: number;
+	    imc: number;
+	    edad_meses: number;
+	    edad: string;
+	    zscore_imc?: number;
+	    clasificacion: string;
+	    observacion: string;
+	    nombre_usuario: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new DerivacionDTO(source);
+	        return new MedicionDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.codigo_caso = source["codigo_caso"];
-	        this.fecha_deteccion = source["fecha_deteccion"];
-	        this.cedula = source["cedula"];
-	        this.estudiante = source["estudiante"];
+	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
 	        this.curso = source["curso"];
-	        this.tipo_caso = source["tipo_caso"];
-	        this.entidad_derivacion = source["entidad_derivacion"];
-	        this.estado = source["estado"];
+	        this.fecha = source["fecha"];
+	        this.peso_kg = source["peso_kg"];
+	        this.talla_cm = source["talla_cm"];
+	        this.imc = source["imc"];
+	        this.edad_meses = source["edad_meses"];
+	        this.edad = source["edad"];
+	        this.zscore_imc = source["zscore_imc"];
+	        this.clasificacion = source["clasificacion"];
+	        this.observacion = source["observacion"];
+	        this.nombre_usuario = source["nombre_usuario"];
 	    }
 	}
-	export class EstadisticaCursoConflictivoDTO {
-	    curso: string;
-	    total_faltas: number;
+	
+	export class RegistrarControlDTO {
+	    estudiante_id: number;
+	    tipo_control_id: number;
+	    fecha: string;
+	    profesional: string;
+	    establecimiento: string;
+	    resultado: string;
+	    observacion: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new EstadisticaCursoConflictivoDTO(source);
+	        return new RegistrarControlDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.curso = source["curso"];
-	        this.total_faltas = source["total_faltas"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.tipo_control_id = source["tipo_control_id"];
+	        this.fecha = source["fecha"];
+	        this.profesional = source["profesional"];
+	        this.establecimiento = source["establecimiento"];
+	        this.resultado = source["resultado"];
+	        this.observacion = source["observacion"];
 	    }
 	}
-	export class EstadisticaDerivacionDTO {
-	    entidad_derivacion: string;
-	    cantidad: number;
+	export class RegistrarDosisDTO {
+	    estudiante_id: number;
+	    vacuna_id: number;
+	    numero_dosis: number;
+	    fecha: string;
+	    lote: string;
+	    establecimiento: string;
+	    observacion: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new EstadisticaDerivacionDTO(source);
+	        return new RegistrarDosisDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.entidad_derivacion = source["entidad_derivacion"];
-	        this.cantidad = source["cantidad"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.vacuna_id = source["vacuna_id"];
+	        this.numero_dosis = source["numero_dosis"];
+	        this.fecha = source["fecha"];
+	        this.lote = source["lote"];
+	        this.establecimiento = source["establecimiento"];
+	        this.observacion = source["observacion"];
 	    }
 	}
-	export class EstadisticaTipoCasoDTO {
-	    tipo_caso: string;
-	    cantidad: number;
+	export class RegistrarMedicionDTO {
+	    matricula_id: number;
+	    fecha: string;
+	    peso_kg: number;
+	    talla_cm: number;
+	    observacion: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new EstadisticaTipoCasoDTO(source);
+	        return new RegistrarMedicionDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.tipo_caso = source["tipo_caso"];
-	        this.cantidad = source["cantidad"];
+	        this.matricula_id = source["matricula_id"];
+	        this.fecha = source["fecha"];
+	        this.peso_kg = source["peso_kg"];
+	        this.talla_cm = source["talla_cm"];
+	        this.observacion = source["observacion"];
 	    }
 	}
-	export class FichaEstudiantilDTO {
-	    datos_personales: DatosPersonalesDTO;
-	    familiares: DatosFamiliarDTO[];
-	    disciplina: DatosDisciplinaDTO[];
-	    casos_sensibles: DatosCasoSensibleDTO[];
+	export class ResultadoImportacionSaludDTO {
+	    archivo: string;
+	    filas_leidas: number;
+	    dosis_registradas: number;
+	    controles_registrados: number;
+	    duplicadas: number;
+	    errores: ErrorImportacionSaludDTO[];
 	
 	    static createFrom(source: any = {}) {
-	        return new FichaEstudiantilDTO(source);
+	        return new ResultadoImportacionSaludDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.datos_personales = this.convertValues(source["datos_personales"], DatosPersonalesDTO);
-	        this.familiares = this.convertValues(source["familiares"], DatosFamiliarDTO);
-	        this.disciplina = this.convertValues(source["disciplina"], DatosDisciplinaDTO);
-	        this.casos_sensibles = this.convertValues(source["casos_sensibles"], DatosCasoSensibleDTO);
+	        this.archivo = source["archivo"];
+	        this.filas_leidas = source["filas_leidas"];
+	        this.dosis_registradas = source["dosis_registradas"];
+	        this.controles_registrados = source["controles_registrados"];
+	        this.duplicadas = source["duplicadas"];
+	        this.errores = this.convertValues(source["errores"], ErrorImportacionSaludDTO);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2340,161 +3211,161 @@ export namespace reports {
 		    return a;
 		}
 	}
-	export class NominaVulnerabilidadDTO {
-	    cedula: string;
-	    estudiante: string;
-	    curso: string;
-	    tipo_caso: string;
-	    codigo_caso: string;
-	    estado: string;
-	    fecha_deteccion: string;
 	
-	    static createFrom(source: any = {}) {
-	        return new NominaVulnerabilidadDTO(source);
-	    }
+
+}
+
+export namespace main {
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.cedula = source["cedula"];
-	        this.estudiante = source["estudiante"];
-	        this.curso = source["curso"];
-	        this.tipo_caso = source["tipo_caso"];
-	        this.codigo_caso = source["codigo_caso"];
-	        this.estado = source["estado"];
-	        this.fecha_deteccion = source["fecha_deteccion"];
-	    }
-	}
-	export class ReporteEstadisticoDTO {
-	    conteo_tipo_caso: EstadisticaTipoCasoDTO[];
-	    top_cursos_conflictivos: EstadisticaCursoConflictivoDTO[];
-	    derivaciones_externas: EstadisticaDerivacionDTO[];
-	    fecha_inicio: string;
-	    fecha_fin: string;
+	export class UpdateCheckResult {
+	    available: boolean;
+	    version: string;
+	    current: string;
+	    error?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ReporteEstadisticoDTO(source);
+	        return new UpdateCheckResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.conteo_tipo_caso = this.convertValues(source["conteo_tipo_caso"], EstadisticaTipoCasoDTO);
-	        this.top_cursos_conflictivos = this.convertValues(source["top_cursos_conflictivos"], EstadisticaCursoConflictivoDTO);
-	        this.derivaciones_externas = this.convertValues(source["derivaciones_externas"], EstadisticaDerivacionDTO);
-	        this.fecha_inicio = source["fecha_inicio"];
-	        this.fecha_fin = source["fecha_fin"];
+	        this.available = source["available"];
+	        this.version = source["version"];
+	        this.current = source["current"];
+	        this.error = source["error"];
 	    }
-	
-		convertValues(a: any, classs: any, asMap: boolean = false): any {
-		    if (!a) {
-		        return a;
-		    }
-		    if (a.slice && a.map) {
-		        return (a as any[]).map(elem => this.convertValues(elem, classs));
-		    } else if ("object" === typeof a) {
-		        if (asMap) {
-		            for (const key of Object.keys(a)) {
-		                a[key] = new classs(a[key]);
-		            }
-		            return a;
-		        }
-		        return new classs(a);
-		    }
-		    return a;
-		}
 	}
 
 }
 
-export namespace search {
+export namespace management {
 	
-	export class GlobalSearchResultDTO {
-	    type: string;
+	export class ActualizarCitaDTO {
 	    id: number;
-	    title: string;
-	    description: string;
-	    route: string;
-	    icon: string;
-	    tiene_caso_sensible: boolean;
-	    tiene_disciplina: boolean;
+	    matricula_id: number;
+	    entidad: string;
+	    motivo: string;
+	    fecha_cita: string;
+	    dias_alerta: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GlobalSearchResultDTO(source);
+	        return new ActualizarCitaDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.type = source["type"];
 	        this.id = source["id"];
-	        this.title = source["title"];
-	        this.description = source["description"];
-	        this.route = source["route"];
-	        this.icon = source["icon"];
-	        this.tiene_caso_sensible = source["tiene_caso_sensible"];
-	        this.tiene_disciplina = source["tiene_disciplina"];
+	        this.matricula_id = source["matricula_id"];
+	        this.entidad = source["entidad"];
+	        this.motivo = source["motivo"];
+	        this.fecha_cita = source["fecha_cita"];
+	        this.dias_alerta = source["dias_alerta"];
 	    }
 	}
-
-}
-
-export namespace security {
+	export class AgendarCitaDTO {
+	    matricula_id: number;
+	    entidad: string;
+	    motivo: string;
+	    fecha_cita: string;
+	    dias_alerta: number;
 	
-	export class ConfiguracionSeguridad {
+	    static createFrom(source: any = {}) {
+	        return new AgendarCitaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matricula_id = source["matricula_id"];
+	        this.entidad = source["entidad"];
+	        this.motivo = source["motivo"];
+	        this.fecha_cita = source["fecha_cita"];
+	        this.dias_alerta = source["dias_alerta"];
+	    }
+	}
+	export class AlertaDashboardDTO {
 	    id: number;
-	    clave: string;
-	    valor: boolean;
+	    titulo: string;
 	    descripcion: string;
+	    fecha_hora: string;
+	    dias_restantes: number;
+	    nivel_urgencia: string;
+	    color: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ConfiguracionSeguridad(source);
+	        return new AlertaDashboardDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.clave = source["clave"];
-	        this.valor = source["valor"];
+	        this.titulo = source["titulo"];
 	        this.descripcion = source["descripcion"];
+	        this.fecha_hora = source["fecha_hora"];
+	        this.dias_restantes = source["dias_restantes"];
+	        this.nivel_urgencia = source["nivel_urgencia"];
+	        this.color = source["color"];
 	    }
 	}
-
-}
-
-export namespace services {
+	export class AudienciaCapacitacion {
+	    grupo_objetivo: string;
+	    jornada_docentes: string;
+	    curso_id: number;
+	    cursos_ids: number[];
+	    grado_especifico: string;
+	    paralelo_especifico: string;
+	    cantidad_beneficiarios: number;
 	
-	export class ImportRowError {
-	    fila: number;
-	    cedula: string;
-	    detalle: string;
+	    static createFrom(source: any = {}) {
+	        return new AudienciaCapacitacion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.grupo_objetivo = source["grupo_objetivo"];
+	        this.jornada_docentes = source["jornada_docentes"];
+	        this.curso_id = source["curso_id"];
+	        this.cursos_ids = source["cursos_ids"];
+	        this.grado_especifico = source["grado_especifico"];
+	        this.paralelo_especifico = source["paralelo_especifico"];
+	        this.cantidad_beneficiarios = source["cantidad_beneficiarios"];
+	    }
+	}
+	export class AulaDTO {
+	    id: number;
+	    nombre: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ImportRowError(source);
+	        return new AulaDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.fila = source["fila"];
-	        this.cedula = source["cedula"];
-	        this.detalle = source["detalle"];
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
 	    }
 	}
-	export class ImportResult {
-	    totalFilas: number;
-	    creados: number;
-	    actualizados: number;
-	    omitidos: number;
-	    errores: ImportRowError[];
+	export class Capacitacion {
+	    id: number;
+	    periodo_id: number;
+	    tema: string;
+	    fecha: string;
+	    detalle_audiencia: common.JSONMap_dece_internal_domain_management_AudienciaCapacitacion_;
+	    ruta_evidencia: string;
+	    periodo: academic.PeriodoLectivo;
 	
 	    static createFrom(source: any = {}) {
-	        return new ImportResult(source);
+	        return new Capacitacion(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.totalFilas = source["totalFilas"];
-	        this.creados = source["creados"];
-	        this.actualizados = source["actualizados"];
-	        this.omitidos = source["omitidos"];
-	        this.errores = this.convertValues(source["errores"], ImportRowError);
+	        this.id = source["id"];
+	        this.periodo_id = source["periodo_id"];
+	        this.tema = source["tema"];
+	        this.fecha = source["fecha"];
+	        this.detalle_audiencia = this.convertValues(source["detalle_audiencia"], common.JSONMap_dece_internal_domain_management_AudienciaCapacitacion_);
+	        this.ruta_evidencia = source["ruta_evidencia"];
+	        this.periodo = this.convertValues(source["periodo"], academic.PeriodoLectivo);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2515,71 +3386,180 @@ export namespace services {
 		    return a;
 		}
 	}
-
-}
-
-export namespace student {
+	export class CapacitacionResumenDTO {
+	    id: number;
+	    fecha: string;
+	    tema: string;
+	    grupo_objetivo: string;
+	    cantidad_beneficiarios: number;
+	    tiene_evidencia: boolean;
+	    ruta_evidencia: string;
 	
-	export class DatosFamiliar {
-	    nivel_instruccion: string;
-	    profesion: string;
-	    lugar_trabajo: string;
+	    static createFrom(source: any = {}) {
+	        return new CapacitacionResumenDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.fecha = source["fecha"];
+	        this.tema = source["tema"];
+	        this.grupo_objetivo = source["grupo_objetivo"];
+	        this.cantidad_beneficiarios = source["cantidad_beneficiarios"];
+	        this.tiene_evidencia = source["tiene_evidencia"];
+	        this.ruta_evidencia = source["ruta_evidencia"];
+	    }
+	}
+	export class CapacitacionesPaginadasDTO {
+	    items: CapacitacionResumenDTO[];
+	    paginacion: query.PaginacionDTO;
 	
 	    static createFrom(source: any = {}) {
-	        return new DatosFamiliar(source);
+	        return new CapacitacionesPaginadasDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.nivel_instruccion = source["nivel_instruccion"];
-	        this.profesion = source["profesion"];
-	        this.lugar_trabajo = source["lugar_trabajo"];
+	        this.items = this.convertValues(source["items"], CapacitacionResumenDTO);
+	        this.paginacion = this.convertValues(source["paginacion"], query.PaginacionDTO);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class DatosFamiliarDTO {
-	    nivel_instruccion: string;
-	    profesion: string;
-	    lugar_trabajo: string;
+	export class CitaDetalleDTO {
+	    id: number;
+	    matricula_id: number;
+	    entidad: string;
+	    motivo: string;
+	    fecha_cita: string;
+	    dias_alerta: number;
+	    completada: boolean;
+	    curso: string;
+	    nombres: string;
+	    apellidos: string;
+	    nombre_completo: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new DatosFamiliarDTO(source);
+	        return new CitaDetalleDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.nivel_instruccion = source["nivel_instruccion"];
-	        this.profesion = source["profesion"];
-	        this.lugar_trabajo = source["lugar_trabajo"];
+	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.entidad = source["entidad"];
+	        this.motivo = source["motivo"];
+	        this.fecha_cita = source["fecha_cita"];
+	        this.dias_alerta = source["dias_alerta"];
+	        this.completada = source["completada"];
+	        this.curso = source["curso"];
+	        this.nombres = source["nombres"];
+	        this.apellidos = source["apellidos"];
+	        this.nombre_completo = source["nombre_completo"];
 	    }
 	}
-	export class Familiar {
+	export class CitaResumenDTO {
 	    id: number;
-	    estudiante_id: number;
-	    cedula: string;
-	    nombres_completos: string;
-	    parentesco: string;
-	    es_representante_legal: boolean;
-	    vive_con_estudiante: boolean;
-	    datos_extendidos: common.JSONMap_dece_internal_domain_student_DatosFamiliar_;
-	    telefono_personal: string;
-	    fallecido: boolean;
+	    fecha_hora: string;
+	    entidad: string;
+	    motivo: string;
+	    estudiante_nombre: string;
+	    curso: string;
+	    completada: boolean;
+	    alerta: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new Familiar(source);
+	        return new CitaResumenDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.estudiante_id = source["estudiante_id"];
-	        this.cedula = source["cedula"];
-	        this.nombres_completos = source["nombres_completos"];
-	        this.parentesco = source["parentesco"];
-	        this.es_representante_legal = source["es_representante_legal"];
-	        this.vive_con_estudiante = source["vive_con_estudiante"];
-	        this.datos_extendidos = this.convertValues(source["datos_extendidos"], common.JSONMap_dece_internal_domain_student_DatosFamiliar_);
-	        this.telefono_personal = source["telefono_personal"];
-	        this.fallecido = source["fallecido"];
+	        this.fecha_hora = source["fecha_hora"];
+	        this.entidad = source["entidad"];
+	        this.motivo = source["motivo"];
+	        this.estudiante_nombre = source["estudiante_nombre"];
+	        this.curso = source["curso"];
+	        this.completada = source["completada"];
+	        this.alerta = source["alerta"];
+	    }
+	}
+	export class CitasPaginadasDTO {
+	    items: CitaResumenDTO[];
+	    paginacion: query.PaginacionDTO;
+	
+	    static createFrom(source: any = {}) {
+	        return new CitasPaginadasDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.items = this.convertValues(source["items"], CitaResumenDTO);
+	        this.paginacion = this.convertValues(source["paginacion"], query.PaginacionDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Convocatoria {
+	    id: number;
+	    matricula_id: number;
+	    entidad: string;
+	    motivo: string;
+	    fecha_cita: string;
+	    dias_alerta: number;
+	    cita_completada: boolean;
+	    telegram_synced: boolean;
+	    telegram_id: number;
+	    matricula: enrollment.Matricula;
+	
+	    static createFrom(source: any = {}) {
+	        return new Convocatoria(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.entidad = source["entidad"];
+	        this.motivo = source["motivo"];
+	        this.fecha_cita = source["fecha_cita"];
+	        this.dias_alerta = source["dias_alerta"];
+	        this.cita_completada = source["cita_completada"];
+	        this.telegram_synced = source["telegram_synced"];
+	        this.telegram_id = source["telegram_id"];
+	        this.matricula = this.convertValues(source["matricula"], enrollment.Matricula);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2600,40 +3580,3810 @@ export namespace student {
 		    return a;
 		}
 	}
-	export class Estudiante {
+	export class FiltroCitasDTO {
+	    tipo: string;
+	    fecha_solo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FiltroCitasDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tipo = source["tipo"];
+	        this.fecha_solo = source["fecha_solo"];
+	    }
+	}
+	export class GuardarCapacitacionDTO {
+	    id: number;
+	    tema: string;
+	    fecha: string;
+	    grupo_objetivo: string;
+	    jornada_docentes: string;
+	    curso_id: number;
+	    cursos_ids: number[];
+	    grado_especifico: string;
+	    paralelo_especifico: string;
+	    cantidad_beneficiarios: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarCapacitacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.tema = source["tema"];
+	        this.fecha = source["fecha"];
+	        this.grupo_objetivo = source["grupo_objetivo"];
+	        this.jornada_docentes = source["jornada_docentes"];
+	        this.curso_id = source["curso_id"];
+	        this.cursos_ids = source["cursos_ids"];
+	        this.grado_especifico = source["grado_especifico"];
+	        this.paralelo_especifico = source["paralelo_especifico"];
+	        this.cantidad_beneficiarios = source["cantidad_beneficiarios"];
+	    }
+	}
+	export class Plantilla {
+	    id: number;
+	    nombre: string;
+	    descripcion: string;
+	    ruta_archivo: string;
+	    tags: common.JSONMap_dece_internal_domain_management_PlantillaTags_;
+	    incluye_firma: boolean;
+	    fecha_creacion: string;
+	    fecha_modificacion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Plantilla(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.descripcion = source["descripcion"];
+	        this.ruta_archivo = source["ruta_archivo"];
+	        this.tags = this.convertValues(source["tags"], common.JSONMap_dece_internal_domain_management_PlantillaTags_);
+	        this.incluye_firma = source["incluye_firma"];
+	        this.fecha_creacion = source["fecha_creacion"];
+	        this.fecha_modificacion = source["fecha_modificacion"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PlantillaTags {
+	    tags: string[];
+	    tag_labels?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new PlantillaTags(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tags = source["tags"];
+	        this.tag_labels = source["tag_labels"];
+	    }
+	}
+
+}
+
+export namespace notifications {
+	
+	export class CitaAlertaItem {
+	    convocatoria_id: number;
+	    fecha_cita: string;
+	    entidad: string;
+	    motivo: string;
+	    estudiante: string;
+	    curso: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CitaAlertaItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.convocatoria_id = source["convocatoria_id"];
+	        this.fecha_cita = source["fecha_cita"];
+	        this.entidad = source["entidad"];
+	        this.motivo = source["motivo"];
+	        this.estudiante = source["estudiante"];
+	        this.curso = source["curso"];
+	    }
+	}
+	export class HitoAlertaItem {
+	    hito_id: number;
+	    protocolo_id: number;
+	    fecha_programada: string;
+	    vencido: boolean;
+	    estudiante: string;
+	    curso: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new HitoAlertaItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.hito_id = source["hito_id"];
+	        this.protocolo_id = source["protocolo_id"];
+	        this.fecha_programada = source["fecha_programada"];
+	        this.vencido = source["vencido"];
+	        this.estudiante = source["estudiante"];
+	        this.curso = source["curso"];
+	    }
+	}
+	export class Notificacion {
+	    id: number;
+	    tipo: string;
+	    rol_destino: string;
+	    fecha_programada: string;
+	    momento: string;
+	    titulo: string;
+	    mensaje: string;
+	    leida: boolean;
+	    metadata: common.JSONMap_dece_internal_domain_notifications_NotificacionMetadata_;
+	    fecha_creacion: time.Time;
+	
+	    static createFrom(source: any = {}) {
+	        return new Notificacion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.tipo = source["tipo"];
+	        this.rol_destino = source["rol_destino"];
+	        this.fecha_programada = source["fecha_programada"];
+	        this.momento = source["momento"];
+	        this.titulo = source["titulo"];
+	        this.mensaje = source["mensaje"];
+	        this.leida = source["leida"];
+	        this.metadata = this.convertValues(source["metadata"], common.JSONMap_dece_internal_domain_notifications_NotificacionMetadata_);
+	        this.fecha_creacion = this.convertValues(source["fecha_creacion"], time.Time);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NotificacionMetadata {
+	    total: number;
+	    items: CitaAlertaItem[];
+	    hitos?: HitoAlertaItem[];
+	
+	    static createFrom(source: any = {}) {
+	        return new NotificacionMetadata(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.total = source["total"];
+	        this.items = this.convertValues(source["items"], CitaAlertaItem);
+	        this.hitos = this.convertValues(source["hitos"], HitoAlertaItem);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace query {
+	
+	export class FiltroDTO {
+	    campo: string;
+	    operador: string;
+	    valor: string;
+	    valores: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FiltroDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.campo = source["campo"];
+	        this.operador = source["operador"];
+	        this.valor = source["valor"];
+	        this.valores = source["valores"];
+	    }
+	}
+	export class ConsultaDTO {
+	    pagina: number;
+	    tamano_pagina: number;
+	    ordenar_por: string;
+	    descendente: boolean;
+	    busqueda: string;
+	    filtros: FiltroDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ConsultaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pagina = source["pagina"];
+	        this.tamano_pagina = source["tamano_pagina"];
+	        this.ordenar_por = source["ordenar_por"];
+	        this.descendente = source["descendente"];
+	        this.busqueda = source["busqueda"];
+	        this.filtros = this.convertValues(source["filtros"], FiltroDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class PaginacionDTO {
+	    pagina: number;
+	    tamano_pagina: number;
+	    total: number;
+	    total_paginas: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PaginacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pagina = source["pagina"];
+	        this.tamano_pagina = source["tamano_pagina"];
+	        this.total = source["total"];
+	        this.total_paginas = source["total_paginas"];
+	    }
+	}
+
+}
+
+export namespace reports {
+	
+	export class BitacoraTallerDTO {
+	    fecha: string;
+	    tema: string;
+	    grupo: string;
+	    asistentes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BitacoraTallerDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fecha = source["fecha"];
+	        this.tema = source["tema"];
+	        this.grupo = source["grupo"];
+	        this.asistentes = source["asistentes"];
+	    }
+	}
+	export class BitacoraKPIsDTO {
+	    citas_realizadas: number;
+	    talleres_dictados: number;
+	    personas_capacitadas: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BitacoraKPIsDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.citas_realizadas = source["citas_realizadas"];
+	        this.talleres_dictados = source["talleres_dictados"];
+	        this.personas_capacitadas = source["personas_capacitadas"];
+	    }
+	}
+	export class BitacoraGestionDTO {
+	    kpis: BitacoraKPIsDTO;
+	    talleres: BitacoraTallerDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new BitacoraGestionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kpis = this.convertValues(source["kpis"], BitacoraKPIsDTO);
+	        this.talleres = this.convertValues(source["talleres"], BitacoraTallerDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class EstudianteConsentimientoDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    faltantes: string[];
+	    por_vencer: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EstudianteConsentimientoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.faltantes = source["faltantes"];
+	        this.por_vencer = source["por_vencer"];
+	    }
+	}
+	export class ConteoPendienteDTO {
+	    nombre: string;
+	    estudiantes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConteoPendienteDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nombre = source["nombre"];
+	        this.estudiantes = source["estudiantes"];
+	    }
+	}
+	export class ConsentimientosCursoDTO {
+	    curso_id: number;
+	    curso: string;
+	    jornada: string;
+	    periodo_lectivo: string;
+	    fecha_corte: string;
+	    total_estudiantes: number;
+	    completos: number;
+	    por_tipo: ConteoPendienteDTO[];
+	    estudiantes: EstudianteConsentimientoDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ConsentimientosCursoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso_id = source["curso_id"];
+	        this.curso = source["curso"];
+	        this.jornada = source["jornada"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.fecha_corte = source["fecha_corte"];
+	        this.total_estudiantes = source["total_estudiantes"];
+	        this.completos = source["completos"];
+	        this.por_tipo = this.convertValues(source["por_tipo"], ConteoPendienteDTO);
+	        this.estudiantes = this.convertValues(source["estudiantes"], EstudianteConsentimientoDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ContactoInformeDTO {
+	    fecha: string;
+	    modalidad: string;
+	    resumen: string;
+	    acuerdos: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ContactoInformeDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fecha = source["fecha"];
+	        this.modalidad = source["modalidad"];
+	        this.resumen = source["resumen"];
+	        this.acuerdos = source["acuerdos"];
+	    }
+	}
+	export class ConteoEstadoDTO {
+	    estado: string;
+	    total: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConteoEstadoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estado = source["estado"];
+	        this.total = source["total"];
+	    }
+	}
+	export class ConteoNutricionalDTO {
+	    clasificacion: string;
+	    hombres: number;
+	    mujeres: number;
+	    total: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConteoNutricionalDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clasificacion = source["clasificacion"];
+	        this.hombres = source["hombres"];
+	        this.mujeres = source["mujeres"];
+	        this.total = source["total"];
+	    }
+	}
+	
+	export class ConteoProtocoloDTO {
+	    tipo: string;
+	    abiertos: number;
+	    cerrados: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConteoProtocoloDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tipo = source["tipo"];
+	        this.abiertos = source["abiertos"];
+	        this.cerrados = source["cerrados"];
+	    }
+	}
+	export class ConteoUbicacionDTO {
+	    parroquia: string;
+	    barrio_recinto: string;
+	    estudiantes: number;
+	    con_coordenadas: number;
+	    casos_abiertos: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConteoUbicacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.parroquia = source["parroquia"];
+	        this.barrio_recinto = source["barrio_recinto"];
+	        this.estudiantes = source["estudiantes"];
+	        this.con_coordenadas = source["con_coordenadas"];
+	        this.casos_abiertos = source["casos_abiertos"];
+	    }
+	}
+	export class EstudianteExtraedadDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    fecha_nacimiento: string;
+	    edad: number;
+	    edad_maxima: number;
+	    anios_desfase: number;
+	    es_repetidor: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstudianteExtraedadDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.edad = source["edad"];
+	        this.edad_maxima = source["edad_maxima"];
+	        this.anios_desfase = source["anios_desfase"];
+	        this.es_repetidor = source["es_repetidor"];
+	    }
+	}
+	export class CursoExtraedadDTO {
+	    curso_id: number;
+	    curso: string;
+	    jornada: string;
+	    rango_esperado: string;
+	    total_estudiantes: number;
+	    total_extraedad: number;
+	    porcentaje: number;
+	    estudiantes: EstudianteExtraedadDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CursoExtraedadDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso_id = source["curso_id"];
+	        this.curso = source["curso"];
+	        this.jornada = source["jornada"];
+	        this.rango_esperado = source["rango_esperado"];
+	        this.total_estudiantes = source["total_estudiantes"];
+	        this.total_extraedad = source["total_extraedad"];
+	        this.porcentaje = source["porcentaje"];
+	        this.estudiantes = this.convertValues(source["estudiantes"], EstudianteExtraedadDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DatosCasoSensibleDTO {
+	    codigo_caso: string;
+	    fecha_deteccion: string;
+	    tipo_caso: string;
+	    estado: string;
+	    entidad_derivacion: string;
+	    descripcion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DatosCasoSensibleDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.codigo_caso = source["codigo_caso"];
+	        this.fecha_deteccion = source["fecha_deteccion"];
+	        this.tipo_caso = source["tipo_caso"];
+	        this.estado = source["estado"];
+	        this.entidad_derivacion = source["entidad_derivacion"];
+	        this.descripcion = source["descripcion"];
+	    }
+	}
+	export class DatosDisciplinaDTO {
+	    fecha: string;
+	    motivo: string;
+	    detalle_sancion: string;
+	    ruta_acta: string;
+	    estado: string;
+	    periodo_lectivo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DatosDisciplinaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	        this.detalle_sancion = source["detalle_sancion"];
+	        this.ruta_acta = source["ruta_acta"];
+	        this.estado = source["estado"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	    }
+	}
+	export class DatosFamiliarDTO {
+	    nombres_completos: string;
+	    parentesco: string;
+	    telefono_personal: string;
+	    es_representante_legal: boolean;
+	    vive_con_estudiante: boolean;
+	    datos_extendidos: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DatosFamiliarDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nombres_completos = source["nombres_completos"];
+	        this.parentesco = source["parentesco"];
+	        this.telefono_personal = source["telefono_personal"];
+	        this.es_representante_legal = source["es_representante_legal"];
+	        this.vive_con_estudiante = source["vive_con_estudiante"];
+	        this.datos_extendidos = source["datos_extendidos"];
+	    }
+	}
+	export class DatosPersonalesDTO {
+	    id: number;
+	    cedula: string;
+	    apellidos: string;
+	    nombres: string;
+	    fecha_nacimiento: string;
+	    genero_nacimiento: string;
+	    correo_electronico: string;
+	    ruta_foto: string;
+	    curso_actual: string;
+	    jornada: string;
+	    direccion_actual: string;
+	    info_nacionalidad: common.JSONMap_map_string_interface____;
+	    datos_salud: common.JSONMap_dece_internal_domain_enrollment_DatosSalud_;
+	    datos_sociales: common.JSONMap_dece_internal_domain_enrollment_DatosSociales_;
+	    antropometria: common.JSONMap_dece_internal_domain_enrollment_Antropometria_;
+	
+	    static createFrom(source: any = {}) {
+	        return new DatosPersonalesDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.cedula = source["cedula"];
+	        this.apellidos = source["apellidos"];
+	        this.nombres = source["nombres"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.genero_nacimiento = source["genero_nacimiento"];
+	        this.correo_electronico = source["correo_electronico"];
+	        this.ruta_foto = source["ruta_foto"];
+	        this.curso_actual = source["curso_actual"];
+	        this.jornada = source["jornada"];
+	        this.direccion_actual = source["direccion_actual"];
+	        this.info_nacionalidad = this.convertValues(source["info_nacionalidad"], common.JSONMap_map_string_interface____);
+	        this.datos_salud = this.convertValues(source["datos_salud"], common.JSONMap_dece_internal_domain_enrollment_DatosSalud_);
+	        this.datos_sociales = this.convertValues(source["datos_sociales"], common.JSONMap_dece_internal_domain_enrollment_DatosSociales_);
+	        this.antropometria = this.convertValues(source["antropometria"], common.JSONMap_dece_internal_domain_enrollment_Antropometria_);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DerivacionDTO {
+	    codigo_caso: string;
+	    fecha_deteccion: string;
+	    cedula: string;
+	    estudiante: string;
+	    curso: string;
+	    tipo_caso: string;
+	    entidad_derivacion: string;
+	    estado: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DerivacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.codigo_caso = source["codigo_caso"];
+	        this.fecha_deteccion = source["fecha_deteccion"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.curso = source["curso"];
+	        this.tipo_caso = source["tipo_caso"];
+	        this.entidad_derivacion = source["entidad_derivacion"];
+	        this.estado = source["estado"];
+	    }
+	}
+	export class DocumentoInvalidoDTO {
+	    entidad: string;
+	    registro_id: number;
+	    titular: string;
+	    campo: string;
+	    valor: string;
+	    problema: string;
+	    referencia: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DocumentoInvalidoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.entidad = source["entidad"];
+	        this.registro_id = source["registro_id"];
+	        this.titular = source["titular"];
+	        this.campo = source["campo"];
+	        this.valor = source["valor"];
+	        this.problema = source["problema"];
+	        this.referencia = source["referencia"];
+	    }
+	}
+	export class EstadisticaCursoConflictivoDTO {
+	    curso: string;
+	    total_faltas: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstadisticaCursoConflictivoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso = source["curso"];
+	        this.total_faltas = source["total_faltas"];
+	    }
+	}
+	export class EstadisticaDerivacionDTO {
+	    entidad_derivacion: string;
+	    cantidad: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstadisticaDerivacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.entidad_derivacion = source["entidad_derivacion"];
+	        this.cantidad = source["cantidad"];
+	    }
+	}
+	export class EstadisticaTipoCasoDTO {
+	    tipo_caso: string;
+	    cantidad: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstadisticaTipoCasoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tipo_caso = source["tipo_caso"];
+	        this.cantidad = source["cantidad"];
+	    }
+	}
+	export class RemisionesPorCategoriaDTO {
+	    categoria: string;
+	    cantidad: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RemisionesPorCategoriaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.categoria = source["categoria"];
+	        this.cantidad = source["cantidad"];
+	    }
+	}
+	export class RemisionesPorEstadoDTO {
+	    estado: string;
+	    cantidad: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RemisionesPorEstadoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estado = source["estado"];
+	        this.cantidad = source["cantidad"];
+	    }
+	}
+	export class RemisionesPorDocenteDTO {
+	    docente: string;
+	    cantidad: number;
+	    convertidas: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RemisionesPorDocenteDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.docente = source["docente"];
+	        this.cantidad = source["cantidad"];
+	        this.convertidas = source["convertidas"];
+	    }
+	}
+	export class RemisionesPorCursoDTO {
+	    curso: string;
+	    cantidad: number;
+	    convertidas: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RemisionesPorCursoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso = source["curso"];
+	        this.cantidad = source["cantidad"];
+	        this.convertidas = source["convertidas"];
+	    }
+	}
+	export class EstadisticasRemisionesDTO {
+	    fecha_inicio: string;
+	    fecha_fin: string;
+	    total: number;
+	    por_curso: RemisionesPorCursoDTO[];
+	    por_docente: RemisionesPorDocenteDTO[];
+	    por_estado: RemisionesPorEstadoDTO[];
+	    por_categoria: RemisionesPorCategoriaDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EstadisticasRemisionesDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fecha_inicio = source["fecha_inicio"];
+	        this.fecha_fin = source["fecha_fin"];
+	        this.total = source["total"];
+	        this.por_curso = this.convertValues(source["por_curso"], RemisionesPorCursoDTO);
+	        this.por_docente = this.convertValues(source["por_docente"], RemisionesPorDocenteDTO);
+	        this.por_estado = this.convertValues(source["por_estado"], RemisionesPorEstadoDTO);
+	        this.por_categoria = this.convertValues(source["por_categoria"], RemisionesPorCategoriaDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class EstudianteNominaSaludDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    tipo_sangre: string;
+	    alergias: enrollment.Alergia[];
+	    condiciones_cronicas: enrollment.CondicionCronica[];
+	    medicaciones: enrollment.Medicacion[];
+	    discapacidad: string;
+	    contactos_emergencia: enrollment.ContactoEmergencia[];
+	    seguro_medico: enrollment.SeguroMedico;
+	    requiere_atencion: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstudianteNominaSaludDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.tipo_sangre = source["tipo_sangre"];
+	        this.alergias = this.convertValues(source["alergias"], enrollment.Alergia);
+	        this.condiciones_cronicas = this.convertValues(source["condiciones_cronicas"], enrollment.CondicionCronica);
+	        this.medicaciones = this.convertValues(source["medicaciones"], enrollment.Medicacion);
+	        this.discapacidad = source["discapacidad"];
+	        this.contactos_emergencia = this.convertValues(source["contactos_emergencia"], enrollment.ContactoEmergencia);
+	        this.seguro_medico = this.convertValues(source["seguro_medico"], enrollment.SeguroMedico);
+	        this.requiere_atencion = source["requiere_atencion"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EstudianteNutricionDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    sexo: string;
+	    fecha: string;
+	    edad: string;
+	    peso_kg: number;
+	    talla_cm: number;
+	    imc: number;
+	    zscore_imc?: number;
+	    clasificacion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstudianteNutricionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.sexo = source["sexo"];
+	        this.fecha = source["fecha"];
+	        this.edad = source["edad"];
+	        this.peso_kg = source["peso_kg"];
+	        this.talla_cm = source["talla_cm"];
+	        this.imc = source["imc"];
+	        this.zscore_imc = source["zscore_imc"];
+	        this.clasificacion = source["clasificacion"];
+	    }
+	}
+	export class EstudiantePendienteSaludDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    edad: string;
+	    dosis_pendientes: string[];
+	    controles_pendientes: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EstudiantePendienteSaludDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.edad = source["edad"];
+	        this.dosis_pendientes = source["dosis_pendientes"];
+	        this.controles_pendientes = source["controles_pendientes"];
+	    }
+	}
+	export class EventoLineaTiempoDTO {
+	    fecha: string;
+	    tipo: string;
+	    titulo: string;
+	    detalle: string;
+	    estado: string;
+	    referencia_id: number;
+	    periodo_id: number;
+	    periodo_lectivo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EventoLineaTiempoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fecha = source["fecha"];
+	        this.tipo = source["tipo"];
+	        this.titulo = source["titulo"];
+	        this.detalle = source["detalle"];
+	        this.estado = source["estado"];
+	        this.referencia_id = source["referencia_id"];
+	        this.periodo_id = source["periodo_id"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	    }
+	}
+	export class ExportacionResidenciasDTO {
+	    ruta: string;
+	    exportados: number;
+	    sin_coordenadas: number;
+	    seudonimizado: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ExportacionResidenciasDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ruta = source["ruta"];
+	        this.exportados = source["exportados"];
+	        this.sin_coordenadas = source["sin_coordenadas"];
+	        this.seudonimizado = source["seudonimizado"];
+	    }
+	}
+	export class FichaEstudiantilDTO {
+	    datos_personales: DatosPersonalesDTO;
+	    familiares: DatosFamiliarDTO[];
+	    disciplina: DatosDisciplinaDTO[];
+	    casos_sensibles: DatosCasoSensibleDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FichaEstudiantilDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.datos_personales = this.convertValues(source["datos_personales"], DatosPersonalesDTO);
+	        this.familiares = this.convertValues(source["familiares"], DatosFamiliarDTO);
+	        this.disciplina = this.convertValues(source["disciplina"], DatosDisciplinaDTO);
+	        this.casos_sensibles = this.convertValues(source["casos_sensibles"], DatosCasoSensibleDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FiltroLineaTiempoDTO {
+	    tipos: string[];
+	    periodo_id: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new FiltroLineaTiempoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tipos = source["tipos"];
+	        this.periodo_id = source["periodo_id"];
+	    }
+	}
+	export class ProtocoloInformeDTO {
+	    id: number;
+	    estudiante: string;
+	    cedula: string;
+	    curso: string;
+	    periodo_lectivo: string;
+	    tipo: string;
+	    estado: string;
+	    fecha_apertura: string;
+	    fecha_cierre: string;
+	    motivo_cierre: string;
+	    hitos_total: number;
+	    hitos_cumplidos: number;
+	    hitos_vencidos: number;
+	    pendientes: string[];
+	    contactos: ContactoInformeDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ProtocoloInformeDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.estudiante = source["estudiante"];
+	        this.cedula = source["cedula"];
+	        this.curso = source["curso"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.tipo = source["tipo"];
+	        this.estado = source["estado"];
+	        this.fecha_apertura = source["fecha_apertura"];
+	        this.fecha_cierre = source["fecha_cierre"];
+	        this.motivo_cierre = source["motivo_cierre"];
+	        this.hitos_total = source["hitos_total"];
+	        this.hitos_cumplidos = source["hitos_cumplidos"];
+	        this.hitos_vencidos = source["hitos_vencidos"];
+	        this.pendientes = source["pendientes"];
+	        this.contactos = this.convertValues(source["contactos"], ContactoInformeDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class InformeProtocolosDTO {
+	    periodo_lectivo: string;
+	    fecha_corte: string;
+	    coordinador: string;
+	    total: number;
+	    abiertos: number;
+	    hitos_vencidos: number;
+	    por_tipo: ConteoProtocoloDTO[];
+	    protocolos: ProtocoloInformeDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new InformeProtocolosDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.fecha_corte = source["fecha_corte"];
+	        this.coordinador = source["coordinador"];
+	        this.total = source["total"];
+	        this.abiertos = source["abiertos"];
+	        this.hitos_vencidos = source["hitos_vencidos"];
+	        this.por_tipo = this.convertValues(source["por_tipo"], ConteoProtocoloDTO);
+	        this.protocolos = this.convertValues(source["protocolos"], ProtocoloInformeDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LineaTiempoDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    eventos: EventoLineaTiempoDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new LineaTiempoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.eventos = this.convertValues(source["eventos"], EventoLineaTiempoDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MatriculadoFechaDTO {
+	    matricula_id: number;
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    curso_id: number;
+	    curso: string;
+	    jornada: string;
+	    estado: string;
+	    desde_fecha: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MatriculadoFechaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matricula_id = source["matricula_id"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.curso_id = source["curso_id"];
+	        this.curso = source["curso"];
+	        this.jornada = source["jornada"];
+	        this.estado = source["estado"];
+	        this.desde_fecha = source["desde_fecha"];
+	    }
+	}
+	export class MatriculadosFechaDTO {
+	    fecha: string;
+	    periodo_lectivo: string;
+	    total: number;
+	    por_estado: ConteoEstadoDTO[];
+	    estudiantes: MatriculadoFechaDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MatriculadosFechaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fecha = source["fecha"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.total = source["total"];
+	        this.por_estado = this.convertValues(source["por_estado"], ConteoEstadoDTO);
+	        this.estudiantes = this.convertValues(source["estudiantes"], MatriculadoFechaDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NominaSaludCursoDTO {
+	    curso_id: number;
+	    curso: string;
+	    jornada: string;
+	    periodo_lectivo: string;
+	    tutor: string;
+	    total_estudiantes: number;
+	    con_atencion: number;
+	    estudiantes: EstudianteNominaSaludDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new NominaSaludCursoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso_id = source["curso_id"];
+	        this.curso = source["curso"];
+	        this.jornada = source["jornada"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.tutor = source["tutor"];
+	        this.total_estudiantes = source["total_estudiantes"];
+	        this.con_atencion = source["con_atencion"];
+	        this.estudiantes = this.convertValues(source["estudiantes"], EstudianteNominaSaludDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NominaVulnerabilidadDTO {
+	    cedula: string;
+	    estudiante: string;
+	    curso: string;
+	    tipo_caso: string;
+	    codigo_caso: string;
+	    estado: string;
+	    fecha_deteccion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new NominaVulnerabilidadDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.curso = source["curso"];
+	        this.tipo_caso = source["tipo_caso"];
+	        this.codigo_caso = source["codigo_caso"];
+	        this.estado = source["estado"];
+	        this.fecha_deteccion = source["fecha_deteccion"];
+	    }
+	}
+	export class PendientesSaludCursoDTO {
+	    curso_id: number;
+	    curso: string;
+	    jornada: string;
+	    periodo_lectivo: string;
+	    fecha_corte: string;
+	    total_estudiantes: number;
+	    completos: number;
+	    por_vacuna: ConteoPendienteDTO[];
+	    por_control: ConteoPendienteDTO[];
+	    estudiantes: EstudiantePendienteSaludDTO[];
+	    advertencias: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PendientesSaludCursoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso_id = source["curso_id"];
+	        this.curso = source["curso"];
+	        this.jornada = source["jornada"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.fecha_corte = source["fecha_corte"];
+	        this.total_estudiantes = source["total_estudiantes"];
+	        this.completos = source["completos"];
+	        this.por_vacuna = this.convertValues(source["por_vacuna"], ConteoPendienteDTO);
+	        this.por_control = this.convertValues(source["por_control"], ConteoPendienteDTO);
+	        this.estudiantes = this.convertValues(source["estudiantes"], EstudiantePendienteSaludDTO);
+	        this.advertencias = source["advertencias"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	
+	
+	export class ReporteEstadisticoDTO {
+	    conteo_tipo_caso: EstadisticaTipoCasoDTO[];
+	    top_cursos_conflictivos: EstadisticaCursoConflictivoDTO[];
+	    derivaciones_externas: EstadisticaDerivacionDTO[];
+	    fecha_inicio: string;
+	    fecha_fin: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ReporteEstadisticoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.conteo_tipo_caso = this.convertValues(source["conteo_tipo_caso"], EstadisticaTipoCasoDTO);
+	        this.top_cursos_conflictivos = this.convertValues(source["top_cursos_conflictivos"], EstadisticaCursoConflictivoDTO);
+	        this.derivaciones_externas = this.convertValues(source["derivaciones_externas"], EstadisticaDerivacionDTO);
+	        this.fecha_inicio = source["fecha_inicio"];
+	        this.fecha_fin = source["fecha_fin"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ReporteExtraedadDTO {
+	    fecha_referencia: string;
+	    total_estudiantes: number;
+	    total_extraedad: number;
+	    porcentaje: number;
+	    sin_fecha_nacimiento: number;
+	    niveles_sin_configurar: string[];
+	    cursos: CursoExtraedadDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ReporteExtraedadDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fecha_referencia = source["fecha_referencia"];
+	        this.total_estudiantes = source["total_estudiantes"];
+	        this.total_extraedad = source["total_extraedad"];
+	        this.porcentaje = source["porcentaje"];
+	        this.sin_fecha_nacimiento = source["sin_fecha_nacimiento"];
+	        this.niveles_sin_configurar = source["niveles_sin_configurar"];
+	        this.cursos = this.convertValues(source["cursos"], CursoExtraedadDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ReporteNutricionalCursoDTO {
+	    curso_id: number;
+	    curso: string;
+	    jornada: string;
+	    periodo_lectivo: string;
+	    fecha_corte: string;
+	    total_estudiantes: number;
+	    evaluados: number;
+	    conteos: ConteoNutricionalDTO[];
+	    estudiantes: EstudianteNutricionDTO[];
+	    advertencias: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ReporteNutricionalCursoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso_id = source["curso_id"];
+	        this.curso = source["curso"];
+	        this.jornada = source["jornada"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.fecha_corte = source["fecha_corte"];
+	        this.total_estudiantes = source["total_estudiantes"];
+	        this.evaluados = source["evaluados"];
+	        this.conteos = this.convertValues(source["conteos"], ConteoNutricionalDTO);
+	        this.estudiantes = this.convertValues(source["estudiantes"], EstudianteNutricionDTO);
+	        this.advertencias = source["advertencias"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ResidenciasDTO {
+	    periodo_lectivo: string;
+	    curso: string;
+	    total_estudiantes: number;
+	    con_coordenadas: number;
+	    sin_parroquia: number;
+	    por_parroquia: ConteoUbicacionDTO[];
+	    por_barrio: ConteoUbicacionDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ResidenciasDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.curso = source["curso"];
+	        this.total_estudiantes = source["total_estudiantes"];
+	        this.con_coordenadas = source["con_coordenadas"];
+	        this.sin_parroquia = source["sin_parroquia"];
+	        this.por_parroquia = this.convertValues(source["por_parroquia"], ConteoUbicacionDTO);
+	        this.por_barrio = this.convertValues(source["por_barrio"], ConteoUbicacionDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ResumenNutricionalDTO {
+	    periodo_lectivo: string;
+	    fecha_corte: string;
+	    total_estudiantes: number;
+	    evaluados: number;
+	    conteos: ConteoNutricionalDTO[];
+	    cursos: ReporteNutricionalCursoDTO[];
+	    advertencias: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ResumenNutricionalDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.fecha_corte = source["fecha_corte"];
+	        this.total_estudiantes = source["total_estudiantes"];
+	        this.evaluados = source["evaluados"];
+	        this.conteos = this.convertValues(source["conteos"], ConteoNutricionalDTO);
+	        this.cursos = this.convertValues(source["cursos"], ReporteNutricionalCursoDTO);
+	        this.advertencias = source["advertencias"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TarjetaEmergenciaDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    fecha_nacimiento: string;
+	    curso: string;
+	    jornada: string;
+	    institucion: string;
+	    tipo_sangre: string;
+	    alergias: enrollment.Alergia[];
+	    condiciones_cronicas: enrollment.CondicionCronica[];
+	    medicaciones: enrollment.Medicacion[];
+	    discapacidad: string;
+	    contactos_emergencia: enrollment.ContactoEmergencia[];
+	    seguro_medico: enrollment.SeguroMedico;
+	    requiere_atencion: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new TarjetaEmergenciaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.curso = source["curso"];
+	        this.jornada = source["jornada"];
+	        this.institucion = source["institucion"];
+	        this.tipo_sangre = source["tipo_sangre"];
+	        this.alergias = this.convertValues(source["alergias"], enrollment.Alergia);
+	        this.condiciones_cronicas = this.convertValues(source["condiciones_cronicas"], enrollment.CondicionCronica);
+	        this.medicaciones = this.convertValues(source["medicaciones"], enrollment.Medicacion);
+	        this.discapacidad = source["discapacidad"];
+	        this.contactos_emergencia = this.convertValues(source["contactos_emergencia"], enrollment.ContactoEmergencia);
+	        this.seguro_medico = this.convertValues(source["seguro_medico"], enrollment.SeguroMedico);
+	        this.requiere_atencion = source["requiere_atencion"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace search {
+	
+	export class GlobalSearchResultDTO {
+	    type: string;
+	    id: number;
+	    title: string;
+	    description: string;
+	    route: string;
+	    icon: string;
+	    tiene_caso_sensible: boolean;
+	    tiene_disciplina: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new GlobalSearchResultDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.type = source["type"];
+	        this.id = source["id"];
+	        this.title = source["title"];
+	        this.description = source["description"];
+	        this.route = source["route"];
+	        this.icon = source["icon"];
+	        this.tiene_caso_sensible = source["tiene_caso_sensible"];
+	        this.tiene_disciplina = source["tiene_disciplina"];
+	    }
+	}
+
+}
+
+export namespace security {
+	
+	export class ConfiguracionSeguridad {
+	    id: number;
+	    clave: string;
+	    valor: boolean;
+	    descripcion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConfiguracionSeguridad(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.clave = source["clave"];
+	        this.valor = source["valor"];
+	        this.descripcion = source["descripcion"];
+	    }
+	}
+
+}
+
+export namespace services {
+	
+	export class ImportRowError {
+	    fila: number;
+	    cedula: string;
+	    detalle: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportRowError(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fila = source["fila"];
+	        this.cedula = source["cedula"];
+	        this.detalle = source["detalle"];
+	    }
+	}
+	export class ImportResult {
+	    loteId: number;
+	    totalFilas: number;
+	    creados: number;
+	    actualizados: number;
+	    sinCambios: number;
+	    omitidos: number;
+	    errores: ImportRowError[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.loteId = source["loteId"];
+	        this.totalFilas = source["totalFilas"];
+	        this.creados = source["creados"];
+	        this.actualizados = source["actualizados"];
+	        this.sinCambios = source["sinCambios"];
+	        this.omitidos = source["omitidos"];
+	        this.errores = this.convertValues(source["errores"], ImportRowError);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace student {
+	
+	export class CambioCampo {
+	    campo: string;
+	    anterior: string;
+	    nuevo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CambioCampo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.campo = source["campo"];
+	        this.anterior = source["anterior"];
+	        this.nuevo = source["nuevo"];
+	    }
+	}
+	export class CampoImportacionDTO {
+	    campo: string;
+	    etiqueta: string;
+	    obligatorio: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new CampoImportacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.campo = source["campo"];
+	        this.etiqueta = source["etiqueta"];
+	        this.obligatorio = source["obligatorio"];
+	    }
+	}
+	export class CasoHogarDTO {
+	    id: number;
+	    codigo_caso: string;
+	    tipo_caso: string;
+	    estado: string;
+	    fecha_deteccion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CasoHogarDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.codigo_caso = source["codigo_caso"];
+	        this.tipo_caso = source["tipo_caso"];
+	        this.estado = source["estado"];
+	        this.fecha_deteccion = source["fecha_deteccion"];
+	    }
+	}
+	export class CatalogosConsentimientoDTO {
+	    tipos: string[];
+	    bloqueantes: string[];
+	    derivaciones_obligatorias: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogosConsentimientoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tipos = source["tipos"];
+	        this.bloqueantes = source["bloqueantes"];
+	        this.derivaciones_obligatorias = source["derivaciones_obligatorias"];
+	    }
+	}
+	export class ConsentimientoDTO {
+	    id: number;
+	    estudiante_id: number;
+	    familiar_id: number;
+	    tipo: string;
+	    alcance: string;
+	    fecha_firma: string;
+	    fecha_vencimiento: string;
+	    ruta_documento: string;
+	    firmante_nombre: string;
+	    firmante_cedula: string;
+	    firmante_parentesco: string;
+	    fecha_revocacion: string;
+	    motivo_revocacion: string;
+	    estado: string;
+	    nombre_usuario: string;
+	    fecha_registro: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConsentimientoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.familiar_id = source["familiar_id"];
+	        this.tipo = source["tipo"];
+	        this.alcance = source["alcance"];
+	        this.fecha_firma = source["fecha_firma"];
+	        this.fecha_vencimiento = source["fecha_vencimiento"];
+	        this.ruta_documento = source["ruta_documento"];
+	        this.firmante_nombre = source["firmante_nombre"];
+	        this.firmante_cedula = source["firmante_cedula"];
+	        this.firmante_parentesco = source["firmante_parentesco"];
+	        this.fecha_revocacion = source["fecha_revocacion"];
+	        this.motivo_revocacion = source["motivo_revocacion"];
+	        this.estado = source["estado"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
+	    }
+	}
+	export class DatosFamiliar {
+	    nivel_instruccion: string;
+	    profesion: string;
+	    lugar_trabajo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DatosFamiliar(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nivel_instruccion = source["nivel_instruccion"];
+	        this.profesion = source["profesion"];
+	        this.lugar_trabajo = source["lugar_trabajo"];
+	    }
+	}
+	export class DatosFamiliarDTO {
+	    nivel_instruccion: string;
+	    profesion: string;
+	    lugar_trabajo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DatosFamiliarDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nivel_instruccion = source["nivel_instruccion"];
+	        this.profesion = source["profesion"];
+	        this.lugar_trabajo = source["lugar_trabajo"];
+	    }
+	}
+	export class Familiar {
+	    id: number;
+	    estudiante_id: number;
+	    cedula: string;
+	    nombres_completos: string;
+	    parentesco: string;
+	    es_representante_legal: boolean;
+	    vive_con_estudiante: boolean;
+	    datos_extendidos: common.JSONMap_dece_internal_domain_student_DatosFamiliar_;
+	    telefono_personal: string;
+	    fallecido: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Familiar(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.nombres_completos = source["nombres_completos"];
+	        this.parentesco = source["parentesco"];
+	        this.es_representante_legal = source["es_representante_legal"];
+	        this.vive_con_estudiante = source["vive_con_estudiante"];
+	        this.datos_extendidos = this.convertValues(source["datos_extendidos"], common.JSONMap_dece_internal_domain_student_DatosFamiliar_);
+	        this.telefono_personal = source["telefono_personal"];
+	        this.fallecido = source["fallecido"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Estudiante {
+	    id: number;
+	    cedula: string;
+	    apellidos: string;
+	    nombres: string;
+	    fecha_nacimiento: string;
+	    genero_nacimiento: string;
+	    correo_electronico: string;
+	    info_nacionalidad: common.JSONMap_dece_internal_domain_student_InfoNacionalidad_;
+	    ruta_foto: string;
+	    ruta_cedula: string;
+	    ruta_partida_nacimiento: string;
+	    hogar_id?: number;
+	    familiares: Familiar[];
+	    fecha_creacion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Estudiante(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.cedula = source["cedula"];
+	        this.apellidos = source["apellidos"];
+	        this.nombres = source["nombres"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.genero_nacimiento = source["genero_nacimiento"];
+	        this.correo_electronico = source["correo_electronico"];
+	        this.info_nacionalidad = this.convertValues(source["info_nacionalidad"], common.JSONMap_dece_internal_domain_student_InfoNacionalidad_);
+	        this.ruta_foto = source["ruta_foto"];
+	        this.ruta_cedula = source["ruta_cedula"];
+	        this.ruta_partida_nacimiento = source["ruta_partida_nacimiento"];
+	        this.hogar_id = source["hogar_id"];
+	        this.familiares = this.convertValues(source["familiares"], Familiar);
+	        this.fecha_creacion = source["fecha_creacion"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DetalleFusion {
+	    estudiante_eliminado: Estudiante;
+	    matricula_ids: number[];
+	    familiar_ids: number[];
+	    familiares_descartados: Familiar[];
+	    caso_ids: number[];
+	    consentimiento_ids: number[];
+	    certificado_ids: number[];
+	    dosis_vacuna_ids: number[];
+	    control_medico_ids: number[];
+	    campos_heredados: Record<string, string>;
+	    version_documento_ids: number[];
+	    versiones_retiradas_ids: number[];
+	    pareja_matricula_ids: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new DetalleFusion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_eliminado = this.convertValues(source["estudiante_eliminado"], Estudiante);
+	        this.matricula_ids = source["matricula_ids"];
+	        this.familiar_ids = source["familiar_ids"];
+	        this.familiares_descartados = this.convertValues(source["familiares_descartados"], Familiar);
+	        this.caso_ids = source["caso_ids"];
+	        this.consentimiento_ids = source["consentimiento_ids"];
+	        this.certificado_ids = source["certificado_ids"];
+	        this.dosis_vacuna_ids = source["dosis_vacuna_ids"];
+	        this.control_medico_ids = source["control_medico_ids"];
+	        this.campos_heredados = source["campos_heredados"];
+	        this.version_documento_ids = source["version_documento_ids"];
+	        this.versiones_retiradas_ids = source["versiones_retiradas_ids"];
+	        this.pareja_matricula_ids = source["pareja_matricula_ids"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EstadoConsentimientoDTO {
+	    tipo: string;
+	    estado: string;
+	    bloquea: boolean;
+	    consentimiento_id: number;
+	    fecha_vencimiento: string;
+	    firmante: string;
+	    mensaje: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstadoConsentimientoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tipo = source["tipo"];
+	        this.estado = source["estado"];
+	        this.bloquea = source["bloquea"];
+	        this.consentimiento_id = source["consentimiento_id"];
+	        this.fecha_vencimiento = source["fecha_vencimiento"];
+	        this.firmante = source["firmante"];
+	        this.mensaje = source["mensaje"];
+	    }
+	}
+	
+	export class EstudianteDuplicadoDTO {
+	    id: number;
+	    cedula: string;
+	    apellidos: string;
+	    nombres: string;
+	    fecha_nacimiento: string;
+	    total_matriculas: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstudianteDuplicadoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.cedula = source["cedula"];
+	        this.apellidos = source["apellidos"];
+	        this.nombres = source["nombres"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.total_matriculas = source["total_matriculas"];
+	    }
+	}
+	export class InfoNacionalidadDTO {
+	    es_extranjero: boolean;
+	    pais_origen: string;
+	    pasaporte_odni: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new InfoNacionalidadDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.es_extranjero = source["es_extranjero"];
+	        this.pais_origen = source["pais_origen"];
+	        this.pasaporte_odni = source["pasaporte_odni"];
+	    }
+	}
+	export class EstudianteListaDTO {
+	    id: number;
+	    cedula: string;
+	    apellidos: string;
+	    nombres: string;
+	    ruta_foto: string;
+	    ruta_cedula: string;
+	    ruta_partida_nacimiento: string;
+	    fecha_nacimiento: string;
+	    correo_electronico: string;
+	    edad: number;
+	    info_nacionalidad?: InfoNacionalidadDTO;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstudianteListaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.cedula = source["cedula"];
+	        this.apellidos = source["apellidos"];
+	        this.nombres = source["nombres"];
+	        this.ruta_foto = source["ruta_foto"];
+	        this.ruta_cedula = source["ruta_cedula"];
+	        this.ruta_partida_nacimiento = source["ruta_partida_nacimiento"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.correo_electronico = source["correo_electronico"];
+	        this.edad = source["edad"];
+	        this.info_nacionalidad = this.convertValues(source["info_nacionalidad"], InfoNacionalidadDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EstudiantesPaginadosDTO {
+	    items: EstudianteListaDTO[];
+	    paginacion: query.PaginacionDTO;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstudiantesPaginadosDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.items = this.convertValues(source["items"], EstudianteListaDTO);
+	        this.paginacion = this.convertValues(source["paginacion"], query.PaginacionDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class FamiliarHogarDTO {
+	    cedula: string;
+	    nombres_completos: string;
+	    parentesco: string;
+	    telefono_personal: string;
+	    es_representante_legal: boolean;
+	    familiar_ids: number[];
+	    estudiante_ids: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FamiliarHogarDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cedula = source["cedula"];
+	        this.nombres_completos = source["nombres_completos"];
+	        this.parentesco = source["parentesco"];
+	        this.telefono_personal = source["telefono_personal"];
+	        this.es_representante_legal = source["es_representante_legal"];
+	        this.familiar_ids = source["familiar_ids"];
+	        this.estudiante_ids = source["estudiante_ids"];
+	    }
+	}
+	export class FilaImportacion {
+	    hoja: string;
+	    fila: number;
+	    cedula: string;
+	    apellidos: string;
+	    nombres: string;
+	    correo: string;
+	    fecha_nacimiento: string;
+	    genero: string;
+	    nacionalidad: string;
+	    pasaporte_odni: string;
+	    direccion: string;
+	    representante_cedula: string;
+	    representante_nombres: string;
+	    representante_parentesco: string;
+	    representante_telefono: string;
+	    accion: string;
+	    estudiante_id: number;
+	    cambios: CambioCampo[];
+	    matricular: boolean;
+	    matricula_id: number;
+	    observacion: string;
+	    error: string;
+	    familiar_id: number;
+	    familiar_creado: boolean;
+	    cambios_familiar: CambioCampo[];
+	    direccion_matricula_id: number;
+	    direccion_anterior: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FilaImportacion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.hoja = source["hoja"];
+	        this.fila = source["fila"];
+	        this.cedula = source["cedula"];
+	        this.apellidos = source["apellidos"];
+	        this.nombres = source["nombres"];
+	        this.correo = source["correo"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.genero = source["genero"];
+	        this.nacionalidad = source["nacionalidad"];
+	        this.pasaporte_odni = source["pasaporte_odni"];
+	        this.direccion = source["direccion"];
+	        this.representante_cedula = source["representante_cedula"];
+	        this.representante_nombres = source["representante_nombres"];
+	        this.representante_parentesco = source["representante_parentesco"];
+	        this.representante_telefono = source["representante_telefono"];
+	        this.accion = source["accion"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cambios = this.convertValues(source["cambios"], CambioCampo);
+	        this.matricular = source["matricular"];
+	        this.matricula_id = source["matricula_id"];
+	        this.observacion = source["observacion"];
+	        this.error = source["error"];
+	        this.familiar_id = source["familiar_id"];
+	        this.familiar_creado = source["familiar_creado"];
+	        this.cambios_familiar = this.convertValues(source["cambios_familiar"], CambioCampo);
+	        this.direccion_matricula_id = source["direccion_matricula_id"];
+	        this.direccion_anterior = source["direccion_anterior"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FotoGuardadaDTO {
+	    ruta: string;
+	    advertencia: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FotoGuardadaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ruta = source["ruta"];
+	        this.advertencia = source["advertencia"];
+	    }
+	}
+	export class FusionEstudiante {
+	    id: number;
+	    estudiante_conservado_id: number;
+	    estudiante_eliminado_id: number;
+	    cedula_eliminada: string;
+	    usuario_id: number;
+	    motivo: string;
+	    fecha_fusion: string;
+	    detalle: common.JSONMap_dece_internal_domain_student_DetalleFusion_;
+	    revertida: boolean;
+	    fecha_reversion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FusionEstudiante(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.estudiante_conservado_id = source["estudiante_conservado_id"];
+	        this.estudiante_eliminado_id = source["estudiante_eliminado_id"];
+	        this.cedula_eliminada = source["cedula_eliminada"];
+	        this.usuario_id = source["usuario_id"];
+	        this.motivo = source["motivo"];
+	        this.fecha_fusion = source["fecha_fusion"];
+	        this.detalle = this.convertValues(source["detalle"], common.JSONMap_dece_internal_domain_student_DetalleFusion_);
+	        this.revertida = source["revertida"];
+	        this.fecha_reversion = source["fecha_reversion"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FusionResumenDTO {
+	    id: number;
+	    estudiante_conservado_id: number;
+	    estudiante_conservado: string;
+	    estudiante_eliminado_id: number;
+	    estudiante_eliminado: string;
+	    cedula_eliminada: string;
+	    motivo: string;
+	    fecha_fusion: string;
+	    matriculas_movidas: number;
+	    familiares_movidos: number;
+	    casos_movidos: number;
+	    revertida: boolean;
+	    fecha_reversion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FusionResumenDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.estudiante_conservado_id = source["estudiante_conservado_id"];
+	        this.estudiante_conservado = source["estudiante_conservado"];
+	        this.estudiante_eliminado_id = source["estudiante_eliminado_id"];
+	        this.estudiante_eliminado = source["estudiante_eliminado"];
+	        this.cedula_eliminada = source["cedula_eliminada"];
+	        this.motivo = source["motivo"];
+	        this.fecha_fusion = source["fecha_fusion"];
+	        this.matriculas_movidas = source["matriculas_movidas"];
+	        this.familiares_movidos = source["familiares_movidos"];
+	        this.casos_movidos = source["casos_movidos"];
+	        this.revertida = source["revertida"];
+	        this.fecha_reversion = source["fecha_reversion"];
+	    }
+	}
+	export class FusionarEstudiantesDTO {
+	    conservar_id: number;
+	    eliminar_id: number;
+	    usuario_id: number;
+	    motivo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FusionarEstudiantesDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.conservar_id = source["conservar_id"];
+	        this.eliminar_id = source["eliminar_id"];
+	        this.usuario_id = source["usuario_id"];
+	        this.motivo = source["motivo"];
+	    }
+	}
+	export class GuardarConsentimientoDTO {
+	    id: number;
+	    estudiante_id: number;
+	    familiar_id: number;
+	    tipo: string;
+	    alcance: string;
+	    fecha_firma: string;
+	    fecha_vencimiento: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarConsentimientoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.familiar_id = source["familiar_id"];
+	        this.tipo = source["tipo"];
+	        this.alcance = source["alcance"];
+	        this.fecha_firma = source["fecha_firma"];
+	        this.fecha_vencimiento = source["fecha_vencimiento"];
+	    }
+	}
+	export class GuardarFamiliarDTO {
+	    id: number;
+	    cedula: string;
+	    nombres_completos: string;
+	    parentesco: string;
+	    es_representante_legal: boolean;
+	    vive_con_estudiante: boolean;
+	    telefono_personal: string;
+	    fallecido: boolean;
+	    datos_extendidos: DatosFamiliarDTO;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarFamiliarDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.cedula = source["cedula"];
+	        this.nombres_completos = source["nombres_completos"];
+	        this.parentesco = source["parentesco"];
+	        this.es_representante_legal = source["es_representante_legal"];
+	        this.vive_con_estudiante = source["vive_con_estudiante"];
+	        this.telefono_personal = source["telefono_personal"];
+	        this.fallecido = source["fallecido"];
+	        this.datos_extendidos = this.convertValues(source["datos_extendidos"], DatosFamiliarDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class GuardarEstudianteDTO {
+	    id: number;
+	    cedula: string;
+	    apellidos: string;
+	    nombres: string;
+	    fecha_nacimiento: string;
+	    genero_nacimiento: string;
+	    correo_electronico: string;
+	    ruta_foto: string;
+	    ruta_cedula: string;
+	    ruta_partida_nacimiento: string;
+	    es_extranjero: boolean;
+	    pais_origen: string;
+	    pasaporte_odni: string;
+	    familiares: GuardarFamiliarDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarEstudianteDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.cedula = source["cedula"];
+	        this.apellidos = source["apellidos"];
+	        this.nombres = source["nombres"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.genero_nacimiento = source["genero_nacimiento"];
+	        this.correo_electronico = source["correo_electronico"];
+	        this.ruta_foto = source["ruta_foto"];
+	        this.ruta_cedula = source["ruta_cedula"];
+	        this.ruta_partida_nacimiento = source["ruta_partida_nacimiento"];
+	        this.es_extranjero = source["es_extranjero"];
+	        this.pais_origen = source["pais_origen"];
+	        this.pasaporte_odni = source["pasaporte_odni"];
+	        this.familiares = this.convertValues(source["familiares"], GuardarFamiliarDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class Hogar {
+	    id: number;
+	    nombre: string;
+	    observacion: string;
+	    fecha_creacion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Hogar(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.observacion = source["observacion"];
+	        this.fecha_creacion = source["fecha_creacion"];
+	    }
+	}
+	export class MiembroHogarDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    apellidos: string;
+	    nombres: string;
+	    fecha_nacimiento: string;
+	    ruta_foto: string;
+	    matricula_id: number;
+	    curso: string;
+	    jornada: string;
+	    estado_matricula: string;
+	    casos_abiertos: CasoHogarDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MiembroHogarDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.apellidos = source["apellidos"];
+	        this.nombres = source["nombres"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.ruta_foto = source["ruta_foto"];
+	        this.matricula_id = source["matricula_id"];
+	        this.curso = source["curso"];
+	        this.jornada = source["jornada"];
+	        this.estado_matricula = source["estado_matricula"];
+	        this.casos_abiertos = this.convertValues(source["casos_abiertos"], CasoHogarDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class HogarDetalleDTO {
+	    id: number;
+	    nombre: string;
+	    observacion: string;
+	    fecha_creacion: string;
+	    miembros: MiembroHogarDTO[];
+	    familiares: FamiliarHogarDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new HogarDetalleDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.observacion = source["observacion"];
+	        this.fecha_creacion = source["fecha_creacion"];
+	        this.miembros = this.convertValues(source["miembros"], MiembroHogarDTO);
+	        this.familiares = this.convertValues(source["familiares"], FamiliarHogarDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class HogarResumenDTO {
+	    id: number;
+	    nombre: string;
+	    total_estudiantes: number;
+	    estudiantes: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new HogarResumenDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.total_estudiantes = source["total_estudiantes"];
+	        this.estudiantes = source["estudiantes"];
+	    }
+	}
+	export class HogarSugeridoDTO {
+	    hogar_id: number;
+	    estudiantes: EstudianteDuplicadoDTO[];
+	    motivos: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new HogarSugeridoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.hogar_id = source["hogar_id"];
+	        this.estudiantes = this.convertValues(source["estudiantes"], EstudianteDuplicadoDTO);
+	        this.motivos = source["motivos"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class InfoNacionalidad {
+	    es_extranjero: boolean;
+	    pais_origen: string;
+	    pasaporte_odni: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new InfoNacionalidad(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.es_extranjero = source["es_extranjero"];
+	        this.pais_origen = source["pais_origen"];
+	        this.pasaporte_odni = source["pasaporte_odni"];
+	    }
+	}
+	
+	export class LoteImportacionResumenDTO {
+	    id: number;
+	    archivo: string;
+	    curso_id: number;
+	    estado: string;
+	    total_filas: number;
+	    creados: number;
+	    actualizados: number;
+	    matriculados: number;
+	    errores: number;
+	    fecha_creacion: string;
+	    fecha_aplicacion: string;
+	    fecha_reversion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoteImportacionResumenDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.archivo = source["archivo"];
+	        this.curso_id = source["curso_id"];
+	        this.estado = source["estado"];
+	        this.total_filas = source["total_filas"];
+	        this.creados = source["creados"];
+	        this.actualizados = source["actualizados"];
+	        this.matriculados = source["matriculados"];
+	        this.errores = source["errores"];
+	        this.fecha_creacion = source["fecha_creacion"];
+	        this.fecha_aplicacion = source["fecha_aplicacion"];
+	        this.fecha_reversion = source["fecha_reversion"];
+	    }
+	}
+	
+	export class PerfilImportacion {
+	    id: number;
+	    nombre: string;
+	    descripcion: string;
+	    delimitador: string;
+	    fila_encabezado: number;
+	    formato_fecha: string;
+	    // Go type: common
+	    hojas: any;
+	    columnas: common.JSONMap_map_string_string_;
+	    fecha_actualizacion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PerfilImportacion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.descripcion = source["descripcion"];
+	        this.delimitador = source["delimitador"];
+	        this.fila_encabezado = source["fila_encabezado"];
+	        this.formato_fecha = source["formato_fecha"];
+	        this.hojas = this.convertValues(source["hojas"], null);
+	        this.columnas = this.convertValues(source["columnas"], common.JSONMap_map_string_string_);
+	        this.fecha_actualizacion = source["fecha_actualizacion"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PerfilImportacionDTO {
+	    id: number;
+	    nombre: string;
+	    descripcion: string;
+	    delimitador: string;
+	    fila_encabezado: number;
+	    formato_fecha: string;
+	    hojas: string[];
+	    columnas: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new PerfilImportacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.nombre = source["nombre"];
+	        this.descripcion = source["descripcion"];
+	        this.delimitador = source["delimitador"];
+	        this.fila_encabezado = source["fila_encabezado"];
+	        this.formato_fecha = source["formato_fecha"];
+	        this.hojas = source["hojas"];
+	        this.columnas = source["columnas"];
+	    }
+	}
+	export class PosibleDuplicadoDTO {
+	    estudiante_a: EstudianteDuplicadoDTO;
+	    estudiante_b: EstudianteDuplicadoDTO;
+	    similitud: number;
+	    motivos: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PosibleDuplicadoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_a = this.convertValues(source["estudiante_a"], EstudianteDuplicadoDTO);
+	        this.estudiante_b = this.convertValues(source["estudiante_b"], EstudianteDuplicadoDTO);
+	        this.similitud = source["similitud"];
+	        this.motivos = source["motivos"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ReversionImportacionDTO {
+	    lote_id: number;
+	    estudiantes_eliminados: number;
+	    estudiantes_restaurados: number;
+	    matriculas_eliminadas: number;
+	    advertencias: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ReversionImportacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.lote_id = source["lote_id"];
+	        this.estudiantes_eliminados = source["estudiantes_eliminados"];
+	        this.estudiantes_restaurados = source["estudiantes_restaurados"];
+	        this.matriculas_eliminadas = source["matriculas_eliminadas"];
+	        this.advertencias = source["advertencias"];
+	    }
+	}
+	export class VincularHogarDTO {
+	    hogar_id: number;
+	    nombre: string;
+	    observacion: string;
+	    estudiante_ids: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new VincularHogarDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.hogar_id = source["hogar_id"];
+	        this.nombre = source["nombre"];
+	        this.observacion = source["observacion"];
+	        this.estudiante_ids = source["estudiante_ids"];
+	    }
+	}
+	export class VistaPreviaImportacionDTO {
+	    lote_id: number;
+	    archivo: string;
+	    curso_id: number;
+	    total_filas: number;
+	    creaciones: number;
+	    actualizaciones: number;
+	    sin_cambios: number;
+	    matriculas: number;
+	    omitidos: number;
+	    errores: number;
+	    capacidad_curso: number;
+	    sin_cupo: number;
+	    filas: FilaImportacion[];
+	
+	    static createFrom(source: any = {}) {
+	        return new VistaPreviaImportacionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.lote_id = source["lote_id"];
+	        this.archivo = source["archivo"];
+	        this.curso_id = source["curso_id"];
+	        this.total_filas = source["total_filas"];
+	        this.creaciones = source["creaciones"];
+	        this.actualizaciones = source["actualizaciones"];
+	        this.sin_cambios = source["sin_cambios"];
+	        this.matriculas = source["matriculas"];
+	        this.omitidos = source["omitidos"];
+	        this.errores = source["errores"];
+	        this.capacidad_curso = source["capacidad_curso"];
+	        this.sin_cupo = source["sin_cupo"];
+	        this.filas = this.convertValues(source["filas"], FilaImportacion);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace time {
+	
+	export class Time {
+	
+	
+	    static createFrom(source: any = {}) {
+	        return new Time(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	
+	    }
+	}
+
+}
+
+export namespace tracking {
+	
+	export class IntervencionCasoDTO {
+	    id: number;
+	    fecha: string;
+	    participantes: string;
+	    accion: string;
+	    acuerdos: string;
+	    proximos_pasos: string;
+	    nombre_usuario: string;
+	    fecha_registro: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new IntervencionCasoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.fecha = source["fecha"];
+	        this.participantes = source["participantes"];
+	        this.accion = source["accion"];
+	        this.acuerdos = source["acuerdos"];
+	        this.proximos_pasos = source["proximos_pasos"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
+	    }
+	}
+	export class CambioEstadoCasoDTO {
+	    id: number;
+	    estado_anterior: string;
+	    estado_nuevo: string;
+	    fecha: string;
+	    nota: string;
+	    motivo_cierre: string;
+	    resumen_cierre: string;
+	    nombre_usuario: string;
+	    fecha_registro: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CambioEstadoCasoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.estado_anterior = source["estado_anterior"];
+	        this.estado_nuevo = source["estado_nuevo"];
+	        this.fecha = source["fecha"];
+	        this.nota = source["nota"];
+	        this.motivo_cierre = source["motivo_cierre"];
+	        this.resumen_cierre = source["resumen_cierre"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
+	    }
+	}
+	export class BitacoraCasoDTO {
+	    caso_id: number;
+	    codigo_caso: string;
+	    estado: string;
+	    cambios: CambioEstadoCasoDTO[];
+	    intervenciones: IntervencionCasoDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new BitacoraCasoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.caso_id = source["caso_id"];
+	        this.codigo_caso = source["codigo_caso"];
+	        this.estado = source["estado"];
+	        this.cambios = this.convertValues(source["cambios"], CambioEstadoCasoDTO);
+	        this.intervenciones = this.convertValues(source["intervenciones"], IntervencionCasoDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CambiarEstadoCasoDTO {
+	    caso_id: number;
+	    estado: string;
+	    fecha: string;
+	    nota: string;
+	    motivo_cierre: string;
+	    resumen_cierre: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CambiarEstadoCasoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.caso_id = source["caso_id"];
+	        this.estado = source["estado"];
+	        this.fecha = source["fecha"];
+	        this.nota = source["nota"];
+	        this.motivo_cierre = source["motivo_cierre"];
+	        this.resumen_cierre = source["resumen_cierre"];
+	    }
+	}
+	
+	export class EvidenciaDTO {
+	    nombre: string;
+	    ruta: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EvidenciaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nombre = source["nombre"];
+	        this.ruta = source["ruta"];
+	    }
+	}
+	export class CasoResumenDTO {
+	    id: number;
+	    codigo_caso: string;
+	    tipo_caso: string;
+	    fecha_deteccion: string;
+	    entidad_derivacion: string;
+	    entidad_derivacion_detalle: string;
+	    descripcion: string;
+	    estado: string;
+	    estados_siguientes: string[];
+	    fecha_cierre: string;
+	    motivo_cierre: string;
+	    resumen_cierre: string;
+	    total_evidencias: number;
+	    rutas_evidencias: EvidenciaDTO[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CasoResumenDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.codigo_caso = source["codigo_caso"];
+	        this.tipo_caso = source["tipo_caso"];
+	        this.fecha_deteccion = source["fecha_deteccion"];
+	        this.entidad_derivacion = source["entidad_derivacion"];
+	        this.entidad_derivacion_detalle = source["entidad_derivacion_detalle"];
+	        this.descripcion = source["descripcion"];
+	        this.estado = source["estado"];
+	        this.estados_siguientes = source["estados_siguientes"];
+	        this.fecha_cierre = source["fecha_cierre"];
+	        this.motivo_cierre = source["motivo_cierre"];
+	        this.resumen_cierre = source["resumen_cierre"];
+	        this.total_evidencias = source["total_evidencias"];
+	        this.rutas_evidencias = this.convertValues(source["rutas_evidencias"], EvidenciaDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CasoSensible {
+	    id: number;
+	    estudiante_id: number;
+	    periodo_id: number;
+	    codigo_caso: string;
+	    tipo_caso: string;
+	    fecha_deteccion: string;
+	    entidad_derivacion: string;
+	    entidad_derivacion_detalle: string;
+	    descripcion: string;
+	    estado: string;
+	    fecha_cierre: string;
+	    motivo_cierre: string;
+	    resumen_cierre: string;
+	    // Go type: common
+	    rutas_documentos: any;
+	    estudiante: student.Estudiante;
+	    periodo: academic.PeriodoLectivo;
+	
+	    static createFrom(source: any = {}) {
+	        return new CasoSensible(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.periodo_id = source["periodo_id"];
+	        this.codigo_caso = source["codigo_caso"];
+	        this.tipo_caso = source["tipo_caso"];
+	        this.fecha_deteccion = source["fecha_deteccion"];
+	        this.entidad_derivacion = source["entidad_derivacion"];
+	        this.entidad_derivacion_detalle = source["entidad_derivacion_detalle"];
+	        this.descripcion = source["descripcion"];
+	        this.estado = source["estado"];
+	        this.fecha_cierre = source["fecha_cierre"];
+	        this.motivo_cierre = source["motivo_cierre"];
+	        this.resumen_cierre = source["resumen_cierre"];
+	        this.rutas_documentos = this.convertValues(source["rutas_documentos"], null);
+	        this.estudiante = this.convertValues(source["estudiante"], student.Estudiante);
+	        this.periodo = this.convertValues(source["periodo"], academic.PeriodoLectivo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CasosPaginadosDTO {
+	    items: CasoResumenDTO[];
+	    paginacion: query.PaginacionDTO;
+	
+	    static createFrom(source: any = {}) {
+	        return new CasosPaginadosDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.items = this.convertValues(source["items"], CasoResumenDTO);
+	        this.paginacion = this.convertValues(source["paginacion"], query.PaginacionDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CatalogosCasoDTO {
+	    estados: string[];
+	    transiciones: Record<string, Array<string>>;
+	    motivos_cierre: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogosCasoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estados = source["estados"];
+	        this.transiciones = source["transiciones"];
+	        this.motivos_cierre = source["motivos_cierre"];
+	    }
+	}
+	export class CatalogosProtocoloDTO {
+	    tipos: string[];
+	    tipos_hito: string[];
+	    modalidades: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogosProtocoloDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tipos = source["tipos"];
+	        this.tipos_hito = source["tipos_hito"];
+	        this.modalidades = source["modalidades"];
+	    }
+	}
+	export class ContactoProtocoloDTO {
+	    id: number;
+	    hito_id: number;
+	    hito: string;
+	    fecha: string;
+	    modalidad: string;
+	    participantes: string;
+	    resumen: string;
+	    acuerdos: string;
+	    nombre_usuario: string;
+	    fecha_registro: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ContactoProtocoloDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.hito_id = source["hito_id"];
+	        this.hito = source["hito"];
+	        this.fecha = source["fecha"];
+	        this.modalidad = source["modalidad"];
+	        this.participantes = source["participantes"];
+	        this.resumen = source["resumen"];
+	        this.acuerdos = source["acuerdos"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.fecha_registro = source["fecha_registro"];
+	    }
+	}
+	export class DetalleSancion {
+	    medida_disciplinaria: string;
+	    ruta_resolucion: string;
+	    cumplio_medida: boolean;
+	    motivo_incumplimiento: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DetalleSancion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.medida_disciplinaria = source["medida_disciplinaria"];
+	        this.ruta_resolucion = source["ruta_resolucion"];
+	        this.cumplio_medida = source["cumplio_medida"];
+	        this.motivo_incumplimiento = source["motivo_incumplimiento"];
+	    }
+	}
+	export class EstudianteDisciplinaDTO {
+	    id: number;
+	    cedula: string;
+	    nombres: string;
+	    apellidos: string;
+	    ruta_foto: string;
+	    matricula_id: number;
+	    curso: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstudianteDisciplinaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.cedula = source["cedula"];
+	        this.nombres = source["nombres"];
+	        this.apellidos = source["apellidos"];
+	        this.ruta_foto = source["ruta_foto"];
+	        this.matricula_id = source["matricula_id"];
+	        this.curso = source["curso"];
+	    }
+	}
+	export class Evidencia {
+	    nombre: string;
+	    ruta: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Evidencia(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nombre = source["nombre"];
+	        this.ruta = source["ruta"];
+	    }
+	}
+	
+	export class FiltroRemisionesDTO {
+	    estado: string;
+	    docente_id: number;
+	    curso_id: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new FiltroRemisionesDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estado = source["estado"];
+	        this.docente_id = source["docente_id"];
+	        this.curso_id = source["curso_id"];
+	    }
+	}
+	export class GuardarCasoDTO {
+	    id: number;
+	    estudiante_id: number;
+	    tipo_caso: string;
+	    fecha_deteccion: string;
+	    entidad_derivacion: string;
+	    entidad_derivacion_detalle: string;
+	    descripcion: string;
+	    estado: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarCasoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.tipo_caso = source["tipo_caso"];
+	        this.fecha_deteccion = source["fecha_deteccion"];
+	        this.entidad_derivacion = source["entidad_derivacion"];
+	        this.entidad_derivacion_detalle = source["entidad_derivacion_detalle"];
+	        this.descripcion = source["descripcion"];
+	        this.estado = source["estado"];
+	    }
+	}
+	export class GuardarContactoDTO {
+	    protocolo_id: number;
+	    hito_id: number;
+	    cumple_hito: boolean;
+	    fecha: string;
+	    modalidad: string;
+	    participantes: string;
+	    resumen: string;
+	    acuerdos: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarContactoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.protocolo_id = source["protocolo_id"];
+	        this.hito_id = source["hito_id"];
+	        this.cumple_hito = source["cumple_hito"];
+	        this.fecha = source["fecha"];
+	        this.modalidad = source["modalidad"];
+	        this.participantes = source["participantes"];
+	        this.resumen = source["resumen"];
+	        this.acuerdos = source["acuerdos"];
+	    }
+	}
+	export class GuardarHitoDTO {
+	    id: number;
+	    protocolo_id: number;
+	    tipo: string;
+	    descripcion: string;
+	    fecha_programada: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarHitoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.protocolo_id = source["protocolo_id"];
+	        this.tipo = source["tipo"];
+	        this.descripcion = source["descripcion"];
+	        this.fecha_programada = source["fecha_programada"];
+	    }
+	}
+	export class GuardarIntervencionDTO {
+	    caso_id: number;
+	    fecha: string;
+	    participantes: string;
+	    accion: string;
+	    acuerdos: string;
+	    proximos_pasos: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarIntervencionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.caso_id = source["caso_id"];
+	        this.fecha = source["fecha"];
+	        this.participantes = source["participantes"];
+	        this.accion = source["accion"];
+	        this.acuerdos = source["acuerdos"];
+	        this.proximos_pasos = source["proximos_pasos"];
+	    }
+	}
+	export class GuardarLlamadoDTO {
+	    id: number;
+	    matricula_id: number;
+	    fecha: string;
+	    motivo: string;
+	    representante_notificado: boolean;
+	    representante_firmo: boolean;
+	    motivo_no_firma: string;
+	    medida_disciplinaria: string;
+	    cumplio_medida: boolean;
+	    motivo_incumplimiento: string;
+	    ruta_acta: string;
+	    ruta_resolucion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarLlamadoDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	        this.representante_notificado = source["representante_notificado"];
+	        this.representante_firmo = source["representante_firmo"];
+	        this.motivo_no_firma = source["motivo_no_firma"];
+	        this.medida_disciplinaria = source["medida_disciplinaria"];
+	        this.cumplio_medida = source["cumplio_medida"];
+	        this.motivo_incumplimiento = source["motivo_incumplimiento"];
+	        this.ruta_acta = source["ruta_acta"];
+	        this.ruta_resolucion = source["ruta_resolucion"];
+	    }
+	}
+	export class GuardarRemisionDTO {
+	    id: number;
+	    docente_id: number;
+	    matricula_id: number;
+	    fecha_remision: string;
+	    categoria: string;
+	    descripcion: string;
+	    urgencia: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardarRemisionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.docente_id = source["docente_id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.fecha_remision = source["fecha_remision"];
+	        this.categoria = source["categoria"];
+	        this.descripcion = source["descripcion"];
+	        this.urgencia = source["urgencia"];
+	    }
+	}
+	export class HitoProtocoloDTO {
+	    id: number;
+	    tipo: string;
+	    descripcion: string;
+	    fecha_programada: string;
+	    fecha_cumplida: string;
+	    observacion: string;
+	    estado: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new HitoProtocoloDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.tipo = source["tipo"];
+	        this.descripcion = source["descripcion"];
+	        this.fecha_programada = source["fecha_programada"];
+	        this.fecha_cumplida = source["fecha_cumplida"];
+	        this.observacion = source["observacion"];
+	        this.estado = source["estado"];
+	    }
+	}
+	
+	export class LlamadoAtencion {
+	    id: number;
+	    matricula_id: number;
+	    fecha: string;
+	    motivo: string;
+	    representante_notificado: boolean;
+	    representante_firmo: boolean;
+	    ruta_acta: string;
+	    motivo_no_firma: string;
+	    detalle_sancion: common.JSONMap_dece_internal_domain_tracking_DetalleSancion_;
+	    matricula?: enrollment.Matricula;
+	
+	    static createFrom(source: any = {}) {
+	        return new LlamadoAtencion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	        this.representante_notificado = source["representante_notificado"];
+	        this.representante_firmo = source["representante_firmo"];
+	        this.ruta_acta = source["ruta_acta"];
+	        this.motivo_no_firma = source["motivo_no_firma"];
+	        this.detalle_sancion = this.convertValues(source["detalle_sancion"], common.JSONMap_dece_internal_domain_tracking_DetalleSancion_);
+	        this.matricula = this.convertValues(source["matricula"], enrollment.Matricula);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LlamadoResumenDTO {
+	    id: number;
+	    fecha: string;
+	    motivo: string;
+	    medida: string;
+	    estado: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LlamadoResumenDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.fecha = source["fecha"];
+	        this.motivo = source["motivo"];
+	        this.medida = source["medida"];
+	        this.estado = source["estado"];
+	    }
+	}
+	export class ProtocoloDetalleDTO {
 	    id: number;
+	    matricula_id: number;
+	    estudiante_id: number;
 	    cedula: string;
-	    apellidos: string;
-	    nombres: string;
-	    fecha_nacimiento: string;
-	    genero_nacimiento: string;
-	    correo_electronico: string;
-	    info_nacionalidad: common.JSONMap_dece_internal_domain_student_InfoNacionalidad_;
-	    ruta_foto: string;
-	    ruta_cedula: string;
-	    ruta_partida_nacimiento: string;
-	    familiares: Familiar[];
-	    fecha_creacion: string;
+	    estudiante: string;
+	    curso: string;
+	    periodo_lectivo: string;
+	    tipo: string;
+	    estado: string;
+	    origen: string;
+	    fecha_apertura: string;
+	    fecha_cierre: string;
+	    hitos_total: number;
+	    hitos_cumplidos: number;
+	    hitos_vencidos: number;
+	    proximo_hito: string;
+	    fecha_proximo_hito: string;
+	    total_contactos: number;
+	    ultimo_contacto: string;
+	    motivo_cierre: string;
+	    nombre_usuario: string;
+	    hitos: HitoProtocoloDTO[];
+	    contactos: ContactoProtocoloDTO[];
 	
 	    static createFrom(source: any = {}) {
-	        return new Estudiante(source);
+	        return new ProtocoloDetalleDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.estudiante_id = source["estudiante_id"];
 	        this.cedula = source["cedula"];
-	        this.apellidos = source["apellidos"];
-	        this.nombres = source["nombres"];
-	        this.fecha_nacimiento = source["fecha_nacimiento"];
-	        this.genero_nacimiento = source["genero_nacimiento"];
-	        this.correo_electronico = source["correo_electronico"];
-	        this.info_nacionalidad = this.convertValues(source["info_nacionalidad"], common.JSONMap_dece_internal_domain_student_InfoNacionalidad_);
-	        this.ruta_foto = source["ruta_foto"];
-	        this.ruta_cedula = source["ruta_cedula"];
-	        this.ruta_partida_nacimiento = source["ruta_partida_nacimiento"];
-	        this.familiares = this.convertValues(source["familiares"], Familiar);
-	        this.fecha_creacion = source["fecha_creacion"];
+	        this.estudiante = source["estudiante"];
+	        this.curso = source["curso"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.tipo = source["tipo"];
+	        this.estado = source["estado"];
+	        this.origen = source["origen"];
+	        this.fecha_apertura = source["fecha_apertura"];
+	        this.fecha_cierre = source["fecha_cierre"];
+	        this.hitos_total = source["hitos_total"];
+	        this.hitos_cumplidos = source["hitos_cumplidos"];
+	        this.hitos_vencidos = source["hitos_vencidos"];
+	        this.proximo_hito = source["proximo_hito"];
+	        this.fecha_proximo_hito = source["fecha_proximo_hito"];
+	        this.total_contactos = source["total_contactos"];
+	        this.ultimo_contacto = source["ultimo_contacto"];
+	        this.motivo_cierre = source["motivo_cierre"];
+	        this.nombre_usuario = source["nombre_usuario"];
+	        this.hitos = this.convertValues(source["hitos"], HitoProtocoloDTO);
+	        this.contactos = this.convertValues(source["contactos"], ContactoProtocoloDTO);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2654,52 +7404,95 @@ export namespace student {
 		    return a;
 		}
 	}
-	export class InfoNacionalidadDTO {
-	    es_extranjero: boolean;
-	    pais_origen: string;
-	    pasaporte_odni: string;
+	export class ProtocoloResumenDTO {
+	    id: number;
+	    matricula_id: number;
+	    estudiante_id: number;
+	    cedula: string;
+	    estudiante: string;
+	    curso: string;
+	    periodo_lectivo: string;
+	    tipo: string;
+	    estado: string;
+	    origen: string;
+	    fecha_apertura: string;
+	    fecha_cierre: string;
+	    hitos_total: number;
+	    hitos_cumplidos: number;
+	    hitos_vencidos: number;
+	    proximo_hito: string;
+	    fecha_proximo_hito: string;
+	    total_contactos: number;
+	    ultimo_contacto: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new InfoNacionalidadDTO(source);
+	        return new ProtocoloResumenDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.es_extranjero = source["es_extranjero"];
-	        this.pais_origen = source["pais_origen"];
-	        this.pasaporte_odni = source["pasaporte_odni"];
+	        this.id = source["id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.estudiante_id = source["estudiante_id"];
+	        this.cedula = source["cedula"];
+	        this.estudiante = source["estudiante"];
+	        this.curso = source["curso"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.tipo = source["tipo"];
+	        this.estado = source["estado"];
+	        this.origen = source["origen"];
+	        this.fecha_apertura = source["fecha_apertura"];
+	        this.fecha_cierre = source["fecha_cierre"];
+	        this.hitos_total = source["hitos_total"];
+	        this.hitos_cumplidos = source["hitos_cumplidos"];
+	        this.hitos_vencidos = source["hitos_vencidos"];
+	        this.proximo_hito = source["proximo_hito"];
+	        this.fecha_proximo_hito = source["fecha_proximo_hito"];
+	        this.total_contactos = source["total_contactos"];
+	        this.ultimo_contacto = source["ultimo_contacto"];
 	    }
 	}
-	export class EstudianteListaDTO {
+	export class Remision {
 	    id: number;
-	    cedula: string;
-	    apellidos: string;
-	    nombres: string;
-	    ruta_foto: string;
-	    ruta_cedula: string;
-	    ruta_partida_nacimiento: string;
-	    fecha_nacimiento: string;
-	    correo_electronico: string;
-	    edad: number;
-	    info_nacionalidad?: InfoNacionalidadDTO;
+	    docente_id: number;
+	    matricula_id: number;
+	    codigo_remision: string;
+	    fecha_remision: string;
+	    categoria: string;
+	    descripcion: string;
+	    urgencia: string;
+	    estado: string;
+	    observacion_triaje: string;
+	    fecha_actualizacion: string;
+	    caso_id?: number;
+	    convocatoria_id?: number;
+	    // Go type: common
+	    adjuntos: any;
+	    docente?: faculty.Docente;
+	    matricula?: enrollment.Matricula;
 	
 	    static createFrom(source: any = {}) {
-	        return new EstudianteListaDTO(source);
+	        return new Remision(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.cedula = source["cedula"];
-	        this.apellidos = source["apellidos"];
-	        this.nombres = source["nombres"];
-	        this.ruta_foto = source["ruta_foto"];
-	        this.ruta_cedula = source["ruta_cedula"];
-	        this.ruta_partida_nacimiento = source["ruta_partida_nacimiento"];
-	        this.fecha_nacimiento = source["fecha_nacimiento"];
-	        this.correo_electronico = source["correo_electronico"];
-	        this.edad = source["edad"];
-	        this.info_nacionalidad = this.convertValues(source["info_nacionalidad"], InfoNacionalidadDTO);
+	        this.docente_id = source["docente_id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.codigo_remision = source["codigo_remision"];
+	        this.fecha_remision = source["fecha_remision"];
+	        this.categoria = source["categoria"];
+	        this.descripcion = source["descripcion"];
+	        this.urgencia = source["urgencia"];
+	        this.estado = source["estado"];
+	        this.observacion_triaje = source["observacion_triaje"];
+	        this.fecha_actualizacion = source["fecha_actualizacion"];
+	        this.caso_id = source["caso_id"];
+	        this.convocatoria_id = source["convocatoria_id"];
+	        this.adjuntos = this.convertValues(source["adjuntos"], null);
+	        this.docente = this.convertValues(source["docente"], faculty.Docente);
+	        this.matricula = this.convertValues(source["matricula"], enrollment.Matricula);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2720,33 +7513,50 @@ export namespace student {
 		    return a;
 		}
 	}
-	
-	export class GuardarFamiliarDTO {
+	export class RemisionDetalleDTO {
 	    id: number;
+	    codigo_remision: string;
+	    docente_id: number;
+	    matricula_id: number;
+	    fecha_remision: string;
+	    categoria: string;
+	    descripcion: string;
+	    urgencia: string;
+	    estado: string;
+	    observacion_triaje: string;
+	    fecha_actualizacion: string;
+	    docente: string;
+	    estudiante: string;
 	    cedula: string;
-	    nombres_completos: string;
-	    parentesco: string;
-	    es_representante_legal: boolean;
-	    vive_con_estudiante: boolean;
-	    telefono_personal: string;
-	    fallecido: boolean;
-	    datos_extendidos: DatosFamiliarDTO;
+	    curso: string;
+	    caso_id?: number;
+	    convocatoria_id?: number;
+	    adjuntos: EvidenciaDTO[];
 	
 	    static createFrom(source: any = {}) {
-	        return new GuardarFamiliarDTO(source);
+	        return new RemisionDetalleDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
+	        this.codigo_remision = source["codigo_remision"];
+	        this.docente_id = source["docente_id"];
+	        this.matricula_id = source["matricula_id"];
+	        this.fecha_remision = source["fecha_remision"];
+	        this.categoria = source["categoria"];
+	        this.descripcion = source["descripcion"];
+	        this.urgencia = source["urgencia"];
+	        this.estado = source["estado"];
+	        this.observacion_triaje = source["observacion_triaje"];
+	        this.fecha_actualizacion = source["fecha_actualizacion"];
+	        this.docente = source["docente"];
+	        this.estudiante = source["estudiante"];
 	        this.cedula = source["cedula"];
-	        this.nombres_completos = source["nombres_completos"];
-	        this.parentesco = source["parentesco"];
-	        this.es_representante_legal = source["es_representante_legal"];
-	        this.vive_con_estudiante = source["vive_con_estudiante"];
-	        this.telefono_personal = source["telefono_personal"];
-	        this.fallecido = source["fallecido"];
-	        this.datos_extendidos = this.convertValues(source["datos_extendidos"], DatosFamiliarDTO);
+	        this.curso = source["curso"];
+	        this.caso_id = source["caso_id"];
+	        this.convocatoria_id = source["convocatoria_id"];
+	        this.adjuntos = this.convertValues(source["adjuntos"], EvidenciaDTO);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2767,42 +7577,105 @@ export namespace student {
 		    return a;
 		}
 	}
-	export class GuardarEstudianteDTO {
+	export class RemisionResumenDTO {
 	    id: number;
-	    cedula: string;
-	    apellidos: string;
-	    nombres: string;
-	    fecha_nacimiento: string;
-	    genero_nacimiento: string;
-	    correo_electronico: string;
-	    ruta_foto: string;
-	    ruta_cedula: string;
-	    ruta_partida_nacimiento: string;
-	    es_extranjero: boolean;
-	    pais_origen: string;
-	    pasaporte_odni: string;
-	    familiares: GuardarFamiliarDTO[];
+	    codigo_remision: string;
+	    fecha_remision: string;
+	    categoria: string;
+	    urgencia: string;
+	    estado: string;
+	    docente: string;
+	    estudiante: string;
+	    curso: string;
+	    caso_id?: number;
+	    convocatoria_id?: number;
+	    total_adjuntos: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GuardarEstudianteDTO(source);
+	        return new RemisionResumenDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
+	        this.codigo_remision = source["codigo_remision"];
+	        this.fecha_remision = source["fecha_remision"];
+	        this.categoria = source["categoria"];
+	        this.urgencia = source["urgencia"];
+	        this.estado = source["estado"];
+	        this.docente = source["docente"];
+	        this.estudiante = source["estudiante"];
+	        this.curso = source["curso"];
+	        this.caso_id = source["caso_id"];
+	        this.convocatoria_id = source["convocatoria_id"];
+	        this.total_adjuntos = source["total_adjuntos"];
+	    }
+	}
+	export class TriajeRemisionDTO {
+	    remision_id: number;
+	    estado: string;
+	    observacion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TriajeRemisionDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.remision_id = source["remision_id"];
+	        this.estado = source["estado"];
+	        this.observacion = source["observacion"];
+	    }
+	}
+
+}
+
+export namespace transfer {
+	
+	export class CasoExpediente {
+	    codigo_caso: string;
+	    tipo_caso: string;
+	    fecha_deteccion: string;
+	    estado: string;
+	    entidad_derivacion: string;
+	    periodo_lectivo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CasoExpediente(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.codigo_caso = source["codigo_caso"];
+	        this.tipo_caso = source["tipo_caso"];
+	        this.fecha_deteccion = source["fecha_deteccion"];
+	        this.estado = source["estado"];
+	        this.entidad_derivacion = source["entidad_derivacion"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	    }
+	}
+	export class ColisionCedulaDTO {
+	    estudiante_id: number;
+	    cedula: string;
+	    nombre_local: string;
+	    diferencias: student.CambioCampo[];
+	    matriculado_en_activo: boolean;
+	    curso_actual: string;
+	    expediente_ya_importado: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ColisionCedulaDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.estudiante_id = source["estudiante_id"];
 	        this.cedula = source["cedula"];
-	        this.apellidos = source["apellidos"];
-	        this.nombres = source["nombres"];
-	        this.fecha_nacimiento = source["fecha_nacimiento"];
-	        this.genero_nacimiento = source["genero_nacimiento"];
-	        this.correo_electronico = source["correo_electronico"];
-	        this.ruta_foto = source["ruta_foto"];
-	        this.ruta_cedula = source["ruta_cedula"];
-	        this.ruta_partida_nacimiento = source["ruta_partida_nacimiento"];
-	        this.es_extranjero = source["es_extranjero"];
-	        this.pais_origen = source["pais_origen"];
-	        this.pasaporte_odni = source["pasaporte_odni"];
-	        this.familiares = this.convertValues(source["familiares"], GuardarFamiliarDTO);
+	        this.nombre_local = source["nombre_local"];
+	        this.diferencias = this.convertValues(source["diferencias"], student.CambioCampo);
+	        this.matriculado_en_activo = source["matriculado_en_activo"];
+	        this.curso_actual = source["curso_actual"];
+	        this.expediente_ya_importado = source["expediente_ya_importado"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2823,87 +7696,120 @@ export namespace student {
 		    return a;
 		}
 	}
+	export class ColisionFamiliarDTO {
+	    cedula: string;
+	    nombre_expediente: string;
+	    nombre_local: string;
+	    estudiante: string;
 	
-	export class InfoNacionalidad {
-	    es_extranjero: boolean;
-	    pais_origen: string;
-	    pasaporte_odni: string;
+	    static createFrom(source: any = {}) {
+	        return new ColisionFamiliarDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cedula = source["cedula"];
+	        this.nombre_expediente = source["nombre_expediente"];
+	        this.nombre_local = source["nombre_local"];
+	        this.estudiante = source["estudiante"];
+	    }
+	}
+	export class ConfirmarExpedienteDTO {
+	    expediente_id: number;
+	    curso_id: number;
+	    vincular_existente: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new InfoNacionalidad(source);
+	        return new ConfirmarExpedienteDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.es_extranjero = source["es_extranjero"];
-	        this.pais_origen = source["pais_origen"];
-	        this.pasaporte_odni = source["pasaporte_odni"];
+	        this.expediente_id = source["expediente_id"];
+	        this.curso_id = source["curso_id"];
+	        this.vincular_existente = source["vincular_existente"];
 	    }
 	}
-
-}
-
-export namespace time {
+	export class CursoDestinoDTO {
+	    curso_id: number;
+	    nombre: string;
+	    jornada: string;
+	    sugerido: boolean;
 	
-	export class Time {
+	    static createFrom(source: any = {}) {
+	        return new CursoDestinoDTO(source);
+	    }
 	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.curso_id = source["curso_id"];
+	        this.nombre = source["nombre"];
+	        this.jornada = source["jornada"];
+	        this.sugerido = source["sugerido"];
+	    }
+	}
+	export class DisciplinaExpediente {
+	    fecha: string;
+	    periodo_lectivo: string;
+	    motivo: string;
+	    medida: string;
+	    cumplio_medida: boolean;
+	    representante_firmo: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new Time(source);
+	        return new DisciplinaExpediente(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	
+	        this.fecha = source["fecha"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.motivo = source["motivo"];
+	        this.medida = source["medida"];
+	        this.cumplio_medida = source["cumplio_medida"];
+	        this.representante_firmo = source["representante_firmo"];
 	    }
 	}
-
-}
-
-export namespace tracking {
-	
-	export class EvidenciaDTO {
+	export class DocumentoPreviaDTO {
+	    tipo: string;
 	    nombre: string;
-	    ruta: string;
+	    tamano_bytes: number;
+	    integro: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new EvidenciaDTO(source);
+	        return new DocumentoPreviaDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tipo = source["tipo"];
 	        this.nombre = source["nombre"];
-	        this.ruta = source["ruta"];
+	        this.tamano_bytes = source["tamano_bytes"];
+	        this.integro = source["integro"];
 	    }
 	}
-	export class CasoResumenDTO {
-	    id: number;
-	    codigo_caso: string;
-	    tipo_caso: string;
-	    fecha_deteccion: string;
-	    entidad_derivacion: string;
-	    entidad_derivacion_detalle: string;
-	    descripcion: string;
-	    estado: string;
-	    total_evidencias: number;
-	    rutas_evidencias: EvidenciaDTO[];
+	export class EstudianteExpediente {
+	    cedula: string;
+	    apellidos: string;
+	    nombres: string;
+	    fecha_nacimiento: string;
+	    genero_nacimiento: string;
+	    correo_electronico: string;
+	    info_nacionalidad: student.InfoNacionalidad;
 	
 	    static createFrom(source: any = {}) {
-	        return new CasoResumenDTO(source);
+	        return new EstudianteExpediente(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.codigo_caso = source["codigo_caso"];
-	        this.tipo_caso = source["tipo_caso"];
-	        this.fecha_deteccion = source["fecha_deteccion"];
-	        this.entidad_derivacion = source["entidad_derivacion"];
-	        this.entidad_derivacion_detalle = source["entidad_derivacion_detalle"];
-	        this.descripcion = source["descripcion"];
-	        this.estado = source["estado"];
-	        this.total_evidencias = source["total_evidencias"];
-	        this.rutas_evidencias = this.convertValues(source["rutas_evidencias"], EvidenciaDTO);
+	        this.cedula = source["cedula"];
+	        this.apellidos = source["apellidos"];
+	        this.nombres = source["nombres"];
+	        this.fecha_nacimiento = source["fecha_nacimiento"];
+	        this.genero_nacimiento = source["genero_nacimiento"];
+	        this.correo_electronico = source["correo_electronico"];
+	        this.info_nacionalidad = this.convertValues(source["info_nacionalidad"], student.InfoNacionalidad);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2924,41 +7830,30 @@ export namespace tracking {
 		    return a;
 		}
 	}
-	export class CasoSensible {
-	    id: number;
-	    estudiante_id: number;
-	    periodo_id: number;
-	    codigo_caso: string;
-	    tipo_caso: string;
-	    fecha_deteccion: string;
-	    entidad_derivacion: string;
-	    entidad_derivacion_detalle: string;
-	    descripcion: string;
-	    estado: string;
-	    // Go type: common
-	    rutas_documentos: any;
-	    estudiante: student.Estudiante;
-	    periodo: academic.PeriodoLectivo;
+	export class FamiliarExpediente {
+	    cedula: string;
+	    nombres_completos: string;
+	    parentesco: string;
+	    es_representante_legal: boolean;
+	    vive_con_estudiante: boolean;
+	    telefono_personal: string;
+	    fallecido: boolean;
+	    datos_extendidos: student.DatosFamiliar;
 	
 	    static createFrom(source: any = {}) {
-	        return new CasoSensible(source);
+	        return new FamiliarExpediente(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.estudiante_id = source["estudiante_id"];
-	        this.periodo_id = source["periodo_id"];
-	        this.codigo_caso = source["codigo_caso"];
-	        this.tipo_caso = source["tipo_caso"];
-	        this.fecha_deteccion = source["fecha_deteccion"];
-	        this.entidad_derivacion = source["entidad_derivacion"];
-	        this.entidad_derivacion_detalle = source["entidad_derivacion_detalle"];
-	        this.descripcion = source["descripcion"];
-	        this.estado = source["estado"];
-	        this.rutas_documentos = this.convertValues(source["rutas_documentos"], null);
-	        this.estudiante = this.convertValues(source["estudiante"], student.Estudiante);
-	        this.periodo = this.convertValues(source["periodo"], academic.PeriodoLectivo);
+	        this.cedula = source["cedula"];
+	        this.nombres_completos = source["nombres_completos"];
+	        this.parentesco = source["parentesco"];
+	        this.es_representante_legal = source["es_representante_legal"];
+	        this.vive_con_estudiante = source["vive_con_estudiante"];
+	        this.telefono_personal = source["telefono_personal"];
+	        this.fallecido = source["fallecido"];
+	        this.datos_extendidos = this.convertValues(source["datos_extendidos"], student.DatosFamiliar);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2979,151 +7874,178 @@ export namespace tracking {
 		    return a;
 		}
 	}
-	export class DetalleSancion {
-	    medida_disciplinaria: string;
-	    ruta_resolucion: string;
-	    cumplio_medida: boolean;
-	    motivo_incumplimiento: string;
+	export class IdentidadInstalacionDTO {
+	    institucion: string;
+	    codigo_amie: string;
+	    huella: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new DetalleSancion(source);
+	        return new IdentidadInstalacionDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.medida_disciplinaria = source["medida_disciplinaria"];
-	        this.ruta_resolucion = source["ruta_resolucion"];
-	        this.cumplio_medida = source["cumplio_medida"];
-	        this.motivo_incumplimiento = source["motivo_incumplimiento"];
+	        this.institucion = source["institucion"];
+	        this.codigo_amie = source["codigo_amie"];
+	        this.huella = source["huella"];
 	    }
 	}
-	export class EstudianteDisciplinaDTO {
-	    id: number;
-	    cedula: string;
-	    nombres: string;
-	    apellidos: string;
-	    ruta_foto: string;
-	    matricula_id: number;
-	    curso: string;
+	export class MatriculaExpediente {
+	    periodo_lectivo: string;
+	    nivel: string;
+	    paralelo: string;
+	    jornada: string;
+	    estado: string;
+	    es_repetidor: boolean;
+	    direccion_actual: string;
+	    ubicacion: enrollment.UbicacionDomicilio;
+	    antropometria: enrollment.Antropometria;
+	    historial_academico: enrollment.HistorialAcademico;
+	    datos_salud: enrollment.DatosSalud;
+	    datos_sociales: enrollment.DatosSociales;
+	    condicion_genero: enrollment.CondicionGenero;
 	
 	    static createFrom(source: any = {}) {
-	        return new EstudianteDisciplinaDTO(source);
+	        return new MatriculaExpediente(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.cedula = source["cedula"];
-	        this.nombres = source["nombres"];
-	        this.apellidos = source["apellidos"];
-	        this.ruta_foto = source["ruta_foto"];
-	        this.matricula_id = source["matricula_id"];
-	        this.curso = source["curso"];
+	        this.periodo_lectivo = source["periodo_lectivo"];
+	        this.nivel = source["nivel"];
+	        this.paralelo = source["paralelo"];
+	        this.jornada = source["jornada"];
+	        this.estado = source["estado"];
+	        this.es_repetidor = source["es_repetidor"];
+	        this.direccion_actual = source["direccion_actual"];
+	        this.ubicacion = this.convertValues(source["ubicacion"], enrollment.UbicacionDomicilio);
+	        this.antropometria = this.convertValues(source["antropometria"], enrollment.Antropometria);
+	        this.historial_academico = this.convertValues(source["historial_academico"], enrollment.HistorialAcademico);
+	        this.datos_salud = this.convertValues(source["datos_salud"], enrollment.DatosSalud);
+	        this.datos_sociales = this.convertValues(source["datos_sociales"], enrollment.DatosSociales);
+	        this.condicion_genero = this.convertValues(source["condicion_genero"], enrollment.CondicionGenero);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class Evidencia {
-	    nombre: string;
-	    ruta: string;
+	export class ResultadoExportacionDTO {
+	    ruta_archivo: string;
+	    codigo_expediente: string;
+	    clave_transferencia: string;
+	    huella_emisor: string;
+	    total_documentos: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new Evidencia(source);
+	        return new ResultadoExportacionDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.nombre = source["nombre"];
-	        this.ruta = source["ruta"];
+	        this.ruta_archivo = source["ruta_archivo"];
+	        this.codigo_expediente = source["codigo_expediente"];
+	        this.clave_transferencia = source["clave_transferencia"];
+	        this.huella_emisor = source["huella_emisor"];
+	        this.total_documentos = source["total_documentos"];
 	    }
 	}
-	
-	export class GuardarCasoDTO {
-	    id: number;
+	export class ResultadoImportacionExpedienteDTO {
 	    estudiante_id: number;
-	    tipo_caso: string;
-	    fecha_deteccion: string;
-	    entidad_derivacion: string;
-	    entidad_derivacion_detalle: string;
-	    descripcion: string;
-	    estado: string;
+	    matricula_id: number;
+	    estudiante_creado: boolean;
+	    familiares_agregados: number;
+	    documentos_importados: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GuardarCasoDTO(source);
+	        return new ResultadoImportacionExpedienteDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
 	        this.estudiante_id = source["estudiante_id"];
-	        this.tipo_caso = source["tipo_caso"];
-	        this.fecha_deteccion = source["fecha_deteccion"];
-	        this.entidad_derivacion = source["entidad_derivacion"];
-	        this.entidad_derivacion_detalle = source["entidad_derivacion_detalle"];
-	        this.descripcion = source["descripcion"];
-	        this.estado = source["estado"];
+	        this.matricula_id = source["matricula_id"];
+	        this.estudiante_creado = source["estudiante_creado"];
+	        this.familiares_agregados = source["familiares_agregados"];
+	        this.documentos_importados = source["documentos_importados"];
 	    }
 	}
-	export class GuardarLlamadoDTO {
-	    id: number;
-	    matricula_id: number;
-	    fecha: string;
+	export class RetiroExpediente {
+	    fecha_retiro: string;
 	    motivo: string;
-	    representante_notificado: boolean;
-	    representante_firmo: boolean;
-	    motivo_no_firma: string;
-	    medida_disciplinaria: string;
-	    cumplio_medida: boolean;
-	    motivo_incumplimiento: string;
-	    ruta_acta: string;
-	    ruta_resolucion: string;
+	    nueva_institucion: string;
+	    provincia_destino: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new GuardarLlamadoDTO(source);
+	        return new RetiroExpediente(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.matricula_id = source["matricula_id"];
-	        this.fecha = source["fecha"];
+	        this.fecha_retiro = source["fecha_retiro"];
 	        this.motivo = source["motivo"];
-	        this.representante_notificado = source["representante_notificado"];
-	        this.representante_firmo = source["representante_firmo"];
-	        this.motivo_no_firma = source["motivo_no_firma"];
-	        this.medida_disciplinaria = source["medida_disciplinaria"];
-	        this.cumplio_medida = source["cumplio_medida"];
-	        this.motivo_incumplimiento = source["motivo_incumplimiento"];
-	        this.ruta_acta = source["ruta_acta"];
-	        this.ruta_resolucion = source["ruta_resolucion"];
+	        this.nueva_institucion = source["nueva_institucion"];
+	        this.provincia_destino = source["provincia_destino"];
 	    }
 	}
-	export class LlamadoAtencion {
-	    id: number;
-	    matricula_id: number;
-	    fecha: string;
-	    motivo: string;
-	    representante_notificado: boolean;
-	    representante_firmo: boolean;
-	    ruta_acta: string;
-	    motivo_no_firma: string;
-	    detalle_sancion: common.JSONMap_dece_internal_domain_tracking_DetalleSancion_;
-	    matricula?: enrollment.Matricula;
+	export class VistaPreviaExpedienteDTO {
+	    expediente_id: number;
+	    codigo_expediente: string;
+	    fecha_exportacion: string;
+	    institucion_origen: string;
+	    codigo_amie_origen: string;
+	    huella_emisor: string;
+	    emisor_conocido: boolean;
+	    estudiante: EstudianteExpediente;
+	    familiares: FamiliarExpediente[];
+	    matricula?: MatriculaExpediente;
+	    retiro?: RetiroExpediente;
+	    disciplina: DisciplinaExpediente[];
+	    casos: CasoExpediente[];
+	    documentos: DocumentoPreviaDTO[];
+	    colision?: ColisionCedulaDTO;
+	    colisiones_familiares: ColisionFamiliarDTO[];
+	    cursos: CursoDestinoDTO[];
+	    advertencias: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new LlamadoAtencion(source);
+	        return new VistaPreviaExpedienteDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.matricula_id = source["matricula_id"];
-	        this.fecha = source["fecha"];
-	        this.motivo = source["motivo"];
-	        this.representante_notificado = source["representante_notificado"];
-	        this.representante_firmo = source["representante_firmo"];
-	        this.ruta_acta = source["ruta_acta"];
-	        this.motivo_no_firma = source["motivo_no_firma"];
-	        this.detalle_sancion = this.convertValues(source["detalle_sancion"], common.JSONMap_dece_internal_domain_tracking_DetalleSancion_);
-	        this.matricula = this.convertValues(source["matricula"], enrollment.Matricula);
+	        this.expediente_id = source["expediente_id"];
+	        this.codigo_expediente = source["codigo_expediente"];
+	        this.fecha_exportacion = source["fecha_exportacion"];
+	        this.institucion_origen = source["institucion_origen"];
+	        this.codigo_amie_origen = source["codigo_amie_origen"];
+	        this.huella_emisor = source["huella_emisor"];
+	        this.emisor_conocido = source["emisor_conocido"];
+	        this.estudiante = this.convertValues(source["estudiante"], EstudianteExpediente);
+	        this.familiares = this.convertValues(source["familiares"], FamiliarExpediente);
+	        this.matricula = this.convertValues(source["matricula"], MatriculaExpediente);
+	        this.retiro = this.convertValues(source["retiro"], RetiroExpediente);
+	        this.disciplina = this.convertValues(source["disciplina"], DisciplinaExpediente);
+	        this.casos = this.convertValues(source["casos"], CasoExpediente);
+	        this.documentos = this.convertValues(source["documentos"], DocumentoPreviaDTO);
+	        this.colision = this.convertValues(source["colision"], ColisionCedulaDTO);
+	        this.colisiones_familiares = this.convertValues(source["colisiones_familiares"], ColisionFamiliarDTO);
+	        this.cursos = this.convertValues(source["cursos"], CursoDestinoDTO);
+	        this.advertencias = source["advertencias"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3144,26 +8066,6 @@ export namespace tracking {
 		    return a;
 		}
 	}
-	export class LlamadoResumenDTO {
-	    id: number;
-	    fecha: string;
-	    motivo: string;
-	    medida: string;
-	    estado: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new LlamadoResumenDTO(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.fecha = source["fecha"];
-	        this.motivo = source["motivo"];
-	        this.medida = source["medida"];
-	        this.estado = source["estado"];
-	    }
-	}
 
 }
 
@@ -4,26 +4,78 @@ import {reports} from '../models';
 
 export function AbrirUbicacionReporte(arg1:string):Promise<void>;
 
+export function ExportarResidenciasGeoJSON(arg1:number,arg2:number,arg3:boolean):Promise<reports.ExportacionResidenciasDTO>;
+
+export function ExportarResidenciasKML(arg1:number,arg2:number,arg3:boolean):Promise<reports.ExportacionResidenciasDTO>;
+
+export function GenerarAcuseRemisionPDF(arg1:number):Promise<string>;
+
+export function GenerarConsentimientosPendientesCursoPDF(arg1:number):Promise<string>;
+
+export function GenerarInformeProtocolosPDF(arg1:number):Promise<string>;
+
+export function GenerarNominaSaludCursoPDF(arg1:number):Promise<string>;
+
+export function GenerarPendientesVacunacionCursoPDF(arg1:number):Promise<string>;
+
 export function GenerarReporteBitacoraGestionPDF(arg1:string,arg2:string):Promise<string>;
 
+export function GenerarReporteCalidadIdentidadPDF():Promise<string>;
+
 export function GenerarReporteDerivacionesPDF(arg1:string,arg2:string):Promise<string>;
 
 export function GenerarReporteDocentes():Promise<string>;
 
 export function GenerarReporteEstadisticoPDF(arg1:string,arg2:string):Promise<string>;
 
+export function GenerarReporteExtraedadPDF(arg1:string):Promise<string>;
+
 export function GenerarReporteFichaEstudiantil(arg1:string):Promise<string>;
 
 export function GenerarReporteInstitucional():Promise<string>;
 
 export function GenerarReporteNominaVulnerabilidadPDF(arg1:string):Promise<string>;
 
+export function GenerarReporteNutricionalCursoPDF(arg1:number,arg2:string):Promise<string>;
+
+export function GenerarResidenciasPDF(arg1:number,arg2:number):Promise<string>;
+
+export function GenerarResumenNutricionalPDF(arg1:string):Promise<string>;
+
+export function GenerarTarjetaEmergenciaPDF(arg1:number):Promise<string>;
+
+export function ObtenerConsentimientosPendientesCurso(arg1:number):Promise<reports.ConsentimientosCursoDTO>;
+
 export function ObtenerDatosFichaEstudiantil(arg1:string):Promise<reports.FichaEstudiantilDTO>;
 
+export function ObtenerEstadisticasRemisiones(arg1:string,arg2:string):Promise<reports.EstadisticasRemisionesDTO>;
+
+export function ObtenerInformeProtocolos(arg1:number):Promise<reports.InformeProtocolosDTO>;
+
+export function ObtenerLineaTiempoEstudiante(arg1:number,arg2:reports.FiltroLineaTiempoDTO):Promise<reports.LineaTiempoDTO>;
+
+export function ObtenerMatriculadosAFecha(arg1:string,arg2:number):Promise<reports.MatriculadosFechaDTO>;
+
+export function ObtenerNominaSaludCurso(arg1:number):Promise<reports.NominaSaludCursoDTO>;
+
+export function ObtenerPendientesVacunacionCurso(arg1:number):Promise<reports.PendientesSaludCursoDTO>;
+
 export function ObtenerReporteBitacoraGestion(arg1:string,arg2:string):Promise<reports.BitacoraGestionDTO>;
 
+export function ObtenerReporteCalidadIdentidad():Promise<Array<reports.DocumentoInvalidoDTO>>;
+
 export function ObtenerReporteDerivaciones(arg1:string,arg2:string):Promise<Array<reports.DerivacionDTO>>;
 
 export function ObtenerReporteEstadistico(arg1:string,arg2:string):Promise<reports.ReporteEstadisticoDTO>;
 
+export function ObtenerReporteExtraedad(arg1:string):Promise<reports.ReporteExtraedadDTO>;
+
 export function ObtenerReporteNominaVulnerabilidad(arg1:string):Promise<Array<reports.NominaVulnerabilidadDTO>>;
+
+export function ObtenerReporteNutricionalCurso(arg1:number,arg2:string):Promise<reports.ReporteNutricionalCursoDTO>;
+
+export function ObtenerResidencias(arg1:number,arg2:number):Promise<reports.ResidenciasDTO>;
+
+export function ObtenerResumenNutricional(arg1:string):Promise<reports.ResumenNutricionalDTO>;
+
+export function ObtenerTarjetaEmergencia(arg1:number):Promise<reports.TarjetaEmergenciaDTO>;
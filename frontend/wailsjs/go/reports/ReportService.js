@@ -6,10 +6,42 @@ export function AbrirUbicacionReporte(arg1) {
   return window['go']['reports']['ReportService']['AbrirUbicacionReporte'](arg1);
 }
 
+export function ExportarResidenciasGeoJSON(arg1, arg2, arg3) {
+  return window['go']['reports']['ReportService']['ExportarResidenciasGeoJSON'](arg1, arg2, arg3);
+}
+
+export function ExportarResidenciasKML(arg1, arg2, arg3) {
+  return window['go']['reports']['ReportService']['ExportarResidenciasKML'](arg1, arg2, arg3);
+}
+
+export function GenerarAcuseRemisionPDF(arg1) {
+  return window['go']['reports']['ReportService']['GenerarAcuseRemisionPDF'](arg1);
+}
+
+export function GenerarConsentimientosPendientesCursoPDF(arg1) {
+  return window['go']['reports']['ReportService']['GenerarConsentimientosPendientesCursoPDF'](arg1);
+}
+
+export function GenerarInformeProtocolosPDF(arg1) {
+  return window['go']['reports']['ReportService']['GenerarInformeProtocolosPDF'](arg1);
+}
+
+export function GenerarNominaSaludCursoPDF(arg1) {
+  return window['go']['reports']['ReportService']['GenerarNominaSaludCursoPDF'](arg1);
+}
+
+export function GenerarPendientesVacunacionCursoPDF(arg1) {
+  return window['go']['reports']['ReportService']['GenerarPendientesVacunacionCursoPDF'](arg1);
+}
+
 export function GenerarReporteBitacoraGestionPDF(arg1, arg2) {
   return window['go']['reports']['ReportService']['GenerarReporteBitacoraGestionPDF'](arg1, arg2);
 }
 
+export function GenerarReporteCalidadIdentidadPDF() {
+  return window['go']['reports']['ReportService']['GenerarReporteCalidadIdentidadPDF']();
+}
+
 export function GenerarReporteDerivacionesPDF(arg1, arg2) {
   return window['go']['reports']['ReportService']['GenerarReporteDerivacionesPDF'](arg1, arg2);
 }
@@ -22,6 +54,10 @@ export function GenerarReporteEstadisticoPDF(arg1, arg2) {
   return window['go']['reports']['ReportService']['GenerarReporteEstadisticoPDF'](arg1, arg2);
 }
 
+export function GenerarReporteExtraedadPDF(arg1) {
+  return window['go']['reports']['ReportService']['GenerarReporteExtraedadPDF'](arg1);
+}
+
 export function GenerarReporteFichaEstudiantil(arg1) {
   return window['go']['reports']['ReportService']['GenerarReporteFichaEstudiantil'](arg1);
 }
@@ -34,14 +70,62 @@ export function GenerarReporteNominaVulnerabilidadPDF(arg1) {
   return window['go']['reports']['ReportService']['GenerarReporteNominaVulnerabilidadPDF'](arg1);
 }
 
+export function GenerarReporteNutricionalCursoPDF(arg1, arg2) {
+  return window['go']['reports']['ReportService']['GenerarReporteNutricionalCursoPDF'](arg1, arg2);
+}
+
+export function GenerarResidenciasPDF(arg1, arg2) {
+  return window['go']['reports']['ReportService']['GenerarResidenciasPDF'](arg1, arg2);
+}
+
+export function GenerarResumenNutricionalPDF(arg1) {
+  return window['go']['reports']['ReportService']['GenerarResumenNutricionalPDF'](arg1);
+}
+
+export function GenerarTarjetaEmergenciaPDF(arg1) {
+  return window['go']['reports']['ReportService']['GenerarTarjetaEmergenciaPDF'](arg1);
+}
+
+export function ObtenerConsentimientosPendientesCurso(arg1) {
+  return window['go']['reports']['ReportService']['ObtenerConsentimientosPendientesCurso'](arg1);
+}
+
 export function ObtenerDatosFichaEstudiantil(arg1) {
   return window['go']['reports']['ReportService']['ObtenerDatosFichaEstudiantil'](arg1);
 }
 
+export function ObtenerEstadisticasRemisiones(arg1, arg2) {
+  return window['go']['reports']['ReportService']['ObtenerEstadisticasRemisiones'](arg1, arg2);
+}
+
+export function ObtenerInformeProtocolos(arg1) {
+  return window['go']['reports']['ReportService']['ObtenerInformeProtocolos'](arg1);
+}
+
+export function ObtenerLineaTiempoEstudiante(arg1, arg2) {
+  return window['go']['reports']['ReportService']['ObtenerLineaTiempoEstudiante'](arg1, arg2);
+}
+
+export function ObtenerMatriculadosAFecha(arg1, arg2) {
+  return window['go']['reports']['ReportService']['ObtenerMatriculadosAFecha'](arg1, arg2);
+}
+
+export function ObtenerNominaSaludCurso(arg1) {
+  return window['go']['reports']['ReportService']['ObtenerNominaSaludCurso'](arg1);
+}
+
+export function ObtenerPendientesVacunacionCurso(arg1) {
+  return window['go']['reports']['ReportService']['ObtenerPendientesVacunacionCurso'](arg1);
+}
+
 export function ObtenerReporteBitacoraGestion(arg1, arg2) {
   return window['go']['reports']['ReportService']['ObtenerReporteBitacoraGestion'](arg1, arg2);
 }
 
+export function ObtenerReporteCalidadIdentidad() {
+  return window['go']['reports']['ReportService']['ObtenerReporteCalidadIdentidad']();
+}
+
 export function ObtenerReporteDerivaciones(arg1, arg2) {
   return window['go']['reports']['ReportService']['ObtenerReporteDerivaciones'](arg1, arg2);
 }
@@ -50,6 +134,26 @@ export function ObtenerReporteEstadistico(arg1, arg2) {
   return window['go']['reports']['ReportService']['ObtenerReporteEstadistico'](arg1, arg2);
 }
 
+export function ObtenerReporteExtraedad(arg1) {
+  return window['go']['reports']['ReportService']['ObtenerReporteExtraedad'](arg1);
+}
+
 export function ObtenerReporteNominaVulnerabilidad(arg1) {
   return window['go']['reports']['ReportService']['ObtenerReporteNominaVulnerabilidad'](arg1);
 }
+
+export function ObtenerReporteNutricionalCurso(arg1, arg2) {
+  return window['go']['reports']['ReportService']['ObtenerReporteNutricionalCurso'](arg1, arg2);
+}
+
+export function ObtenerResidencias(arg1, arg2) {
+  return window['go']['reports']['ReportService']['ObtenerResidencias'](arg1, arg2);
+}
+
+export function ObtenerResumenNutricional(arg1) {
+  return window['go']['reports']['ReportService']['ObtenerResumenNutricional'](arg1);
+}
+
+export function ObtenerTarjetaEmergencia(arg1) {
+  return window['go']['reports']['ReportService']['ObtenerTarjetaEmergencia'](arg1);
+}
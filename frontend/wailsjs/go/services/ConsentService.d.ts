@@ -0,0 +1,17 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {student} from '../models';
+
+export function EliminarConsentimiento(arg1:number):Promise<void>;
+
+export function GuardarConsentimiento(arg1:student.GuardarConsentimientoDTO):Promise<student.ConsentimientoDTO>;
+
+export function ListarCatalogosConsentimiento():Promise<student.CatalogosConsentimientoDTO>;
+
+export function ListarConsentimientos(arg1:number):Promise<Array<student.ConsentimientoDTO>>;
+
+export function ObtenerEstadoConsentimientos(arg1:number):Promise<Array<student.EstadoConsentimientoDTO>>;
+
+export function RevocarConsentimiento(arg1:number,arg2:string,arg3:string):Promise<void>;
+
+export function SubirDocumentoConsentimiento(arg1:number,arg2:string):Promise<string>;
@@ -0,0 +1,31 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function EliminarConsentimiento(arg1) {
+  return window['go']['services']['ConsentService']['EliminarConsentimiento'](arg1);
+}
+
+export function GuardarConsentimiento(arg1) {
+  return window['go']['services']['ConsentService']['GuardarConsentimiento'](arg1);
+}
+
+export function ListarCatalogosConsentimiento() {
+  return window['go']['services']['ConsentService']['ListarCatalogosConsentimiento']();
+}
+
+export function ListarConsentimientos(arg1) {
+  return window['go']['services']['ConsentService']['ListarConsentimientos'](arg1);
+}
+
+export function ObtenerEstadoConsentimientos(arg1) {
+  return window['go']['services']['ConsentService']['ObtenerEstadoConsentimientos'](arg1);
+}
+
+export function RevocarConsentimiento(arg1, arg2, arg3) {
+  return window['go']['services']['ConsentService']['RevocarConsentimiento'](arg1, arg2, arg3);
+}
+
+export function SubirDocumentoConsentimiento(arg1, arg2) {
+  return window['go']['services']['ConsentService']['SubirDocumentoConsentimiento'](arg1, arg2);
+}
@@ -1,9 +1,12 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {faculty} from '../models';
+import {query} from '../models';
 
 export function ActualizarCurso(arg1:faculty.GuardarCursoDTO):Promise<void>;
 
+export function ConsultarCursos(arg1:query.ConsultaDTO):Promise<faculty.CursosPaginadosDTO>;
+
 export function CrearCurso(arg1:faculty.GuardarCursoDTO):Promise<void>;
 
 export function EliminarCurso(arg1:number):Promise<void>;
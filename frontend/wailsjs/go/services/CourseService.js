@@ -6,6 +6,10 @@ export function ActualizarCurso(arg1) {
   return window['go']['services']['CourseService']['ActualizarCurso'](arg1);
 }
 
+export function ConsultarCursos(arg1) {
+  return window['go']['services']['CourseService']['ConsultarCursos'](arg1);
+}
+
 export function CrearCurso(arg1) {
   return window['go']['services']['CourseService']['CrearCurso'](arg1);
 }
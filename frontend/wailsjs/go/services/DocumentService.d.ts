@@ -0,0 +1,11 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {documents} from '../models';
+
+export function EliminarVersionPermanente(arg1:number):Promise<void>;
+
+export function ListarVersiones(arg1:string,arg2:number,arg3:string):Promise<Array<documents.VersionDocumentoDTO>>;
+
+export function ObtenerVersionBase64(arg1:number):Promise<string>;
+
+export function VerificarIntegridad(arg1:number):Promise<documents.IntegridadDocumentoDTO>;
@@ -0,0 +1,19 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function EliminarVersionPermanente(arg1) {
+  return window['go']['services']['DocumentService']['EliminarVersionPermanente'](arg1);
+}
+
+export function ListarVersiones(arg1, arg2, arg3) {
+  return window['go']['services']['DocumentService']['ListarVersiones'](arg1, arg2, arg3);
+}
+
+export function ObtenerVersionBase64(arg1) {
+  return window['go']['services']['DocumentService']['ObtenerVersionBase64'](arg1);
+}
+
+export function VerificarIntegridad(arg1) {
+  return window['go']['services']['DocumentService']['VerificarIntegridad'](arg1);
+}
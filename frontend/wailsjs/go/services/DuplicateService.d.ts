@@ -0,0 +1,11 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {student} from '../models';
+
+export function BuscarPosiblesDuplicados():Promise<Array<student.PosibleDuplicadoDTO>>;
+
+export function FusionarEstudiantes(arg1:student.FusionarEstudiantesDTO):Promise<student.FusionEstudiante>;
+
+export function ListarFusiones():Promise<Array<student.FusionResumenDTO>>;
+
+export function RevertirFusion(arg1:number):Promise<void>;
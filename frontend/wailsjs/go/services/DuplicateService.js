@@ -0,0 +1,19 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function BuscarPosiblesDuplicados() {
+  return window['go']['services']['DuplicateService']['BuscarPosiblesDuplicados']();
+}
+
+export function FusionarEstudiantes(arg1) {
+  return window['go']['services']['DuplicateService']['FusionarEstudiantes'](arg1);
+}
+
+export function ListarFusiones() {
+  return window['go']['services']['DuplicateService']['ListarFusiones']();
+}
+
+export function RevertirFusion(arg1) {
+  return window['go']['services']['DuplicateService']['RevertirFusion'](arg1);
+}
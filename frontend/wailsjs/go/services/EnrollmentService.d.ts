@@ -3,16 +3,38 @@
 import {enrollment} from '../models';
 import {context} from '../models';
 
+export function AplicarBalanceo(arg1:enrollment.AplicarBalanceoDTO):Promise<void>;
+
+export function CambiarCurso(arg1:enrollment.CambiarCursoDTO):Promise<void>;
+
+export function CambiarEstadoMatricula(arg1:enrollment.CambiarEstadoMatriculaDTO):Promise<void>;
+
 export function GuardarMatricula(arg1:enrollment.GuardarMatriculaDTO):Promise<enrollment.Matricula>;
 
 export function LeerArchivoParaVista(arg1:string):Promise<string>;
 
+export function ObtenerCambiosCurso(arg1:number):Promise<Array<enrollment.CambioCursoDTO>>;
+
+export function ObtenerEstadosPermitidos(arg1:number):Promise<Array<string>>;
+
+export function ObtenerFichaEnFecha(arg1:number,arg2:string):Promise<enrollment.FichaEnFechaDTO>;
+
 export function ObtenerHistorial(arg1:number):Promise<Array<enrollment.HistorialMatriculaDTO>>;
 
 export function ObtenerMatriculaActual(arg1:number):Promise<enrollment.MatriculaResponseDTO>;
 
+export function ObtenerReincorporaciones(arg1:number):Promise<Array<enrollment.ReincorporacionDTO>>;
+
+export function ObtenerTransicionesMatricula(arg1:number):Promise<Array<enrollment.TransicionMatricula>>;
+
+export function ObtenerVersionesFicha(arg1:number,arg2:string):Promise<Array<enrollment.VersionFichaDTO>>;
+
+export function ProponerBalanceo(arg1:number,arg2:string):Promise<enrollment.PropuestaBalanceoDTO>;
+
 export function RegistrarRetiroCompleto(arg1:number,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string):Promise<void>;
 
+export function ReincorporarEstudiante(arg1:enrollment.ReincorporarEstudianteDTO):Promise<enrollment.Reincorporacion>;
+
 export function RetirarEstudiante(arg1:number,arg2:string):Promise<void>;
 
 export function SeleccionarArchivo(arg1:string):Promise<string>;
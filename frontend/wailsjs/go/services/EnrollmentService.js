@@ -2,6 +2,18 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AplicarBalanceo(arg1) {
+  return window['go']['services']['EnrollmentService']['AplicarBalanceo'](arg1);
+}
+
+export function CambiarCurso(arg1) {
+  return window['go']['services']['EnrollmentService']['CambiarCurso'](arg1);
+}
+
+export function CambiarEstadoMatricula(arg1) {
+  return window['go']['services']['EnrollmentService']['CambiarEstadoMatricula'](arg1);
+}
+
 export function GuardarMatricula(arg1) {
   return window['go']['services']['EnrollmentService']['GuardarMatricula'](arg1);
 }
@@ -10,6 +22,18 @@ export function LeerArchivoParaVista(arg1) {
   return window['go']['services']['EnrollmentService']['LeerArchivoParaVista'](arg1);
 }
 
+export function ObtenerCambiosCurso(arg1) {
+  return window['go']['services']['EnrollmentService']['ObtenerCambiosCurso'](arg1);
+}
+
+export function ObtenerEstadosPermitidos(arg1) {
+  return window['go']['services']['EnrollmentService']['ObtenerEstadosPermitidos'](arg1);
+}
+
+export function ObtenerFichaEnFecha(arg1, arg2) {
+  return window['go']['services']['EnrollmentService']['ObtenerFichaEnFecha'](arg1, arg2);
+}
+
 export function ObtenerHistorial(arg1) {
   return window['go']['services']['EnrollmentService']['ObtenerHistorial'](arg1);
 }
@@ -18,10 +42,30 @@ export function ObtenerMatriculaActual(arg1) {
   return window['go']['services']['EnrollmentService']['ObtenerMatriculaActual'](arg1);
 }
 
+export function ObtenerReincorporaciones(arg1) {
+  return window['go']['services']['EnrollmentService']['ObtenerReincorporaciones'](arg1);
+}
+
+export function ObtenerTransicionesMatricula(arg1) {
+  return window['go']['services']['EnrollmentService']['ObtenerTransicionesMatricula'](arg1);
+}
+
+export function ObtenerVersionesFicha(arg1, arg2) {
+  return window['go']['services']['EnrollmentService']['ObtenerVersionesFicha'](arg1, arg2);
+}
+
+export function ProponerBalanceo(arg1, arg2) {
+  return window['go']['services']['EnrollmentService']['ProponerBalanceo'](arg1, arg2);
+}
+
 export function RegistrarRetiroCompleto(arg1, arg2, arg3, arg4, arg5, arg6) {
   return window['go']['services']['EnrollmentService']['RegistrarRetiroCompleto'](arg1, arg2, arg3, arg4, arg5, arg6);
 }
 
+export function ReincorporarEstudiante(arg1) {
+  return window['go']['services']['EnrollmentService']['ReincorporarEstudiante'](arg1);
+}
+
 export function RetirarEstudiante(arg1, arg2) {
   return window['go']['services']['EnrollmentService']['RetirarEstudiante'](arg1, arg2);
 }
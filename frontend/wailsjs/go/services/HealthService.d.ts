@@ -0,0 +1,58 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {health} from '../models';
+import {context} from '../models';
+
+export function EliminarAdjuntoIncidente(arg1:number,arg2:string):Promise<void>;
+
+export function EliminarControl(arg1:number):Promise<void>;
+
+export function EliminarDosis(arg1:number):Promise<void>;
+
+export function EliminarEsquemaDosis(arg1:number):Promise<void>;
+
+export function EliminarIncidente(arg1:number):Promise<void>;
+
+export function EliminarMedicion(arg1:number):Promise<void>;
+
+export function EliminarTipoControl(arg1:number):Promise<void>;
+
+export function EliminarVacuna(arg1:number):Promise<void>;
+
+export function GuardarEsquemaDosis(arg1:health.GuardarEsquemaDTO):Promise<health.EsquemaVacuna>;
+
+export function GuardarIncidente(arg1:health.GuardarIncidenteDTO):Promise<health.IncidenteDTO>;
+
+export function GuardarTipoControl(arg1:health.GuardarTipoControlDTO):Promise<health.TipoControlMedico>;
+
+export function GuardarVacuna(arg1:health.GuardarVacunaDTO):Promise<health.Vacuna>;
+
+export function ImportarSaludExcel():Promise<health.ResultadoImportacionSaludDTO>;
+
+export function ListarCatalogosIncidente():Promise<health.CatalogosIncidenteDTO>;
+
+export function ListarEsquema():Promise<Array<health.EsquemaVacuna>>;
+
+export function ListarIncidentes(arg1:number):Promise<Array<health.IncidenteDTO>>;
+
+export function ListarIncidentesPorFecha(arg1:string,arg2:string):Promise<Array<health.IncidenteDTO>>;
+
+export function ListarTiposControl():Promise<Array<health.TipoControlMedico>>;
+
+export function ListarVacunas():Promise<Array<health.Vacuna>>;
+
+export function ObtenerCarnetVacunacion(arg1:number):Promise<health.CarnetVacunacionDTO>;
+
+export function ObtenerEstadisticaIncidentes(arg1:number):Promise<health.EstadisticaIncidentesDTO>;
+
+export function ObtenerMediciones(arg1:number):Promise<Array<health.MedicionDTO>>;
+
+export function RegistrarControl(arg1:health.RegistrarControlDTO):Promise<health.ControlMedico>;
+
+export function RegistrarDosis(arg1:health.RegistrarDosisDTO):Promise<health.DosisVacuna>;
+
+export function RegistrarMedicion(arg1:health.RegistrarMedicionDTO):Promise<health.MedicionDTO>;
+
+export function SetContext(arg1:context.Context):Promise<void>;
+
+export function SubirAdjuntoIncidente(arg1:number,arg2:string,arg3:string):Promise<string>;
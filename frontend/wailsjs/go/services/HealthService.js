@@ -0,0 +1,111 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function EliminarAdjuntoIncidente(arg1, arg2) {
+  return window['go']['services']['HealthService']['EliminarAdjuntoIncidente'](arg1, arg2);
+}
+
+export function EliminarControl(arg1) {
+  return window['go']['services']['HealthService']['EliminarControl'](arg1);
+}
+
+export function EliminarDosis(arg1) {
+  return window['go']['services']['HealthService']['EliminarDosis'](arg1);
+}
+
+export function EliminarEsquemaDosis(arg1) {
+  return window['go']['services']['HealthService']['EliminarEsquemaDosis'](arg1);
+}
+
+export function EliminarIncidente(arg1) {
+  return window['go']['services']['HealthService']['EliminarIncidente'](arg1);
+}
+
+export function EliminarMedicion(arg1) {
+  return window['go']['services']['HealthService']['EliminarMedicion'](arg1);
+}
+
+export function EliminarTipoControl(arg1) {
+  return window['go']['services']['HealthService']['EliminarTipoControl'](arg1);
+}
+
+export function EliminarVacuna(arg1) {
+  return window['go']['services']['HealthService']['EliminarVacuna'](arg1);
+}
+
+export function GuardarEsquemaDosis(arg1) {
+  return window['go']['services']['HealthService']['GuardarEsquemaDosis'](arg1);
+}
+
+export function GuardarIncidente(arg1) {
+  return window['go']['services']['HealthService']['GuardarIncidente'](arg1);
+}
+
+export function GuardarTipoControl(arg1) {
+  return window['go']['services']['HealthService']['GuardarTipoControl'](arg1);
+}
+
+export function GuardarVacuna(arg1) {
+  return window['go']['services']['HealthService']['GuardarVacuna'](arg1);
+}
+
+export function ImportarSaludExcel() {
+  return window['go']['services']['HealthService']['ImportarSaludExcel']();
+}
+
+export function ListarCatalogosIncidente() {
+  return window['go']['services']['HealthService']['ListarCatalogosIncidente']();
+}
+
+export function ListarEsquema() {
+  return window['go']['services']['HealthService']['ListarEsquema']();
+}
+
+export function ListarIncidentes(arg1) {
+  return window['go']['services']['HealthService']['ListarIncidentes'](arg1);
+}
+
+export function ListarIncidentesPorFecha(arg1, arg2) {
+  return window['go']['services']['HealthService']['ListarIncidentesPorFecha'](arg1, arg2);
+}
+
+export function ListarTiposControl() {
+  return window['go']['services']['HealthService']['ListarTiposControl']();
+}
+
+export function ListarVacunas() {
+  return window['go']['services']['HealthService']['ListarVacunas']();
+}
+
+export function ObtenerCarnetVacunacion(arg1) {
+  return window['go']['services']['HealthService']['ObtenerCarnetVacunacion'](arg1);
+}
+
+export function ObtenerEstadisticaIncidentes(arg1) {
+  return window['go']['services']['HealthService']['ObtenerEstadisticaIncidentes'](arg1);
+}
+
+export function ObtenerMediciones(arg1) {
+  return window['go']['services']['HealthService']['ObtenerMediciones'](arg1);
+}
+
+export function RegistrarControl(arg1) {
+  return window['go']['services']['HealthService']['RegistrarControl'](arg1);
+}
+
+export function RegistrarDosis(arg1) {
+  return window['go']['services']['HealthService']['RegistrarDosis'](arg1);
+}
+
+export function RegistrarMedicion(arg1) {
+  return window['go']['services']['HealthService']['RegistrarMedicion'](arg1);
+}
+
+export function SetContext(arg1) {
+  return window['go']['services']['HealthService']['SetContext'](arg1);
+}
+
+export function SubirAdjuntoIncidente(arg1, arg2, arg3) {
+  return window['go']['services']['HealthService']['SubirAdjuntoIncidente'](arg1, arg2, arg3);
+}
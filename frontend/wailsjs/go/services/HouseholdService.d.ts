@@ -0,0 +1,17 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {student} from '../models';
+
+export function ActualizarTelefonoFamiliar(arg1:number,arg2:string):Promise<number>;
+
+export function DesvincularDeHogar(arg1:number):Promise<void>;
+
+export function DetectarHogares():Promise<Array<student.HogarSugeridoDTO>>;
+
+export function ListarHogares():Promise<Array<student.HogarResumenDTO>>;
+
+export function ObtenerHogar(arg1:number):Promise<student.HogarDetalleDTO>;
+
+export function ObtenerHogarDeEstudiante(arg1:number):Promise<student.HogarDetalleDTO>;
+
+export function VincularHogar(arg1:student.VincularHogarDTO):Promise<student.Hogar>;
@@ -0,0 +1,31 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function ActualizarTelefonoFamiliar(arg1, arg2) {
+  return window['go']['services']['HouseholdService']['ActualizarTelefonoFamiliar'](arg1, arg2);
+}
+
+export function DesvincularDeHogar(arg1) {
+  return window['go']['services']['HouseholdService']['DesvincularDeHogar'](arg1);
+}
+
+export function DetectarHogares() {
+  return window['go']['services']['HouseholdService']['DetectarHogares']();
+}
+
+export function ListarHogares() {
+  return window['go']['services']['HouseholdService']['ListarHogares']();
+}
+
+export function ObtenerHogar(arg1) {
+  return window['go']['services']['HouseholdService']['ObtenerHogar'](arg1);
+}
+
+export function ObtenerHogarDeEstudiante(arg1) {
+  return window['go']['services']['HouseholdService']['ObtenerHogarDeEstudiante'](arg1);
+}
+
+export function VincularHogar(arg1) {
+  return window['go']['services']['HouseholdService']['VincularHogar'](arg1);
+}
@@ -1,12 +1,17 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {management} from '../models';
+import {query} from '../models';
 import {context} from '../models';
 
 export function ActualizarCita(arg1:management.ActualizarCitaDTO):Promise<management.Convocatoria>;
 
 export function AgendarCita(arg1:management.AgendarCitaDTO):Promise<management.Convocatoria>;
 
+export function ConsultarCapacitaciones(arg1:query.ConsultaDTO):Promise<management.CapacitacionesPaginadasDTO>;
+
+export function ConsultarCitas(arg1:query.ConsultaDTO):Promise<management.CitasPaginadasDTO>;
+
 export function EliminarCapacitacion(arg1:number):Promise<void>;
 
 export function EliminarCita(arg1:number):Promise<void>;
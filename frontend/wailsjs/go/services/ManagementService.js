@@ -10,6 +10,14 @@ export function AgendarCita(arg1) {
   return window['go']['services']['ManagementService']['AgendarCita'](arg1);
 }
 
+export function ConsultarCapacitaciones(arg1) {
+  return window['go']['services']['ManagementService']['ConsultarCapacitaciones'](arg1);
+}
+
+export function ConsultarCitas(arg1) {
+  return window['go']['services']['ManagementService']['ConsultarCitas'](arg1);
+}
+
 export function EliminarCapacitacion(arg1) {
   return window['go']['services']['ManagementService']['EliminarCapacitacion'](arg1);
 }
@@ -5,6 +5,8 @@ import {notifications} from '../models';
 import {dto} from '../models';
 import {context} from '../models';
 
+export function GenerarRecordatorioHitosProtocolo(arg1:string,arg2:string,arg3:time.Time):Promise<notifications.Notificacion>;
+
 export function GenerarResumenAlertasCitas(arg1:string,arg2:string,arg3:time.Time):Promise<notifications.Notificacion>;
 
 export function ListarNotificacionesPaginadas(arg1:string,arg2:number,arg3:number):Promise<dto.NotificacionesPaginadasDTO>;
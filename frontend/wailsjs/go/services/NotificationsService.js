@@ -2,6 +2,10 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function GenerarRecordatorioHitosProtocolo(arg1, arg2, arg3) {
+  return window['go']['services']['NotificationsService']['GenerarRecordatorioHitosProtocolo'](arg1, arg2, arg3);
+}
+
 export function GenerarResumenAlertasCitas(arg1, arg2, arg3) {
   return window['go']['services']['NotificationsService']['GenerarResumenAlertasCitas'](arg1, arg2, arg3);
 }
@@ -0,0 +1,25 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {tracking} from '../models';
+
+export function AbrirProtocolo(arg1:number,arg2:string):Promise<tracking.ProtocoloDetalleDTO>;
+
+export function CerrarProtocolo(arg1:number,arg2:string):Promise<void>;
+
+export function CumplirHito(arg1:number,arg2:string,arg3:string):Promise<void>;
+
+export function EliminarContacto(arg1:number):Promise<void>;
+
+export function EliminarHito(arg1:number):Promise<void>;
+
+export function GuardarHito(arg1:tracking.GuardarHitoDTO):Promise<void>;
+
+export function ListarCatalogosProtocolo():Promise<tracking.CatalogosProtocoloDTO>;
+
+export function ListarProtocolos(arg1:string):Promise<Array<tracking.ProtocoloResumenDTO>>;
+
+export function ListarProtocolosEstudiante(arg1:number):Promise<Array<tracking.ProtocoloResumenDTO>>;
+
+export function ObtenerProtocolo(arg1:number):Promise<tracking.ProtocoloDetalleDTO>;
+
+export function RegistrarContacto(arg1:tracking.GuardarContactoDTO):Promise<tracking.ContactoProtocoloDTO>;
@@ -0,0 +1,47 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function AbrirProtocolo(arg1, arg2) {
+  return window['go']['services']['ProtocolService']['AbrirProtocolo'](arg1, arg2);
+}
+
+export function CerrarProtocolo(arg1, arg2) {
+  return window['go']['services']['ProtocolService']['CerrarProtocolo'](arg1, arg2);
+}
+
+export function CumplirHito(arg1, arg2, arg3) {
+  return window['go']['services']['ProtocolService']['CumplirHito'](arg1, arg2, arg3);
+}
+
+export function EliminarContacto(arg1) {
+  return window['go']['services']['ProtocolService']['EliminarContacto'](arg1);
+}
+
+export function EliminarHito(arg1) {
+  return window['go']['services']['ProtocolService']['EliminarHito'](arg1);
+}
+
+export function GuardarHito(arg1) {
+  return window['go']['services']['ProtocolService']['GuardarHito'](arg1);
+}
+
+export function ListarCatalogosProtocolo() {
+  return window['go']['services']['ProtocolService']['ListarCatalogosProtocolo']();
+}
+
+export function ListarProtocolos(arg1) {
+  return window['go']['services']['ProtocolService']['ListarProtocolos'](arg1);
+}
+
+export function ListarProtocolosEstudiante(arg1) {
+  return window['go']['services']['ProtocolService']['ListarProtocolosEstudiante'](arg1);
+}
+
+export function ObtenerProtocolo(arg1) {
+  return window['go']['services']['ProtocolService']['ObtenerProtocolo'](arg1);
+}
+
+export function RegistrarContacto(arg1) {
+  return window['go']['services']['ProtocolService']['RegistrarContacto'](arg1);
+}
@@ -0,0 +1,20 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {tracking} from '../models';
+import {management} from '../models';
+
+export function ConvertirRemisionEnCaso(arg1:number,arg2:tracking.GuardarCasoDTO):Promise<tracking.CasoSensible>;
+
+export function ConvertirRemisionEnCita(arg1:number,arg2:management.AgendarCitaDTO):Promise<management.Convocatoria>;
+
+export function EliminarAdjuntoRemision(arg1:number,arg2:string):Promise<void>;
+
+export function ListarRemisiones(arg1:tracking.FiltroRemisionesDTO):Promise<Array<tracking.RemisionResumenDTO>>;
+
+export function ObtenerRemision(arg1:number):Promise<tracking.RemisionDetalleDTO>;
+
+export function RegistrarRemision(arg1:tracking.GuardarRemisionDTO):Promise<tracking.Remision>;
+
+export function SubirAdjuntoRemision(arg1:number,arg2:string,arg3:string):Promise<string>;
+
+export function TriarRemision(arg1:tracking.TriajeRemisionDTO):Promise<void>;
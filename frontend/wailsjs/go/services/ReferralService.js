@@ -0,0 +1,35 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function ConvertirRemisionEnCaso(arg1, arg2) {
+  return window['go']['services']['ReferralService']['ConvertirRemisionEnCaso'](arg1, arg2);
+}
+
+export function ConvertirRemisionEnCita(arg1, arg2) {
+  return window['go']['services']['ReferralService']['ConvertirRemisionEnCita'](arg1, arg2);
+}
+
+export function EliminarAdjuntoRemision(arg1, arg2) {
+  return window['go']['services']['ReferralService']['EliminarAdjuntoRemision'](arg1, arg2);
+}
+
+export function ListarRemisiones(arg1) {
+  return window['go']['services']['ReferralService']['ListarRemisiones'](arg1);
+}
+
+export function ObtenerRemision(arg1) {
+  return window['go']['services']['ReferralService']['ObtenerRemision'](arg1);
+}
+
+export function RegistrarRemision(arg1) {
+  return window['go']['services']['ReferralService']['RegistrarRemision'](arg1);
+}
+
+export function SubirAdjuntoRemision(arg1, arg2, arg3) {
+  return window['go']['services']['ReferralService']['SubirAdjuntoRemision'](arg1, arg2, arg3);
+}
+
+export function TriarRemision(arg1) {
+  return window['go']['services']['ReferralService']['TriarRemision'](arg1);
+}
@@ -2,21 +2,36 @@
 // This file is automatically generated. DO NOT EDIT
 import {student} from '../models';
 import {services} from '../models';
+import {query} from '../models';
 import {context} from '../models';
 
 export function BuscarEstudiantes(arg1:string):Promise<Array<student.EstudianteListaDTO>>;
 
+export function ConfirmarImportacion(arg1:number):Promise<services.ImportResult>;
+
+export function ConsultarEstudiantes(arg1:query.ConsultaDTO):Promise<student.EstudiantesPaginadosDTO>;
+
+export function DescartarImportacion(arg1:number):Promise<void>;
+
 export function EliminarFamiliar(arg1:number):Promise<void>;
 
+export function EliminarPerfilImportacion(arg1:number):Promise<void>;
+
 export function GuardarDocumentoPDF(arg1:number,arg2:string,arg3:string):Promise<string>;
 
 export function GuardarEstudiante(arg1:student.GuardarEstudianteDTO):Promise<student.Estudiante>;
 
-export function GuardarFoto(arg1:number,arg2:string):Promise<string>;
+export function GuardarFoto(arg1:number,arg2:string):Promise<student.FotoGuardadaDTO>;
+
+export function GuardarFotoBase64(arg1:number,arg2:string,arg3:string):Promise<student.FotoGuardadaDTO>;
+
+export function GuardarPerfilImportacion(arg1:student.PerfilImportacionDTO):Promise<student.PerfilImportacion>;
 
-export function GuardarFotoBase64(arg1:number,arg2:string,arg3:string):Promise<string>;
+export function ListarCamposImportacion():Promise<Array<student.CampoImportacionDTO>>;
 
-export function ImportarEstudiantes(arg1:number):Promise<services.ImportResult>;
+export function ListarLotesImportacion():Promise<Array<student.LoteImportacionResumenDTO>>;
+
+export function ListarPerfilesImportacion():Promise<Array<student.PerfilImportacionDTO>>;
 
 export function ObtenerDocumentoPDF(arg1:number,arg2:string):Promise<string>;
 
@@ -24,4 +39,12 @@ export function ObtenerEstudiante(arg1:number):Promise<student.Estudiante>;
 
 export function ObtenerFotoBase64(arg1:number):Promise<string>;
 
+export function ObtenerMiniaturaBase64(arg1:number):Promise<string>;
+
+export function PrevisualizarImportacion(arg1:number):Promise<student.VistaPreviaImportacionDTO>;
+
+export function PrevisualizarImportacionConPerfil(arg1:number,arg2:number):Promise<student.VistaPreviaImportacionDTO>;
+
+export function RevertirImportacion(arg1:number):Promise<student.ReversionImportacionDTO>;
+
 export function SetContext(arg1:context.Context):Promise<void>;
@@ -6,10 +6,26 @@ export function BuscarEstudiantes(arg1) {
   return window['go']['services']['StudentService']['BuscarEstudiantes'](arg1);
 }
 
+export function ConfirmarImportacion(arg1) {
+  return window['go']['services']['StudentService']['ConfirmarImportacion'](arg1);
+}
+
+export function ConsultarEstudiantes(arg1) {
+  return window['go']['services']['StudentService']['ConsultarEstudiantes'](arg1);
+}
+
+export function DescartarImportacion(arg1) {
+  return window['go']['services']['StudentService']['DescartarImportacion'](arg1);
+}
+
 export function EliminarFamiliar(arg1) {
   return window['go']['services']['StudentService']['EliminarFamiliar'](arg1);
 }
 
+export function EliminarPerfilImportacion(arg1) {
+  return window['go']['services']['StudentService']['EliminarPerfilImportacion'](arg1);
+}
+
 export function GuardarDocumentoPDF(arg1, arg2, arg3) {
   return window['go']['services']['StudentService']['GuardarDocumentoPDF'](arg1, arg2, arg3);
 }
@@ -26,8 +42,20 @@ export function GuardarFotoBase64(arg1, arg2, arg3) {
   return window['go']['services']['StudentService']['GuardarFotoBase64'](arg1, arg2, arg3);
 }
 
-export function ImportarEstudiantes(arg1) {
-  return window['go']['services']['StudentService']['ImportarEstudiantes'](arg1);
+export function GuardarPerfilImportacion(arg1) {
+  return window['go']['services']['StudentService']['GuardarPerfilImportacion'](arg1);
+}
+
+export function ListarCamposImportacion() {
+  return window['go']['services']['StudentService']['ListarCamposImportacion']();
+}
+
+export function ListarLotesImportacion() {
+  return window['go']['services']['StudentService']['ListarLotesImportacion']();
+}
+
+export function ListarPerfilesImportacion() {
+  return window['go']['services']['StudentService']['ListarPerfilesImportacion']();
 }
 
 export function ObtenerDocumentoPDF(arg1, arg2) {
@@ -42,6 +70,22 @@ export function ObtenerFotoBase64(arg1) {
   return window['go']['services']['StudentService']['ObtenerFotoBase64'](arg1);
 }
 
+export function ObtenerMiniaturaBase64(arg1) {
+  return window['go']['services']['StudentService']['ObtenerMiniaturaBase64'](arg1);
+}
+
+export function PrevisualizarImportacion(arg1) {
+  return window['go']['services']['StudentService']['PrevisualizarImportacion'](arg1);
+}
+
+export function PrevisualizarImportacionConPerfil(arg1, arg2) {
+  return window['go']['services']['StudentService']['PrevisualizarImportacionConPerfil'](arg1, arg2);
+}
+
+export function RevertirImportacion(arg1) {
+  return window['go']['services']['StudentService']['RevertirImportacion'](arg1);
+}
+
 export function SetContext(arg1) {
   return window['go']['services']['StudentService']['SetContext'](arg1);
 }
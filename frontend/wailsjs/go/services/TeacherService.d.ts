@@ -1,9 +1,12 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {faculty} from '../models';
+import {query} from '../models';
 
 export function ActualizarDocente(arg1:faculty.GuardarDocenteDTO):Promise<void>;
 
+export function ConsultarDocentes(arg1:query.ConsultaDTO):Promise<faculty.DocentesPaginadosDTO>;
+
 export function CrearDocente(arg1:faculty.GuardarDocenteDTO):Promise<void>;
 
 export function ListarDocentes(arg1:boolean):Promise<Array<faculty.DocenteDTO>>;
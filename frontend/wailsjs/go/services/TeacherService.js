@@ -6,6 +6,10 @@ export function ActualizarDocente(arg1) {
   return window['go']['services']['TeacherService']['ActualizarDocente'](arg1);
 }
 
+export function ConsultarDocentes(arg1) {
+  return window['go']['services']['TeacherService']['ConsultarDocentes'](arg1);
+}
+
 export function CrearDocente(arg1) {
   return window['go']['services']['TeacherService']['CrearDocente'](arg1);
 }
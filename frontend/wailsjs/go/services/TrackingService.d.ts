@@ -1,26 +1,39 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {tracking} from '../models';
+import {query} from '../models';
 import {context} from '../models';
 
 export function BuscarEstudiantesActivos(arg1:string):Promise<Array<tracking.EstudianteDisciplinaDTO>>;
 
+export function CambiarEstadoCaso(arg1:tracking.CambiarEstadoCasoDTO):Promise<void>;
+
+export function ConsultarCasos(arg1:query.ConsultaDTO):Promise<tracking.CasosPaginadosDTO>;
+
 export function CrearCaso(arg1:tracking.GuardarCasoDTO):Promise<tracking.CasoSensible>;
 
 export function CrearLlamado(arg1:tracking.GuardarLlamadoDTO):Promise<tracking.LlamadoAtencion>;
 
 export function EliminarEvidenciaCaso(arg1:number,arg2:string):Promise<void>;
 
+export function EliminarIntervencion(arg1:number):Promise<void>;
+
 export function LeerArchivoParaVista(arg1:string):Promise<string>;
 
 export function ListarCasos(arg1:number):Promise<Array<tracking.CasoResumenDTO>>;
 
+export function ListarCatalogosCaso():Promise<tracking.CatalogosCasoDTO>;
+
 export function ListarLlamados(arg1:number):Promise<Array<tracking.LlamadoResumenDTO>>;
 
+export function ObtenerBitacoraCaso(arg1:number):Promise<tracking.BitacoraCasoDTO>;
+
 export function ObtenerCaso(arg1:number):Promise<tracking.GuardarCasoDTO>;
 
 export function ObtenerLlamado(arg1:number):Promise<tracking.GuardarLlamadoDTO>;
 
+export function RegistrarIntervencion(arg1:tracking.GuardarIntervencionDTO):Promise<tracking.IntervencionCasoDTO>;
+
 export function SeleccionarArchivo(arg1:string):Promise<string>;
 
 export function SetContext(arg1:context.Context):Promise<void>;
@@ -6,6 +6,14 @@ export function BuscarEstudiantesActivos(arg1) {
   return window['go']['services']['TrackingService']['BuscarEstudiantesActivos'](arg1);
 }
 
+export function CambiarEstadoCaso(arg1) {
+  return window['go']['services']['TrackingService']['CambiarEstadoCaso'](arg1);
+}
+
+export function ConsultarCasos(arg1) {
+  return window['go']['services']['TrackingService']['ConsultarCasos'](arg1);
+}
+
 export function CrearCaso(arg1) {
   return window['go']['services']['TrackingService']['CrearCaso'](arg1);
 }
@@ -18,6 +26,10 @@ export function EliminarEvidenciaCaso(arg1, arg2) {
   return window['go']['services']['TrackingService']['EliminarEvidenciaCaso'](arg1, arg2);
 }
 
+export function EliminarIntervencion(arg1) {
+  return window['go']['services']['TrackingService']['EliminarIntervencion'](arg1);
+}
+
 export function LeerArchivoParaVista(arg1) {
   return window['go']['services']['TrackingService']['LeerArchivoParaVista'](arg1);
 }
@@ -26,10 +38,18 @@ export function ListarCasos(arg1) {
   return window['go']['services']['TrackingService']['ListarCasos'](arg1);
 }
 
+export function ListarCatalogosCaso() {
+  return window['go']['services']['TrackingService']['ListarCatalogosCaso']();
+}
+
 export function ListarLlamados(arg1) {
   return window['go']['services']['TrackingService']['ListarLlamados'](arg1);
 }
 
+export function ObtenerBitacoraCaso(arg1) {
+  return window['go']['services']['TrackingService']['ObtenerBitacoraCaso'](arg1);
+}
+
 export function ObtenerCaso(arg1) {
   return window['go']['services']['TrackingService']['ObtenerCaso'](arg1);
 }
@@ -38,6 +58,10 @@ export function ObtenerLlamado(arg1) {
   return window['go']['services']['TrackingService']['ObtenerLlamado'](arg1);
 }
 
+export function RegistrarIntervencion(arg1) {
+  return window['go']['services']['TrackingService']['RegistrarIntervencion'](arg1);
+}
+
 export function SeleccionarArchivo(arg1) {
   return window['go']['services']['TrackingService']['SeleccionarArchivo'](arg1);
 }
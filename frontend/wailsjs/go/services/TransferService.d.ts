@@ -0,0 +1,16 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {transfer} from '../models';
+import {context} from '../models';
+
+export function ConfirmarExpediente(arg1:transfer.ConfirmarExpedienteDTO):Promise<transfer.ResultadoImportacionExpedienteDTO>;
+
+export function DescartarExpediente(arg1:number):Promise<void>;
+
+export function ExportarExpediente(arg1:number):Promise<transfer.ResultadoExportacionDTO>;
+
+export function ObtenerIdentidadInstalacion():Promise<transfer.IdentidadInstalacionDTO>;
+
+export function PrevisualizarExpediente(arg1:string):Promise<transfer.VistaPreviaExpedienteDTO>;
+
+export function SetContext(arg1:context.Context):Promise<void>;
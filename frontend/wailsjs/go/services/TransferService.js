@@ -0,0 +1,27 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function ConfirmarExpediente(arg1) {
+  return window['go']['services']['TransferService']['ConfirmarExpediente'](arg1);
+}
+
+export function DescartarExpediente(arg1) {
+  return window['go']['services']['TransferService']['DescartarExpediente'](arg1);
+}
+
+export function ExportarExpediente(arg1) {
+  return window['go']['services']['TransferService']['ExportarExpediente'](arg1);
+}
+
+export function ObtenerIdentidadInstalacion() {
+  return window['go']['services']['TransferService']['ObtenerIdentidadInstalacion']();
+}
+
+export function PrevisualizarExpediente(arg1) {
+  return window['go']['services']['TransferService']['PrevisualizarExpediente'](arg1);
+}
+
+export function SetContext(arg1) {
+  return window['go']['services']['TransferService']['SetContext'](arg1);
+}
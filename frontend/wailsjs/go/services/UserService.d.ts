@@ -11,6 +11,8 @@ export function ListarUsuarios():Promise<Array<dtos.UsuarioResponseDTO>>;
 
 export function ObtenerFotoPerfilBase64(arg1:number):Promise<string>;
 
+export function ObtenerMiniaturaPerfilBase64(arg1:number):Promise<string>;
+
 export function SetContext(arg1:context.Context):Promise<void>;
 
 export function SubirFotoPerfil(arg1:number):Promise<string>;
@@ -18,6 +18,10 @@ export function ObtenerFotoPerfilBase64(arg1) {
   return window['go']['services']['UserService']['ObtenerFotoPerfilBase64'](arg1);
 }
 
+export function ObtenerMiniaturaPerfilBase64(arg1) {
+  return window['go']['services']['UserService']['ObtenerMiniaturaPerfilBase64'](arg1);
+}
+
 export function SetContext(arg1) {
   return window['go']['services']['UserService']['SetContext'](arg1);
 }
@@ -5,4 +5,8 @@ type NivelEducativoDTO struct {
 	Nombre         string `json:"nombre" validate:"required"`
 	NombreCompleto string `json:"nombre_completo" validate:"required"`
 	Orden          int    `json:"orden" validate:"required,min=1"`
+	EdadMinima     int    `json:"edad_minima"`
+	EdadMaxima     int    `json:"edad_maxima"`
+
+	CapacidadPorCurso int `json:"capacidad_por_curso"`
 }
@@ -5,6 +5,7 @@ type KPIDashboardDTO struct {
 	CasosAbiertos    int64 `json:"casos_abiertos"`
 	CitasPendientes  int64 `json:"citas_pendientes"`
 	SancionesMes     int64 `json:"sanciones_mes"`
+	Extraedad        int64 `json:"extraedad"`
 }
 
 type CitaProximaDTO struct {
@@ -21,6 +22,11 @@ type CursoConflictivoDTO struct {
 	CantidadFaltas int    `json:"cantidad_faltas"`
 }
 
+type CursoExtraedadDTO struct {
+	Curso    string `json:"curso"`
+	Cantidad int    `json:"cantidad"`
+}
+
 type CasoPorTipoDTO struct {
 	TipoCaso string `json:"tipo_caso"`
 	Cantidad int    `json:"cantidad"`
@@ -42,6 +48,7 @@ type DashboardDataDTO struct {
 	KPI                KPIDashboardDTO       `json:"kpi"`
 	CitasProximas      []CitaProximaDTO      `json:"citas_proximas"`
 	CursosConflictivos []CursoConflictivoDTO `json:"cursos_conflictivos"`
+	ExtraedadPorCurso  []CursoExtraedadDTO   `json:"extraedad_por_curso"`
 	CasosPorTipo       []CasoPorTipoDTO      `json:"casos_por_tipo"`
 	EstudiantesGenero  []GeneroDTO           `json:"estudiantes_genero"`
 	ActividadReciente  []ActividadDTO        `json:"actividad_reciente"`
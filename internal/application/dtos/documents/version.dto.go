@@ -0,0 +1,25 @@
+package documents
+
+type VersionDocumentoDTO struct {
+	ID            uint   `json:"id"`
+	Entidad       string `json:"entidad"`
+	EntidadID     uint   `json:"entidad_id"`
+	TipoDocumento string `json:"tipo_documento"`
+	Version       int    `json:"version"`
+	Vigente       bool   `json:"vigente"`
+	Nombre        string `json:"nombre"`
+	TamanoBytes   int64  `json:"tamano_bytes"`
+	HashSHA256    string `json:"hash_sha256"`
+	SubidoPor     string `json:"subido_por"`
+	FechaSubida   string `json:"fecha_subida"`
+	FechaRetiro   string `json:"fecha_retiro"`
+	ArchivoExiste bool   `json:"archivo_existe"`
+}
+
+type IntegridadDocumentoDTO struct {
+	VersionID    uint   `json:"version_id"`
+	Integro      bool   `json:"integro"`
+	HashEsperado string `json:"hash_esperado"`
+	HashActual   string `json:"hash_actual"`
+	Detalle      string `json:"detalle"`
+}
@@ -0,0 +1,48 @@
+package enrollment
+
+// ComposicionCursoDTO resume a los estudiantes vigentes de un curso según los criterios que
+// considera el balanceo.
+type ComposicionCursoDTO struct {
+	Total   int `json:"total"`
+	Mujeres int `json:"mujeres"`
+	Hombres int `json:"hombres"`
+	NEE     int `json:"nee"`
+}
+
+type CursoBalanceoDTO struct {
+	CursoID   uint                `json:"curso_id"`
+	Curso     string              `json:"curso"`
+	Capacidad int                 `json:"capacidad"`
+	Actual    ComposicionCursoDTO `json:"actual"`
+	Propuesto ComposicionCursoDTO `json:"propuesto"`
+}
+
+type MovimientoBalanceoDTO struct {
+	MatriculaID    uint   `json:"matricula_id"`
+	EstudianteID   uint   `json:"estudiante_id"`
+	Estudiante     string `json:"estudiante"`
+	Genero         string `json:"genero"`
+	EsNEE          bool   `json:"es_nee"`
+	CursoOrigenID  uint   `json:"curso_origen_id"`
+	CursoOrigen    string `json:"curso_origen"`
+	CursoDestinoID uint   `json:"curso_destino_id"`
+	CursoDestino   string `json:"curso_destino"`
+}
+
+// PropuestaBalanceoDTO es la redistribución sugerida para los paralelos de un nivel y jornada
+// del periodo activo. No cambia nada hasta aplicarla con AplicarBalanceo.
+type PropuestaBalanceoDTO struct {
+	NivelID      uint                    `json:"nivel_id"`
+	Nivel        string                  `json:"nivel"`
+	Jornada      string                  `json:"jornada"`
+	Cursos       []CursoBalanceoDTO      `json:"cursos"`
+	Movimientos  []MovimientoBalanceoDTO `json:"movimientos"`
+	Advertencias []string                `json:"advertencias"`
+}
+
+// AplicarBalanceoDTO aplica como un solo lote los movimientos elegidos de la propuesta.
+type AplicarBalanceoDTO struct {
+	Movimientos []MovimientoBalanceoDTO `json:"movimientos"`
+	Fecha       string                  `json:"fecha"`
+	Motivo      string                  `json:"motivo"`
+}
@@ -12,6 +12,73 @@ type HistorialMatriculaDTO struct {
 	Fecha          string `json:"fecha"`
 }
 
+// CambiarEstadoMatriculaDTO lleva la transición pedida. Fecha es la fecha efectiva
+// (YYYY-MM-DD, hoy si va vacía) y RutaDocumento el respaldo elegido con SeleccionarArchivo.
+type CambiarEstadoMatriculaDTO struct {
+	MatriculaID   uint   `json:"matricula_id"`
+	Estado        string `json:"estado"`
+	Fecha         string `json:"fecha"`
+	Motivo        string `json:"motivo"`
+	RutaDocumento string `json:"ruta_documento"`
+}
+
+// CambiarCursoDTO mueve la matrícula a otro curso del mismo periodo desde Fecha (YYYY-MM-DD,
+// hoy si va vacía).
+type CambiarCursoDTO struct {
+	MatriculaID uint   `json:"matricula_id"`
+	CursoID     uint   `json:"curso_id"`
+	Fecha       string `json:"fecha"`
+	Motivo      string `json:"motivo"`
+}
+
+type CambioCursoDTO struct {
+	ID            uint   `json:"id"`
+	MatriculaID   uint   `json:"matricula_id"`
+	CursoAnterior string `json:"curso_anterior"`
+	CursoNuevo    string `json:"curso_nuevo"`
+	Fecha         string `json:"fecha"`
+	Motivo        string `json:"motivo"`
+	NombreUsuario string `json:"nombre_usuario"`
+	FechaRegistro string `json:"fecha_registro"`
+}
+
+// ReincorporarEstudianteDTO reabre la matrícula de un estudiante retirado. CursoID en cero
+// mantiene el curso de la matrícula; Seguimiento, si se envía, agenda una convocatoria.
+type ReincorporarEstudianteDTO struct {
+	MatriculaID   uint                           `json:"matricula_id"`
+	CursoID       uint                           `json:"curso_id"`
+	Fecha         string                         `json:"fecha"`
+	Motivo        string                         `json:"motivo"`
+	Compromisos   string                         `json:"compromisos"`
+	RutaDocumento string                         `json:"ruta_documento"`
+	Seguimiento   *SeguimientoReincorporacionDTO `json:"seguimiento,omitempty"`
+}
+
+type SeguimientoReincorporacionDTO struct {
+	Entidad    string `json:"entidad"`
+	Motivo     string `json:"motivo"`
+	FechaCita  string `json:"fecha_cita"`
+	DiasAlerta int    `json:"dias_alerta"`
+}
+
+type ReincorporacionDTO struct {
+	ID               uint   `json:"id"`
+	MatriculaID      uint   `json:"matricula_id"`
+	PeriodoLectivo   string `json:"periodo_lectivo"`
+	CursoAnterior    string `json:"curso_anterior"`
+	Curso            string `json:"curso"`
+	EstadoAnterior   string `json:"estado_anterior"`
+	FechaRetiro      string `json:"fecha_retiro"`
+	MotivoRetiro     string `json:"motivo_retiro"`
+	Fecha            string `json:"fecha"`
+	Motivo           string `json:"motivo"`
+	Compromisos      string `json:"compromisos"`
+	RutaDocumento    string `json:"ruta_documento"`
+	ConvocatoriaID   *uint  `json:"convocatoria_id"`
+	FechaSeguimiento string `json:"fecha_seguimiento"`
+	NombreUsuario    string `json:"nombre_usuario"`
+}
+
 type GuardarMatriculaDTO struct {
 	ID           uint `json:"id"`
 	EstudianteID uint `json:"estudiante_id" validate:"required"`
@@ -24,12 +91,36 @@ type GuardarMatriculaDTO struct {
 	DatosSociales      domain.DatosSociales      `json:"datos_sociales"`
 	CondicionGenero    domain.CondicionGenero    `json:"condicion_genero"`
 
-	DireccionActual    string `json:"direccion_actual"`
-	RutaCroquis        string `json:"ruta_croquis"`
-	RutaConsentimiento string `json:"ruta_consentimiento"`
-	Estado             string `json:"estado"`
+	DireccionActual    string                    `json:"direccion_actual"`
+	Ubicacion          domain.UbicacionDomicilio `json:"ubicacion"`
+	RutaCroquis        string                    `json:"ruta_croquis"`
+	RutaConsentimiento string                    `json:"ruta_consentimiento"`
+	Estado             string                    `json:"estado"`
 }
 
 type MatriculaResponseDTO struct {
 	GuardarMatriculaDTO
 }
+
+type VersionFichaDTO struct {
+	ID            uint                 `json:"id"`
+	Bloque        string               `json:"bloque"`
+	Version       int                  `json:"version"`
+	Cambios       []domain.CambioCampo `json:"cambios"`
+	NombreUsuario string               `json:"nombre_usuario"`
+	FechaRegistro string               `json:"fecha_registro"`
+}
+
+// FichaEnFechaDTO es la ficha DECE tal como estaba registrada al final del día indicado.
+// Versiones indica, por bloque, qué versión se muestra (0 si el bloque nunca se versionó y se
+// muestra el contenido actual).
+type FichaEnFechaDTO struct {
+	MatriculaID        uint                      `json:"matricula_id"`
+	Fecha              string                    `json:"fecha"`
+	Antropometria      domain.Antropometria      `json:"antropometria"`
+	HistorialAcademico domain.HistorialAcademico `json:"historial_academico"`
+	DatosSalud         domain.DatosSalud         `json:"datos_salud"`
+	DatosSociales      domain.DatosSociales      `json:"datos_sociales"`
+	CondicionGenero    domain.CondicionGenero    `json:"condicion_genero"`
+	Versiones          map[string]int            `json:"versiones"`
+}
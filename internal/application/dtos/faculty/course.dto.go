@@ -1,5 +1,7 @@
 package faculty
 
+import queryDTO "dece/internal/application/dtos/query"
+
 type CursoResponseDTO struct {
 	ID             uint   `json:"id"`
 	NivelNombre    string `json:"nivel_nombre"`
@@ -10,6 +12,12 @@ type CursoResponseDTO struct {
 
 	NivelID uint  `json:"nivel_id"`
 	TutorID *uint `json:"tutor_id"`
+
+	// Capacidad es la del curso (0 si usa la del nivel); CapacidadEfectiva la que se aplica,
+	// 0 sin límite.
+	Capacidad         int `json:"capacidad"`
+	CapacidadEfectiva int `json:"capacidad_efectiva"`
+	Matriculados      int `json:"matriculados"`
 }
 
 type GuardarCursoDTO struct {
@@ -19,4 +27,10 @@ type GuardarCursoDTO struct {
 	Paralelo  string `json:"paralelo" validate:"required"`
 	Jornada   string `json:"jornada" validate:"required"`
 	TutorID   *uint  `json:"tutor_id"`
+	Capacidad int    `json:"capacidad"`
+}
+
+type CursosPaginadosDTO struct {
+	Items      []CursoResponseDTO     `json:"items"`
+	Paginacion queryDTO.PaginacionDTO `json:"paginacion"`
 }
@@ -1,5 +1,7 @@
 package faculty
 
+import queryDTO "dece/internal/application/dtos/query"
+
 type DocenteDTO struct {
 	ID               uint   `json:"id"`
 	Cedula           string `json:"cedula" validate:"required,len=10,numeric"`
@@ -16,3 +18,8 @@ type GuardarDocenteDTO struct {
 	Telefono         string `json:"telefono"`
 	Correo           string `json:"correo"`
 }
+
+type DocentesPaginadosDTO struct {
+	Items      []DocenteDTO           `json:"items"`
+	Paginacion queryDTO.PaginacionDTO `json:"paginacion"`
+}
@@ -0,0 +1,26 @@
+package health
+
+type RegistrarMedicionDTO struct {
+	MatriculaID uint    `json:"matricula_id"`
+	Fecha       string  `json:"fecha"`
+	PesoKg      float64 `json:"peso_kg"`
+	TallaCm     float64 `json:"talla_cm"`
+	Observacion string  `json:"observacion"`
+}
+
+type MedicionDTO struct {
+	ID             uint     `json:"id"`
+	MatriculaID    uint     `json:"matricula_id"`
+	PeriodoLectivo string   `json:"periodo_lectivo"`
+	Curso          string   `json:"curso"`
+	Fecha          string   `json:"fecha"`
+	PesoKg         float64  `json:"peso_kg"`
+	TallaCm        float64  `json:"talla_cm"`
+	IMC            float64  `json:"imc"`
+	EdadMeses      float64  `json:"edad_meses"`
+	Edad           string   `json:"edad"`
+	ZScoreIMC      *float64 `json:"zscore_imc"`
+	Clasificacion  string   `json:"clasificacion"`
+	Observacion    string   `json:"observacion"`
+	NombreUsuario  string   `json:"nombre_usuario"`
+}
@@ -0,0 +1,80 @@
+package health
+
+// GuardarIncidenteDTO crea el incidente si ID es 0. LlamadoAtencionID en 0 deja el incidente
+// sin vínculo disciplinario.
+type GuardarIncidenteDTO struct {
+	ID                      uint   `json:"id"`
+	MatriculaID             uint   `json:"matricula_id"`
+	LlamadoAtencionID       uint   `json:"llamado_atencion_id"`
+	Fecha                   string `json:"fecha"`
+	Hora                    string `json:"hora"`
+	Lugar                   string `json:"lugar"`
+	Tipo                    string `json:"tipo"`
+	Gravedad                string `json:"gravedad"`
+	Descripcion             string `json:"descripcion"`
+	PrimerosAuxilios        string `json:"primeros_auxilios"`
+	AtendidoPor             string `json:"atendido_por"`
+	RepresentanteNotificado bool   `json:"representante_notificado"`
+	MedioNotificacion       string `json:"medio_notificacion"`
+	HoraNotificacion        string `json:"hora_notificacion"`
+	PersonaNotificada       string `json:"persona_notificada"`
+	DerivadoCentroSalud     bool   `json:"derivado_centro_salud"`
+	CentroSalud             string `json:"centro_salud"`
+	Traslado                string `json:"traslado"`
+}
+
+type AdjuntoIncidenteDTO struct {
+	Nombre string `json:"nombre"`
+	Ruta   string `json:"ruta"`
+}
+
+type IncidenteDTO struct {
+	GuardarIncidenteDTO
+	EstudianteID   uint                  `json:"estudiante_id"`
+	Cedula         string                `json:"cedula"`
+	Estudiante     string                `json:"estudiante"`
+	Curso          string                `json:"curso"`
+	PeriodoLectivo string                `json:"periodo_lectivo"`
+	MotivoLlamado  string                `json:"motivo_llamado"`
+	Adjuntos       []AdjuntoIncidenteDTO `json:"adjuntos"`
+	NombreUsuario  string                `json:"nombre_usuario"`
+	FechaRegistro  string                `json:"fecha_registro"`
+}
+
+type CatalogosIncidenteDTO struct {
+	Tipos              []string `json:"tipos"`
+	Gravedades         []string `json:"gravedades"`
+	Lugares            []string `json:"lugares"`
+	MediosNotificacion []string `json:"medios_notificacion"`
+}
+
+type ConteoIncidenteDTO struct {
+	Nombre string `json:"nombre"`
+	Total  int    `json:"total"`
+}
+
+type MesIncidentesDTO struct {
+	Mes         string               `json:"mes"`
+	Nombre      string               `json:"nombre"`
+	Total       int                  `json:"total"`
+	Graves      int                  `json:"graves"`
+	Notificados int                  `json:"notificados"`
+	Derivados   int                  `json:"derivados"`
+	PorTipo     []ConteoIncidenteDTO `json:"por_tipo"`
+}
+
+// EstadisticaIncidentesDTO resume los incidentes del periodo lectivo mes a mes, incluidos los
+// meses sin incidentes.
+type EstadisticaIncidentesDTO struct {
+	PeriodoLectivo string               `json:"periodo_lectivo"`
+	Desde          string               `json:"desde"`
+	Hasta          string               `json:"hasta"`
+	Total          int                  `json:"total"`
+	Notificados    int                  `json:"notificados"`
+	Derivados      int                  `json:"derivados"`
+	ConDisciplina  int                  `json:"con_disciplina"`
+	Meses          []MesIncidentesDTO   `json:"meses"`
+	PorTipo        []ConteoIncidenteDTO `json:"por_tipo"`
+	PorGravedad    []ConteoIncidenteDTO `json:"por_gravedad"`
+	PorLugar       []ConteoIncidenteDTO `json:"por_lugar"`
+}
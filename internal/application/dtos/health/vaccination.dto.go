@@ -0,0 +1,114 @@
+package health
+
+type GuardarVacunaDTO struct {
+	ID          uint   `json:"id"`
+	Nombre      string `json:"nombre"`
+	Alias       string `json:"alias"`
+	Descripcion string `json:"descripcion"`
+	Activa      bool   `json:"activa"`
+}
+
+type GuardarEsquemaDTO struct {
+	ID          uint   `json:"id"`
+	VacunaID    uint   `json:"vacuna_id"`
+	NumeroDosis int    `json:"numero_dosis"`
+	EdadMeses   int    `json:"edad_meses"`
+	Sexo        string `json:"sexo"`
+	Descripcion string `json:"descripcion"`
+}
+
+type GuardarTipoControlDTO struct {
+	ID                uint   `json:"id"`
+	Nombre            string `json:"nombre"`
+	Alias             string `json:"alias"`
+	PeriodicidadMeses int    `json:"periodicidad_meses"`
+	Activo            bool   `json:"activo"`
+}
+
+type RegistrarDosisDTO struct {
+	EstudianteID    uint   `json:"estudiante_id"`
+	VacunaID        uint   `json:"vacuna_id"`
+	NumeroDosis     int    `json:"numero_dosis"`
+	Fecha           string `json:"fecha"`
+	Lote            string `json:"lote"`
+	Establecimiento string `json:"establecimiento"`
+	Observacion     string `json:"observacion"`
+}
+
+type RegistrarControlDTO struct {
+	EstudianteID    uint   `json:"estudiante_id"`
+	TipoControlID   uint   `json:"tipo_control_id"`
+	Fecha           string `json:"fecha"`
+	Profesional     string `json:"profesional"`
+	Establecimiento string `json:"establecimiento"`
+	Resultado       string `json:"resultado"`
+	Observacion     string `json:"observacion"`
+}
+
+// DosisCarnetDTO es una dosis del esquema con su estado, o una dosis aplicada fuera del
+// esquema configurado (Estado "Aplicada" y EdadMeses -1).
+type DosisCarnetDTO struct {
+	VacunaID        uint   `json:"vacuna_id"`
+	Vacuna          string `json:"vacuna"`
+	NumeroDosis     int    `json:"numero_dosis"`
+	Descripcion     string `json:"descripcion"`
+	EdadMeses       int    `json:"edad_meses"`
+	Estado          string `json:"estado"`
+	DosisID         uint   `json:"dosis_id"`
+	Fecha           string `json:"fecha"`
+	Lote            string `json:"lote"`
+	Establecimiento string `json:"establecimiento"`
+	Origen          string `json:"origen"`
+}
+
+type ControlCarnetDTO struct {
+	ID              uint   `json:"id"`
+	TipoControlID   uint   `json:"tipo_control_id"`
+	Tipo            string `json:"tipo"`
+	Fecha           string `json:"fecha"`
+	Profesional     string `json:"profesional"`
+	Establecimiento string `json:"establecimiento"`
+	Resultado       string `json:"resultado"`
+	Observacion     string `json:"observacion"`
+	Origen          string `json:"origen"`
+}
+
+// EstadoControlDTO indica si el estudiante tiene al día un control exigido.
+type EstadoControlDTO struct {
+	TipoControlID     uint   `json:"tipo_control_id"`
+	Tipo              string `json:"tipo"`
+	PeriodicidadMeses int    `json:"periodicidad_meses"`
+	UltimaFecha       string `json:"ultima_fecha"`
+	AlDia             bool   `json:"al_dia"`
+}
+
+type CarnetVacunacionDTO struct {
+	EstudianteID        uint               `json:"estudiante_id"`
+	Cedula              string             `json:"cedula"`
+	Estudiante          string             `json:"estudiante"`
+	FechaNacimiento     string             `json:"fecha_nacimiento"`
+	Edad                string             `json:"edad"`
+	Dosis               []DosisCarnetDTO   `json:"dosis"`
+	DosisPendientes     int                `json:"dosis_pendientes"`
+	EstadoControles     []EstadoControlDTO `json:"estado_controles"`
+	ControlesPendientes int                `json:"controles_pendientes"`
+	Controles           []ControlCarnetDTO `json:"controles"`
+	Completo            bool               `json:"completo"`
+	Advertencia         string             `json:"advertencia"`
+}
+
+type ErrorImportacionSaludDTO struct {
+	Hoja    string `json:"hoja"`
+	Fila    int    `json:"fila"`
+	Cedula  string `json:"cedula"`
+	Mensaje string `json:"mensaje"`
+}
+
+type ResultadoImportacionSaludDTO struct {
+	Archivo              string                     `json:"archivo"`
+	FilasLeidas          int                        `json:"filas_leidas"`
+	DosisRegistradas     int                        `json:"dosis_registradas"`
+	ControlesRegistrados int                        `json:"controles_registrados"`
+	Duplicadas           int                        `json:"duplicadas"`
+	Errores              []ErrorImportacionSaludDTO `json:"errores"`
+}
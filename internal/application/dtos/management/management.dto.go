@@ -1,5 +1,7 @@
 package management
 
+import queryDTO "dece/internal/application/dtos/query"
+
 type AgendarCitaDTO struct {
 	MatriculaID uint   `json:"matricula_id" validate:"required"`
 	Entidad     string `json:"entidad" validate:"required"`
@@ -52,3 +54,8 @@ type FiltroCitasDTO struct {
 	Tipo      string `json:"tipo"`
 	FechaSolo string `json:"fecha_solo"`
 }
+
+type CitasPaginadasDTO struct {
+	Items      []CitaResumenDTO       `json:"items"`
+	Paginacion queryDTO.PaginacionDTO `json:"paginacion"`
+}
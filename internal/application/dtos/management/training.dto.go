@@ -1,5 +1,7 @@
 package management
 
+import queryDTO "dece/internal/application/dtos/query"
+
 type CapacitacionResumenDTO struct {
 	ID                    uint   `json:"id"`
 	Fecha                 string `json:"fecha"`
@@ -40,3 +42,8 @@ type AlertaDashboardDTO struct {
 	NivelUrgencia string `json:"nivel_urgencia"` // "Alta" (hoy/mañana), "Media"
 	Color         string `json:"color"`          // "red", "orange", "blue" (para el UI)
 }
+
+type CapacitacionesPaginadasDTO struct {
+	Items      []CapacitacionResumenDTO `json:"items"`
+	Paginacion queryDTO.PaginacionDTO   `json:"paginacion"`
+}
@@ -0,0 +1,40 @@
+package query
+
+const (
+	OperadorIgual      = "igual"
+	OperadorDistinto   = "distinto"
+	OperadorContiene   = "contiene"
+	OperadorEmpiezaCon = "empieza_con"
+	OperadorMayor      = "mayor"
+	OperadorMayorIgual = "mayor_igual"
+	OperadorMenor      = "menor"
+	OperadorMenorIgual = "menor_igual"
+	OperadorEn         = "en"
+)
+
+// FiltroDTO restringe un campo del listado. Valor se interpreta según el tipo del campo
+// (texto, número, booleano o fecha); Valores se usa solo con el operador "en".
+type FiltroDTO struct {
+	Campo    string   `json:"campo"`
+	Operador string   `json:"operador"`
+	Valor    string   `json:"valor"`
+	Valores  []string `json:"valores"`
+}
+
+// ConsultaDTO es la especificación común que aceptan los listados paginados. Los campos
+// admitidos para ordenar y filtrar los define cada servicio.
+type ConsultaDTO struct {
+	Pagina       int         `json:"pagina"`
+	TamanoPagina int         `json:"tamano_pagina"`
+	OrdenarPor   string      `json:"ordenar_por"`
+	Descendente  bool        `json:"descendente"`
+	Busqueda     string      `json:"busqueda"`
+	Filtros      []FiltroDTO `json:"filtros"`
+}
+
+type PaginacionDTO struct {
+	Pagina       int   `json:"pagina"`
+	TamanoPagina int   `json:"tamano_pagina"`
+	Total        int64 `json:"total"`
+	TotalPaginas int   `json:"total_paginas"`
+}
@@ -0,0 +1,11 @@
+package reports
+
+type DocumentoInvalidoDTO struct {
+	Entidad    string `json:"entidad"`
+	RegistroID uint   `json:"registro_id"`
+	Titular    string `json:"titular"`
+	Campo      string `json:"campo"`
+	Valor      string `json:"valor"`
+	Problema   string `json:"problema"`
+	Referencia string `json:"referencia"`
+}
@@ -0,0 +1,23 @@
+package reports
+
+type EstudianteConsentimientoDTO struct {
+	EstudianteID uint   `json:"estudiante_id"`
+	Cedula       string `json:"cedula"`
+	Estudiante   string `json:"estudiante"`
+	// Faltantes son los tipos sin consentimiento vigente, con el motivo (vencido, revocado...)
+	Faltantes []string `json:"faltantes"`
+	// PorVencer son los tipos cuyo consentimiento vence pronto
+	PorVencer []string `json:"por_vencer"`
+}
+
+type ConsentimientosCursoDTO struct {
+	CursoID          uint                          `json:"curso_id"`
+	Curso            string                        `json:"curso"`
+	Jornada          string                        `json:"jornada"`
+	PeriodoLectivo   string                        `json:"periodo_lectivo"`
+	FechaCorte       string                        `json:"fecha_corte"`
+	TotalEstudiantes int                           `json:"total_estudiantes"`
+	Completos        int                           `json:"completos"`
+	PorTipo          []ConteoPendienteDTO          `json:"por_tipo"`
+	Estudiantes      []EstudianteConsentimientoDTO `json:"estudiantes"`
+}
@@ -0,0 +1,33 @@
+package reports
+
+type EstudianteExtraedadDTO struct {
+	EstudianteID    uint   `json:"estudiante_id"`
+	Cedula          string `json:"cedula"`
+	Estudiante      string `json:"estudiante"`
+	FechaNacimiento string `json:"fecha_nacimiento"`
+	Edad            int    `json:"edad"`
+	EdadMaxima      int    `json:"edad_maxima"`
+	AniosDesfase    int    `json:"anios_desfase"`
+	EsRepetidor     bool   `json:"es_repetidor"`
+}
+
+type CursoExtraedadDTO struct {
+	CursoID          uint                     `json:"curso_id"`
+	Curso            string                   `json:"curso"`
+	Jornada          string                   `json:"jornada"`
+	RangoEsperado    string                   `json:"rango_esperado"`
+	TotalEstudiantes int                      `json:"total_estudiantes"`
+	TotalExtraedad   int                      `json:"total_extraedad"`
+	Porcentaje       float64                  `json:"porcentaje"`
+	Estudiantes      []EstudianteExtraedadDTO `json:"estudiantes"`
+}
+
+type ReporteExtraedadDTO struct {
+	FechaReferencia      string              `json:"fecha_referencia"`
+	TotalEstudiantes     int                 `json:"total_estudiantes"`
+	TotalExtraedad       int                 `json:"total_extraedad"`
+	Porcentaje           float64             `json:"porcentaje"`
+	SinFechaNacimiento   int                 `json:"sin_fecha_nacimiento"`
+	NivelesSinConfigurar []string            `json:"niveles_sin_configurar"`
+	Cursos               []CursoExtraedadDTO `json:"cursos"`
+}
@@ -0,0 +1,38 @@
+package reports
+
+const (
+	EventoMatricula    = "matricula"
+	EventoRetiro       = "retiro"
+	EventoEstado       = "estado_matricula"
+	EventoCambioCurso  = "cambio_curso"
+	EventoDisciplina   = "disciplina"
+	EventoCaso         = "caso"
+	EventoEvidencia    = "evidencia"
+	EventoConvocatoria = "convocatoria"
+	EventoCertificado  = "certificado"
+	EventoIncidente    = "incidente"
+)
+
+type EventoLineaTiempoDTO struct {
+	Fecha          string `json:"fecha"`
+	Tipo           string `json:"tipo"`
+	Titulo         string `json:"titulo"`
+	Detalle        string `json:"detalle"`
+	Estado         string `json:"estado"`
+	ReferenciaID   uint   `json:"referencia_id"`
+	PeriodoID      uint   `json:"periodo_id"`
+	PeriodoLectivo string `json:"periodo_lectivo"`
+}
+
+// FiltroLineaTiempoDTO limita los eventos por tipo (vacío = todos) y por periodo (0 = todos).
+type FiltroLineaTiempoDTO struct {
+	Tipos     []string `json:"tipos"`
+	PeriodoID uint     `json:"periodo_id"`
+}
+
+type LineaTiempoDTO struct {
+	EstudianteID uint                   `json:"estudiante_id"`
+	Cedula       string                 `json:"cedula"`
+	Estudiante   string                 `json:"estudiante"`
+	Eventos      []EventoLineaTiempoDTO `json:"eventos"`
+}
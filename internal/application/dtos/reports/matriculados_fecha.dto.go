@@ -0,0 +1,29 @@
+package reports
+
+type MatriculadoFechaDTO struct {
+	MatriculaID  uint   `json:"matricula_id"`
+	EstudianteID uint   `json:"estudiante_id"`
+	Cedula       string `json:"cedula"`
+	Estudiante   string `json:"estudiante"`
+	CursoID      uint   `json:"curso_id"`
+	Curso        string `json:"curso"`
+	Jornada      string `json:"jornada"`
+	Estado       string `json:"estado"`
+	DesdeFecha   string `json:"desde_fecha"`
+}
+
+type ConteoEstadoDTO struct {
+	Estado string `json:"estado"`
+	Total  int    `json:"total"`
+}
+
+// MatriculadosFechaDTO responde "quién estaba matriculado el día X": Estudiantes solo trae a
+// los que contaban como matriculados y PorEstado resume todas las matrículas del periodo según
+// el estado que tenían ese día.
+type MatriculadosFechaDTO struct {
+	Fecha          string                `json:"fecha"`
+	PeriodoLectivo string                `json:"periodo_lectivo"`
+	Total          int                   `json:"total"`
+	PorEstado      []ConteoEstadoDTO     `json:"por_estado"`
+	Estudiantes    []MatriculadoFechaDTO `json:"estudiantes"`
+}
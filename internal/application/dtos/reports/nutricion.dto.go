@@ -0,0 +1,49 @@
+package reports
+
+// EstudianteNutricionDTO resume la última medición del estudiante hasta la fecha de corte.
+// Fecha vacía indica que no tiene mediciones.
+type EstudianteNutricionDTO struct {
+	EstudianteID  uint     `json:"estudiante_id"`
+	Cedula        string   `json:"cedula"`
+	Estudiante    string   `json:"estudiante"`
+	Sexo          string   `json:"sexo"`
+	Fecha         string   `json:"fecha"`
+	Edad          string   `json:"edad"`
+	PesoKg        float64  `json:"peso_kg"`
+	TallaCm       float64  `json:"talla_cm"`
+	IMC           float64  `json:"imc"`
+	ZScoreIMC     *float64 `json:"zscore_imc"`
+	Clasificacion string   `json:"clasificacion"`
+}
+
+type ConteoNutricionalDTO struct {
+	Clasificacion string `json:"clasificacion"`
+	Hombres       int    `json:"hombres"`
+	Mujeres       int    `json:"mujeres"`
+	Total         int    `json:"total"`
+}
+
+type ReporteNutricionalCursoDTO struct {
+	CursoID          uint                     `json:"curso_id"`
+	Curso            string                   `json:"curso"`
+	Jornada          string                   `json:"jornada"`
+	PeriodoLectivo   string                   `json:"periodo_lectivo"`
+	FechaCorte       string                   `json:"fecha_corte"`
+	TotalEstudiantes int                      `json:"total_estudiantes"`
+	Evaluados        int                      `json:"evaluados"`
+	Conteos          []ConteoNutricionalDTO   `json:"conteos"`
+	Estudiantes      []EstudianteNutricionDTO `json:"estudiantes"`
+	Advertencias     []string                 `json:"advertencias"`
+}
+
+// ResumenNutricionalDTO consolida los conteos de todos los cursos del periodo activo, en el
+// formato que solicita el distrito de salud.
+type ResumenNutricionalDTO struct {
+	PeriodoLectivo   string                       `json:"periodo_lectivo"`
+	FechaCorte       string                       `json:"fecha_corte"`
+	TotalEstudiantes int                          `json:"total_estudiantes"`
+	Evaluados        int                          `json:"evaluados"`
+	Conteos          []ConteoNutricionalDTO       `json:"conteos"`
+	Cursos           []ReporteNutricionalCursoDTO `json:"cursos"`
+	Advertencias     []string                     `json:"advertencias"`
+}
@@ -0,0 +1,48 @@
+package reports
+
+type ContactoInformeDTO struct {
+	Fecha     string `json:"fecha"`
+	Modalidad string `json:"modalidad"`
+	Resumen   string `json:"resumen"`
+	Acuerdos  string `json:"acuerdos"`
+}
+
+type ProtocoloInformeDTO struct {
+	ID             uint   `json:"id"`
+	Estudiante     string `json:"estudiante"`
+	Cedula         string `json:"cedula"`
+	Curso          string `json:"curso"`
+	PeriodoLectivo string `json:"periodo_lectivo"`
+	Tipo           string `json:"tipo"`
+	Estado         string `json:"estado"`
+	FechaApertura  string `json:"fecha_apertura"`
+	FechaCierre    string `json:"fecha_cierre"`
+	MotivoCierre   string `json:"motivo_cierre"`
+
+	HitosTotal     int `json:"hitos_total"`
+	HitosCumplidos int `json:"hitos_cumplidos"`
+	HitosVencidos  int `json:"hitos_vencidos"`
+
+	// Pendientes son los hitos vencidos y los próximos, con su fecha
+	Pendientes []string             `json:"pendientes"`
+	Contactos  []ContactoInformeDTO `json:"contactos"`
+}
+
+type ConteoProtocoloDTO struct {
+	Tipo     string `json:"tipo"`
+	Abiertos int    `json:"abiertos"`
+	Cerrados int    `json:"cerrados"`
+}
+
+// InformeProtocolosDTO es el informe confidencial para la coordinación del DECE con los
+// protocolos del periodo y los que siguen abiertos de periodos anteriores.
+type InformeProtocolosDTO struct {
+	PeriodoLectivo string                `json:"periodo_lectivo"`
+	FechaCorte     string                `json:"fecha_corte"`
+	Coordinador    string                `json:"coordinador"`
+	Total          int                   `json:"total"`
+	Abiertos       int                   `json:"abiertos"`
+	HitosVencidos  int                   `json:"hitos_vencidos"`
+	PorTipo        []ConteoProtocoloDTO  `json:"por_tipo"`
+	Protocolos     []ProtocoloInformeDTO `json:"protocolos"`
+}
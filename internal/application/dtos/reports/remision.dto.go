@@ -0,0 +1,33 @@
+package reports
+
+type EstadisticasRemisionesDTO struct {
+	FechaInicio  string                      `json:"fecha_inicio"`
+	FechaFin     string                      `json:"fecha_fin"`
+	Total        int                         `json:"total"`
+	PorCurso     []RemisionesPorCursoDTO     `json:"por_curso"`
+	PorDocente   []RemisionesPorDocenteDTO   `json:"por_docente"`
+	PorEstado    []RemisionesPorEstadoDTO    `json:"por_estado"`
+	PorCategoria []RemisionesPorCategoriaDTO `json:"por_categoria"`
+}
+
+type RemisionesPorCursoDTO struct {
+	Curso       string `json:"curso"`
+	Cantidad    int    `json:"cantidad"`
+	Convertidas int    `json:"convertidas"`
+}
+
+type RemisionesPorDocenteDTO struct {
+	Docente     string `json:"docente"`
+	Cantidad    int    `json:"cantidad"`
+	Convertidas int    `json:"convertidas"`
+}
+
+type RemisionesPorEstadoDTO struct {
+	Estado   string `json:"estado"`
+	Cantidad int    `json:"cantidad"`
+}
+
+type RemisionesPorCategoriaDTO struct {
+	Categoria string `json:"categoria"`
+	Cantidad  int    `json:"cantidad"`
+}
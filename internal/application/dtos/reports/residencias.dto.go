@@ -0,0 +1,28 @@
+package reports
+
+// ConteoUbicacionDTO agrupa a los estudiantes por parroquia y, si BarrioRecinto no está vacío,
+// por barrio. CasosAbiertos cuenta estudiantes con al menos un caso DECE sin cerrar.
+type ConteoUbicacionDTO struct {
+	Parroquia      string `json:"parroquia"`
+	BarrioRecinto  string `json:"barrio_recinto"`
+	Estudiantes    int    `json:"estudiantes"`
+	ConCoordenadas int    `json:"con_coordenadas"`
+	CasosAbiertos  int    `json:"casos_abiertos"`
+}
+
+type ResidenciasDTO struct {
+	PeriodoLectivo   string               `json:"periodo_lectivo"`
+	Curso            string               `json:"curso"`
+	TotalEstudiantes int                  `json:"total_estudiantes"`
+	ConCoordenadas   int                  `json:"con_coordenadas"`
+	SinParroquia     int                  `json:"sin_parroquia"`
+	PorParroquia     []ConteoUbicacionDTO `json:"por_parroquia"`
+	PorBarrio        []ConteoUbicacionDTO `json:"por_barrio"`
+}
+
+type ExportacionResidenciasDTO struct {
+	Ruta           string `json:"ruta"`
+	Exportados     int    `json:"exportados"`
+	SinCoordenadas int    `json:"sin_coordenadas"`
+	Seudonimizado  bool   `json:"seudonimizado"`
+}
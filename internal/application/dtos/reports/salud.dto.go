@@ -0,0 +1,46 @@
+package reports
+
+import "dece/internal/domain/enrollment"
+
+// ResumenSaludDTO reúne solo los datos de salud útiles ante una emergencia. Las fichas que no
+// tienen listas estructuradas muestran el texto libre anterior como una sola fila.
+type ResumenSaludDTO struct {
+	TipoSangre          string                          `json:"tipo_sangre"`
+	Alergias            []enrollment.Alergia            `json:"alergias"`
+	CondicionesCronicas []enrollment.CondicionCronica   `json:"condiciones_cronicas"`
+	Medicaciones        []enrollment.Medicacion         `json:"medicaciones"`
+	Discapacidad        string                          `json:"discapacidad"`
+	ContactosEmergencia []enrollment.ContactoEmergencia `json:"contactos_emergencia"`
+	SeguroMedico        enrollment.SeguroMedico         `json:"seguro_medico"`
+	// Alguna alergia grave, condición crónica, medicación en la institución o discapacidad
+	RequiereAtencion bool `json:"requiere_atencion"`
+}
+
+type TarjetaEmergenciaDTO struct {
+	EstudianteID    uint   `json:"estudiante_id"`
+	Cedula          string `json:"cedula"`
+	Estudiante      string `json:"estudiante"`
+	FechaNacimiento string `json:"fecha_nacimiento"`
+	Curso           string `json:"curso"`
+	Jornada         string `json:"jornada"`
+	Institucion     string `json:"institucion"`
+	ResumenSaludDTO
+}
+
+type EstudianteNominaSaludDTO struct {
+	EstudianteID uint   `json:"estudiante_id"`
+	Cedula       string `json:"cedula"`
+	Estudiante   string `json:"estudiante"`
+	ResumenSaludDTO
+}
+
+type NominaSaludCursoDTO struct {
+	CursoID          uint                       `json:"curso_id"`
+	Curso            string                     `json:"curso"`
+	Jornada          string                     `json:"jornada"`
+	PeriodoLectivo   string                     `json:"periodo_lectivo"`
+	Tutor            string                     `json:"tutor"`
+	TotalEstudiantes int                        `json:"total_estudiantes"`
+	ConAtencion      int                        `json:"con_atencion"`
+	Estudiantes      []EstudianteNominaSaludDTO `json:"estudiantes"`
+}
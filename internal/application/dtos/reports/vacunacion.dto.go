@@ -0,0 +1,31 @@
+package reports
+
+// EstudiantePendienteSaludDTO lista lo que le falta al estudiante: dosis del esquema exigibles
+// a su edad y controles que no están al día.
+type EstudiantePendienteSaludDTO struct {
+	EstudianteID        uint     `json:"estudiante_id"`
+	Cedula              string   `json:"cedula"`
+	Estudiante          string   `json:"estudiante"`
+	Edad                string   `json:"edad"`
+	DosisPendientes     []string `json:"dosis_pendientes"`
+	ControlesPendientes []string `json:"controles_pendientes"`
+}
+
+type ConteoPendienteDTO struct {
+	Nombre      string `json:"nombre"`
+	Estudiantes int    `json:"estudiantes"`
+}
+
+type PendientesSaludCursoDTO struct {
+	CursoID          uint                          `json:"curso_id"`
+	Curso            string                        `json:"curso"`
+	Jornada          string                        `json:"jornada"`
+	PeriodoLectivo   string                        `json:"periodo_lectivo"`
+	FechaCorte       string                        `json:"fecha_corte"`
+	TotalEstudiantes int                           `json:"total_estudiantes"`
+	Completos        int                           `json:"completos"`
+	PorVacuna        []ConteoPendienteDTO          `json:"por_vacuna"`
+	PorControl       []ConteoPendienteDTO          `json:"por_control"`
+	Estudiantes      []EstudiantePendienteSaludDTO `json:"estudiantes"`
+	Advertencias     []string                      `json:"advertencias"`
+}
@@ -0,0 +1,50 @@
+package student
+
+type CatalogosConsentimientoDTO struct {
+	Tipos                    []string `json:"tipos"`
+	Bloqueantes              []string `json:"bloqueantes"`
+	DerivacionesObligatorias []string `json:"derivaciones_obligatorias"`
+}
+
+// GuardarConsentimientoDTO registra el consentimiento si ID es 0. FamiliarID en 0 indica que
+// firma el propio estudiante, lo que solo se admite si es mayor de edad.
+type GuardarConsentimientoDTO struct {
+	ID               uint   `json:"id"`
+	EstudianteID     uint   `json:"estudiante_id"`
+	FamiliarID       uint   `json:"familiar_id"`
+	Tipo             string `json:"tipo"`
+	Alcance          string `json:"alcance"`
+	FechaFirma       string `json:"fecha_firma"`
+	FechaVencimiento string `json:"fecha_vencimiento"`
+}
+
+type ConsentimientoDTO struct {
+	ID                 uint   `json:"id"`
+	EstudianteID       uint   `json:"estudiante_id"`
+	FamiliarID         uint   `json:"familiar_id"`
+	Tipo               string `json:"tipo"`
+	Alcance            string `json:"alcance"`
+	FechaFirma         string `json:"fecha_firma"`
+	FechaVencimiento   string `json:"fecha_vencimiento"`
+	RutaDocumento      string `json:"ruta_documento"`
+	FirmanteNombre     string `json:"firmante_nombre"`
+	FirmanteCedula     string `json:"firmante_cedula"`
+	FirmanteParentesco string `json:"firmante_parentesco"`
+	FechaRevocacion    string `json:"fecha_revocacion"`
+	MotivoRevocacion   string `json:"motivo_revocacion"`
+	Estado             string `json:"estado"`
+	NombreUsuario      string `json:"nombre_usuario"`
+	FechaRegistro      string `json:"fecha_registro"`
+}
+
+// EstadoConsentimientoDTO resume, por tipo, si el estudiante tiene una autorización vigente y
+// qué pasa si falta.
+type EstadoConsentimientoDTO struct {
+	Tipo             string `json:"tipo"`
+	Estado           string `json:"estado"`
+	Bloquea          bool   `json:"bloquea"`
+	ConsentimientoID uint   `json:"consentimiento_id"`
+	FechaVencimiento string `json:"fecha_vencimiento"`
+	Firmante         string `json:"firmante"`
+	Mensaje          string `json:"mensaje"`
+}
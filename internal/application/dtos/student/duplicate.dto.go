@@ -0,0 +1,40 @@
+package student
+
+type EstudianteDuplicadoDTO struct {
+	ID              uint   `json:"id"`
+	Cedula          string `json:"cedula"`
+	Apellidos       string `json:"apellidos"`
+	Nombres         string `json:"nombres"`
+	FechaNacimiento string `json:"fecha_nacimiento"`
+	TotalMatriculas int    `json:"total_matriculas"`
+}
+
+type PosibleDuplicadoDTO struct {
+	EstudianteA EstudianteDuplicadoDTO `json:"estudiante_a"`
+	EstudianteB EstudianteDuplicadoDTO `json:"estudiante_b"`
+	Similitud   float64                `json:"similitud"`
+	Motivos     []string               `json:"motivos"`
+}
+
+type FusionarEstudiantesDTO struct {
+	ConservarID uint   `json:"conservar_id" validate:"required"`
+	EliminarID  uint   `json:"eliminar_id" validate:"required"`
+	UsuarioID   uint   `json:"usuario_id"`
+	Motivo      string `json:"motivo"`
+}
+
+type FusionResumenDTO struct {
+	ID                     uint   `json:"id"`
+	EstudianteConservadoID uint   `json:"estudiante_conservado_id"`
+	EstudianteConservado   string `json:"estudiante_conservado"`
+	EstudianteEliminadoID  uint   `json:"estudiante_eliminado_id"`
+	EstudianteEliminado    string `json:"estudiante_eliminado"`
+	CedulaEliminada        string `json:"cedula_eliminada"`
+	Motivo                 string `json:"motivo"`
+	FechaFusion            string `json:"fecha_fusion"`
+	MatriculasMovidas      int    `json:"matriculas_movidas"`
+	FamiliaresMovidos      int    `json:"familiares_movidos"`
+	CasosMovidos           int    `json:"casos_movidos"`
+	Revertida              bool   `json:"revertida"`
+	FechaReversion         string `json:"fecha_reversion"`
+}
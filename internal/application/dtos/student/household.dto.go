@@ -0,0 +1,65 @@
+package student
+
+type CasoHogarDTO struct {
+	ID             uint   `json:"id"`
+	CodigoCaso     string `json:"codigo_caso"`
+	TipoCaso       string `json:"tipo_caso"`
+	Estado         string `json:"estado"`
+	FechaDeteccion string `json:"fecha_deteccion"`
+}
+
+type MiembroHogarDTO struct {
+	EstudianteID    uint   `json:"estudiante_id"`
+	Cedula          string `json:"cedula"`
+	Apellidos       string `json:"apellidos"`
+	Nombres         string `json:"nombres"`
+	FechaNacimiento string `json:"fecha_nacimiento"`
+	RutaFoto        string `json:"ruta_foto"`
+
+	MatriculaID     uint   `json:"matricula_id"`
+	Curso           string `json:"curso"`
+	Jornada         string `json:"jornada"`
+	EstadoMatricula string `json:"estado_matricula"`
+
+	CasosAbiertos []CasoHogarDTO `json:"casos_abiertos"`
+}
+
+type FamiliarHogarDTO struct {
+	Cedula               string `json:"cedula"`
+	NombresCompletos     string `json:"nombres_completos"`
+	Parentesco           string `json:"parentesco"`
+	TelefonoPersonal     string `json:"telefono_personal"`
+	EsRepresentanteLegal bool   `json:"es_representante_legal"`
+	FamiliarIDs          []uint `json:"familiar_ids"`
+	EstudianteIDs        []uint `json:"estudiante_ids"`
+}
+
+type HogarDetalleDTO struct {
+	ID            uint   `json:"id"`
+	Nombre        string `json:"nombre"`
+	Observacion   string `json:"observacion"`
+	FechaCreacion string `json:"fecha_creacion"`
+
+	Miembros   []MiembroHogarDTO  `json:"miembros"`
+	Familiares []FamiliarHogarDTO `json:"familiares"`
+}
+
+type HogarResumenDTO struct {
+	ID               uint   `json:"id"`
+	Nombre           string `json:"nombre"`
+	TotalEstudiantes int    `json:"total_estudiantes"`
+	Estudiantes      string `json:"estudiantes"`
+}
+
+type HogarSugeridoDTO struct {
+	HogarID     uint                     `json:"hogar_id"`
+	Estudiantes []EstudianteDuplicadoDTO `json:"estudiantes"`
+	Motivos     []string                 `json:"motivos"`
+}
+
+type VincularHogarDTO struct {
+	HogarID       uint   `json:"hogar_id"`
+	Nombre        string `json:"nombre"`
+	Observacion   string `json:"observacion"`
+	EstudianteIDs []uint `json:"estudiante_ids" validate:"required"`
+}
@@ -0,0 +1,63 @@
+package student
+
+import "dece/internal/domain/student"
+
+type VistaPreviaImportacionDTO struct {
+	LoteID  uint   `json:"lote_id"`
+	Archivo string `json:"archivo"`
+	CursoID uint   `json:"curso_id"`
+
+	TotalFilas      int `json:"total_filas"`
+	Creaciones      int `json:"creaciones"`
+	Actualizaciones int `json:"actualizaciones"`
+	SinCambios      int `json:"sin_cambios"`
+	Matriculas      int `json:"matriculas"`
+	Omitidos        int `json:"omitidos"`
+	Errores         int `json:"errores"`
+
+	// SinCupo son las filas que no se matricularán porque el curso se llena antes.
+	CapacidadCurso int `json:"capacidad_curso"`
+	SinCupo        int `json:"sin_cupo"`
+
+	Filas []student.FilaImportacion `json:"filas"`
+}
+
+type LoteImportacionResumenDTO struct {
+	ID              uint   `json:"id"`
+	Archivo         string `json:"archivo"`
+	CursoID         uint   `json:"curso_id"`
+	Estado          string `json:"estado"`
+	TotalFilas      int    `json:"total_filas"`
+	Creados         int    `json:"creados"`
+	Actualizados    int    `json:"actualizados"`
+	Matriculados    int    `json:"matriculados"`
+	Errores         int    `json:"errores"`
+	FechaCreacion   string `json:"fecha_creacion"`
+	FechaAplicacion string `json:"fecha_aplicacion"`
+	FechaReversion  string `json:"fecha_reversion"`
+}
+
+type ReversionImportacionDTO struct {
+	LoteID                 uint     `json:"lote_id"`
+	EstudiantesEliminados  int      `json:"estudiantes_eliminados"`
+	EstudiantesRestaurados int      `json:"estudiantes_restaurados"`
+	MatriculasEliminadas   int      `json:"matriculas_eliminadas"`
+	Advertencias           []string `json:"advertencias"`
+}
+
+type CampoImportacionDTO struct {
+	Campo       string `json:"campo"`
+	Etiqueta    string `json:"etiqueta"`
+	Obligatorio bool   `json:"obligatorio"`
+}
+
+type PerfilImportacionDTO struct {
+	ID             uint              `json:"id"`
+	Nombre         string            `json:"nombre" validate:"required"`
+	Descripcion    string            `json:"descripcion"`
+	Delimitador    string            `json:"delimitador"`
+	FilaEncabezado int               `json:"fila_encabezado"`
+	FormatoFecha   string            `json:"formato_fecha"`
+	Hojas          []string          `json:"hojas"`
+	Columnas       map[string]string `json:"columnas"`
+}
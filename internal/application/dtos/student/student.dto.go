@@ -1,5 +1,7 @@
 package student
 
+import queryDTO "dece/internal/application/dtos/query"
+
 type InfoNacionalidadDTO struct {
 	EsExtranjero   bool   `json:"es_extranjero"`
 	PaisOrigen     string `json:"pais_origen"`
@@ -20,6 +22,11 @@ type EstudianteListaDTO struct {
 	InfoNacionalidad      *InfoNacionalidadDTO `json:"info_nacionalidad"`
 }
 
+type EstudiantesPaginadosDTO struct {
+	Items      []EstudianteListaDTO   `json:"items"`
+	Paginacion queryDTO.PaginacionDTO `json:"paginacion"`
+}
+
 type DatosFamiliarDTO struct {
 	NivelInstruccion string `json:"nivel_instruccion"`
 	Profesion        string `json:"profesion"`
@@ -58,3 +65,10 @@ type GuardarEstudianteDTO struct {
 	PasaporteOrDNI string               `json:"pasaporte_odni"`
 	Familiares     []GuardarFamiliarDTO `json:"familiares"`
 }
+
+// FotoGuardadaDTO es la ruta de la foto guardada y, si falta la autorización de uso de imagen,
+// la advertencia a mostrar.
+type FotoGuardadaDTO struct {
+	Ruta        string `json:"ruta"`
+	Advertencia string `json:"advertencia"`
+}
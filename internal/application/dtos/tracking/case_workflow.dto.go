@@ -0,0 +1,60 @@
+package tracking
+
+type CatalogosCasoDTO struct {
+	Estados       []string            `json:"estados"`
+	Transiciones  map[string][]string `json:"transiciones"`
+	MotivosCierre []string            `json:"motivos_cierre"`
+}
+
+// CambiarEstadoCasoDTO mueve el caso a Estado con una nota obligatoria. Al cerrar se exigen
+// además MotivoCierre y ResumenCierre.
+type CambiarEstadoCasoDTO struct {
+	CasoID        uint   `json:"caso_id"`
+	Estado        string `json:"estado"`
+	Fecha         string `json:"fecha"`
+	Nota          string `json:"nota"`
+	MotivoCierre  string `json:"motivo_cierre"`
+	ResumenCierre string `json:"resumen_cierre"`
+}
+
+type CambioEstadoCasoDTO struct {
+	ID             uint   `json:"id"`
+	EstadoAnterior string `json:"estado_anterior"`
+	EstadoNuevo    string `json:"estado_nuevo"`
+	Fecha          string `json:"fecha"`
+	Nota           string `json:"nota"`
+	MotivoCierre   string `json:"motivo_cierre"`
+	ResumenCierre  string `json:"resumen_cierre"`
+	NombreUsuario  string `json:"nombre_usuario"`
+	FechaRegistro  string `json:"fecha_registro"`
+}
+
+type GuardarIntervencionDTO struct {
+	CasoID        uint   `json:"caso_id"`
+	Fecha         string `json:"fecha"`
+	Participantes string `json:"participantes"`
+	Accion        string `json:"accion"`
+	Acuerdos      string `json:"acuerdos"`
+	ProximosPasos string `json:"proximos_pasos"`
+}
+
+type IntervencionCasoDTO struct {
+	ID            uint   `json:"id"`
+	Fecha         string `json:"fecha"`
+	Participantes string `json:"participantes"`
+	Accion        string `json:"accion"`
+	Acuerdos      string `json:"acuerdos"`
+	ProximosPasos string `json:"proximos_pasos"`
+	NombreUsuario string `json:"nombre_usuario"`
+	FechaRegistro string `json:"fecha_registro"`
+}
+
+// BitacoraCasoDTO reúne, en orden cronológico, los cambios de estado y las intervenciones del
+// caso.
+type BitacoraCasoDTO struct {
+	CasoID         uint                  `json:"caso_id"`
+	CodigoCaso     string                `json:"codigo_caso"`
+	Estado         string                `json:"estado"`
+	Cambios        []CambioEstadoCasoDTO `json:"cambios"`
+	Intervenciones []IntervencionCasoDTO `json:"intervenciones"`
+}
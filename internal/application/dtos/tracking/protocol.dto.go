@@ -0,0 +1,84 @@
+package tracking
+
+type CatalogosProtocoloDTO struct {
+	Tipos       []string `json:"tipos"`
+	TiposHito   []string `json:"tipos_hito"`
+	Modalidades []string `json:"modalidades"`
+}
+
+type ProtocoloResumenDTO struct {
+	ID             uint   `json:"id"`
+	MatriculaID    uint   `json:"matricula_id"`
+	EstudianteID   uint   `json:"estudiante_id"`
+	Cedula         string `json:"cedula"`
+	Estudiante     string `json:"estudiante"`
+	Curso          string `json:"curso"`
+	PeriodoLectivo string `json:"periodo_lectivo"`
+
+	Tipo          string `json:"tipo"`
+	Estado        string `json:"estado"`
+	Origen        string `json:"origen"`
+	FechaApertura string `json:"fecha_apertura"`
+	FechaCierre   string `json:"fecha_cierre"`
+
+	HitosTotal       int    `json:"hitos_total"`
+	HitosCumplidos   int    `json:"hitos_cumplidos"`
+	HitosVencidos    int    `json:"hitos_vencidos"`
+	ProximoHito      string `json:"proximo_hito"`
+	FechaProximoHito string `json:"fecha_proximo_hito"`
+	TotalContactos   int    `json:"total_contactos"`
+	UltimoContacto   string `json:"ultimo_contacto"`
+}
+
+type HitoProtocoloDTO struct {
+	ID              uint   `json:"id"`
+	Tipo            string `json:"tipo"`
+	Descripcion     string `json:"descripcion"`
+	FechaProgramada string `json:"fecha_programada"`
+	FechaCumplida   string `json:"fecha_cumplida"`
+	Observacion     string `json:"observacion"`
+	Estado          string `json:"estado"`
+}
+
+type ContactoProtocoloDTO struct {
+	ID            uint   `json:"id"`
+	HitoID        uint   `json:"hito_id"`
+	Hito          string `json:"hito"`
+	Fecha         string `json:"fecha"`
+	Modalidad     string `json:"modalidad"`
+	Participantes string `json:"participantes"`
+	Resumen       string `json:"resumen"`
+	Acuerdos      string `json:"acuerdos"`
+	NombreUsuario string `json:"nombre_usuario"`
+	FechaRegistro string `json:"fecha_registro"`
+}
+
+type ProtocoloDetalleDTO struct {
+	ProtocoloResumenDTO
+	MotivoCierre  string                 `json:"motivo_cierre"`
+	NombreUsuario string                 `json:"nombre_usuario"`
+	Hitos         []HitoProtocoloDTO     `json:"hitos"`
+	Contactos     []ContactoProtocoloDTO `json:"contactos"`
+}
+
+// GuardarHitoDTO agrega un hito al plan si ID es 0; si no, reprograma uno pendiente.
+type GuardarHitoDTO struct {
+	ID              uint   `json:"id"`
+	ProtocoloID     uint   `json:"protocolo_id"`
+	Tipo            string `json:"tipo"`
+	Descripcion     string `json:"descripcion"`
+	FechaProgramada string `json:"fecha_programada"`
+}
+
+// GuardarContactoDTO registra un contacto; con CumpleHito da por cumplido el hito HitoID en la
+// fecha del contacto.
+type GuardarContactoDTO struct {
+	ProtocoloID   uint   `json:"protocolo_id"`
+	HitoID        uint   `json:"hito_id"`
+	CumpleHito    bool   `json:"cumple_hito"`
+	Fecha         string `json:"fecha"`
+	Modalidad     string `json:"modalidad"`
+	Participantes string `json:"participantes"`
+	Resumen       string `json:"resumen"`
+	Acuerdos      string `json:"acuerdos"`
+}
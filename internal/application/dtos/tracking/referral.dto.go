@@ -0,0 +1,64 @@
+package tracking
+
+type GuardarRemisionDTO struct {
+	ID            uint   `json:"id"`
+	DocenteID     uint   `json:"docente_id" validate:"required"`
+	MatriculaID   uint   `json:"matricula_id" validate:"required"`
+	FechaRemision string `json:"fecha_remision" validate:"required"`
+	Categoria     string `json:"categoria" validate:"required"`
+	Descripcion   string `json:"descripcion" validate:"required"`
+	Urgencia      string `json:"urgencia" validate:"required"`
+}
+
+type FiltroRemisionesDTO struct {
+	Estado    string `json:"estado"`
+	DocenteID uint   `json:"docente_id"`
+	CursoID   uint   `json:"curso_id"`
+}
+
+type RemisionResumenDTO struct {
+	ID             uint   `json:"id"`
+	CodigoRemision string `json:"codigo_remision"`
+	FechaRemision  string `json:"fecha_remision"`
+	Categoria      string `json:"categoria"`
+	Urgencia       string `json:"urgencia"`
+	Estado         string `json:"estado"`
+
+	Docente    string `json:"docente"`
+	Estudiante string `json:"estudiante"`
+	Curso      string `json:"curso"`
+
+	CasoID         *uint `json:"caso_id"`
+	ConvocatoriaID *uint `json:"convocatoria_id"`
+	TotalAdjuntos  int   `json:"total_adjuntos"`
+}
+
+type RemisionDetalleDTO struct {
+	ID             uint   `json:"id"`
+	CodigoRemision string `json:"codigo_remision"`
+	DocenteID      uint   `json:"docente_id"`
+	MatriculaID    uint   `json:"matricula_id"`
+	FechaRemision  string `json:"fecha_remision"`
+	Categoria      string `json:"categoria"`
+	Descripcion    string `json:"descripcion"`
+	Urgencia       string `json:"urgencia"`
+
+	Estado             string `json:"estado"`
+	ObservacionTriaje  string `json:"observacion_triaje"`
+	FechaActualizacion string `json:"fecha_actualizacion"`
+
+	Docente    string `json:"docente"`
+	Estudiante string `json:"estudiante"`
+	Cedula     string `json:"cedula"`
+	Curso      string `json:"curso"`
+
+	CasoID         *uint          `json:"caso_id"`
+	ConvocatoriaID *uint          `json:"convocatoria_id"`
+	Adjuntos       []EvidenciaDTO `json:"adjuntos"`
+}
+
+type TriajeRemisionDTO struct {
+	RemisionID  uint   `json:"remision_id" validate:"required"`
+	Estado      string `json:"estado" validate:"required"`
+	Observacion string `json:"observacion"`
+}
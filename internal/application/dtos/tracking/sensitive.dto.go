@@ -1,5 +1,7 @@
 package tracking
 
+import queryDTO "dece/internal/application/dtos/query"
+
 type EvidenciaDTO struct {
 	Nombre string `json:"nombre"`
 	Ruta   string `json:"ruta"`
@@ -14,10 +16,16 @@ type CasoResumenDTO struct {
 	EntidadDerivacionDetalle string         `json:"entidad_derivacion_detalle"`
 	Descripcion              string         `json:"descripcion"`
 	Estado                   string         `json:"estado"`
+	EstadosSiguientes        []string       `json:"estados_siguientes"`
+	FechaCierre              string         `json:"fecha_cierre"`
+	MotivoCierre             string         `json:"motivo_cierre"`
+	ResumenCierre            string         `json:"resumen_cierre"`
 	TotalEvidencias          int            `json:"total_evidencias"`
 	RutasEvidencias          []EvidenciaDTO `json:"rutas_evidencias"`
 }
 
+// GuardarCasoDTO abre el caso si ID es 0 o actualiza sus datos. Estado solo se informa: el
+// estado cambia por CambiarEstadoCaso, que exige la nota de la transición.
 type GuardarCasoDTO struct {
 	ID                       uint   `json:"id"`
 	EstudianteID             uint   `json:"estudiante_id" validate:"required"`
@@ -28,3 +36,8 @@ type GuardarCasoDTO struct {
 	Descripcion              string `json:"descripcion" validate:"required"`
 	Estado                   string `json:"estado"`
 }
+
+type CasosPaginadosDTO struct {
+	Items      []CasoResumenDTO       `json:"items"`
+	Paginacion queryDTO.PaginacionDTO `json:"paginacion"`
+}
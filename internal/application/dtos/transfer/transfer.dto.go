@@ -0,0 +1,93 @@
+package transfer
+
+import (
+	"dece/internal/domain/student"
+	"dece/internal/domain/transfer"
+)
+
+type IdentidadInstalacionDTO struct {
+	Institucion string `json:"institucion"`
+	CodigoAMIE  string `json:"codigo_amie"`
+	Huella      string `json:"huella"`
+}
+
+// ResultadoExportacionDTO lleva la clave de transferencia, que solo se muestra una vez y debe
+// enviarse a la institución destino por un canal distinto al del archivo.
+type ResultadoExportacionDTO struct {
+	RutaArchivo        string `json:"ruta_archivo"`
+	CodigoExpediente   string `json:"codigo_expediente"`
+	ClaveTransferencia string `json:"clave_transferencia"`
+	HuellaEmisor       string `json:"huella_emisor"`
+	TotalDocumentos    int    `json:"total_documentos"`
+}
+
+type DocumentoPreviaDTO struct {
+	Tipo        string `json:"tipo"`
+	Nombre      string `json:"nombre"`
+	TamanoBytes int64  `json:"tamano_bytes"`
+	Integro     bool   `json:"integro"`
+}
+
+// ColisionCedulaDTO indica que la cédula del expediente ya existe en esta instalación.
+type ColisionCedulaDTO struct {
+	EstudianteID          uint                  `json:"estudiante_id"`
+	Cedula                string                `json:"cedula"`
+	NombreLocal           string                `json:"nombre_local"`
+	Diferencias           []student.CambioCampo `json:"diferencias"`
+	MatriculadoEnActivo   bool                  `json:"matriculado_en_activo"`
+	CursoActual           string                `json:"curso_actual"`
+	ExpedienteYaImportado bool                  `json:"expediente_ya_importado"`
+}
+
+type ColisionFamiliarDTO struct {
+	Cedula           string `json:"cedula"`
+	NombreExpediente string `json:"nombre_expediente"`
+	NombreLocal      string `json:"nombre_local"`
+	Estudiante       string `json:"estudiante"`
+}
+
+type CursoDestinoDTO struct {
+	CursoID  uint   `json:"curso_id"`
+	Nombre   string `json:"nombre"`
+	Jornada  string `json:"jornada"`
+	Sugerido bool   `json:"sugerido"`
+}
+
+type VistaPreviaExpedienteDTO struct {
+	ExpedienteID      uint   `json:"expediente_id"`
+	CodigoExpediente  string `json:"codigo_expediente"`
+	FechaExportacion  string `json:"fecha_exportacion"`
+	InstitucionOrigen string `json:"institucion_origen"`
+	CodigoAMIEOrigen  string `json:"codigo_amie_origen"`
+	HuellaEmisor      string `json:"huella_emisor"`
+	EmisorConocido    bool   `json:"emisor_conocido"`
+
+	Estudiante transfer.EstudianteExpediente   `json:"estudiante"`
+	Familiares []transfer.FamiliarExpediente   `json:"familiares"`
+	Matricula  *transfer.MatriculaExpediente   `json:"matricula"`
+	Retiro     *transfer.RetiroExpediente      `json:"retiro"`
+	Disciplina []transfer.DisciplinaExpediente `json:"disciplina"`
+	Casos      []transfer.CasoExpediente       `json:"casos"`
+	Documentos []DocumentoPreviaDTO            `json:"documentos"`
+
+	Colision             *ColisionCedulaDTO    `json:"colision"`
+	ColisionesFamiliares []ColisionFamiliarDTO `json:"colisiones_familiares"`
+	Cursos               []CursoDestinoDTO     `json:"cursos"`
+	Advertencias         []string              `json:"advertencias"`
+}
+
+type ConfirmarExpedienteDTO struct {
+	ExpedienteID uint `json:"expediente_id"`
+	CursoID      uint `json:"curso_id"`
+	// Obligatorio cuando la cédula ya existe: el expediente se agrega a esa ficha sin
+	// sobrescribir los datos que ya tiene.
+	VincularExistente bool `json:"vincular_existente"`
+}
+
+type ResultadoImportacionExpedienteDTO struct {
+	EstudianteID         uint `json:"estudiante_id"`
+	MatriculaID          uint `json:"matricula_id"`
+	EstudianteCreado     bool `json:"estudiante_creado"`
+	FamiliaresAgregados  int  `json:"familiares_agregados"`
+	DocumentosImportados int  `json:"documentos_importados"`
+}
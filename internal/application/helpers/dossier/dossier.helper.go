@@ -0,0 +1,233 @@
+package helpers
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	FormatoSobre = "SIGDECE-EXPEDIENTE"
+	VersionSobre = 1
+
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+
+	// Alfabeto sin caracteres que se confunden al dictarlos o copiarlos (0/O, 1/I/L, U/V)
+	alfabetoClave   = "ABCDEFGHJKMNPQRSTWXYZ23456789"
+	gruposClave     = 4
+	caracteresGrupo = 5
+)
+
+// Emisor identifica a la instalación que firmó el expediente. Va en claro para que el receptor
+// sepa de dónde viene antes de ingresar la clave.
+type Emisor struct {
+	Institucion  string `json:"institucion"`
+	CodigoAMIE   string `json:"codigo_amie"`
+	ClavePublica string `json:"clave_publica"`
+	Huella       string `json:"huella"`
+}
+
+// Sobre es el archivo .sigdece: el contenido va cifrado con AES-256-GCM usando una clave
+// derivada (scrypt) de la clave de transferencia, y todo el sobre va firmado con Ed25519 por
+// la instalación de origen.
+type Sobre struct {
+	Formato   string `json:"formato"`
+	Version   int    `json:"version"`
+	Emisor    Emisor `json:"emisor"`
+	Sal       string `json:"sal"`
+	N         int    `json:"n"`
+	R         int    `json:"r"`
+	P         int    `json:"p"`
+	Nonce     string `json:"nonce"`
+	Contenido string `json:"contenido"`
+	Firma     string `json:"firma"`
+}
+
+// GenerarParClaves crea la identidad de firma de una instalación.
+func GenerarParClaves() (publica string, privada string, huella string, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", "", err
+	}
+	return base64.StdEncoding.EncodeToString(pub), base64.StdEncoding.EncodeToString(priv.Seed()), HuellaClave(pub), nil
+}
+
+// HuellaClave resume una clave pública en grupos legibles para compararla por teléfono.
+func HuellaClave(pub []byte) string {
+	sum := sha256.Sum256(pub)
+	h := strings.ToUpper(hex.EncodeToString(sum[:10]))
+	grupos := make([]string, 0, len(h)/4)
+	for i := 0; i < len(h); i += 4 {
+		grupos = append(grupos, h[i:i+4])
+	}
+	return strings.Join(grupos, "-")
+}
+
+// GenerarClaveTransferencia devuelve una clave aleatoria del tipo XXXXX-XXXXX-XXXXX-XXXXX.
+func GenerarClaveTransferencia() (string, error) {
+	grupos := make([]string, gruposClave)
+	buf := make([]byte, caracteresGrupo)
+	for g := range grupos {
+		var sb strings.Builder
+		for sb.Len() < caracteresGrupo {
+			if _, err := rand.Read(buf[:1]); err != nil {
+				return "", err
+			}
+			// Se descartan los bytes que sesgarían la distribución
+			if int(buf[0]) >= 256-256%len(alfabetoClave) {
+				continue
+			}
+			sb.WriteByte(alfabetoClave[int(buf[0])%len(alfabetoClave)])
+		}
+		grupos[g] = sb.String()
+	}
+	return strings.Join(grupos, "-"), nil
+}
+
+// NormalizarClaveTransferencia tolera minúsculas, espacios y guiones al ingresarla.
+func NormalizarClaveTransferencia(clave string) string {
+	clave = strings.ToUpper(clave)
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' || r == '\t' {
+			return -1
+		}
+		return r
+	}, clave)
+}
+
+// Sellar cifra el contenido con la clave de transferencia y firma el sobre.
+func Sellar(contenido []byte, claveTransferencia string, emisor Emisor, clavePrivada string) ([]byte, error) {
+	seed, err := base64.StdEncoding.DecodeString(clavePrivada)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, errors.New("La clave privada de la instalación es inválida")
+	}
+	priv := ed25519.NewKeyFromSeed(seed)
+
+	sal := make([]byte, 16)
+	if _, err := rand.Read(sal); err != nil {
+		return nil, err
+	}
+	aead, err := nuevoCifrador(claveTransferencia, sal, scryptN, scryptR, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	sobre := Sobre{
+		Formato:   FormatoSobre,
+		Version:   VersionSobre,
+		Emisor:    emisor,
+		Sal:       base64.StdEncoding.EncodeToString(sal),
+		N:         scryptN,
+		R:         scryptR,
+		P:         scryptP,
+		Nonce:     base64.StdEncoding.EncodeToString(nonce),
+		Contenido: base64.StdEncoding.EncodeToString(aead.Seal(nil, nonce, contenido, []byte(emisor.Huella))),
+	}
+
+	firmable, err := bytesFirmables(sobre)
+	if err != nil {
+		return nil, err
+	}
+	sobre.Firma = base64.StdEncoding.EncodeToString(ed25519.Sign(priv, firmable))
+
+	return json.MarshalIndent(sobre, "", "  ")
+}
+
+// LeerSobre valida formato y firma sin descifrar. Un error aquí significa que el archivo fue
+// alterado o no es un expediente.
+func LeerSobre(data []byte) (*Sobre, error) {
+	var sobre Sobre
+	if err := json.Unmarshal(data, &sobre); err != nil || sobre.Formato != FormatoSobre {
+		return nil, errors.New("El archivo no es un expediente de SIGDECE")
+	}
+	if sobre.Version != VersionSobre {
+		return nil, fmt.Errorf("Versión de expediente no soportada (%d)", sobre.Version)
+	}
+
+	pub, err := base64.StdEncoding.DecodeString(sobre.Emisor.ClavePublica)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return nil, errors.New("La clave pública del emisor es inválida")
+	}
+	if HuellaClave(pub) != sobre.Emisor.Huella {
+		return nil, errors.New("La huella del emisor no corresponde a su clave pública")
+	}
+
+	firma, err := base64.StdEncoding.DecodeString(sobre.Firma)
+	if err != nil {
+		return nil, errors.New("La firma del expediente es inválida")
+	}
+	firmable, err := bytesFirmables(sobre)
+	if err != nil {
+		return nil, err
+	}
+	if !ed25519.Verify(pub, firmable, firma) {
+		return nil, errors.New("La firma no es válida: el expediente fue modificado después de exportarse")
+	}
+
+	return &sobre, nil
+}
+
+// Abrir descifra el contenido de un sobre ya verificado.
+func Abrir(sobre *Sobre, claveTransferencia string) ([]byte, error) {
+	sal, err1 := base64.StdEncoding.DecodeString(sobre.Sal)
+	nonce, err2 := base64.StdEncoding.DecodeString(sobre.Nonce)
+	cifrado, err3 := base64.StdEncoding.DecodeString(sobre.Contenido)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return nil, errors.New("El expediente está dañado")
+	}
+	// Límites para que un archivo manipulado no dispare un consumo de memoria excesivo
+	if sobre.N < 1<<14 || sobre.N > 1<<20 || sobre.R < 1 || sobre.R > 16 || sobre.P < 1 || sobre.P > 4 {
+		return nil, errors.New("Parámetros de cifrado no soportados")
+	}
+
+	aead, err := nuevoCifrador(claveTransferencia, sal, sobre.N, sobre.R, sobre.P)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("El expediente está dañado")
+	}
+
+	contenido, err := aead.Open(nil, nonce, cifrado, []byte(sobre.Emisor.Huella))
+	if err != nil {
+		return nil, errors.New("Clave de transferencia incorrecta")
+	}
+	return contenido, nil
+}
+
+func nuevoCifrador(claveTransferencia string, sal []byte, n, r, p int) (cipher.AEAD, error) {
+	clave := NormalizarClaveTransferencia(claveTransferencia)
+	if clave == "" {
+		return nil, errors.New("Debe ingresar la clave de transferencia")
+	}
+	key, err := scrypt.Key([]byte(clave), sal, n, r, p, 32)
+	if err != nil {
+		return nil, fmt.Errorf("Error al derivar la clave: %v", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func bytesFirmables(sobre Sobre) ([]byte, error) {
+	sobre.Firma = ""
+	return json.Marshal(sobre)
+}
@@ -0,0 +1,403 @@
+package helpers
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Tablas LMS de IMC para la edad de la OMS, por sexo: patrones 0-5 años (2006) y referencia
+// 5-19 años (2007). Se compilan en el binario para que el cálculo funcione sin conexión.
+//
+//go:embed oms/*.txt
+var tablasOMS embed.FS
+
+// Días promedio por mes que usa la OMS para pasar de días a meses
+const diasPorMes = 30.4375
+
+// Edad (meses) en la que termina la referencia de 5-19 años; desde ahí se usan los puntos de
+// corte de adultos
+const MesesFinReferencia = 228
+
+// Edad (meses) hasta la que aplican los puntos de corte de menores de 5 años
+const MesesPrimeraInfancia = 60
+
+const (
+	ClasificacionEmaciacionSevera = "Emaciación severa"
+	ClasificacionEmaciacion       = "Emaciación"
+	ClasificacionDelgadezSevera   = "Delgadez severa"
+	ClasificacionDelgadez         = "Delgadez"
+	ClasificacionNormal           = "Normal"
+	ClasificacionRiesgoSobrepeso  = "Riesgo de sobrepeso"
+	ClasificacionSobrepeso        = "Sobrepeso"
+	ClasificacionObesidad         = "Obesidad"
+	ClasificacionSinReferencia    = "Sin referencia"
+)
+
+// ClasificacionesIMC en el orden en que se presentan en los reportes, de menor a mayor IMC
+var ClasificacionesIMC = []string{
+	ClasificacionEmaciacionSevera,
+	ClasificacionEmaciacion,
+	ClasificacionDelgadezSevera,
+	ClasificacionDelgadez,
+	ClasificacionNormal,
+	ClasificacionRiesgoSobrepeso,
+	ClasificacionSobrepeso,
+	ClasificacionObesidad,
+	ClasificacionSinReferencia,
+}
+
+type puntoLMS struct {
+	Mes     float64
+	L, M, S float64
+}
+
+var (
+	cargaReferencia sync.Once
+	referencia      map[string][]puntoLMS
+	errReferencia   error
+)
+
+func tablaSexo(sexo string) ([]puntoLMS, error) {
+	cargaReferencia.Do(func() {
+		referencia, errReferencia = cargarTablas()
+	})
+	if errReferencia != nil {
+		return nil, errReferencia
+	}
+	return referencia[strings.ToUpper(sexo)], nil
+}
+
+// cargarTablas lee los archivos imc_<sexo>_<rango>.txt y une los rangos de cada sexo en una
+// sola tabla ordenada por edad.
+func cargarTablas() (map[string][]puntoLMS, error) {
+	archivos, err := tablasOMS.ReadDir("oms")
+	if err != nil {
+		return nil, err
+	}
+
+	tablas := map[string][]puntoLMS{}
+	for _, a := range archivos {
+		partes := strings.Split(strings.TrimSuffix(a.Name(), ".txt"), "_")
+		if len(partes) < 2 || partes[0] != "imc" {
+			continue
+		}
+		sexo := strings.ToUpper(partes[1])
+
+		puntos, err := leerTablaLMS(path.Join("oms", a.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("Tabla de referencia OMS %s inválida: %v", a.Name(), err)
+		}
+		tablas[sexo] = append(tablas[sexo], puntos...)
+	}
+
+	for sexo, puntos := range tablas {
+		sort.SliceStable(puntos, func(i, j int) bool { return puntos[i].Mes < puntos[j].Mes })
+		// Los rangos comparten el mes 60; se queda el de los patrones 0-5 años
+		unicos := puntos[:0]
+		for _, p := range puntos {
+			if len(unicos) > 0 && unicos[len(unicos)-1].Mes == p.Mes {
+				continue
+			}
+			unicos = append(unicos, p)
+		}
+		tablas[sexo] = unicos
+	}
+	return tablas, nil
+}
+
+// leerTablaLMS acepta las tablas de la OMS tal como se publican: separadas por tabulaciones o
+// comas, con la edad en meses (Month) o en días (Day) y las columnas L, M y S.
+func leerTablaLMS(nombre string) ([]puntoLMS, error) {
+	archivo, err := tablasOMS.Open(nombre)
+	if err != nil {
+		return nil, err
+	}
+	defer archivo.Close()
+
+	var puntos []puntoLMS
+	columnas := map[string]int{}
+	enDias := false
+
+	scanner := bufio.NewScanner(archivo)
+	for scanner.Scan() {
+		linea := strings.TrimSpace(scanner.Text())
+		if linea == "" || strings.HasPrefix(linea, "#") {
+			continue
+		}
+		campos := strings.FieldsFunc(linea, func(r rune) bool { return r == '\t' || r == ',' || r == ';' })
+
+		if len(columnas) == 0 {
+			for i, c := range campos {
+				columnas[strings.ToLower(strings.TrimSpace(c))] = i
+			}
+			_, enDias = columnas["day"]
+			if _, ok := columnas["month"]; !ok && !enDias {
+				return nil, errors.New("falta la columna Month o Day")
+			}
+			for _, c := range []string{"l", "m", "s"} {
+				if _, ok := columnas[c]; !ok {
+					return nil, fmt.Errorf("falta la columna %s", strings.ToUpper(c))
+				}
+			}
+			continue
+		}
+
+		valor := func(columna string) (float64, error) {
+			i := columnas[columna]
+			if i >= len(campos) {
+				return 0, fmt.Errorf("fila incompleta: %s", linea)
+			}
+			return strconv.ParseFloat(strings.TrimSpace(campos[i]), 64)
+		}
+
+		var p puntoLMS
+		if enDias {
+			dia, err := valor("day")
+			if err != nil {
+				return nil, err
+			}
+			p.Mes = dia / diasPorMes
+		} else if p.Mes, err = valor("month"); err != nil {
+			return nil, err
+		}
+		if p.L, err = valor("l"); err != nil {
+			return nil, err
+		}
+		if p.M, err = valor("m"); err != nil {
+			return nil, err
+		}
+		if p.S, err = valor("s"); err != nil {
+			return nil, err
+		}
+		puntos = append(puntos, p)
+	}
+	return puntos, scanner.Err()
+}
+
+// lmsEnEdad interpola linealmente los parámetros entre los dos puntos de la tabla que rodean
+// la edad. ok es false si la edad está fuera de la tabla.
+func lmsEnEdad(tabla []puntoLMS, meses float64) (puntoLMS, bool) {
+	if len(tabla) == 0 || meses < tabla[0].Mes || meses > tabla[len(tabla)-1].Mes {
+		return puntoLMS{}, false
+	}
+	i := sort.Search(len(tabla), func(i int) bool { return tabla[i].Mes >= meses })
+	if tabla[i].Mes == meses || i == 0 {
+		return tabla[i], true
+	}
+	a, b := tabla[i-1], tabla[i]
+	t := (meses - a.Mes) / (b.Mes - a.Mes)
+	return puntoLMS{
+		Mes: meses,
+		L:   a.L + (b.L-a.L)*t,
+		M:   a.M + (b.M-a.M)*t,
+		S:   a.S + (b.S-a.S)*t,
+	}, true
+}
+
+func valorEnZ(p puntoLMS, z float64) float64 {
+	if p.L == 0 {
+		return p.M * math.Exp(p.S*z)
+	}
+	return p.M * math.Pow(1+p.L*p.S*z, 1/p.L)
+}
+
+// zLMS aplica la fórmula LMS con el ajuste de la OMS para valores más allá de ±3 DE, que se
+// extrapolan con la distancia entre 2 y 3 DE para no exagerar los extremos.
+func zLMS(x float64, p puntoLMS) float64 {
+	var z float64
+	if p.L == 0 {
+		z = math.Log(x/p.M) / p.S
+	} else {
+		z = (math.Pow(x/p.M, p.L) - 1) / (p.L * p.S)
+	}
+
+	switch {
+	case z > 3:
+		sd3 := valorEnZ(p, 3)
+		z = 3 + (x-sd3)/(sd3-valorEnZ(p, 2))
+	case z < -3:
+		sd3 := valorEnZ(p, -3)
+		z = -3 - (sd3-x)/(valorEnZ(p, -2)-sd3)
+	}
+	return z
+}
+
+// Rangos fuera de los cuales la medición es casi seguro un error de digitación
+const (
+	PesoMinimoKg  = 2
+	PesoMaximoKg  = 250
+	TallaMinimaCm = 40
+	TallaMaximaCm = 230
+)
+
+// ValidarMedicion rechaza pesos y tallas fuera de los rangos posibles.
+func ValidarMedicion(pesoKg, tallaCm float64) error {
+	if pesoKg < PesoMinimoKg || pesoKg > PesoMaximoKg {
+		return fmt.Errorf("El peso debe estar entre %d y %d kg", PesoMinimoKg, PesoMaximoKg)
+	}
+	if tallaCm < TallaMinimaCm || tallaCm > TallaMaximaCm {
+		return fmt.Errorf("La talla debe estar entre %d y %d cm", TallaMinimaCm, TallaMaximaCm)
+	}
+	return nil
+}
+
+// ReferenciaDisponible indica si las tablas de la OMS del sexo están cargadas en el binario.
+func ReferenciaDisponible(sexo string) bool {
+	tabla, err := tablaSexo(sexo)
+	return err == nil && len(tabla) > 0
+}
+
+// CalcularIMC devuelve el índice de masa corporal con dos decimales.
+func CalcularIMC(pesoKg, tallaCm float64) float64 {
+	if pesoKg <= 0 || tallaCm <= 0 {
+		return 0
+	}
+	metros := tallaCm / 100
+	return math.Round(pesoKg/(metros*metros)*100) / 100
+}
+
+// EdadEnMeses calcula la edad exacta en meses (con fracción) a la fecha de la medición.
+func EdadEnMeses(fechaNacimiento, fecha string) (float64, error) {
+	nacimiento, err := time.Parse("2006-01-02", fechaNacimiento)
+	if err != nil {
+		return 0, errors.New("El estudiante no tiene una fecha de nacimiento válida")
+	}
+	medicion, err := time.Parse("2006-01-02", fecha)
+	if err != nil {
+		return 0, errors.New("La fecha de la medición es inválida, use el formato AAAA-MM-DD")
+	}
+	if medicion.Before(nacimiento) {
+		return 0, errors.New("La fecha de la medición es anterior al nacimiento")
+	}
+	return medicion.Sub(nacimiento).Hours() / 24 / diasPorMes, nil
+}
+
+// ZScoreIMC devuelve el puntaje z del IMC para la edad y el sexo ("M" o "F"). ok es false si
+// no hay tabla de referencia para esa edad y sexo.
+func ZScoreIMC(imc float64, edadMeses float64, sexo string) (float64, bool, error) {
+	tabla, err := tablaSexo(sexo)
+	if err != nil {
+		return 0, false, err
+	}
+	p, ok := lmsEnEdad(tabla, edadMeses)
+	if !ok || imc <= 0 {
+		return 0, false, nil
+	}
+	// Sumar 0 evita mostrar -0 cuando el puntaje redondeado es nulo
+	return math.Round(zLMS(imc, p)*100)/100 + 0, true, nil
+}
+
+// ClasificarIMC aplica los puntos de corte de la OMS: para menores de 5 años los de los
+// patrones de crecimiento, de 5 a 19 años los de la referencia 2007 y desde los 19 años los
+// de adultos sobre el IMC. z es nil si no hubo referencia para calcularlo.
+func ClasificarIMC(imc float64, z *float64, edadMeses float64) string {
+	if edadMeses > MesesFinReferencia {
+		switch {
+		case imc < 16:
+			return ClasificacionDelgadezSevera
+		case imc < 18.5:
+			return ClasificacionDelgadez
+		case imc < 25:
+			return ClasificacionNormal
+		case imc < 30:
+			return ClasificacionSobrepeso
+		}
+		return ClasificacionObesidad
+	}
+	if z == nil {
+		return ClasificacionSinReferencia
+	}
+
+	if edadMeses <= MesesPrimeraInfancia {
+		switch {
+		case *z < -3:
+			return ClasificacionEmaciacionSevera
+		case *z < -2:
+			return ClasificacionEmaciacion
+		case *z > 3:
+			return ClasificacionObesidad
+		case *z > 2:
+			return ClasificacionSobrepeso
+		case *z > 1:
+			return ClasificacionRiesgoSobrepeso
+		}
+		return ClasificacionNormal
+	}
+
+	switch {
+	case *z < -3:
+		return ClasificacionDelgadezSevera
+	case *z < -2:
+		return ClasificacionDelgadez
+	case *z > 2:
+		return ClasificacionObesidad
+	case *z > 1:
+		return ClasificacionSobrepeso
+	}
+	return ClasificacionNormal
+}
+
+// PuntoCurva es un punto de una curva de referencia (IMC en la edad dada para un puntaje z).
+type PuntoCurva struct {
+	EdadMeses float64
+	IMC       float64
+}
+
+// CurvaReferencia devuelve la curva del puntaje z entre dos edades, un punto por mes. Vacía si
+// no hay tabla para ese sexo o rango.
+func CurvaReferencia(sexo string, z float64, desdeMeses, hastaMeses float64) []PuntoCurva {
+	tabla, err := tablaSexo(sexo)
+	if err != nil {
+		return nil
+	}
+	var curva []PuntoCurva
+	for m := math.Floor(desdeMeses); m <= math.Ceil(hastaMeses); m++ {
+		if p, ok := lmsEnEdad(tabla, m); ok {
+			curva = append(curva, PuntoCurva{EdadMeses: m, IMC: valorEnZ(p, z)})
+		}
+	}
+	return curva
+}
+
+// EvaluacionIMC es el resultado de evaluar una medición contra la referencia.
+type EvaluacionIMC struct {
+	EdadMeses     float64
+	ZScore        *float64
+	Clasificacion string
+}
+
+// EvaluarIMC calcula la edad a la fecha de la medición, el puntaje z y la clasificación.
+func EvaluarIMC(imc float64, fechaNacimiento, fecha, sexo string) (EvaluacionIMC, error) {
+	edad, err := EdadEnMeses(fechaNacimiento, fecha)
+	if err != nil {
+		return EvaluacionIMC{Clasificacion: ClasificacionSinReferencia}, err
+	}
+	evaluacion := EvaluacionIMC{EdadMeses: math.Round(edad*10) / 10}
+
+	if edad <= MesesFinReferencia {
+		z, ok, err := ZScoreIMC(imc, edad, sexo)
+		if err != nil {
+			return evaluacion, err
+		}
+		if ok {
+			evaluacion.ZScore = &z
+		}
+	}
+	evaluacion.Clasificacion = ClasificarIMC(imc, evaluacion.ZScore, edad)
+	return evaluacion, nil
+}
+
+// TextoEdad muestra la edad en años y meses cumplidos ("10 a 3 m").
+func TextoEdad(edadMeses float64) string {
+	meses := int(edadMeses)
+	return fmt.Sprintf("%d a %d m", meses/12, meses%12)
+}
@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"math"
+	"testing"
+)
+
+// Parámetros LMS de prueba con L negativo, nulo y positivo; no son valores de la OMS.
+var puntosPrueba = []puntoLMS{
+	{Mes: 24, L: -0.6187, M: 16.0189, S: 0.08118},
+	{Mes: 120, L: 0, M: 16.5, S: 0.12},
+	{Mes: 200, L: 0.5, M: 20.1, S: 0.1},
+}
+
+func TestZLMSMedianaEsCero(t *testing.T) {
+	for _, p := range puntosPrueba {
+		if z := zLMS(p.M, p); math.Abs(z) > 1e-9 {
+			t.Errorf("L=%v: z de la mediana = %v, se esperaba 0", p.L, z)
+		}
+	}
+}
+
+func TestZLMSIdaYVuelta(t *testing.T) {
+	for _, p := range puntosPrueba {
+		for _, z := range []float64{-3, -2, -1, 1, 2, 3} {
+			x := valorEnZ(p, z)
+			if got := zLMS(x, p); math.Abs(got-z) > 1e-9 {
+				t.Errorf("L=%v: zLMS(valorEnZ(%v)) = %v", p.L, z, got)
+			}
+		}
+	}
+}
+
+func TestZLMSExtrapolaMasAllaDeTres(t *testing.T) {
+	p := puntosPrueba[0]
+	sd2, sd3 := valorEnZ(p, 2), valorEnZ(p, 3)
+	if got := zLMS(sd3+(sd3-sd2), p); math.Abs(got-4) > 1e-9 {
+		t.Errorf("z sobre +3 DE = %v, se esperaba 4", got)
+	}
+	sd2n, sd3n := valorEnZ(p, -2), valorEnZ(p, -3)
+	if got := zLMS(sd3n-(sd2n-sd3n), p); math.Abs(got+4) > 1e-9 {
+		t.Errorf("z bajo -3 DE = %v, se esperaba -4", got)
+	}
+}
+
+func TestLmsEnEdadInterpola(t *testing.T) {
+	tabla := []puntoLMS{{Mes: 60, L: -1, M: 15, S: 0.08}, {Mes: 61, L: -0.8, M: 16, S: 0.1}}
+	p, ok := lmsEnEdad(tabla, 60.5)
+	if !ok || math.Abs(p.M-15.5) > 1e-9 || math.Abs(p.L+0.9) > 1e-9 || math.Abs(p.S-0.09) > 1e-9 {
+		t.Errorf("interpolación = %+v, %v", p, ok)
+	}
+	if _, ok := lmsEnEdad(tabla, 59); ok {
+		t.Error("una edad fuera de la tabla no debe tener referencia")
+	}
+}
@@ -0,0 +1,189 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Códigos de provincia del Registro Civil: 01-24, y 30 para ecuatorianos registrados en el exterior.
+func provinciaValida(codigo int) bool {
+	return (codigo >= 1 && codigo <= 24) || codigo == 30
+}
+
+func soloDigitos(valor string) bool {
+	if valor == "" {
+		return false
+	}
+	for _, r := range valor {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidarCedula verifica longitud, provincia, tercer dígito y dígito verificador (módulo 10).
+func ValidarCedula(cedula string) error {
+	cedula = strings.TrimSpace(cedula)
+
+	if len(cedula) != 10 || !soloDigitos(cedula) {
+		return fmt.Errorf("La cédula %s debe tener 10 dígitos numéricos", cedula)
+	}
+
+	provincia := int(cedula[0]-'0')*10 + int(cedula[1]-'0')
+	if !provinciaValida(provincia) {
+		return fmt.Errorf("La cédula %s tiene un código de provincia inválido (%02d)", cedula, provincia)
+	}
+
+	if cedula[2]-'0' > 5 {
+		return fmt.Errorf("La cédula %s tiene un tercer dígito inválido", cedula)
+	}
+
+	suma := 0
+	for i := 0; i < 9; i++ {
+		d := int(cedula[i] - '0')
+		if i%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		suma += d
+	}
+
+	verificador := (10 - suma%10) % 10
+	if verificador != int(cedula[9]-'0') {
+		return fmt.Errorf("La cédula %s tiene un dígito verificador incorrecto", cedula)
+	}
+
+	return nil
+}
+
+// ValidarRUC valida RUC de persona natural (cédula + establecimiento), sociedad privada
+// (módulo 11, tercer dígito 9) y entidad pública (módulo 11, tercer dígito 6).
+func ValidarRUC(ruc string) error {
+	ruc = strings.TrimSpace(ruc)
+
+	if len(ruc) != 13 || !soloDigitos(ruc) {
+		return fmt.Errorf("El RUC %s debe tener 13 dígitos numéricos", ruc)
+	}
+
+	provincia := int(ruc[0]-'0')*10 + int(ruc[1]-'0')
+	if !provinciaValida(provincia) {
+		return fmt.Errorf("El RUC %s tiene un código de provincia inválido (%02d)", ruc, provincia)
+	}
+
+	tercero := ruc[2] - '0'
+
+	switch {
+	case tercero <= 5:
+		if err := ValidarCedula(ruc[:10]); err != nil {
+			return fmt.Errorf("El RUC %s no contiene una cédula válida", ruc)
+		}
+		if ruc[10:] == "000" {
+			return fmt.Errorf("El RUC %s tiene un establecimiento inválido", ruc)
+		}
+	case tercero == 6:
+		if !moduloOnceValido(ruc[:8], ruc[8], []int{3, 2, 7, 6, 5, 4, 3, 2}) || ruc[9:] == "0000" {
+			return fmt.Errorf("El RUC público %s tiene un dígito verificador incorrecto", ruc)
+		}
+	case tercero == 9:
+		if !moduloOnceValido(ruc[:9], ruc[9], []int{4, 3, 2, 7, 6, 5, 4, 3, 2}) || ruc[10:] == "000" {
+			return fmt.Errorf("El RUC privado %s tiene un dígito verificador incorrecto", ruc)
+		}
+	default:
+		return fmt.Errorf("El RUC %s tiene un tercer dígito inválido", ruc)
+	}
+
+	return nil
+}
+
+func moduloOnceValido(base string, verificador byte, coeficientes []int) bool {
+	suma := 0
+	for i, c := range coeficientes {
+		suma += int(base[i]-'0') * c
+	}
+
+	esperado := 11 - suma%11
+	if esperado == 11 {
+		esperado = 0
+	}
+	if esperado == 10 {
+		return false
+	}
+
+	return esperado == int(verificador-'0')
+}
+
+// ValidarDocumentoExtranjero acepta pasaportes y DNI: 5 a 20 caracteres alfanuméricos o guiones.
+func ValidarDocumentoExtranjero(documento string) error {
+	documento = strings.TrimSpace(documento)
+
+	if len(documento) < 5 || len(documento) > 20 {
+		return fmt.Errorf("El documento extranjero %s debe tener entre 5 y 20 caracteres", documento)
+	}
+
+	for _, r := range documento {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' {
+			return fmt.Errorf("El documento extranjero %s contiene caracteres no permitidos", documento)
+		}
+	}
+
+	return nil
+}
+
+// ValidarIdentificacion se usa en campos que no distinguen nacionalidad (familiares, docentes,
+// autoridades). Un valor de 9 a 13 dígitos se trata como documento ecuatoriano: con 10 es
+// cédula, con 13 es RUC y cualquier otra longitud es un error. El resto de formatos se valida
+// como documento extranjero.
+func ValidarIdentificacion(valor string) error {
+	valor = strings.TrimSpace(valor)
+	if valor == "" {
+		return errors.New("El documento de identidad está vacío")
+	}
+
+	if soloDigitos(valor) && len(valor) >= 9 && len(valor) <= 13 {
+		switch len(valor) {
+		case 10:
+			return ValidarCedula(valor)
+		case 13:
+			return ValidarRUC(valor)
+		}
+		return fmt.Errorf("El documento %s tiene %d dígitos; debe ser una cédula (10) o un RUC (13)", valor, len(valor))
+	}
+
+	return ValidarDocumentoExtranjero(valor)
+}
+
+// ValidarIdentidadEstudiante aplica la cédula ecuatoriana a estudiantes nacionales. Para
+// extranjeros valida el pasaporte/DNI y acepta en la cédula ese mismo documento.
+func ValidarIdentidadEstudiante(cedula string, esExtranjero bool, pasaporteODNI string) error {
+	cedula = strings.TrimSpace(cedula)
+	pasaporteODNI = strings.TrimSpace(pasaporteODNI)
+
+	if !esExtranjero {
+		return ValidarCedula(cedula)
+	}
+
+	if pasaporteODNI != "" {
+		if err := ValidarDocumentoExtranjero(pasaporteODNI); err != nil {
+			return err
+		}
+		if cedula == pasaporteODNI {
+			return nil
+		}
+	}
+
+	return ValidarIdentificacion(cedula)
+}
+
+// NormalizarCedula recupera el cero inicial que Excel elimina al tratar la cédula como número.
+func NormalizarCedula(cedula string) string {
+	cedula = strings.TrimSpace(cedula)
+	if len(cedula) == 9 && soloDigitos(cedula) {
+		return "0" + cedula
+	}
+	return cedula
+}
@@ -0,0 +1,284 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// FormatoFoto define el tamaño final de una foto y de su miniatura.
+type FormatoFoto struct {
+	Ancho          int
+	Alto           int
+	AnchoMiniatura int
+	AltoMiniatura  int
+}
+
+var (
+	// FormatoFotoEstudiante es la foto carné en proporción 3:4.
+	FormatoFotoEstudiante = FormatoFoto{Ancho: 600, Alto: 800, AnchoMiniatura: 150, AltoMiniatura: 200}
+	// FormatoFotoPerfil es el avatar cuadrado de los usuarios del sistema.
+	FormatoFotoPerfil = FormatoFoto{Ancho: 400, Alto: 400, AnchoMiniatura: 96, AltoMiniatura: 96}
+)
+
+const (
+	tamanoMaximoArchivo = 25 << 20
+	pixelesMaximos      = 60_000_000
+	sufijoMiniatura     = "_thumb"
+)
+
+var tiposPermitidos = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// ProcesarFoto valida que el contenido sea una imagen (sin confiar en la extensión), la
+// endereza según la orientación EXIF, la recorta al centro con la proporción del formato y la
+// reescala. Las imágenes se vuelven a codificar en JPEG, con lo que se descarta todo el EXIF
+// (incluida la ubicación GPS).
+func ProcesarFoto(data []byte, formato FormatoFoto) (foto []byte, miniatura []byte, err error) {
+	if len(data) == 0 {
+		return nil, nil, errors.New("El archivo de imagen está vacío")
+	}
+	if len(data) > tamanoMaximoArchivo {
+		return nil, nil, fmt.Errorf("La imagen supera el tamaño máximo de %d MB", tamanoMaximoArchivo>>20)
+	}
+
+	tipo := http.DetectContentType(data)
+	if !tiposPermitidos[tipo] {
+		return nil, nil, fmt.Errorf("El archivo no es una imagen válida (%s)", tipo)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("No se pudo leer la imagen: %v", err)
+	}
+	if cfg.Width*cfg.Height > pixelesMaximos {
+		return nil, nil, fmt.Errorf("La imagen es demasiado grande (%dx%d)", cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("No se pudo decodificar la imagen: %v", err)
+	}
+
+	if tipo == "image/jpeg" {
+		img = aplicarOrientacion(img, orientacionEXIF(data))
+	}
+
+	recorte := recortarProporcion(img, formato.Ancho, formato.Alto)
+
+	foto, err = codificarJPEG(recorte, formato.Ancho, formato.Alto, 88)
+	if err != nil {
+		return nil, nil, err
+	}
+	miniatura, err = codificarJPEG(recorte, formato.AnchoMiniatura, formato.AltoMiniatura, 80)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return foto, miniatura, nil
+}
+
+// GuardarFoto procesa la imagen y escribe <nombreBase>.jpg y su miniatura en destinoDir.
+func GuardarFoto(data []byte, formato FormatoFoto, destinoDir string, nombreBase string) (string, error) {
+	foto, miniatura, err := ProcesarFoto(data, formato)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(destinoDir, 0755); err != nil {
+		return "", fmt.Errorf("Error al crear carpeta de fotos: %v", err)
+	}
+
+	rutaFoto := filepath.Join(destinoDir, nombreBase+".jpg")
+	if err := os.WriteFile(rutaFoto, foto, 0644); err != nil {
+		return "", fmt.Errorf("Error al escribir imagen destino: %v", err)
+	}
+	if err := os.WriteFile(RutaMiniatura(rutaFoto), miniatura, 0644); err != nil {
+		os.Remove(rutaFoto)
+		return "", fmt.Errorf("Error al escribir miniatura: %v", err)
+	}
+
+	return rutaFoto, nil
+}
+
+// RutaMiniatura devuelve la ruta donde se guarda la miniatura de una foto.
+func RutaMiniatura(rutaFoto string) string {
+	ext := filepath.Ext(rutaFoto)
+	return strings.TrimSuffix(rutaFoto, ext) + sufijoMiniatura + ".jpg"
+}
+
+// MiniaturaOFoto devuelve la miniatura si existe; las fotos subidas antes del procesamiento
+// no tienen miniatura y se usa la original.
+func MiniaturaOFoto(rutaFoto string) string {
+	if rutaFoto == "" {
+		return ""
+	}
+	if _, err := os.Stat(RutaMiniatura(rutaFoto)); err == nil {
+		return RutaMiniatura(rutaFoto)
+	}
+	return rutaFoto
+}
+
+// EliminarFoto borra la foto y su miniatura, si existen.
+func EliminarFoto(rutaFoto string) {
+	if rutaFoto == "" {
+		return
+	}
+	os.Remove(rutaFoto)
+	os.Remove(RutaMiniatura(rutaFoto))
+}
+
+func recortarProporcion(img image.Image, ancho, alto int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	// Se conserva el alto o el ancho completo según cuál sobre respecto a la proporción destino
+	cw, ch := w, w*alto/ancho
+	if ch > h {
+		cw, ch = h*ancho/alto, h
+	}
+
+	x0 := b.Min.X + (w-cw)/2
+	// En retratos el rostro suele estar en la parte superior; el recorte vertical se sesga hacia arriba
+	y0 := b.Min.Y + (h-ch)/4
+
+	r := image.Rect(x0, y0, x0+cw, y0+ch)
+	out := image.NewRGBA(image.Rect(0, 0, cw, ch))
+	xdraw.Draw(out, out.Bounds(), img, r.Min, xdraw.Src)
+	return out
+}
+
+func codificarJPEG(img image.Image, ancho, alto int, calidad int) ([]byte, error) {
+	dst := image.NewRGBA(image.Rect(0, 0, ancho, alto))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), xdraw.Src, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: calidad}); err != nil {
+		return nil, fmt.Errorf("Error al codificar imagen: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// orientacionEXIF lee la etiqueta 0x0112 del segmento APP1 de un JPEG. Devuelve 1 (normal) si
+// no existe o no se puede leer.
+func orientacionEXIF(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	i := 2
+	for i+4 <= len(data) {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marcador := data[i+1]
+		if marcador == 0xDA || marcador == 0xD9 {
+			return 1
+		}
+		largo := int(binary.BigEndian.Uint16(data[i+2 : i+4]))
+		if largo < 2 || i+2+largo > len(data) {
+			return 1
+		}
+		segmento := data[i+4 : i+2+largo]
+		if marcador == 0xE1 && len(segmento) > 14 && string(segmento[:6]) == "Exif\x00\x00" {
+			return orientacionTIFF(segmento[6:])
+		}
+		i += 2 + largo
+	}
+
+	return 1
+}
+
+func orientacionTIFF(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var orden binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		orden = binary.LittleEndian
+	case "MM":
+		orden = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(orden.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return 1
+	}
+	entradas := int(orden.Uint16(tiff[ifd : ifd+2]))
+	for e := 0; e < entradas; e++ {
+		pos := ifd + 2 + e*12
+		if pos+12 > len(tiff) {
+			return 1
+		}
+		if orden.Uint16(tiff[pos:pos+2]) == 0x0112 {
+			valor := int(orden.Uint16(tiff[pos+8 : pos+10]))
+			if valor >= 1 && valor <= 8 {
+				return valor
+			}
+			return 1
+		}
+	}
+
+	return 1
+}
+
+// aplicarOrientacion transforma la imagen para que se vea derecha según el valor EXIF (1-8).
+func aplicarOrientacion(img image.Image, orientacion int) image.Image {
+	if orientacion <= 1 || orientacion > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	ow, oh := w, h
+	if orientacion >= 5 {
+		ow, oh = h, w
+	}
+	out := image.NewRGBA(image.Rect(0, 0, ow, oh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientacion {
+			case 2: // espejo horizontal
+				dx, dy = w-1-x, y
+			case 3: // 180°
+				dx, dy = w-1-x, h-1-y
+			case 4: // espejo vertical
+				dx, dy = x, h-1-y
+			case 5: // transpuesta
+				dx, dy = y, x
+			case 6: // 90° horario
+				dx, dy = h-1-y, x
+			case 7: // transversa
+				dx, dy = h-1-y, w-1-x
+			case 8: // 90° antihorario
+				dx, dy = y, w-1-x
+			}
+			out.Set(dx, dy, img.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,274 @@
+package helpers
+
+import (
+	queryDTO "dece/internal/application/dtos/query"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	TamanoPaginaPorDefecto = 25
+	TamanoPaginaMaximo     = 200
+)
+
+type TipoCampo int
+
+const (
+	CampoTexto TipoCampo = iota
+	CampoNumero
+	CampoBooleano
+	CampoFecha
+)
+
+// Campo asocia el nombre público de un campo con su columna SQL. Solo los campos declarados
+// pueden filtrarse u ordenarse, así el frontend nunca arma SQL.
+type Campo struct {
+	Columna   string
+	Tipo      TipoCampo
+	Ordenable bool
+}
+
+// Especificacion describe lo que un listado admite: campos filtrables, columnas de la búsqueda
+// libre y orden por defecto. Desempate es una columna única que se agrega al final del orden
+// para que las páginas no repitan ni salten filas.
+type Especificacion struct {
+	Campos                map[string]Campo
+	Busqueda              []string
+	OrdenPorDefecto       string
+	DescendentePorDefecto bool
+	Desempate             string
+}
+
+// Filtrar aplica la búsqueda libre y los filtros de la consulta, sin paginar.
+func Filtrar(q *gorm.DB, spec Especificacion, consulta queryDTO.ConsultaDTO) (*gorm.DB, error) {
+	if len(spec.Busqueda) > 0 {
+		// Cada palabra debe aparecer en alguna de las columnas: "perez ana" encuentra
+		// apellidos y nombres por separado
+		for _, termino := range strings.Fields(consulta.Busqueda) {
+			condiciones := make([]string, len(spec.Busqueda))
+			args := make([]interface{}, len(spec.Busqueda))
+			for i, col := range spec.Busqueda {
+				condiciones[i] = col + " LIKE ?"
+				args[i] = "%" + termino + "%"
+			}
+			q = q.Where("("+strings.Join(condiciones, " OR ")+")", args...)
+		}
+	}
+
+	for _, f := range consulta.Filtros {
+		campo, ok := spec.Campos[f.Campo]
+		if !ok {
+			return nil, fmt.Errorf("No se puede filtrar por '%s'", f.Campo)
+		}
+		var err error
+		q, err = aplicarFiltro(q, campo, f)
+		if err != nil {
+			return nil, fmt.Errorf("Filtro '%s': %v", f.Campo, err)
+		}
+	}
+
+	return q, nil
+}
+
+// Paginar filtra, cuenta el total, ordena y carga en destino solo la página pedida.
+func Paginar(q *gorm.DB, spec Especificacion, consulta queryDTO.ConsultaDTO, destino interface{}) (queryDTO.PaginacionDTO, error) {
+	pagina, tamano := normalizarPagina(consulta)
+	paginacion := queryDTO.PaginacionDTO{Pagina: pagina, TamanoPagina: tamano}
+
+	q, err := Filtrar(q, spec, consulta)
+	if err != nil {
+		return paginacion, err
+	}
+
+	if err := q.Session(&gorm.Session{}).Count(&paginacion.Total).Error; err != nil {
+		return paginacion, fmt.Errorf("Error al contar registros: %v", err)
+	}
+	paginacion.TotalPaginas = int(math.Ceil(float64(paginacion.Total) / float64(tamano)))
+
+	q, err = Ordenar(q, spec, consulta)
+	if err != nil {
+		return paginacion, err
+	}
+
+	if err := q.Offset((pagina - 1) * tamano).Limit(tamano).Find(destino).Error; err != nil {
+		return paginacion, err
+	}
+	return paginacion, nil
+}
+
+// Ordenar aplica el orden pedido (o el de la especificación) seguido del desempate.
+func Ordenar(q *gorm.DB, spec Especificacion, consulta queryDTO.ConsultaDTO) (*gorm.DB, error) {
+	clave := consulta.OrdenarPor
+	descendente := consulta.Descendente
+	if clave == "" {
+		clave = spec.OrdenPorDefecto
+		descendente = spec.DescendentePorDefecto
+	}
+
+	if clave != "" {
+		campo, ok := spec.Campos[clave]
+		if !ok || !campo.Ordenable {
+			return nil, fmt.Errorf("No se puede ordenar por '%s'", clave)
+		}
+		direccion := " ASC"
+		if descendente {
+			direccion = " DESC"
+		}
+		q = q.Order(campo.Columna + direccion)
+	}
+	if spec.Desempate != "" {
+		q = q.Order(spec.Desempate + " ASC")
+	}
+	return q, nil
+}
+
+func normalizarPagina(consulta queryDTO.ConsultaDTO) (int, int) {
+	pagina := consulta.Pagina
+	if pagina < 1 {
+		pagina = 1
+	}
+	tamano := consulta.TamanoPagina
+	if tamano < 1 {
+		tamano = TamanoPaginaPorDefecto
+	}
+	if tamano > TamanoPaginaMaximo {
+		tamano = TamanoPaginaMaximo
+	}
+	return pagina, tamano
+}
+
+func aplicarFiltro(q *gorm.DB, campo Campo, f queryDTO.FiltroDTO) (*gorm.DB, error) {
+	col := campo.Columna
+
+	if f.Operador == queryDTO.OperadorEn {
+		if campo.Tipo != CampoTexto && campo.Tipo != CampoNumero {
+			return nil, fmt.Errorf("el operador '%s' no aplica a este campo", f.Operador)
+		}
+		if len(f.Valores) == 0 {
+			return nil, fmt.Errorf("debe indicar al menos un valor")
+		}
+		valores := make([]interface{}, len(f.Valores))
+		for i, v := range f.Valores {
+			valor, err := convertirValor(campo.Tipo, v)
+			if err != nil {
+				return nil, err
+			}
+			valores[i] = valor
+		}
+		return q.Where(col+" IN ?", valores), nil
+	}
+
+	if campo.Tipo == CampoFecha {
+		return filtrarFecha(q, col, f)
+	}
+
+	valor, err := convertirValor(campo.Tipo, f.Valor)
+	if err != nil {
+		return nil, err
+	}
+
+	switch f.Operador {
+	case queryDTO.OperadorIgual, "":
+		return q.Where(col+" = ?", valor), nil
+	case queryDTO.OperadorDistinto:
+		return q.Where(col+" <> ?", valor), nil
+	case queryDTO.OperadorContiene, queryDTO.OperadorEmpiezaCon:
+		if campo.Tipo != CampoTexto {
+			return nil, fmt.Errorf("el operador '%s' solo aplica a texto", f.Operador)
+		}
+		patron := strings.TrimSpace(f.Valor) + "%"
+		if f.Operador == queryDTO.OperadorContiene {
+			patron = "%" + patron
+		}
+		return q.Where(col+" LIKE ?", patron), nil
+	}
+
+	if campo.Tipo == CampoBooleano {
+		return nil, fmt.Errorf("el operador '%s' no aplica a este campo", f.Operador)
+	}
+	switch f.Operador {
+	case queryDTO.OperadorMayor:
+		return q.Where(col+" > ?", valor), nil
+	case queryDTO.OperadorMayorIgual:
+		return q.Where(col+" >= ?", valor), nil
+	case queryDTO.OperadorMenor:
+		return q.Where(col+" < ?", valor), nil
+	case queryDTO.OperadorMenorIgual:
+		return q.Where(col+" <= ?", valor), nil
+	}
+	return nil, fmt.Errorf("operador desconocido '%s'", f.Operador)
+}
+
+// filtrarFecha compara las fechas como texto (las columnas guardan "2006-01-02 15:04:05"). Un
+// valor de solo día cubre el día completo, para que "igual a 2025-03-10" incluya las 10:30.
+func filtrarFecha(q *gorm.DB, col string, f queryDTO.FiltroDTO) (*gorm.DB, error) {
+	valor := strings.TrimSpace(f.Valor)
+	soloDia := false
+	if _, err := time.Parse("2006-01-02", valor); err == nil {
+		soloDia = true
+	} else if _, err := time.Parse("2006-01-02 15:04", valor); err != nil {
+		if _, err := time.Parse("2006-01-02 15:04:05", valor); err != nil {
+			return nil, fmt.Errorf("fecha inválida '%s'", f.Valor)
+		}
+	}
+
+	siguiente := valor
+	if soloDia {
+		dia, _ := time.Parse("2006-01-02", valor)
+		siguiente = dia.AddDate(0, 0, 1).Format("2006-01-02")
+	}
+
+	switch f.Operador {
+	case queryDTO.OperadorIgual, "":
+		if soloDia {
+			return q.Where(col+" >= ? AND "+col+" < ?", valor, siguiente), nil
+		}
+		return q.Where(col+" = ?", valor), nil
+	case queryDTO.OperadorDistinto:
+		if soloDia {
+			return q.Where("("+col+" < ? OR "+col+" >= ?)", valor, siguiente), nil
+		}
+		return q.Where(col+" <> ?", valor), nil
+	case queryDTO.OperadorMayor:
+		if soloDia {
+			return q.Where(col+" >= ?", siguiente), nil
+		}
+		return q.Where(col+" > ?", valor), nil
+	case queryDTO.OperadorMayorIgual:
+		return q.Where(col+" >= ?", valor), nil
+	case queryDTO.OperadorMenor:
+		return q.Where(col+" < ?", valor), nil
+	case queryDTO.OperadorMenorIgual:
+		if soloDia {
+			return q.Where(col+" < ?", siguiente), nil
+		}
+		return q.Where(col+" <= ?", valor), nil
+	}
+	return nil, fmt.Errorf("el operador '%s' no aplica a fechas", f.Operador)
+}
+
+func convertirValor(tipo TipoCampo, valor string) (interface{}, error) {
+	valor = strings.TrimSpace(valor)
+	switch tipo {
+	case CampoNumero:
+		n, err := strconv.ParseInt(valor, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' no es un número", valor)
+		}
+		return n, nil
+	case CampoBooleano:
+		switch strings.ToLower(valor) {
+		case "true", "1", "si", "sí":
+			return true, nil
+		case "false", "0", "no":
+			return false, nil
+		}
+		return nil, fmt.Errorf("'%s' no es un valor sí/no", valor)
+	}
+	return valor, nil
+}
@@ -0,0 +1,126 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Hoja es una hoja del Excel o el contenido completo de un CSV.
+type Hoja struct {
+	Nombre string
+	Filas  [][]string
+}
+
+// LeerHojas lee un archivo CSV o Excel que se va a importar. En el CSV, un delimitador vacío se
+// deduce de la primera línea; en el Excel, sin nombres de hojas se leen todas.
+func LeerHojas(filePath string, delimitador string, nombres []string) ([]Hoja, error) {
+	if strings.EqualFold(filepath.Ext(filePath), ".csv") {
+		hoja, err := leerCSV(filePath, delimitador)
+		if err != nil {
+			return nil, err
+		}
+		return []Hoja{hoja}, nil
+	}
+
+	f, err := excelize.OpenFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error al abrir el archivo Excel: %v", err)
+	}
+	defer f.Close()
+
+	if len(nombres) == 0 {
+		nombres = f.GetSheetList()
+	}
+
+	hojas := make([]Hoja, 0, len(nombres))
+	for _, nombre := range nombres {
+		filas, err := f.GetRows(nombre)
+		if err != nil {
+			return nil, fmt.Errorf("error al leer la hoja %s: %v", nombre, err)
+		}
+		hojas = append(hojas, Hoja{Nombre: nombre, Filas: filas})
+	}
+	return hojas, nil
+}
+
+func leerCSV(filePath string, delimitador string) (Hoja, error) {
+	contenido, err := os.ReadFile(filePath)
+	if err != nil {
+		return Hoja{}, fmt.Errorf("error al abrir el archivo CSV: %v", err)
+	}
+
+	contenido = bytes.TrimPrefix(contenido, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(contenido) {
+		// Las exportaciones de Excel en Windows suelen venir en Latin-1
+		runas := make([]rune, len(contenido))
+		for i, b := range contenido {
+			runas[i] = rune(b)
+		}
+		contenido = []byte(string(runas))
+	}
+
+	separador := ','
+	if delimitador == "\\t" {
+		separador = '\t'
+	} else if delimitador != "" {
+		separador, _ = utf8.DecodeRuneInString(delimitador)
+	} else {
+		primeraLinea, _, _ := bytes.Cut(contenido, []byte("\n"))
+		mejor := 0
+		for _, d := range []rune{',', ';', '\t', '|'} {
+			if n := bytes.Count(primeraLinea, []byte(string(d))); n > mejor {
+				mejor = n
+				separador = d
+			}
+		}
+	}
+
+	reader := csv.NewReader(bytes.NewReader(contenido))
+	reader.Comma = separador
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	filas, err := reader.ReadAll()
+	if err != nil {
+		return Hoja{}, fmt.Errorf("error al leer el archivo CSV: %v", err)
+	}
+
+	return Hoja{Nombre: filepath.Base(filePath), Filas: filas}, nil
+}
+
+// formatosFecha son los formatos habituales en las hojas, con el día antes del mes.
+var formatosFecha = []string{"2006-01-02", "02/01/2006", "2/1/2006", "02-01-2006", "2006/01/02", "02-01-06"}
+
+// NormalizarFecha convierte una celda de fecha al formato 2006-01-02. Prueba primero el formato
+// indicado (el del perfil de importación, si lo hay), luego los habituales y al final el número
+// de serie de Excel.
+func NormalizarFecha(valor string, formato string) (string, bool) {
+	valor = strings.TrimSpace(valor)
+
+	formatos := formatosFecha
+	if formato != "" {
+		formatos = append([]string{formato}, formatosFecha...)
+	}
+	for _, layout := range formatos {
+		if t, err := time.Parse(layout, valor); err == nil {
+			return t.Format("2006-01-02"), true
+		}
+	}
+
+	if serial, err := strconv.ParseFloat(valor, 64); err == nil && serial > 1000 && serial < 100000 {
+		if t, err := excelize.ExcelDateToTime(serial, false); err == nil {
+			return t.Format("2006-01-02"), true
+		}
+	}
+
+	return "", false
+}
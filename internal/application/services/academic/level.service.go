@@ -32,6 +32,10 @@ func (s *LevelService) ListarNiveles() ([]academicDTO.NivelEducativoDTO, error)
 			Nombre:         n.Nombre,
 			NombreCompleto: n.NombreCompleto,
 			Orden:          n.Orden,
+			EdadMinima:     n.EdadMinima,
+			EdadMaxima:     n.EdadMaxima,
+
+			CapacidadPorCurso: n.CapacidadPorCurso,
 		}
 	}
 
@@ -39,6 +43,12 @@ func (s *LevelService) ListarNiveles() ([]academicDTO.NivelEducativoDTO, error)
 }
 
 func (s *LevelService) CrearNivel(input academicDTO.NivelEducativoDTO) error {
+	if err := validarRangoEdad(input); err != nil {
+		return err
+	}
+	if input.CapacidadPorCurso < 0 {
+		return errors.New("La capacidad por curso no puede ser negativa")
+	}
 
 	var countNombre int64
 	s.db.Model(&academic.NivelEducativo{}).Where("nombre = ?", input.Nombre).Count(&countNombre)
@@ -56,6 +66,10 @@ func (s *LevelService) CrearNivel(input academicDTO.NivelEducativoDTO) error {
 		Nombre:         input.Nombre,
 		NombreCompleto: input.NombreCompleto,
 		Orden:          input.Orden,
+		EdadMinima:     input.EdadMinima,
+		EdadMaxima:     input.EdadMaxima,
+
+		CapacidadPorCurso: input.CapacidadPorCurso,
 	}
 
 	if err := s.db.Create(&nuevoNivel).Error; err != nil {
@@ -72,6 +86,13 @@ func (s *LevelService) ActualizarNivel(input academicDTO.NivelEducativoDTO) erro
 		return errors.New("El nivel educativo no existe")
 	}
 
+	if err := validarRangoEdad(input); err != nil {
+		return err
+	}
+	if input.CapacidadPorCurso < 0 {
+		return errors.New("La capacidad por curso no puede ser negativa")
+	}
+
 	var countNombre int64
 	s.db.Model(&academic.NivelEducativo{}).
 		Where("nombre = ? AND id <> ?", input.Nombre, input.ID).
@@ -91,6 +112,9 @@ func (s *LevelService) ActualizarNivel(input academicDTO.NivelEducativoDTO) erro
 	nivel.Nombre = input.Nombre
 	nivel.NombreCompleto = input.NombreCompleto
 	nivel.Orden = input.Orden
+	nivel.EdadMinima = input.EdadMinima
+	nivel.EdadMaxima = input.EdadMaxima
+	nivel.CapacidadPorCurso = input.CapacidadPorCurso
 
 	return s.db.Save(&nivel).Error
 }
@@ -111,3 +135,17 @@ func (s *LevelService) EliminarNivel(id uint) error {
 
 	return s.db.Delete(&nivel).Error
 }
+
+// validarRangoEdad acepta el rango vacío (nivel sin configurar) o un rango coherente.
+func validarRangoEdad(input academicDTO.NivelEducativoDTO) error {
+	if input.EdadMinima == 0 && input.EdadMaxima == 0 {
+		return nil
+	}
+	if input.EdadMinima < 3 || input.EdadMaxima > 30 {
+		return errors.New("El rango de edad esperado debe estar entre 3 y 30 años")
+	}
+	if input.EdadMinima > input.EdadMaxima {
+		return errors.New("La edad mínima no puede ser mayor que la edad máxima")
+	}
+	return nil
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	dtos "dece/internal/application/dtos/student"
+	growth "dece/internal/application/helpers/growth"
+	documentSvc "dece/internal/application/services/documents"
+	securitySvc "dece/internal/application/services/security"
+	"dece/internal/domain/documents"
+	"dece/internal/domain/student"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ConsentService struct {
+	db        *gorm.DB
+	auth      *securitySvc.AuthService
+	versiones *documentSvc.Versionador
+}
+
+func NewConsentService(db *gorm.DB, auth *securitySvc.AuthService, versiones *documentSvc.Versionador) *ConsentService {
+	return &ConsentService{db: db, auth: auth, versiones: versiones}
+}
+
+func (s *ConsentService) ListarCatalogosConsentimiento() dtos.CatalogosConsentimientoDTO {
+	return dtos.CatalogosConsentimientoDTO{
+		Tipos:                    student.TiposConsentimiento,
+		Bloqueantes:              student.ConsentimientosBloqueantes,
+		DerivacionesObligatorias: student.DerivacionesObligatorias,
+	}
+}
+
+// ListarConsentimientos devuelve el historial del estudiante, del más reciente al más antiguo.
+func (s *ConsentService) ListarConsentimientos(estudianteID uint) ([]dtos.ConsentimientoDTO, error) {
+	var lista []student.Consentimiento
+	if err := s.db.Where("estudiante_id = ?", estudianteID).Order("fecha_firma DESC, id DESC").Find(&lista).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener los consentimientos: %v", err)
+	}
+
+	hoy := time.Now().Format("2006-01-02")
+	resultado := make([]dtos.ConsentimientoDTO, 0, len(lista))
+	for _, c := range lista {
+		resultado = append(resultado, mapearConsentimiento(c, hoy))
+	}
+	return resultado, nil
+}
+
+// ObtenerEstadoConsentimientos indica para cada tipo si hay una autorización vigente y si su
+// falta bloquea o solo se advierte.
+func (s *ConsentService) ObtenerEstadoConsentimientos(estudianteID uint) ([]dtos.EstadoConsentimientoDTO, error) {
+	var lista []student.Consentimiento
+	if err := s.db.Where("estudiante_id = ?", estudianteID).Find(&lista).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener los consentimientos: %v", err)
+	}
+
+	hoy := time.Now().Format("2006-01-02")
+	estados := make([]dtos.EstadoConsentimientoDTO, 0, len(student.TiposConsentimiento))
+	for _, tipo := range student.TiposConsentimiento {
+		c, estado := student.ConsentimientoVigente(lista, tipo, hoy)
+		item := dtos.EstadoConsentimientoDTO{
+			Tipo:    tipo,
+			Estado:  estado,
+			Bloquea: esBloqueante(tipo),
+			Mensaje: mensajeConsentimiento(tipo, estado, c),
+		}
+		if c != nil {
+			item.ConsentimientoID = c.ID
+			item.FechaVencimiento = c.FechaVencimiento
+			item.Firmante = c.FirmanteNombre
+		}
+		estados = append(estados, item)
+	}
+	return estados, nil
+}
+
+func (s *ConsentService) GuardarConsentimiento(datos dtos.GuardarConsentimientoDTO) (*dtos.ConsentimientoDTO, error) {
+	valido := false
+	for _, t := range student.TiposConsentimiento {
+		if t == datos.Tipo {
+			valido = true
+			break
+		}
+	}
+	if !valido {
+		return nil, errors.New("Tipo de consentimiento no válido")
+	}
+
+	firma, err := time.Parse("2006-01-02", strings.TrimSpace(datos.FechaFirma))
+	if err != nil {
+		return nil, errors.New("La fecha de firma es inválida, use el formato AAAA-MM-DD")
+	}
+	if firma.After(time.Now()) {
+		return nil, errors.New("La fecha de firma no puede ser futura")
+	}
+	datos.FechaFirma = firma.Format("2006-01-02")
+	datos.FechaVencimiento = strings.TrimSpace(datos.FechaVencimiento)
+	if datos.FechaVencimiento != "" {
+		vence, err := time.Parse("2006-01-02", datos.FechaVencimiento)
+		if err != nil {
+			return nil, errors.New("La fecha de vencimiento es inválida, use el formato AAAA-MM-DD")
+		}
+		if !vence.After(firma) {
+			return nil, errors.New("La fecha de vencimiento debe ser posterior a la firma")
+		}
+	}
+
+	var est student.Estudiante
+	if err := s.db.Select("id, nombres, apellidos, cedula, fecha_nacimiento").First(&est, datos.EstudianteID).Error; err != nil {
+		return nil, errors.New("Estudiante no encontrado")
+	}
+
+	consentimiento := student.Consentimiento{}
+	if datos.ID > 0 {
+		if err := s.db.First(&consentimiento, datos.ID).Error; err != nil {
+			return nil, errors.New("Consentimiento no encontrado")
+		}
+		if consentimiento.EstudianteID != est.ID {
+			return nil, errors.New("El consentimiento no pertenece al estudiante")
+		}
+		if consentimiento.FechaRevocacion != "" {
+			return nil, errors.New("No se puede editar un consentimiento revocado; registre uno nuevo")
+		}
+	} else {
+		consentimiento.EstudianteID = est.ID
+		consentimiento.UsuarioID, consentimiento.NombreUsuario = s.usuarioSesion()
+		consentimiento.FechaRegistro = time.Now().Format("2006-01-02 15:04:05")
+	}
+
+	if datos.FamiliarID > 0 {
+		var familiar student.Familiar
+		if err := s.db.First(&familiar, datos.FamiliarID).Error; err != nil || familiar.EstudianteID != est.ID {
+			return nil, errors.New("El firmante debe ser un familiar registrado del estudiante")
+		}
+		if familiar.Fallecido {
+			return nil, errors.New("El familiar seleccionado consta como fallecido")
+		}
+		consentimiento.FamiliarID = &familiar.ID
+		consentimiento.FirmanteNombre = familiar.NombresCompletos
+		consentimiento.FirmanteCedula = familiar.Cedula
+		consentimiento.FirmanteParentesco = familiar.Parentesco
+	} else {
+		// Sin familiar firma el propio estudiante, que debe ser mayor de edad a la fecha de firma
+		meses, err := growth.EdadEnMeses(est.FechaNacimiento, datos.FechaFirma)
+		if err != nil || meses < 18*12 {
+			return nil, errors.New("Seleccione al familiar que firma; el estudiante solo puede firmar si es mayor de edad")
+		}
+		consentimiento.FamiliarID = nil
+		consentimiento.FirmanteNombre = strings.TrimSpace(est.Nombres + " " + est.Apellidos)
+		consentimiento.FirmanteCedula = est.Cedula
+		consentimiento.FirmanteParentesco = "El estudiante"
+	}
+
+	consentimiento.Tipo = datos.Tipo
+	consentimiento.Alcance = strings.TrimSpace(datos.Alcance)
+	consentimiento.FechaFirma = datos.FechaFirma
+	consentimiento.FechaVencimiento = datos.FechaVencimiento
+
+	if err := s.db.Save(&consentimiento).Error; err != nil {
+		return nil, fmt.Errorf("Error al guardar el consentimiento: %v", err)
+	}
+	resultado := mapearConsentimiento(consentimiento, time.Now().Format("2006-01-02"))
+	return &resultado, nil
+}
+
+// RevocarConsentimiento deja constancia de que el firmante retiró la autorización. El registro
+// se conserva para el historial.
+func (s *ConsentService) RevocarConsentimiento(id uint, fecha string, motivo string) error {
+	var consentimiento student.Consentimiento
+	if err := s.db.First(&consentimiento, id).Error; err != nil {
+		return errors.New("Consentimiento no encontrado")
+	}
+	if consentimiento.FechaRevocacion != "" {
+		return errors.New("El consentimiento ya está revocado")
+	}
+	f, err := time.Parse("2006-01-02", strings.TrimSpace(fecha))
+	if err != nil {
+		return errors.New("La fecha de revocación es inválida, use el formato AAAA-MM-DD")
+	}
+	if f.After(time.Now()) {
+		return errors.New("La fecha de revocación no puede ser futura")
+	}
+	if f.Format("2006-01-02") < consentimiento.FechaFirma {
+		return errors.New("La fecha de revocación no puede ser anterior a la firma")
+	}
+	motivo = strings.TrimSpace(motivo)
+	if motivo == "" {
+		return errors.New("Indique el motivo de la revocación")
+	}
+
+	return s.db.Model(&consentimiento).Updates(map[string]interface{}{
+		"fecha_revocacion":  f.Format("2006-01-02"),
+		"motivo_revocacion": motivo,
+	}).Error
+}
+
+// EliminarConsentimiento borra un registro ingresado por error. Para retirar una autorización
+// válida se usa RevocarConsentimiento.
+func (s *ConsentService) EliminarConsentimiento(id uint) error {
+	var consentimiento student.Consentimiento
+	if err := s.db.First(&consentimiento, id).Error; err != nil {
+		return errors.New("Consentimiento no encontrado")
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if consentimiento.RutaDocumento != "" {
+			if err := s.versiones.Retirar(tx, documents.EntidadConsentimiento, consentimiento.ID, consentimiento.RutaDocumento); err != nil {
+				return err
+			}
+		}
+		if err := tx.Delete(&consentimiento).Error; err != nil {
+			return fmt.Errorf("Error al eliminar el consentimiento: %v", err)
+		}
+		return nil
+	})
+}
+
+// SubirDocumentoConsentimiento guarda el formulario firmado escaneado. Reemplaza al anterior,
+// que queda en el historial de versiones.
+func (s *ConsentService) SubirDocumentoConsentimiento(id uint, rutaOrigen string) (string, error) {
+	var consentimiento student.Consentimiento
+	if err := s.db.First(&consentimiento, id).Error; err != nil {
+		return "", errors.New("Consentimiento no encontrado")
+	}
+	var est student.Estudiante
+	if err := s.db.Select("cedula").First(&est, consentimiento.EstudianteID).Error; err != nil {
+		return "", errors.New("Estudiante no encontrado")
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.New("Error de sistema de archivos")
+	}
+
+	destinoDir := filepath.Join(homeDir, "Documents", "SistemaDECE", "Consentimientos", est.Cedula)
+	if err := os.MkdirAll(destinoDir, 0755); err != nil {
+		return "", fmt.Errorf("Error al crear carpeta: %v", err)
+	}
+
+	ext := filepath.Ext(rutaOrigen)
+	if ext == "" {
+		ext = ".pdf"
+	}
+	rutaDestino := filepath.Join(destinoDir, fmt.Sprintf("CONS-%d_%d%s", consentimiento.ID, time.Now().UnixMilli(), ext))
+
+	src, err := os.Open(rutaOrigen)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(rutaDestino)
+	if err != nil {
+		return "", err
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return "", err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&consentimiento).Update("ruta_documento", rutaDestino).Error; err != nil {
+			return err
+		}
+		_, err := s.versiones.Registrar(tx, documents.EntidadConsentimiento, consentimiento.ID, "consentimiento", consentimiento.Tipo, rutaDestino, true)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("Documento guardado pero error al actualizar BD: %v", err)
+	}
+
+	return rutaDestino, nil
+}
+
+func (s *ConsentService) usuarioSesion() (uint, string) {
+	usuario, err := s.auth.ObtenerUsuarioSesion()
+	if err != nil {
+		return 0, ""
+	}
+	return usuario.ID, usuario.NombreCompleto
+}
+
+func mapearConsentimiento(c student.Consentimiento, hoy string) dtos.ConsentimientoDTO {
+	resultado := dtos.ConsentimientoDTO{
+		ID:                 c.ID,
+		EstudianteID:       c.EstudianteID,
+		Tipo:               c.Tipo,
+		Alcance:            c.Alcance,
+		FechaFirma:         c.FechaFirma,
+		FechaVencimiento:   c.FechaVencimiento,
+		RutaDocumento:      c.RutaDocumento,
+		FirmanteNombre:     c.FirmanteNombre,
+		FirmanteCedula:     c.FirmanteCedula,
+		FirmanteParentesco: c.FirmanteParentesco,
+		FechaRevocacion:    c.FechaRevocacion,
+		MotivoRevocacion:   c.MotivoRevocacion,
+		Estado:             c.Estado(hoy),
+		NombreUsuario:      c.NombreUsuario,
+		FechaRegistro:      c.FechaRegistro,
+	}
+	if c.FamiliarID != nil {
+		resultado.FamiliarID = *c.FamiliarID
+	}
+	return resultado
+}
+
+func esBloqueante(tipo string) bool {
+	for _, t := range student.ConsentimientosBloqueantes {
+		if t == tipo {
+			return true
+		}
+	}
+	return false
+}
+
+func mensajeConsentimiento(tipo, estado string, c *student.Consentimiento) string {
+	switch estado {
+	case student.EstadoConsentimientoFalta:
+		return fmt.Sprintf("No hay consentimiento de %s firmado", strings.ToLower(tipo))
+	case student.EstadoConsentimientoRevocado:
+		return fmt.Sprintf("El consentimiento de %s fue revocado el %s", strings.ToLower(tipo), c.FechaRevocacion)
+	case student.EstadoConsentimientoVencido:
+		return fmt.Sprintf("El consentimiento de %s venció el %s", strings.ToLower(tipo), c.FechaVencimiento)
+	case student.EstadoConsentimientoPorVencer:
+		return fmt.Sprintf("El consentimiento de %s vence el %s", strings.ToLower(tipo), c.FechaVencimiento)
+	}
+	return ""
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"dece/internal/domain/student"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Exigir lo usan los servicios cuyas acciones necesitan una autorización firmada (subir una
+// evaluación psicológica, derivar a una entidad externa). Devuelve el error a mostrar si el
+// estudiante no tiene un consentimiento vigente del tipo; accion completa la frase "No se
+// puede ...".
+func Exigir(db *gorm.DB, estudianteID uint, tipo string, accion string) error {
+	_, err := Verificar(db, estudianteID, tipo, accion)
+	return err
+}
+
+// Verificar comprueba el consentimiento que autoriza la acción. Si falta y el tipo es bloqueante
+// devuelve el error de Exigir; si no lo es (uso de imagen, tratamiento de datos), la acción
+// sigue y se devuelve la advertencia a mostrar.
+func Verificar(db *gorm.DB, estudianteID uint, tipo string, accion string) (string, error) {
+	var lista []student.Consentimiento
+	if err := db.Where("estudiante_id = ? AND tipo = ?", estudianteID, tipo).Find(&lista).Error; err != nil {
+		return "", fmt.Errorf("Error al verificar el consentimiento: %v", err)
+	}
+
+	c, estado := student.ConsentimientoVigente(lista, tipo, time.Now().Format("2006-01-02"))
+	if student.Autoriza(estado) {
+		return "", nil
+	}
+	if esBloqueante(tipo) {
+		return "", fmt.Errorf("No se puede %s: %s. Registre el consentimiento firmado en la ficha del estudiante", accion, mensajeConsentimiento(tipo, estado, c))
+	}
+	return fmt.Sprintf("%s. Registre el consentimiento firmado antes de %s", mensajeConsentimiento(tipo, estado, c), accion), nil
+}
+
+// DerivacionRequiereConsentimiento indica si derivar a la entidad necesita la autorización del
+// representante. Las derivaciones que la ley impone no la necesitan.
+func DerivacionRequiereConsentimiento(entidad string) bool {
+	if entidad == "" {
+		return false
+	}
+	for _, e := range student.DerivacionesObligatorias {
+		if e == entidad {
+			return false
+		}
+	}
+	return true
+}
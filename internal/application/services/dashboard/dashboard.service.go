@@ -3,6 +3,7 @@ package dashboard
 import (
 	"context"
 	dtos "dece/internal/application/dtos/dashboard"
+	"dece/internal/domain/enrollment"
 	"fmt"
 
 	"gorm.io/gorm"
@@ -30,14 +31,14 @@ func (s *DashboardService) GetDashboardData() (*dtos.DashboardDataDTO, error) {
 		FROM matriculas m
 		JOIN cursos c ON m.curso_id = c.id
 		JOIN periodo_lectivos p ON c.periodo_id = p.id
-		WHERE p.es_activo = 1 AND m.estado = 'Matriculado'
+		WHERE p.es_activo = 1 AND m.estado IN ('Matriculado', 'Reingreso')
 	`).Scan(&data.KPI.TotalEstudiantes)
 
 	s.db.Raw(`
 		SELECT COUNT(*) 
 		FROM casos_sensibles c
 		JOIN periodo_lectivos p ON c.periodo_id = p.id
-		WHERE p.es_activo = 1 AND c.estado = 'Abierto'
+		WHERE p.es_activo = 1 AND c.estado <> 'Cerrado'
 	`).Scan(&data.KPI.CasosAbiertos)
 
 	s.db.Raw(`
@@ -77,7 +78,7 @@ func (s *DashboardService) GetDashboardData() (*dtos.DashboardDataDTO, error) {
 			COUNT(la.id) as cantidad_faltas
 		FROM llamados_atencion la
 		JOIN matriculas m ON la.matricula_id = m.id
-		JOIN cursos c ON m.curso_id = c.id
+		JOIN cursos c ON c.id = ` + enrollment.CursoEnFechaSQL("m", "la.fecha") + `
 		JOIN nivel_educativos ne ON c.nivel_id = ne.id
 		JOIN periodo_lectivos p ON c.periodo_id = p.id
 		WHERE p.es_activo = 1
@@ -86,6 +87,28 @@ func (s *DashboardService) GetDashboardData() (*dtos.DashboardDataDTO, error) {
 		LIMIT 5
 	`).Scan(&data.CursosConflictivos)
 
+	// Edad en años cumplidos al inicio del periodo activo, igual que el reporte de extraedad
+	extraedad := `
+		FROM matriculas m
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		JOIN cursos c ON m.curso_id = c.id
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos p ON c.periodo_id = p.id
+		WHERE p.es_activo = 1 AND m.estado IN ('Matriculado', 'Reingreso') AND ne.edad_maxima > 0
+		AND (CAST(strftime('%Y', p.fecha_inicio) AS INTEGER) - CAST(strftime('%Y', e.fecha_nacimiento) AS INTEGER)
+			- (strftime('%m-%d', p.fecha_inicio) < strftime('%m-%d', e.fecha_nacimiento))) > ne.edad_maxima`
+
+	s.db.Raw(`SELECT COUNT(*) ` + extraedad).Scan(&data.KPI.Extraedad)
+
+	s.db.Raw(`
+		SELECT 
+			ne.nombre || ' ' || c.paralelo as curso,
+			COUNT(*) as cantidad
+		` + extraedad + `
+		GROUP BY c.id
+		ORDER BY cantidad DESC
+	`).Scan(&data.ExtraedadPorCurso)
+
 	s.db.Raw(`
 		SELECT 
 			tipo_caso,
@@ -155,6 +178,9 @@ func (s *DashboardService) GetDashboardData() (*dtos.DashboardDataDTO, error) {
 	if data.CursosConflictivos == nil {
 		data.CursosConflictivos = []dtos.CursoConflictivoDTO{}
 	}
+	if data.ExtraedadPorCurso == nil {
+		data.ExtraedadPorCurso = []dtos.CursoExtraedadDTO{}
+	}
 	if data.CasosPorTipo == nil {
 		data.CasosPorTipo = []dtos.CasoPorTipoDTO{}
 	}
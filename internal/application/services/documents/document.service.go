@@ -0,0 +1,138 @@
+package services
+
+import (
+	dtos "dece/internal/application/dtos/documents"
+	securitySvc "dece/internal/application/services/security"
+	"dece/internal/domain/documents"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"gorm.io/gorm"
+)
+
+type DocumentService struct {
+	db   *gorm.DB
+	auth *securitySvc.AuthService
+}
+
+func NewDocumentService(db *gorm.DB, auth *securitySvc.AuthService) *DocumentService {
+	return &DocumentService{db: db, auth: auth}
+}
+
+// ListarVersiones devuelve el historial de un documento (o de todos los de la entidad si el
+// tipo va vacío), del más reciente al más antiguo.
+func (s *DocumentService) ListarVersiones(entidad string, entidadID uint, tipo string) ([]dtos.VersionDocumentoDTO, error) {
+	var versiones []documents.VersionDocumento
+
+	query := s.db.Where("entidad = ? AND entidad_id = ?", entidad, entidadID)
+	if tipo != "" {
+		query = query.Where("tipo_documento = ?", tipo)
+	}
+
+	if err := query.Order("tipo_documento ASC, version DESC").Find(&versiones).Error; err != nil {
+		return nil, fmt.Errorf("Error al listar versiones: %v", err)
+	}
+
+	response := make([]dtos.VersionDocumentoDTO, len(versiones))
+	for i, v := range versiones {
+		_, statErr := os.Stat(v.Ruta)
+		response[i] = dtos.VersionDocumentoDTO{
+			ID:            v.ID,
+			Entidad:       v.Entidad,
+			EntidadID:     v.EntidadID,
+			TipoDocumento: v.TipoDocumento,
+			Version:       v.Version,
+			Vigente:       v.Vigente,
+			Nombre:        v.Nombre,
+			TamanoBytes:   v.TamanoBytes,
+			HashSHA256:    v.HashSHA256,
+			SubidoPor:     v.NombreUsuario,
+			FechaSubida:   v.FechaSubida,
+			FechaRetiro:   v.FechaRetiro,
+			ArchivoExiste: statErr == nil,
+		}
+	}
+
+	return response, nil
+}
+
+// ObtenerVersionBase64 devuelve cualquier versión, vigente o no, como Data URI.
+func (s *DocumentService) ObtenerVersionBase64(versionID uint) (string, error) {
+	var version documents.VersionDocumento
+	if err := s.db.First(&version, versionID).Error; err != nil {
+		return "", errors.New("Versión no encontrada")
+	}
+
+	data, err := os.ReadFile(version.Ruta)
+	if err != nil {
+		return "", errors.New("El archivo físico no existe")
+	}
+
+	mimeType := http.DetectContentType(data)
+	return fmt.Sprintf("data:%s;base64,%s", mimeType, base64.StdEncoding.EncodeToString(data)), nil
+}
+
+// VerificarIntegridad compara el hash guardado al subir el archivo con el actual en disco.
+func (s *DocumentService) VerificarIntegridad(versionID uint) (*dtos.IntegridadDocumentoDTO, error) {
+	var version documents.VersionDocumento
+	if err := s.db.First(&version, versionID).Error; err != nil {
+		return nil, errors.New("Versión no encontrada")
+	}
+
+	response := &dtos.IntegridadDocumentoDTO{
+		VersionID:    version.ID,
+		HashEsperado: version.HashSHA256,
+	}
+
+	_, hash, err := HashArchivo(version.Ruta)
+	if err != nil {
+		response.Detalle = "El archivo físico no existe o no se puede leer"
+		return response, nil
+	}
+
+	response.HashActual = hash
+	response.Integro = hash == version.HashSHA256
+	if !response.Integro {
+		response.Detalle = "El archivo fue modificado después de subirse"
+	}
+
+	return response, nil
+}
+
+// EliminarVersionPermanente borra del disco y del historial una versión que ya no está
+// vigente. Solo disponible para administradores.
+func (s *DocumentService) EliminarVersionPermanente(versionID uint) error {
+	usuario, err := s.auth.ObtenerUsuarioSesion()
+	if err != nil {
+		return errors.New("Debe iniciar sesión para eliminar documentos")
+	}
+	if usuario.Rol != "admin" {
+		return errors.New("Solo un administrador puede eliminar versiones de documentos")
+	}
+
+	var version documents.VersionDocumento
+	if err := s.db.First(&version, versionID).Error; err != nil {
+		return errors.New("Versión no encontrada")
+	}
+	if version.Vigente {
+		return errors.New("No se puede eliminar la versión vigente; suba un reemplazo o retírela primero")
+	}
+
+	var compartida int64
+	s.db.Model(&documents.VersionDocumento{}).Where("ruta = ? AND id <> ?", version.Ruta, version.ID).Count(&compartida)
+
+	if err := s.db.Delete(&version).Error; err != nil {
+		return fmt.Errorf("Error al eliminar versión: %v", err)
+	}
+
+	if compartida == 0 {
+		if err := os.Remove(version.Ruta); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Versión eliminada del historial pero no se pudo borrar el archivo: %v", err)
+		}
+	}
+
+	return nil
+}
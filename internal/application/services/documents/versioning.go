@@ -0,0 +1,146 @@
+package services
+
+import (
+	"crypto/sha256"
+	securitySvc "dece/internal/application/services/security"
+	"dece/internal/domain/documents"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Versionador lo usan los servicios que guardan archivos para dejar registro de cada subida.
+// No se expone al frontend.
+type Versionador struct {
+	auth *securitySvc.AuthService
+}
+
+func NewVersionador(auth *securitySvc.AuthService) *Versionador {
+	return &Versionador{auth: auth}
+}
+
+// Registrar calcula tamaño y hash del archivo ya copiado y lo agrega como nueva versión. Con
+// reemplaza, las versiones vigentes del mismo documento dejan de estarlo (cédula, acta...);
+// sin reemplaza, conviven (evidencias y adjuntos).
+func (v *Versionador) Registrar(tx *gorm.DB, entidad string, entidadID uint, tipo string, nombre string, ruta string, reemplaza bool) (*documents.VersionDocumento, error) {
+	tamano, hash, err := HashArchivo(ruta)
+	if err != nil {
+		return nil, fmt.Errorf("Error al calcular hash del documento: %v", err)
+	}
+
+	if nombre == "" {
+		nombre = filepath.Base(ruta)
+	}
+
+	version := documents.VersionDocumento{
+		Entidad:       entidad,
+		EntidadID:     entidadID,
+		TipoDocumento: tipo,
+		Vigente:       true,
+		Nombre:        nombre,
+		Ruta:          ruta,
+		TamanoBytes:   tamano,
+		HashSHA256:    hash,
+		FechaSubida:   time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	if v != nil && v.auth != nil {
+		if usuario, err := v.auth.ObtenerUsuarioSesion(); err == nil {
+			version.UsuarioID = usuario.ID
+			version.NombreUsuario = usuario.NombreCompleto
+		}
+	}
+
+	var ultima int
+	tx.Model(&documents.VersionDocumento{}).
+		Where("entidad = ? AND entidad_id = ? AND tipo_documento = ?", entidad, entidadID, tipo).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&ultima)
+	version.Version = ultima + 1
+
+	if reemplaza {
+		if err := tx.Model(&documents.VersionDocumento{}).
+			Where("entidad = ? AND entidad_id = ? AND tipo_documento = ? AND vigente = ?", entidad, entidadID, tipo, true).
+			Updates(map[string]interface{}{"vigente": false, "fecha_retiro": version.FechaSubida}).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Create(&version).Error; err != nil {
+		return nil, fmt.Errorf("Error al registrar versión del documento: %v", err)
+	}
+
+	return &version, nil
+}
+
+// Adoptar incorpora al historial un archivo subido antes de que existiera el versionado, para
+// que no se pierda al reemplazarlo.
+func (v *Versionador) Adoptar(tx *gorm.DB, entidad string, entidadID uint, tipo string, ruta string) error {
+	if ruta == "" {
+		return nil
+	}
+
+	info, err := os.Stat(ruta)
+	if err != nil {
+		return nil
+	}
+
+	var existe int64
+	tx.Model(&documents.VersionDocumento{}).Where("entidad = ? AND entidad_id = ? AND ruta = ?", entidad, entidadID, ruta).Count(&existe)
+	if existe > 0 {
+		return nil
+	}
+
+	_, hash, err := HashArchivo(ruta)
+	if err != nil {
+		return err
+	}
+
+	var ultima int
+	tx.Model(&documents.VersionDocumento{}).
+		Where("entidad = ? AND entidad_id = ? AND tipo_documento = ?", entidad, entidadID, tipo).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&ultima)
+
+	return tx.Create(&documents.VersionDocumento{
+		Entidad:       entidad,
+		EntidadID:     entidadID,
+		TipoDocumento: tipo,
+		Version:       ultima + 1,
+		Vigente:       true,
+		Nombre:        filepath.Base(ruta),
+		Ruta:          ruta,
+		TamanoBytes:   info.Size(),
+		HashSHA256:    hash,
+		FechaSubida:   info.ModTime().Format("2006-01-02 15:04:05"),
+	}).Error
+}
+
+// Retirar marca como no vigente la versión de un archivo que se quitó de la ficha. El archivo
+// se conserva; solo un administrador puede eliminarlo definitivamente.
+func (v *Versionador) Retirar(tx *gorm.DB, entidad string, entidadID uint, ruta string) error {
+	return tx.Model(&documents.VersionDocumento{}).
+		Where("entidad = ? AND entidad_id = ? AND ruta = ? AND vigente = ?", entidad, entidadID, ruta, true).
+		Updates(map[string]interface{}{"vigente": false, "fecha_retiro": time.Now().Format("2006-01-02 15:04:05")}).Error
+}
+
+func HashArchivo(ruta string) (int64, string, error) {
+	f, err := os.Open(ruta)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
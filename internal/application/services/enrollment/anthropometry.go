@@ -0,0 +1,46 @@
+package services
+
+import (
+	growth "dece/internal/application/helpers/growth"
+	domain "dece/internal/domain/enrollment"
+	"dece/internal/domain/health"
+	"fmt"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// registrarMedicionFicha lleva al historial antropométrico el peso y la talla de la ficha
+// cuando se registran por primera vez o cambian. anterior es nil en una matrícula nueva. Los
+// valores imposibles no bloquean el guardado de la ficha; solo no pasan al historial.
+func (c *CicloMatricula) registrarMedicionFicha(tx *gorm.DB, mat *domain.Matricula, anterior *domain.Antropometria) error {
+	actual := mat.Antropometria.Data
+	if anterior != nil && anterior.Peso == actual.Peso && anterior.Talla == actual.Talla {
+		return nil
+	}
+	// La ficha pide la talla en metros; valores mayores ya vienen en centímetros
+	tallaCm := actual.Talla
+	if tallaCm > 0 && tallaCm < 3 {
+		tallaCm = math.Round(tallaCm*100*10) / 10
+	}
+	if growth.ValidarMedicion(actual.Peso, tallaCm) != nil {
+		return nil
+	}
+
+	ahora := time.Now()
+	medicion := health.MedicionAntropometrica{
+		MatriculaID:   mat.ID,
+		Fecha:         ahora.Format("2006-01-02"),
+		PesoKg:        actual.Peso,
+		TallaCm:       tallaCm,
+		IMC:           growth.CalcularIMC(actual.Peso, tallaCm),
+		Observacion:   "Registrada desde la ficha DECE",
+		FechaRegistro: ahora.Format("2006-01-02 15:04:05"),
+	}
+	medicion.UsuarioID, medicion.NombreUsuario = c.usuarioSesion()
+	if err := tx.Create(&medicion).Error; err != nil {
+		return fmt.Errorf("Error al registrar la medición antropométrica: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package services
+
+import (
+	enrollmentDTO "dece/internal/application/dtos/enrollment"
+	"dece/internal/domain/common"
+	domain "dece/internal/domain/enrollment"
+	"dece/internal/domain/faculty"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Los estudiantes se reparten por grupos en este orden: primero los de NEE (para que ningún
+// paralelo concentre los casos), luego mujeres y hombres. El último grupo completa los cupos.
+var estratosBalanceo = []string{"nee", "F", "M", "otro"}
+
+type alumnoBalanceo struct {
+	MatriculaID   uint
+	EstudianteID  uint
+	Estudiante    string
+	Genero        string
+	CursoID       uint
+	FechaRegistro string
+	DatosSalud    common.JSONMap[domain.DatosSalud] `gorm:"type:text"`
+}
+
+func (a alumnoBalanceo) estrato() string {
+	switch {
+	case a.DatosSalud.Data.EsNEE():
+		return "nee"
+	case a.Genero == "F" || a.Genero == "M":
+		return a.Genero
+	}
+	return "otro"
+}
+
+// ProponerBalanceo sugiere cómo redistribuir a los estudiantes vigentes entre los paralelos de
+// un nivel y jornada del periodo activo: igual cantidad por curso (respetando la capacidad) y
+// proporción pareja de mujeres, hombres y estudiantes con NEE, moviendo a la menor cantidad de
+// estudiantes posible. Entre los que podrían moverse se eligen los últimos en matricularse.
+func (s *EnrollmentService) ProponerBalanceo(nivelID uint, jornada string) (*enrollmentDTO.PropuestaBalanceoDTO, error) {
+	var cursos []faculty.Curso
+	err := s.db.Preload("Nivel").
+		Joins("JOIN periodo_lectivos p ON p.id = cursos.periodo_id").
+		Where("p.es_activo = ? AND cursos.nivel_id = ? AND cursos.jornada = ?", true, nivelID, jornada).
+		Order("cursos.paralelo").
+		Find(&cursos).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener los paralelos: %v", err)
+	}
+	if len(cursos) < 2 {
+		return nil, errors.New("El nivel necesita al menos dos paralelos en la jornada para balancear")
+	}
+
+	indice := map[uint]int{}
+	ids := make([]uint, len(cursos))
+	for i, c := range cursos {
+		indice[c.ID] = i
+		ids[i] = c.ID
+	}
+
+	var alumnos []alumnoBalanceo
+	err = s.db.Raw(`
+		SELECT m.id as matricula_id, e.id as estudiante_id,
+			e.apellidos || ' ' || e.nombres as estudiante,
+			e.genero_nacimiento as genero, m.curso_id, m.fecha_registro, m.datos_salud
+		FROM matriculas m
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		WHERE m.curso_id IN ? AND m.estado IN ?
+		ORDER BY m.fecha_registro DESC, m.id DESC`, ids, domain.EstadosVigentes).Scan(&alumnos).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener los estudiantes: %v", err)
+	}
+
+	propuesta := &enrollmentDTO.PropuestaBalanceoDTO{
+		NivelID:      nivelID,
+		Nivel:        cursos[0].Nivel.Nombre,
+		Jornada:      jornada,
+		Cursos:       make([]enrollmentDTO.CursoBalanceoDTO, len(cursos)),
+		Movimientos:  []enrollmentDTO.MovimientoBalanceoDTO{},
+		Advertencias: []string{},
+	}
+
+	actuales := make([]int, len(cursos))
+	capacidades := make([]int, len(cursos))
+	porEstrato := map[string][][]alumnoBalanceo{}
+	for _, e := range estratosBalanceo {
+		porEstrato[e] = make([][]alumnoBalanceo, len(cursos))
+	}
+	for _, a := range alumnos {
+		i := indice[a.CursoID]
+		actuales[i]++
+		porEstrato[a.estrato()][i] = append(porEstrato[a.estrato()][i], a)
+	}
+	for i, c := range cursos {
+		capacidades[i] = c.CapacidadEfectiva()
+		propuesta.Cursos[i] = enrollmentDTO.CursoBalanceoDTO{
+			CursoID:   c.ID,
+			Curso:     fmt.Sprintf("%s %s", c.Nivel.Nombre, c.Paralelo),
+			Capacidad: capacidades[i],
+		}
+	}
+
+	metas, caben := metasPorCurso(len(alumnos), actuales, capacidades)
+	if !caben {
+		propuesta.Advertencias = append(propuesta.Advertencias,
+			fmt.Sprintf("Los %d estudiantes no caben en la capacidad de los paralelos; la propuesta reparte sin considerarla", len(alumnos)))
+		metas, _ = metasPorCurso(len(alumnos), actuales, make([]int, len(cursos)))
+	}
+
+	restantes := append([]int{}, metas...)
+	for _, estrato := range estratosBalanceo {
+		grupo := porEstrato[estrato]
+		enCurso := make([]int, len(cursos))
+		total := 0
+		for i := range grupo {
+			enCurso[i] = len(grupo[i])
+			total += enCurso[i]
+		}
+		if total == 0 {
+			continue
+		}
+
+		asignados := repartirProporcional(total, restantes, enCurso)
+		for i := range restantes {
+			restantes[i] -= asignados[i]
+		}
+
+		// Los que sobran en un curso pasan, en orden, a los cursos que les faltan
+		var salientes []alumnoBalanceo
+		for i := range grupo {
+			if enCurso[i] > asignados[i] {
+				salientes = append(salientes, grupo[i][:enCurso[i]-asignados[i]]...)
+			}
+		}
+		for i := range grupo {
+			for falta := asignados[i] - enCurso[i]; falta > 0 && len(salientes) > 0; falta-- {
+				a := salientes[0]
+				salientes = salientes[1:]
+				propuesta.Movimientos = append(propuesta.Movimientos, enrollmentDTO.MovimientoBalanceoDTO{
+					MatriculaID:    a.MatriculaID,
+					EstudianteID:   a.EstudianteID,
+					Estudiante:     a.Estudiante,
+					Genero:         a.Genero,
+					EsNEE:          estrato == "nee",
+					CursoOrigenID:  a.CursoID,
+					CursoOrigen:    propuesta.Cursos[indice[a.CursoID]].Curso,
+					CursoDestinoID: cursos[i].ID,
+					CursoDestino:   propuesta.Cursos[i].Curso,
+				})
+			}
+		}
+	}
+
+	destino := map[uint]uint{}
+	for _, m := range propuesta.Movimientos {
+		destino[m.MatriculaID] = m.CursoDestinoID
+	}
+	for _, a := range alumnos {
+		sumarComposicion(&propuesta.Cursos[indice[a.CursoID]].Actual, a)
+		cursoFinal := a.CursoID
+		if d, ok := destino[a.MatriculaID]; ok {
+			cursoFinal = d
+		}
+		sumarComposicion(&propuesta.Cursos[indice[cursoFinal]].Propuesto, a)
+	}
+
+	return propuesta, nil
+}
+
+func sumarComposicion(c *enrollmentDTO.ComposicionCursoDTO, a alumnoBalanceo) {
+	c.Total++
+	switch a.Genero {
+	case "F":
+		c.Mujeres++
+	case "M":
+		c.Hombres++
+	}
+	if a.DatosSalud.Data.EsNEE() {
+		c.NEE++
+	}
+}
+
+// metasPorCurso reparte total en partes iguales sin superar la capacidad de cada curso (0 sin
+// límite). Los sobrantes de la división van a los cursos que hoy tienen más estudiantes, para
+// mover lo menos posible. Devuelve false si el total no cabe.
+func metasPorCurso(total int, actuales []int, capacidades []int) ([]int, bool) {
+	metas := make([]int, len(actuales))
+	libres := make([]int, 0, len(actuales))
+	for i := range actuales {
+		libres = append(libres, i)
+	}
+
+	restantes := total
+	for len(libres) > 0 {
+		techo := (restantes + len(libres) - 1) / len(libres)
+		siguientes := libres[:0:0]
+		for _, i := range libres {
+			if capacidades[i] > 0 && capacidades[i] < techo {
+				metas[i] = capacidades[i]
+				restantes -= capacidades[i]
+			} else {
+				siguientes = append(siguientes, i)
+			}
+		}
+		if len(siguientes) < len(libres) {
+			libres = siguientes
+			continue
+		}
+
+		sort.SliceStable(libres, func(a, b int) bool { return actuales[libres[a]] > actuales[libres[b]] })
+		for n, i := range libres {
+			metas[i] = restantes / len(libres)
+			if n < restantes%len(libres) {
+				metas[i]++
+			}
+		}
+		return metas, true
+	}
+	return metas, restantes == 0
+}
+
+// repartirProporcional distribuye total entre los cursos en proporción a los cupos que aún les
+// quedan (método del mayor resto). Los empates favorecen a quien ya tiene más del grupo.
+func repartirProporcional(total int, cupos []int, enCurso []int) []int {
+	suma := 0
+	for _, c := range cupos {
+		suma += c
+	}
+	asignados := make([]int, len(cupos))
+	if suma == 0 {
+		return asignados
+	}
+
+	restos := make([]float64, len(cupos))
+	repartidos := 0
+	for i, c := range cupos {
+		cuota := float64(total) * float64(c) / float64(suma)
+		asignados[i] = int(math.Floor(cuota))
+		restos[i] = cuota - float64(asignados[i])
+		repartidos += asignados[i]
+	}
+
+	orden := make([]int, len(cupos))
+	for i := range orden {
+		orden[i] = i
+	}
+	sort.SliceStable(orden, func(a, b int) bool {
+		if restos[orden[a]] != restos[orden[b]] {
+			return restos[orden[a]] > restos[orden[b]]
+		}
+		return enCurso[orden[a]] > enCurso[orden[b]]
+	})
+	for n := 0; n < total-repartidos; n++ {
+		asignados[orden[n]]++
+	}
+	return asignados
+}
+
+// AplicarBalanceo ejecuta los movimientos como cambios de curso en una sola transacción. La
+// capacidad se verifica al final, porque a mitad del lote un curso puede excederla.
+func (s *EnrollmentService) AplicarBalanceo(input enrollmentDTO.AplicarBalanceoDTO) error {
+	if len(input.Movimientos) == 0 {
+		return errors.New("No hay movimientos para aplicar")
+	}
+	motivo := strings.TrimSpace(input.Motivo)
+	if motivo == "" {
+		motivo = "Balanceo de paralelos"
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		destinos := map[uint]faculty.Curso{}
+		for _, m := range input.Movimientos {
+			var mat domain.Matricula
+			if err := tx.First(&mat, m.MatriculaID).Error; err != nil {
+				return fmt.Errorf("Matrícula de %s no encontrada", m.Estudiante)
+			}
+			if mat.CursoID != m.CursoOrigenID || (mat.Estado != domain.EstadoMatriculado && mat.Estado != domain.EstadoReingreso) {
+				return fmt.Errorf("La matrícula de %s cambió desde que se generó la propuesta; genere una nueva", m.Estudiante)
+			}
+
+			curso, ok := destinos[m.CursoDestinoID]
+			if !ok {
+				if err := tx.Preload("Nivel").First(&curso, m.CursoDestinoID).Error; err != nil {
+					return errors.New("El curso de destino no existe")
+				}
+				destinos[curso.ID] = curso
+			}
+
+			if err := s.ciclo.CambiarCurso(tx, &mat, curso, input.Fecha, motivo); err != nil {
+				return fmt.Errorf("%s: %v", m.Estudiante, err)
+			}
+		}
+
+		for _, curso := range destinos {
+			capacidad, ocupados, err := s.ciclo.CupoCurso(tx, curso.ID, 0)
+			if err != nil {
+				return err
+			}
+			if capacidad > 0 && ocupados > capacidad {
+				return fmt.Errorf("El curso %s %s quedaría con %d estudiantes y su capacidad es %d", curso.Nivel.Nombre, curso.Paralelo, ocupados, capacidad)
+			}
+		}
+		return nil
+	})
+}
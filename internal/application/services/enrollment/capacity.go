@@ -0,0 +1,42 @@
+package services
+
+import (
+	domain "dece/internal/domain/enrollment"
+	"dece/internal/domain/faculty"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// CupoCurso devuelve la capacidad efectiva del curso (0 sin límite) y cuántos estudiantes
+// vigentes lo ocupan, sin contar la matrícula excluida.
+func (c *CicloMatricula) CupoCurso(tx *gorm.DB, cursoID uint, excluirMatriculaID uint) (int, int, error) {
+	var curso faculty.Curso
+	if err := tx.Preload("Nivel").First(&curso, cursoID).Error; err != nil {
+		return 0, 0, errors.New("El curso seleccionado no existe")
+	}
+
+	var ocupados int64
+	q := tx.Model(&domain.Matricula{}).Where("curso_id = ? AND estado IN ?", cursoID, domain.EstadosVigentes)
+	if excluirMatriculaID > 0 {
+		q = q.Where("id <> ?", excluirMatriculaID)
+	}
+	if err := q.Count(&ocupados).Error; err != nil {
+		return 0, 0, err
+	}
+	return curso.CapacidadEfectiva(), int(ocupados), nil
+}
+
+// VerificarCupo falla si el curso ya está lleno. matriculaID es la matrícula que va a ocupar el
+// cupo (0 si aún no existe), para no contarla dos veces.
+func (c *CicloMatricula) VerificarCupo(tx *gorm.DB, cursoID uint, matriculaID uint) error {
+	capacidad, ocupados, err := c.CupoCurso(tx, cursoID, matriculaID)
+	if err != nil {
+		return err
+	}
+	if capacidad > 0 && ocupados >= capacidad {
+		return fmt.Errorf("El curso alcanzó su capacidad máxima (%d estudiantes)", capacidad)
+	}
+	return nil
+}
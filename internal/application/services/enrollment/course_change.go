@@ -0,0 +1,111 @@
+package services
+
+import (
+	enrollmentDTO "dece/internal/application/dtos/enrollment"
+	domain "dece/internal/domain/enrollment"
+	"dece/internal/domain/faculty"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CambiarCurso mueve la matrícula a otro curso del mismo periodo desde la fecha indicada y deja
+// el cambio en el historial. Los llamados, citas y demás registros siguen en la matrícula; los
+// reportes por curso los atribuyen según la fecha con domain.CursoEnFechaSQL. No verifica el
+// cupo: en un balanceo los cursos pueden excederlo a mitad del lote.
+func (c *CicloMatricula) CambiarCurso(tx *gorm.DB, mat *domain.Matricula, curso faculty.Curso, fecha string, motivo string) error {
+	if curso.ID == mat.CursoID {
+		return errors.New("El estudiante ya pertenece a ese curso")
+	}
+
+	var actual faculty.Curso
+	if err := tx.Select("id", "periodo_id").First(&actual, mat.CursoID).Error; err != nil {
+		return errors.New("No se encontró el curso actual de la matrícula")
+	}
+	if actual.PeriodoID != curso.PeriodoID {
+		return errors.New("El cambio de curso solo es posible dentro del mismo periodo lectivo")
+	}
+
+	fecha, err := c.validarFecha(tx, mat.ID, fecha)
+	if err != nil {
+		return err
+	}
+	var ultimo domain.CambioCurso
+	if err := tx.Where("matricula_id = ?", mat.ID).Order("fecha DESC, id DESC").Limit(1).Find(&ultimo).Error; err != nil {
+		return err
+	}
+	if ultimo.ID != 0 && fecha < ultimo.Fecha {
+		return fmt.Errorf("La fecha no puede ser anterior al último cambio de curso (%s)", ultimo.Fecha)
+	}
+
+	cambio := domain.CambioCurso{
+		MatriculaID:     mat.ID,
+		CursoAnteriorID: mat.CursoID,
+		CursoNuevoID:    curso.ID,
+		Fecha:           fecha,
+		Motivo:          strings.TrimSpace(motivo),
+		FechaRegistro:   time.Now().Format("2006-01-02 15:04:05"),
+	}
+	cambio.UsuarioID, cambio.NombreUsuario = c.usuarioSesion()
+
+	if err := tx.Model(mat).Update("curso_id", curso.ID).Error; err != nil {
+		return fmt.Errorf("Error al cambiar de curso: %v", err)
+	}
+	mat.CursoID = curso.ID
+
+	if err := tx.Create(&cambio).Error; err != nil {
+		return fmt.Errorf("Error al registrar el cambio de curso: %v", err)
+	}
+	return nil
+}
+
+// CambiarCurso es el pase de un estudiante a otro paralelo o jornada a mitad de año.
+func (s *EnrollmentService) CambiarCurso(input enrollmentDTO.CambiarCursoDTO) error {
+	motivo := strings.TrimSpace(input.Motivo)
+	if motivo == "" {
+		return errors.New("Debe indicar el motivo del cambio de curso")
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var mat domain.Matricula
+		if err := tx.First(&mat, input.MatriculaID).Error; err != nil {
+			return errors.New("Matrícula no encontrada")
+		}
+		if mat.Estado != domain.EstadoMatriculado && mat.Estado != domain.EstadoReingreso {
+			return fmt.Errorf("Solo se puede cambiar de curso una matrícula vigente (estado actual: %s)", mat.Estado)
+		}
+
+		var curso faculty.Curso
+		if err := tx.First(&curso, input.CursoID).Error; err != nil {
+			return errors.New("El curso seleccionado no existe")
+		}
+		if err := s.ciclo.VerificarCupo(tx, curso.ID, mat.ID); err != nil {
+			return err
+		}
+
+		return s.ciclo.CambiarCurso(tx, &mat, curso, input.Fecha, motivo)
+	})
+}
+
+func (s *EnrollmentService) ObtenerCambiosCurso(matriculaID uint) ([]enrollmentDTO.CambioCursoDTO, error) {
+	var cambios []enrollmentDTO.CambioCursoDTO
+	err := s.db.Raw(`
+		SELECT cc.id, cc.matricula_id,
+			nea.nombre || ' ' || ca.paralelo || ' (' || ca.jornada || ')' as curso_anterior,
+			nen.nombre || ' ' || cn.paralelo || ' (' || cn.jornada || ')' as curso_nuevo,
+			cc.fecha, cc.motivo, cc.nombre_usuario, cc.fecha_registro
+		FROM cambios_curso cc
+		JOIN cursos ca ON ca.id = cc.curso_anterior_id
+		JOIN nivel_educativos nea ON nea.id = ca.nivel_id
+		JOIN cursos cn ON cn.id = cc.curso_nuevo_id
+		JOIN nivel_educativos nen ON nen.id = cn.nivel_id
+		WHERE cc.matricula_id = ?
+		ORDER BY cc.fecha ASC, cc.id ASC`, matriculaID).Scan(&cambios).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener cambios de curso: %v", err)
+	}
+	return cambios, nil
+}
@@ -3,6 +3,9 @@ package services
 import (
 	"context"
 	enrollmentDTO "dece/internal/application/dtos/enrollment"
+	identity "dece/internal/application/helpers/identity"
+	consentSvc "dece/internal/application/services/consent"
+	managementSvc "dece/internal/application/services/management"
 	"dece/internal/domain/common"
 	domain "dece/internal/domain/enrollment"
 	"dece/internal/domain/faculty"
@@ -22,12 +25,14 @@ import (
 )
 
 type EnrollmentService struct {
-	db  *gorm.DB
-	ctx context.Context
+	db         *gorm.DB
+	ctx        context.Context
+	ciclo      *CicloMatricula
+	management *managementSvc.ManagementService
 }
 
-func NewEnrollmentService(db *gorm.DB) *EnrollmentService {
-	return &EnrollmentService{db: db}
+func NewEnrollmentService(db *gorm.DB, ciclo *CicloMatricula, managementService *managementSvc.ManagementService) *EnrollmentService {
+	return &EnrollmentService{db: db, ciclo: ciclo, management: managementService}
 }
 
 func (s *EnrollmentService) SetContext(ctx context.Context) {
@@ -166,6 +171,7 @@ func (s *EnrollmentService) ObtenerMatriculaActual(estudianteID uint) (*enrollme
 			DatosSociales:      matricula.DatosSociales.Data,
 			CondicionGenero:    matricula.CondicionGenero.Data,
 			DireccionActual:    matricula.DireccionActual,
+			Ubicacion:          matricula.Ubicacion.Data,
 			RutaCroquis:        matricula.RutaCroquis,
 			RutaConsentimiento: matricula.RutaConsentimiento,
 			Estado:             matricula.Estado,
@@ -176,6 +182,18 @@ func (s *EnrollmentService) ObtenerMatriculaActual(estudianteID uint) (*enrollme
 }
 
 func (s *EnrollmentService) GuardarMatricula(input enrollmentDTO.GuardarMatriculaDTO) (*domain.Matricula, error) {
+	if padres := input.CondicionGenero.DetallePadresPareja; padres != nil && strings.TrimSpace(padres.Cedula) != "" {
+		if err := identity.ValidarIdentificacion(padres.Cedula); err != nil {
+			return nil, fmt.Errorf("Padres de la pareja: %v", err)
+		}
+	}
+
+	if err := normalizarDatosSalud(&input.DatosSalud); err != nil {
+		return nil, err
+	}
+	if err := normalizarUbicacion(&input.Ubicacion); err != nil {
+		return nil, err
+	}
 
 	var est student.Estudiante
 	if err := s.db.Select("cedula").First(&est, input.EstudianteID).Error; err != nil {
@@ -183,6 +201,19 @@ func (s *EnrollmentService) GuardarMatricula(input enrollmentDTO.GuardarMatricul
 	}
 
 	if input.DatosSalud.TieneEvalPsicopedagogica && input.DatosSalud.RutaEvalPsicopedagogica != "" {
+		// Solo una evaluación nueva exige la autorización; la ya archivada en la matrícula se conserva
+		var rutaEvalAnterior string
+		if input.ID > 0 {
+			var anterior domain.Matricula
+			if err := s.db.Select("datos_salud").First(&anterior, input.ID).Error; err == nil {
+				rutaEvalAnterior = anterior.DatosSalud.Data.RutaEvalPsicopedagogica
+			}
+		}
+		if input.DatosSalud.RutaEvalPsicopedagogica != rutaEvalAnterior {
+			if err := consentSvc.Exigir(s.db, input.EstudianteID, student.ConsentimientoEvaluacion, "adjuntar la evaluación psicopedagógica"); err != nil {
+				return nil, err
+			}
+		}
 		newPath, err := s.guardarArchivo(
 			input.DatosSalud.RutaEvalPsicopedagogica,
 			"DocumentosEstudiantes",
@@ -251,24 +282,65 @@ func (s *EnrollmentService) GuardarMatricula(input enrollmentDTO.GuardarMatricul
 		CondicionGenero:    common.JSONMap[domain.CondicionGenero]{Data: input.CondicionGenero},
 
 		DireccionActual:    input.DireccionActual,
+		Ubicacion:          common.JSONMap[domain.UbicacionDomicilio]{Data: input.Ubicacion},
 		RutaCroquis:        input.RutaCroquis,
 		RutaConsentimiento: input.RutaConsentimiento,
 	}
 
 	if mat.ID == 0 {
-		mat.Estado = "Matriculado"
+		mat.Estado = domain.EstadoMatriculado
 		mat.FechaRegistro = time.Now().Format("2006-01-02 15:04:05")
 
-	} else {
-		var matAnterior domain.Matricula
-		s.db.Select("estado", "fecha_registro").First(&matAnterior, mat.ID)
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(&mat).Error; err != nil {
+				return fmt.Errorf("Error al procesar la matrícula: %v", err)
+			}
+			if err := s.ciclo.Alta(tx, &mat, "Matrícula registrada"); err != nil {
+				return err
+			}
+			if err := s.ciclo.registrarMedicionFicha(tx, &mat, nil); err != nil {
+				return err
+			}
+			return s.ciclo.abrirProtocolos(tx, &mat)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &mat, nil
+	}
+
+	// El estado solo cambia por CambiarEstadoMatricula y el curso por CambiarCurso, que dejan
+	// historial
+	var matAnterior domain.Matricula
+	if err := s.db.First(&matAnterior, mat.ID).Error; err != nil {
+		return nil, errors.New("Matrícula no encontrada")
+	}
 
-		mat.Estado = matAnterior.Estado
-		mat.FechaRegistro = matAnterior.FechaRegistro
+	if mat.CursoID != matAnterior.CursoID {
+		return nil, errors.New("Para mover al estudiante a otro curso use el cambio de curso, que conserva el historial")
 	}
 
-	if err := s.db.Save(&mat).Error; err != nil {
-		return nil, fmt.Errorf("Error al procesar la matrícula: %v", err)
+	mat.Estado = matAnterior.Estado
+	mat.FechaRegistro = matAnterior.FechaRegistro
+
+	// Los bloques de la ficha se reemplazan completos; las versiones conservan lo anterior
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.ciclo.versionBase(tx, &matAnterior); err != nil {
+			return err
+		}
+		if err := tx.Save(&mat).Error; err != nil {
+			return fmt.Errorf("Error al procesar la matrícula: %v", err)
+		}
+		if err := s.ciclo.registrarMedicionFicha(tx, &mat, &matAnterior.Antropometria.Data); err != nil {
+			return err
+		}
+		if err := s.ciclo.abrirProtocolos(tx, &mat); err != nil {
+			return err
+		}
+		return s.ciclo.VersionarFicha(tx, &mat)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &mat, nil
@@ -310,15 +382,11 @@ func (s *EnrollmentService) ObtenerHistorial(estudianteID uint) ([]enrollmentDTO
 }
 
 func (s *EnrollmentService) RetirarEstudiante(matriculaID uint, motivo string) error {
-	var matricula domain.Matricula
-	if err := s.db.First(&matricula, matriculaID).Error; err != nil {
-		return errors.New("Matrícula no encontrada")
-	}
-	matricula.Estado = "Retirado"
-	if err := s.db.Save(&matricula).Error; err != nil {
-		return fmt.Errorf("Error al retirar estudiante: %v", err)
-	}
-	return nil
+	return s.CambiarEstadoMatricula(enrollmentDTO.CambiarEstadoMatriculaDTO{
+		MatriculaID: matriculaID,
+		Estado:      domain.EstadoRetirado,
+		Motivo:      motivo,
+	})
 }
 
 func (s *EnrollmentService) RegistrarRetiroCompleto(matriculaID uint, fecha string, motivo string, nuevaInstitucion string, provinciaDestino string, observaciones string) error {
@@ -328,13 +396,17 @@ func (s *EnrollmentService) RegistrarRetiroCompleto(matriculaID uint, fecha stri
 			return errors.New("Matrícula no encontrada")
 		}
 
-		if matricula.Estado == "Retirado" {
+		if matricula.Estado == domain.EstadoRetirado || matricula.Estado == domain.EstadoTrasladado {
 			return errors.New("El estudiante ya se encuentra retirado")
 		}
 
-		matricula.Estado = "Retirado"
-		if err := tx.Save(&matricula).Error; err != nil {
-			return fmt.Errorf("Error al actualizar estado de matrícula: %v", err)
+		// Con institución de destino el retiro es un traslado
+		estado := domain.EstadoRetirado
+		if strings.TrimSpace(nuevaInstitucion) != "" {
+			estado = domain.EstadoTrasladado
+		}
+		if err := s.ciclo.Transicionar(tx, &matricula, estado, fecha, motivo, ""); err != nil {
+			return err
 		}
 
 		retiro := domain.RetiroEstudiante{
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"database/sql"
+	enrollmentDTO "dece/internal/application/dtos/enrollment"
+	domain "dece/internal/domain/enrollment"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// VersionarFicha registra una versión de cada bloque de la ficha que cambió desde su última
+// versión. Se llama después de crear o guardar la matrícula, dentro de la misma transacción.
+func (c *CicloMatricula) VersionarFicha(tx *gorm.DB, mat *domain.Matricula) error {
+	usuarioID, nombreUsuario := c.usuarioSesion()
+	return c.versionar(tx, mat, false, usuarioID, nombreUsuario, time.Now().Format("2006-01-02 15:04:05"))
+}
+
+// ReasignarPareja cambia la pareja registrada en la condición de género de las matrículas ids
+// que aún apuntan a deID para que apunten a aID, y versiona la ficha de cada una. La usan la
+// fusión de fichas duplicadas y su reversión.
+func (c *CicloMatricula) ReasignarPareja(tx *gorm.DB, ids []uint, deID uint, aID uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	var matriculas []domain.Matricula
+	if err := tx.Where("id IN ?", ids).Find(&matriculas).Error; err != nil {
+		return fmt.Errorf("Error al obtener las matrículas con pareja: %v", err)
+	}
+	for i := range matriculas {
+		mat := &matriculas[i]
+		if mat.CondicionGenero.Data.ParejaID != deID {
+			continue
+		}
+		if err := c.versionBase(tx, mat); err != nil {
+			return err
+		}
+		mat.CondicionGenero.Data.ParejaID = aID
+		if err := tx.Model(mat).Update("condicion_genero", mat.CondicionGenero).Error; err != nil {
+			return fmt.Errorf("Error al actualizar la pareja de la matrícula: %v", err)
+		}
+		if err := c.VersionarFicha(tx, mat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// versionBase guarda como primera versión el contenido de los bloques que aún no tienen
+// historial, fechada al registro de la matrícula. Cubre las matrículas creadas antes de que
+// existiera el versionado, para que su primera edición no pierda lo que había.
+func (c *CicloMatricula) versionBase(tx *gorm.DB, mat *domain.Matricula) error {
+	return c.versionar(tx, mat, true, 0, "", mat.FechaRegistro)
+}
+
+func (c *CicloMatricula) versionar(tx *gorm.DB, mat *domain.Matricula, soloSinHistorial bool, usuarioID uint, nombreUsuario string, fechaRegistro string) error {
+	contenido, err := mat.ContenidoBloques()
+	if err != nil {
+		return err
+	}
+
+	var ultimas []domain.VersionFicha
+	err = tx.Where(`matricula_id = ? AND version = (
+		SELECT MAX(v.version) FROM versiones_ficha v
+		WHERE v.matricula_id = versiones_ficha.matricula_id AND v.bloque = versiones_ficha.bloque)`, mat.ID).
+		Find(&ultimas).Error
+	if err != nil {
+		return fmt.Errorf("Error al obtener las versiones de la ficha: %v", err)
+	}
+	porBloque := map[string]domain.VersionFicha{}
+	for _, v := range ultimas {
+		porBloque[v.Bloque] = v
+	}
+
+	for _, bloque := range domain.BloquesFicha {
+		ultima, existe := porBloque[bloque]
+		if existe && (soloSinHistorial || ultima.Contenido == contenido[bloque]) {
+			continue
+		}
+
+		cambios, err := domain.DiferenciasBloque(ultima.Contenido, contenido[bloque])
+		if err != nil {
+			return err
+		}
+		version := domain.VersionFicha{
+			MatriculaID:   mat.ID,
+			Bloque:        bloque,
+			Version:       ultima.Version + 1,
+			Contenido:     contenido[bloque],
+			UsuarioID:     usuarioID,
+			NombreUsuario: nombreUsuario,
+			FechaRegistro: fechaRegistro,
+		}
+		version.Cambios.Data = cambios
+		if err := tx.Create(&version).Error; err != nil {
+			return fmt.Errorf("Error al registrar la versión de la ficha: %v", err)
+		}
+	}
+	return nil
+}
+
+// ObtenerVersionesFicha lista las versiones de la ficha de la matrícula con sus diferencias,
+// de la más reciente a la más antigua. bloque vacío trae todos los bloques.
+func (s *EnrollmentService) ObtenerVersionesFicha(matriculaID uint, bloque string) ([]enrollmentDTO.VersionFichaDTO, error) {
+	q := s.db.Where("matricula_id = ?", matriculaID)
+	if bloque != "" {
+		if !domain.EsBloqueFicha(bloque) {
+			return nil, fmt.Errorf("Bloque de la ficha desconocido: %s", bloque)
+		}
+		q = q.Where("bloque = ?", bloque)
+	}
+
+	var versiones []domain.VersionFicha
+	if err := q.Order("fecha_registro DESC, id DESC").Find(&versiones).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener las versiones de la ficha: %v", err)
+	}
+
+	response := make([]enrollmentDTO.VersionFichaDTO, len(versiones))
+	for i, v := range versiones {
+		cambios := v.Cambios.Data
+		if cambios == nil {
+			cambios = []domain.CambioCampo{}
+		}
+		response[i] = enrollmentDTO.VersionFichaDTO{
+			ID:            v.ID,
+			Bloque:        v.Bloque,
+			Version:       v.Version,
+			Cambios:       cambios,
+			NombreUsuario: v.NombreUsuario,
+			FechaRegistro: v.FechaRegistro,
+		}
+	}
+	return response, nil
+}
+
+// ObtenerFichaEnFecha reconstruye la ficha DECE de la matrícula con la última versión de cada
+// bloque registrada hasta el final de la fecha (AAAA-MM-DD).
+func (s *EnrollmentService) ObtenerFichaEnFecha(matriculaID uint, fecha string) (*enrollmentDTO.FichaEnFechaDTO, error) {
+	fecha = strings.TrimSpace(fecha)
+	if _, err := time.Parse("2006-01-02", fecha); err != nil {
+		return nil, errors.New("La fecha es inválida, use el formato AAAA-MM-DD")
+	}
+
+	var mat domain.Matricula
+	if err := s.db.First(&mat, matriculaID).Error; err != nil {
+		return nil, errors.New("Matrícula no encontrada")
+	}
+	if len(mat.FechaRegistro) >= 10 && fecha < mat.FechaRegistro[:10] {
+		return nil, fmt.Errorf("La matrícula se registró el %s; no hay ficha en esa fecha", mat.FechaRegistro)
+	}
+
+	actual, err := mat.ContenidoBloques()
+	if err != nil {
+		return nil, err
+	}
+
+	// Los bloques se leen sobre una matrícula vacía para no heredar datos del contenido actual
+	var enFecha domain.Matricula
+	destinos := map[string]sql.Scanner{
+		domain.BloqueAntropometria:      &enFecha.Antropometria,
+		domain.BloqueHistorialAcademico: &enFecha.HistorialAcademico,
+		domain.BloqueDatosSalud:         &enFecha.DatosSalud,
+		domain.BloqueDatosSociales:      &enFecha.DatosSociales,
+		domain.BloqueCondicionGenero:    &enFecha.CondicionGenero,
+	}
+	versiones := map[string]int{}
+
+	for _, bloque := range domain.BloquesFicha {
+		var version domain.VersionFicha
+		err := s.db.Where("matricula_id = ? AND bloque = ? AND substr(fecha_registro, 1, 10) <= ?", mat.ID, bloque, fecha).
+			Order("version DESC").Limit(1).Find(&version).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error al obtener la versión de la ficha: %v", err)
+		}
+
+		contenido := actual[bloque]
+		if version.ID != 0 {
+			contenido = version.Contenido
+		}
+		if err := destinos[bloque].Scan(contenido); err != nil {
+			return nil, fmt.Errorf("Error al leer la versión %d de %s: %v", version.Version, bloque, err)
+		}
+		versiones[bloque] = version.Version
+	}
+
+	return &enrollmentDTO.FichaEnFechaDTO{
+		MatriculaID:        mat.ID,
+		Fecha:              fecha,
+		Antropometria:      enFecha.Antropometria.Data,
+		HistorialAcademico: enFecha.HistorialAcademico.Data,
+		DatosSalud:         enFecha.DatosSalud.Data,
+		DatosSociales:      enFecha.DatosSociales.Data,
+		CondicionGenero:    enFecha.CondicionGenero.Data,
+		Versiones:          versiones,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	domain "dece/internal/domain/enrollment"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// normalizarDatosSalud limpia las listas de salud, descarta las filas vacías y valida lo mínimo
+// para que la tarjeta de emergencia sea útil.
+func normalizarDatosSalud(d *domain.DatosSalud) error {
+	alergias := make([]domain.Alergia, 0, len(d.Alergias))
+	for _, a := range d.Alergias {
+		a.Alergeno = strings.TrimSpace(a.Alergeno)
+		a.Reaccion = strings.TrimSpace(a.Reaccion)
+		a.Actuacion = strings.TrimSpace(a.Actuacion)
+		if a.Alergeno == "" && a.Reaccion == "" && a.Actuacion == "" {
+			continue
+		}
+		if a.Alergeno == "" {
+			return errors.New("Indique a qué es alérgico el estudiante")
+		}
+		if !esSeveridadAlergia(a.Severidad) {
+			return fmt.Errorf("Indique la severidad de la alergia a %s", a.Alergeno)
+		}
+		alergias = append(alergias, a)
+	}
+	d.Alergias = alergias
+
+	condiciones := make([]domain.CondicionCronica, 0, len(d.CondicionesCronicas))
+	for _, c := range d.CondicionesCronicas {
+		c.Nombre = strings.TrimSpace(c.Nombre)
+		c.Actuacion = strings.TrimSpace(c.Actuacion)
+		if c.Nombre == "" {
+			if c.Actuacion != "" {
+				return errors.New("Indique el nombre de la condición crónica")
+			}
+			continue
+		}
+		condiciones = append(condiciones, c)
+	}
+	d.CondicionesCronicas = condiciones
+
+	medicaciones := make([]domain.Medicacion, 0, len(d.Medicaciones))
+	for _, m := range d.Medicaciones {
+		m.Medicamento = strings.TrimSpace(m.Medicamento)
+		m.Dosis = strings.TrimSpace(m.Dosis)
+		m.Frecuencia = strings.TrimSpace(m.Frecuencia)
+		if m.Medicamento == "" && m.Dosis == "" && m.Frecuencia == "" {
+			continue
+		}
+		if m.Medicamento == "" || m.Dosis == "" {
+			return errors.New("Cada medicación debe indicar el medicamento y la dosis")
+		}
+		medicaciones = append(medicaciones, m)
+	}
+	d.Medicaciones = medicaciones
+
+	contactos := make([]domain.ContactoEmergencia, 0, len(d.ContactosEmergencia))
+	for _, c := range d.ContactosEmergencia {
+		c.Nombres = strings.TrimSpace(c.Nombres)
+		c.Parentesco = strings.TrimSpace(c.Parentesco)
+		c.Telefono = strings.TrimSpace(c.Telefono)
+		if c.Nombres == "" && c.Telefono == "" {
+			continue
+		}
+		if c.Nombres == "" || c.Telefono == "" {
+			return errors.New("Cada contacto de emergencia debe tener nombre y teléfono")
+		}
+		contactos = append(contactos, c)
+	}
+	d.ContactosEmergencia = contactos
+
+	if d.SeguroMedico.TieneSeguro {
+		d.SeguroMedico.Aseguradora = strings.TrimSpace(d.SeguroMedico.Aseguradora)
+		d.SeguroMedico.NumeroPoliza = strings.TrimSpace(d.SeguroMedico.NumeroPoliza)
+		d.SeguroMedico.Telefono = strings.TrimSpace(d.SeguroMedico.Telefono)
+		if d.SeguroMedico.Aseguradora == "" {
+			return errors.New("Indique la aseguradora del seguro médico")
+		}
+	} else {
+		d.SeguroMedico = domain.SeguroMedico{}
+	}
+	return nil
+}
+
+func esSeveridadAlergia(severidad string) bool {
+	for _, s := range domain.SeveridadesAlergia {
+		if s == severidad {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	enrollmentDTO "dece/internal/application/dtos/enrollment"
+	securitySvc "dece/internal/application/services/security"
+	domain "dece/internal/domain/enrollment"
+	"dece/internal/domain/student"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CicloMatricula registra las transiciones de estado de las matrículas. Lo usan todos los
+// servicios que crean o cambian matrículas (ficha, importación, transferencias); no se expone
+// al frontend.
+type CicloMatricula struct {
+	auth *securitySvc.AuthService
+}
+
+func NewCicloMatricula(auth *securitySvc.AuthService) *CicloMatricula {
+	return &CicloMatricula{auth: auth}
+}
+
+// Alta deja la matrícula recién creada en estado Matriculado con su primera transición y la
+// primera versión de su ficha. Falla si el curso ya no tiene cupo.
+func (c *CicloMatricula) Alta(tx *gorm.DB, mat *domain.Matricula, motivo string) error {
+	if err := c.VerificarCupo(tx, mat.CursoID, mat.ID); err != nil {
+		return err
+	}
+	if mat.Estado == "" {
+		mat.Estado = domain.EstadoMatriculado
+		if err := tx.Model(mat).Update("estado", mat.Estado).Error; err != nil {
+			return err
+		}
+	}
+	if err := c.registrar(tx, mat.ID, "", mat.Estado, time.Now().Format("2006-01-02"), motivo, ""); err != nil {
+		return err
+	}
+	return c.VersionarFicha(tx, mat)
+}
+
+// Transicionar valida y aplica el cambio de estado. La fecha no puede ser futura ni anterior al
+// último cambio, para que la consulta "matriculados a una fecha" sea consistente.
+func (c *CicloMatricula) Transicionar(tx *gorm.DB, mat *domain.Matricula, nuevo string, fecha string, motivo string, rutaDocumento string) error {
+	if !domain.EsEstadoMatricula(nuevo) {
+		return fmt.Errorf("Estado de matrícula desconocido: %s", nuevo)
+	}
+	actual := mat.Estado
+	if actual == "" {
+		actual = domain.EstadoMatriculado
+	}
+	if !domain.PuedeTransicionar(actual, nuevo) {
+		return fmt.Errorf("No se puede pasar de '%s' a '%s'", actual, nuevo)
+	}
+	// El reingreso vuelve a ocupar un cupo que se liberó con el retiro
+	if nuevo == domain.EstadoReingreso {
+		if err := c.VerificarCupo(tx, mat.CursoID, mat.ID); err != nil {
+			return err
+		}
+	}
+
+	fecha, err := c.validarFecha(tx, mat.ID, fecha)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Model(mat).Update("estado", nuevo).Error; err != nil {
+		return fmt.Errorf("Error al actualizar estado de matrícula: %v", err)
+	}
+	mat.Estado = nuevo
+
+	return c.registrar(tx, mat.ID, actual, nuevo, fecha, strings.TrimSpace(motivo), rutaDocumento)
+}
+
+// validarFecha normaliza la fecha de un cambio de estado o de curso (vacía es hoy) y comprueba
+// que no sea futura ni anterior a la última transición de la matrícula.
+func (c *CicloMatricula) validarFecha(tx *gorm.DB, matriculaID uint, fecha string) (string, error) {
+	fecha = strings.TrimSpace(fecha)
+	if fecha == "" {
+		fecha = time.Now().Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", fecha); err != nil {
+		return "", errors.New("La fecha es inválida, use el formato AAAA-MM-DD")
+	}
+	if fecha > time.Now().Format("2006-01-02") {
+		return "", errors.New("La fecha no puede ser futura")
+	}
+
+	var ultima domain.TransicionMatricula
+	if err := tx.Where("matricula_id = ?", matriculaID).Order("fecha DESC, id DESC").Limit(1).Find(&ultima).Error; err != nil {
+		return "", err
+	}
+	if ultima.ID != 0 && fecha < ultima.Fecha {
+		return "", fmt.Errorf("La fecha no puede ser anterior al último cambio de estado (%s)", ultima.Fecha)
+	}
+	return fecha, nil
+}
+
+func (c *CicloMatricula) registrar(tx *gorm.DB, matriculaID uint, anterior, nuevo, fecha, motivo, rutaDocumento string) error {
+	transicion := domain.TransicionMatricula{
+		MatriculaID:    matriculaID,
+		EstadoAnterior: anterior,
+		EstadoNuevo:    nuevo,
+		Fecha:          fecha,
+		Motivo:         motivo,
+		RutaDocumento:  rutaDocumento,
+		FechaRegistro:  time.Now().Format("2006-01-02 15:04:05"),
+	}
+	transicion.UsuarioID, transicion.NombreUsuario = c.usuarioSesion()
+	if err := tx.Create(&transicion).Error; err != nil {
+		return fmt.Errorf("Error al registrar el cambio de estado: %v", err)
+	}
+	return nil
+}
+
+func (c *CicloMatricula) usuarioSesion() (uint, string) {
+	if c == nil || c.auth == nil {
+		return 0, ""
+	}
+	usuario, err := c.auth.ObtenerUsuarioSesion()
+	if err != nil {
+		return 0, ""
+	}
+	return usuario.ID, usuario.NombreCompleto
+}
+
+// CambiarEstadoMatricula aplica una transición del ciclo de vida (retiro, traslado, deserción,
+// promoción, reingreso) con su motivo y documento de respaldo.
+func (s *EnrollmentService) CambiarEstadoMatricula(input enrollmentDTO.CambiarEstadoMatriculaDTO) error {
+	motivo := strings.TrimSpace(input.Motivo)
+	if motivo == "" && input.Estado != domain.EstadoPromovido && input.Estado != domain.EstadoNoPromovido {
+		return errors.New("Debe indicar el motivo del cambio de estado")
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var mat domain.Matricula
+		if err := tx.First(&mat, input.MatriculaID).Error; err != nil {
+			return errors.New("Matrícula no encontrada")
+		}
+
+		rutaDocumento := ""
+		if input.RutaDocumento != "" {
+			var est student.Estudiante
+			tx.Select("cedula").First(&est, mat.EstudianteID)
+			ruta, err := s.guardarArchivo(input.RutaDocumento, "DocumentosEstudiantes", fmt.Sprintf("%s_estado", est.Cedula))
+			if err != nil {
+				return err
+			}
+			rutaDocumento = ruta
+		}
+
+		return s.ciclo.Transicionar(tx, &mat, input.Estado, input.Fecha, motivo, rutaDocumento)
+	})
+}
+
+func (s *EnrollmentService) ObtenerTransicionesMatricula(matriculaID uint) ([]domain.TransicionMatricula, error) {
+	var transiciones []domain.TransicionMatricula
+	if err := s.db.Where("matricula_id = ?", matriculaID).Order("fecha ASC, id ASC").Find(&transiciones).Error; err != nil {
+		return nil, err
+	}
+	return transiciones, nil
+}
+
+// ObtenerEstadosPermitidos indica al frontend qué cambios ofrecer para la matrícula.
+func (s *EnrollmentService) ObtenerEstadosPermitidos(matriculaID uint) ([]string, error) {
+	var mat domain.Matricula
+	if err := s.db.Select("id", "estado").First(&mat, matriculaID).Error; err != nil {
+		return nil, errors.New("Matrícula no encontrada")
+	}
+	return domain.TransicionesPermitidas(mat.Estado), nil
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	domain "dece/internal/domain/enrollment"
+	"errors"
+	"strings"
+)
+
+// normalizarUbicacion limpia la parroquia y el barrio y valida las coordenadas. Las
+// coordenadas son opcionales, pero se registran juntas: una sola no ubica la vivienda.
+func normalizarUbicacion(u *domain.UbicacionDomicilio) error {
+	u.Parroquia = strings.TrimSpace(u.Parroquia)
+	u.BarrioRecinto = strings.TrimSpace(u.BarrioRecinto)
+
+	if u.Latitud == nil && u.Longitud == nil {
+		return nil
+	}
+	if !u.TieneCoordenadas() {
+		return errors.New("Indique la latitud y la longitud de la vivienda, o deje ambas vacías")
+	}
+	if *u.Latitud < -90 || *u.Latitud > 90 {
+		return errors.New("La latitud debe estar entre -90 y 90")
+	}
+	if *u.Longitud < -180 || *u.Longitud > 180 {
+		return errors.New("La longitud debe estar entre -180 y 180")
+	}
+	// 0,0 es lo que queda cuando el GPS o la hoja de cálculo no tenían el dato
+	if *u.Latitud == 0 && *u.Longitud == 0 {
+		return errors.New("Las coordenadas 0, 0 no corresponden a una vivienda; deje los campos vacíos si no las conoce")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	domain "dece/internal/domain/enrollment"
+	"dece/internal/domain/tracking"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// abrirProtocolos abre con su plan de hitos el protocolo de embarazo, maternidad o paternidad
+// que la ficha marca y el estudiante aún no tiene abierto, aunque venga de otra matrícula. Quitar
+// la marca no cierra el protocolo; lo cierra el DECE con su motivo.
+func (c *CicloMatricula) abrirProtocolos(tx *gorm.DB, mat *domain.Matricula) error {
+	tipos := tracking.ProtocolosRequeridos(mat.CondicionGenero.Data)
+	if len(tipos) == 0 {
+		return nil
+	}
+
+	ahora := time.Now()
+	for _, tipo := range tipos {
+		var abiertos int64
+		tx.Model(&tracking.ProtocoloMaternidad{}).
+			Joins("JOIN matriculas m ON m.id = protocolos_maternidad.matricula_id").
+			Where("m.estudiante_id = ? AND protocolos_maternidad.tipo = ? AND protocolos_maternidad.estado = ?", mat.EstudianteID, tipo, tracking.EstadoProtocoloAbierto).
+			Count(&abiertos)
+		if abiertos > 0 {
+			continue
+		}
+
+		protocolo := tracking.ProtocoloMaternidad{
+			MatriculaID:   mat.ID,
+			Tipo:          tipo,
+			Estado:        tracking.EstadoProtocoloAbierto,
+			Origen:        "Ficha DECE",
+			FechaApertura: ahora.Format("2006-01-02"),
+			Hitos:         tracking.PlanProtocolo(tipo, mat.CondicionGenero.Data, ahora),
+		}
+		protocolo.UsuarioID, protocolo.NombreUsuario = c.usuarioSesion()
+		if err := tx.Create(&protocolo).Error; err != nil {
+			return fmt.Errorf("Error al abrir el protocolo de %s: %v", tipo, err)
+		}
+	}
+	return nil
+}
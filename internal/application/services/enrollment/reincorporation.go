@@ -0,0 +1,266 @@
+package services
+
+import (
+	enrollmentDTO "dece/internal/application/dtos/enrollment"
+	managementDTO "dece/internal/application/dtos/management"
+	"dece/internal/domain/academic"
+	domain "dece/internal/domain/enrollment"
+	"dece/internal/domain/faculty"
+	"dece/internal/domain/student"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ReincorporarEstudiante devuelve a clases a un estudiante retirado, trasladado o desertor. Si
+// el curso elegido es del mismo periodo se reabre la matrícula (cambiando de curso si hace
+// falta); si es de otro periodo se crea una matrícula nueva con los datos de la ficha anterior.
+// El retiro queda como historial y, si se pide, se agenda la convocatoria de seguimiento.
+func (s *EnrollmentService) ReincorporarEstudiante(input enrollmentDTO.ReincorporarEstudianteDTO) (*domain.Reincorporacion, error) {
+	motivo := strings.TrimSpace(input.Motivo)
+	if motivo == "" {
+		return nil, errors.New("Debe indicar el motivo de la reincorporación")
+	}
+	if input.Seguimiento != nil {
+		if strings.TrimSpace(input.Seguimiento.Entidad) == "" || strings.TrimSpace(input.Seguimiento.FechaCita) == "" {
+			return nil, errors.New("Indique la entidad y la fecha de la convocatoria de seguimiento")
+		}
+		if s.management == nil {
+			return nil, errors.New("No se puede agendar el seguimiento en este momento")
+		}
+	}
+
+	var reincorporacion domain.Reincorporacion
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var anterior domain.Matricula
+		if err := tx.First(&anterior, input.MatriculaID).Error; err != nil {
+			return errors.New("Matrícula no encontrada")
+		}
+		if !domain.PuedeTransicionar(anterior.Estado, domain.EstadoReingreso) {
+			return fmt.Errorf("Solo se puede reincorporar una matrícula retirada, trasladada o desertora (estado actual: %s)", anterior.Estado)
+		}
+
+		cursoID := input.CursoID
+		if cursoID == 0 {
+			cursoID = anterior.CursoID
+		}
+		var curso faculty.Curso
+		if err := tx.First(&curso, cursoID).Error; err != nil {
+			return errors.New("El curso seleccionado no existe")
+		}
+		var periodo academic.PeriodoLectivo
+		if err := tx.First(&periodo, curso.PeriodoID).Error; err != nil || !periodo.EsActivo {
+			return errors.New("Solo se puede reincorporar en un curso del periodo lectivo activo")
+		}
+
+		var cursoAnterior faculty.Curso
+		tx.Select("id", "periodo_id").First(&cursoAnterior, anterior.CursoID)
+
+		var est student.Estudiante
+		tx.Select("cedula").First(&est, anterior.EstudianteID)
+
+		rutaDocumento := ""
+		if input.RutaDocumento != "" {
+			ruta, err := s.guardarArchivo(input.RutaDocumento, "DocumentosEstudiantes", fmt.Sprintf("%s_reincorporacion", est.Cedula))
+			if err != nil {
+				return err
+			}
+			rutaDocumento = ruta
+		}
+
+		reincorporacion = domain.Reincorporacion{
+			MatriculaAnteriorID: anterior.ID,
+			CursoAnteriorID:     anterior.CursoID,
+			CursoID:             curso.ID,
+			EstadoAnterior:      anterior.Estado,
+			Motivo:              motivo,
+			Compromisos:         strings.TrimSpace(input.Compromisos),
+			RutaDocumento:       rutaDocumento,
+			FechaRegistro:       time.Now().Format("2006-01-02 15:04:05"),
+		}
+
+		var retiro domain.RetiroEstudiante
+		if err := tx.Where("matricula_id = ?", anterior.ID).Order("id DESC").Limit(1).Find(&retiro).Error; err != nil {
+			return err
+		}
+		if retiro.ID != 0 {
+			reincorporacion.RetiroID = &retiro.ID
+		}
+
+		if curso.PeriodoID == cursoAnterior.PeriodoID {
+			fecha, err := s.ciclo.validarFecha(tx, anterior.ID, input.Fecha)
+			if err != nil {
+				return err
+			}
+			// El cambio de curso rige desde la misma fecha del reingreso, que verifica el cupo
+			// del curso de destino
+			if curso.ID != anterior.CursoID {
+				if err := s.ciclo.CambiarCurso(tx, &anterior, curso, fecha, "Reincorporación: "+motivo); err != nil {
+					return err
+				}
+			}
+			if err := s.ciclo.Transicionar(tx, &anterior, domain.EstadoReingreso, fecha, motivo, rutaDocumento); err != nil {
+				return err
+			}
+			reincorporacion.MatriculaID = anterior.ID
+		} else {
+			nueva, err := s.matriculaReingreso(tx, anterior, curso, input.Fecha, motivo, rutaDocumento)
+			if err != nil {
+				return err
+			}
+			reincorporacion.MatriculaID = nueva.ID
+		}
+
+		var ultima domain.TransicionMatricula
+		tx.Where("matricula_id = ?", reincorporacion.MatriculaID).Order("id DESC").Limit(1).Find(&ultima)
+		reincorporacion.Fecha = ultima.Fecha
+		reincorporacion.UsuarioID = ultima.UsuarioID
+		reincorporacion.NombreUsuario = ultima.NombreUsuario
+
+		if err := tx.Create(&reincorporacion).Error; err != nil {
+			return fmt.Errorf("Error al registrar la reincorporación: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Seguimiento != nil {
+		motivoCita := strings.TrimSpace(input.Seguimiento.Motivo)
+		if motivoCita == "" {
+			motivoCita = fmt.Sprintf("Seguimiento de reincorporación: %s", motivo)
+		}
+		cita, err := s.management.AgendarCita(managementDTO.AgendarCitaDTO{
+			MatriculaID: reincorporacion.MatriculaID,
+			Entidad:     input.Seguimiento.Entidad,
+			Motivo:      motivoCita,
+			FechaCita:   input.Seguimiento.FechaCita,
+			DiasAlerta:  input.Seguimiento.DiasAlerta,
+		})
+		if err != nil {
+			return &reincorporacion, fmt.Errorf("Estudiante reincorporado pero error al agendar el seguimiento: %v", err)
+		}
+		reincorporacion.ConvocatoriaID = &cita.ID
+		if err := s.db.Model(&reincorporacion).Update("convocatoria_id", cita.ID).Error; err != nil {
+			return &reincorporacion, fmt.Errorf("Seguimiento agendado pero error al actualizar la reincorporación: %v", err)
+		}
+	}
+
+	return &reincorporacion, nil
+}
+
+// matriculaReingreso crea la matrícula del nuevo periodo copiando la ficha de la anterior. Nace
+// en estado Reingreso para distinguirla de una matrícula ordinaria.
+func (s *EnrollmentService) matriculaReingreso(tx *gorm.DB, anterior domain.Matricula, curso faculty.Curso, fecha string, motivo string, rutaDocumento string) (*domain.Matricula, error) {
+	var count int64
+	tx.Table("matriculas").
+		Joins("JOIN cursos c ON c.id = matriculas.curso_id").
+		Where("matriculas.estudiante_id = ? AND c.periodo_id = ?", anterior.EstudianteID, curso.PeriodoID).
+		Count(&count)
+	if count > 0 {
+		return nil, errors.New("El estudiante ya se encuentra matriculado en este periodo lectivo")
+	}
+
+	// La fecha no puede ser anterior al retiro de la matrícula que se reabre
+	fecha, err := s.ciclo.validarFecha(tx, anterior.ID, fecha)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ciclo.VerificarCupo(tx, curso.ID, 0); err != nil {
+		return nil, err
+	}
+
+	nueva := domain.Matricula{
+		EstudianteID:       anterior.EstudianteID,
+		CursoID:            curso.ID,
+		Estado:             domain.EstadoReingreso,
+		Antropometria:      anterior.Antropometria,
+		HistorialAcademico: anterior.HistorialAcademico,
+		DatosSalud:         anterior.DatosSalud,
+		DatosSociales:      anterior.DatosSociales,
+		CondicionGenero:    anterior.CondicionGenero,
+		DireccionActual:    anterior.DireccionActual,
+		Ubicacion:          anterior.Ubicacion,
+		RutaCroquis:        anterior.RutaCroquis,
+		RutaConsentimiento: anterior.RutaConsentimiento,
+		FechaRegistro:      time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if err := tx.Create(&nueva).Error; err != nil {
+		return nil, fmt.Errorf("Error al crear la matrícula de reingreso: %v", err)
+	}
+	if err := s.ciclo.registrar(tx, nueva.ID, "", domain.EstadoReingreso, fecha, motivo, rutaDocumento); err != nil {
+		return nil, err
+	}
+	if err := s.ciclo.VersionarFicha(tx, &nueva); err != nil {
+		return nil, err
+	}
+	if err := s.ciclo.abrirProtocolos(tx, &nueva); err != nil {
+		return nil, err
+	}
+	return &nueva, nil
+}
+
+// ObtenerReincorporaciones lista las reincorporaciones del estudiante con el retiro que las
+// precedió, de la más reciente a la más antigua.
+func (s *EnrollmentService) ObtenerReincorporaciones(estudianteID uint) ([]enrollmentDTO.ReincorporacionDTO, error) {
+	var filas []struct {
+		domain.Reincorporacion
+		PeriodoLectivo   string
+		CursoAnterior    string
+		Curso            string
+		FechaRetiro      string
+		MotivoRetiro     string
+		FechaSeguimiento string
+	}
+
+	err := s.db.Raw(`
+		SELECT r.*,
+			pl.nombre as periodo_lectivo,
+			COALESCE(nea.nombre || ' ' || ca.paralelo, '') as curso_anterior,
+			ne.nombre || ' ' || c.paralelo as curso,
+			COALESCE(ret.fecha_retiro, '') as fecha_retiro,
+			COALESCE(ret.motivo, '') as motivo_retiro,
+			COALESCE(cv.fecha_cita, '') as fecha_seguimiento
+		FROM reincorporaciones r
+		JOIN matriculas m ON m.id = r.matricula_id
+		JOIN cursos c ON c.id = r.curso_id
+		JOIN nivel_educativos ne ON ne.id = c.nivel_id
+		JOIN periodo_lectivos pl ON pl.id = c.periodo_id
+		LEFT JOIN cursos ca ON ca.id = r.curso_anterior_id
+		LEFT JOIN nivel_educativos nea ON nea.id = ca.nivel_id
+		LEFT JOIN retiro_estudiantes ret ON ret.id = r.retiro_id
+		LEFT JOIN convocatoria cv ON cv.id = r.convocatoria_id
+		WHERE m.estudiante_id = ?
+		ORDER BY r.fecha DESC, r.id DESC`, estudianteID).Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener reincorporaciones: %v", err)
+	}
+
+	response := make([]enrollmentDTO.ReincorporacionDTO, len(filas))
+	for i, f := range filas {
+		response[i] = enrollmentDTO.ReincorporacionDTO{
+			ID:               f.ID,
+			MatriculaID:      f.MatriculaID,
+			PeriodoLectivo:   f.PeriodoLectivo,
+			CursoAnterior:    f.CursoAnterior,
+			Curso:            f.Curso,
+			EstadoAnterior:   f.EstadoAnterior,
+			FechaRetiro:      f.FechaRetiro,
+			MotivoRetiro:     f.MotivoRetiro,
+			Fecha:            f.Fecha,
+			Motivo:           f.Motivo,
+			Compromisos:      f.Compromisos,
+			RutaDocumento:    f.RutaDocumento,
+			ConvocatoriaID:   f.ConvocatoriaID,
+			FechaSeguimiento: f.FechaSeguimiento,
+			NombreUsuario:    f.NombreUsuario,
+		}
+	}
+	return response, nil
+}
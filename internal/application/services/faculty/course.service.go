@@ -2,10 +2,14 @@ package services
 
 import (
 	courseDTO "dece/internal/application/dtos/faculty"
+	queryDTO "dece/internal/application/dtos/query"
+	queryHelper "dece/internal/application/helpers/query"
 	"dece/internal/domain/academic"
+	"dece/internal/domain/enrollment"
 	"dece/internal/domain/faculty"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"gorm.io/gorm"
@@ -19,22 +23,87 @@ func NewCourseService(db *gorm.DB) *CourseService {
 	return &CourseService{db: db}
 }
 
+var especificacionCursos = queryHelper.Especificacion{
+	Campos: map[string]queryHelper.Campo{
+		"periodo_id": {Columna: "cursos.periodo_id", Tipo: queryHelper.CampoNumero},
+		"nivel_id":   {Columna: "cursos.nivel_id", Tipo: queryHelper.CampoNumero},
+		"tutor_id":   {Columna: "cursos.tutor_id", Tipo: queryHelper.CampoNumero},
+		"nivel":      {Columna: "nivel_educativos.orden", Tipo: queryHelper.CampoNumero, Ordenable: true},
+		"paralelo":   {Columna: "cursos.paralelo", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"jornada":    {Columna: "cursos.jornada", Tipo: queryHelper.CampoTexto, Ordenable: true},
+	},
+	Busqueda:        []string{"nivel_educativos.nombre", "cursos.paralelo", "cursos.jornada"},
+	OrdenPorDefecto: "nivel",
+	Desempate:       "cursos.paralelo, cursos.id",
+}
+
+// ListarCursos devuelve todos los cursos del periodo; lo usan los selectores de matrícula y
+// distributivo, que necesitan la lista completa.
 func (s *CourseService) ListarCursos(periodoID uint) ([]courseDTO.CursoResponseDTO, error) {
 	var cursos []faculty.Curso
 
-	result := s.db.
-		Preload("Nivel").
-		Preload("Tutor").
-		Joins("JOIN nivel_educativos ON nivel_educativos.id = cursos.nivel_id").
-		Where("cursos.periodo_id = ?", periodoID).
-		Order("nivel_educativos.orden ASC").
-		Order("cursos.paralelo ASC").
-		Find(&cursos)
+	consulta := queryDTO.ConsultaDTO{
+		Filtros: []queryDTO.FiltroDTO{{Campo: "periodo_id", Operador: queryDTO.OperadorIgual, Valor: strconv.FormatUint(uint64(periodoID), 10)}},
+	}
+	query, err := queryHelper.Filtrar(s.consultaBase(), especificacionCursos, consulta)
+	if err != nil {
+		return nil, err
+	}
+	if query, err = queryHelper.Ordenar(query, especificacionCursos, consulta); err != nil {
+		return nil, err
+	}
+	if err := query.Find(&cursos).Error; err != nil {
+		return nil, err
+	}
+
+	return mapearCursos(cursos, s.contarVigentes(cursos)), nil
+}
+
+func (s *CourseService) ConsultarCursos(consulta queryDTO.ConsultaDTO) (*courseDTO.CursosPaginadosDTO, error) {
+	var cursos []faculty.Curso
+
+	paginacion, err := queryHelper.Paginar(s.consultaBase(), especificacionCursos, consulta, &cursos)
+	if err != nil {
+		return nil, err
+	}
+
+	return &courseDTO.CursosPaginadosDTO{Items: mapearCursos(cursos, s.contarVigentes(cursos)), Paginacion: paginacion}, nil
+}
+
+// contarVigentes cuenta en una sola consulta los estudiantes que ocupan cupo en cada curso.
+func (s *CourseService) contarVigentes(cursos []faculty.Curso) map[uint]int {
+	conteo := map[uint]int{}
+	if len(cursos) == 0 {
+		return conteo
+	}
+	ids := make([]uint, len(cursos))
+	for i, c := range cursos {
+		ids[i] = c.ID
+	}
 
-	if result.Error != nil {
-		return nil, result.Error
+	var filas []struct {
+		CursoID uint
+		Total   int
 	}
+	s.db.Table("matriculas").
+		Select("curso_id, COUNT(*) as total").
+		Where("curso_id IN ? AND estado IN ?", ids, enrollment.EstadosVigentes).
+		Group("curso_id").
+		Scan(&filas)
+	for _, f := range filas {
+		conteo[f.CursoID] = f.Total
+	}
+	return conteo
+}
+
+func (s *CourseService) consultaBase() *gorm.DB {
+	return s.db.Model(&faculty.Curso{}).
+		Preload("Nivel").
+		Preload("Tutor").
+		Joins("JOIN nivel_educativos ON nivel_educativos.id = cursos.nivel_id")
+}
 
+func mapearCursos(cursos []faculty.Curso, vigentes map[uint]int) []courseDTO.CursoResponseDTO {
 	response := make([]courseDTO.CursoResponseDTO, len(cursos))
 	for i, c := range cursos {
 		tutorNombre := "Sin Tutor Asignado"
@@ -54,15 +123,22 @@ func (s *CourseService) ListarCursos(periodoID uint) ([]courseDTO.CursoResponseD
 			NombreCompleto: nombreFull,
 			NivelID:        c.NivelID,
 			TutorID:        c.TutorID,
+
+			Capacidad:         c.Capacidad,
+			CapacidadEfectiva: c.CapacidadEfectiva(),
+			Matriculados:      vigentes[c.ID],
 		}
 	}
-
-	return response, nil
+	return response
 }
 
 func (s *CourseService) CrearCurso(input courseDTO.GuardarCursoDTO) error {
 	paralelo := strings.ToUpper(strings.TrimSpace(input.Paralelo))
 
+	if input.Capacidad < 0 {
+		return errors.New("La capacidad del curso no puede ser negativa")
+	}
+
 	var count int64
 	s.db.Model(&faculty.Curso{}).
 		Where("periodo_id = ? AND nivel_id = ? AND paralelo = ? AND jornada = ?",
@@ -90,6 +166,7 @@ func (s *CourseService) CrearCurso(input courseDTO.GuardarCursoDTO) error {
 		Paralelo:  paralelo,
 		Jornada:   input.Jornada,
 		TutorID:   input.TutorID,
+		Capacidad: input.Capacidad,
 	}
 
 	if err := s.db.Create(&nuevoCurso).Error; err != nil {
@@ -130,10 +207,22 @@ func (s *CourseService) ActualizarCurso(input courseDTO.GuardarCursoDTO) error {
 		}
 	}
 
+	if input.Capacidad < 0 {
+		return errors.New("La capacidad del curso no puede ser negativa")
+	}
+	if input.Capacidad > 0 {
+		var vigentes int64
+		s.db.Table("matriculas").Where("curso_id = ? AND estado IN ?", curso.ID, enrollment.EstadosVigentes).Count(&vigentes)
+		if int64(input.Capacidad) < vigentes {
+			return fmt.Errorf("El curso ya tiene %d estudiantes; la capacidad no puede ser menor", vigentes)
+		}
+	}
+
 	curso.NivelID = input.NivelID
 	curso.Paralelo = paralelo
 	curso.Jornada = input.Jornada
 	curso.TutorID = input.TutorID
+	curso.Capacidad = input.Capacidad
 
 	return s.db.Save(&curso).Error
 }
@@ -2,6 +2,9 @@ package services
 
 import (
 	teacherDTO "dece/internal/application/dtos/faculty"
+	queryDTO "dece/internal/application/dtos/query"
+	identity "dece/internal/application/helpers/identity"
+	queryHelper "dece/internal/application/helpers/query"
 	"dece/internal/domain/faculty"
 	"errors"
 	"fmt"
@@ -18,21 +21,53 @@ func NewTeacherService(db *gorm.DB) *TeacherService {
 	return &TeacherService{db: db}
 }
 
+var especificacionDocentes = queryHelper.Especificacion{
+	Campos: map[string]queryHelper.Campo{
+		"cedula":            {Columna: "cedula", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"nombres_completos": {Columna: "nombres_completos", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"correo":            {Columna: "correo", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"activo":            {Columna: "activo", Tipo: queryHelper.CampoBooleano, Ordenable: true},
+	},
+	Busqueda:        []string{"cedula", "nombres_completos", "correo"},
+	OrdenPorDefecto: "nombres_completos",
+	Desempate:       "id",
+}
+
+// ListarDocentes devuelve la nómina completa para los selectores (tutores, distributivo).
 func (s *TeacherService) ListarDocentes(soloActivos bool) ([]teacherDTO.DocenteDTO, error) {
 	var docentes []faculty.Docente
-	var result *gorm.DB
-
-	query := s.db.Order("nombres_completos asc")
 
+	consulta := queryDTO.ConsultaDTO{}
 	if soloActivos {
-		query = query.Where("activo = ?", true)
+		consulta.Filtros = []queryDTO.FiltroDTO{{Campo: "activo", Operador: queryDTO.OperadorIgual, Valor: "true"}}
+	}
+
+	query, err := queryHelper.Filtrar(s.db.Model(&faculty.Docente{}), especificacionDocentes, consulta)
+	if err != nil {
+		return nil, err
 	}
+	if query, err = queryHelper.Ordenar(query, especificacionDocentes, consulta); err != nil {
+		return nil, err
+	}
+	if err := query.Find(&docentes).Error; err != nil {
+		return nil, err
+	}
+
+	return mapearDocentes(docentes), nil
+}
 
-	result = query.Find(&docentes)
-	if result.Error != nil {
-		return nil, result.Error
+func (s *TeacherService) ConsultarDocentes(consulta queryDTO.ConsultaDTO) (*teacherDTO.DocentesPaginadosDTO, error) {
+	var docentes []faculty.Docente
+
+	paginacion, err := queryHelper.Paginar(s.db.Model(&faculty.Docente{}), especificacionDocentes, consulta, &docentes)
+	if err != nil {
+		return nil, err
 	}
 
+	return &teacherDTO.DocentesPaginadosDTO{Items: mapearDocentes(docentes), Paginacion: paginacion}, nil
+}
+
+func mapearDocentes(docentes []faculty.Docente) []teacherDTO.DocenteDTO {
 	response := make([]teacherDTO.DocenteDTO, len(docentes))
 	for i, d := range docentes {
 		response[i] = teacherDTO.DocenteDTO{
@@ -44,12 +79,14 @@ func (s *TeacherService) ListarDocentes(soloActivos bool) ([]teacherDTO.DocenteD
 			Activo:           d.Activo,
 		}
 	}
-
-	return response, nil
+	return response
 }
 
 func (s *TeacherService) CrearDocente(input teacherDTO.GuardarDocenteDTO) error {
 	cedulaLimpia := strings.TrimSpace(input.Cedula)
+	if err := identity.ValidarIdentificacion(cedulaLimpia); err != nil {
+		return err
+	}
 
 	var count int64
 	s.db.Model(&faculty.Docente{}).Where("cedula = ?", cedulaLimpia).Count(&count)
@@ -80,6 +117,9 @@ func (s *TeacherService) ActualizarDocente(input teacherDTO.GuardarDocenteDTO) e
 	}
 
 	cedulaLimpia := strings.TrimSpace(input.Cedula)
+	if err := identity.ValidarIdentificacion(cedulaLimpia); err != nil {
+		return err
+	}
 
 	var count int64
 	s.db.Model(&faculty.Docente{}).
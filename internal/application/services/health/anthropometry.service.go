@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	dtos "dece/internal/application/dtos/health"
+	growth "dece/internal/application/helpers/growth"
+	documentSvc "dece/internal/application/services/documents"
+	securitySvc "dece/internal/application/services/security"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/health"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type HealthService struct {
+	db        *gorm.DB
+	ctx       context.Context
+	auth      *securitySvc.AuthService
+	versiones *documentSvc.Versionador
+}
+
+func NewHealthService(db *gorm.DB, auth *securitySvc.AuthService, versiones *documentSvc.Versionador) *HealthService {
+	return &HealthService{db: db, auth: auth, versiones: versiones}
+}
+
+func (s *HealthService) SetContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+type filaMedicion struct {
+	health.MedicionAntropometrica
+	PeriodoLectivo   string
+	Curso            string
+	FechaNacimiento  string
+	GeneroNacimiento string
+}
+
+const consultaMediciones = `
+	SELECT ma.*, pl.nombre as periodo_lectivo, ne.nombre || ' ' || c.paralelo as curso,
+		e.fecha_nacimiento, e.genero_nacimiento
+	FROM mediciones_antropometricas ma
+	JOIN matriculas m ON ma.matricula_id = m.id
+	JOIN estudiantes e ON m.estudiante_id = e.id
+	JOIN cursos c ON m.curso_id = c.id
+	JOIN nivel_educativos ne ON c.nivel_id = ne.id
+	JOIN periodo_lectivos pl ON c.periodo_id = pl.id`
+
+// RegistrarMedicion guarda una toma de peso y talla de la matrícula y devuelve su evaluación
+// con la referencia de IMC para la edad.
+func (s *HealthService) RegistrarMedicion(datos dtos.RegistrarMedicionDTO) (*dtos.MedicionDTO, error) {
+	datos.Fecha = strings.TrimSpace(datos.Fecha)
+	if datos.Fecha == "" {
+		datos.Fecha = time.Now().Format("2006-01-02")
+	}
+	fecha, err := time.Parse("2006-01-02", datos.Fecha)
+	if err != nil {
+		return nil, errors.New("La fecha de la medición es inválida, use el formato AAAA-MM-DD")
+	}
+	if fecha.After(time.Now()) {
+		return nil, errors.New("La fecha de la medición no puede ser futura")
+	}
+	if err := growth.ValidarMedicion(datos.PesoKg, datos.TallaCm); err != nil {
+		return nil, err
+	}
+
+	var mat enrollment.Matricula
+	if err := s.db.Preload("Estudiante").First(&mat, datos.MatriculaID).Error; err != nil {
+		return nil, errors.New("Matrícula no encontrada")
+	}
+	if _, err := growth.EdadEnMeses(mat.Estudiante.FechaNacimiento, datos.Fecha); err != nil {
+		return nil, err
+	}
+
+	medicion := health.MedicionAntropometrica{
+		MatriculaID:   mat.ID,
+		Fecha:         datos.Fecha,
+		PesoKg:        datos.PesoKg,
+		TallaCm:       datos.TallaCm,
+		IMC:           growth.CalcularIMC(datos.PesoKg, datos.TallaCm),
+		Observacion:   strings.TrimSpace(datos.Observacion),
+		FechaRegistro: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if usuario, err := s.auth.ObtenerUsuarioSesion(); err == nil {
+		medicion.UsuarioID = usuario.ID
+		medicion.NombreUsuario = usuario.NombreCompleto
+	}
+	if err := s.db.Create(&medicion).Error; err != nil {
+		return nil, fmt.Errorf("Error al registrar la medición: %v", err)
+	}
+
+	mediciones, err := s.listar("ma.id = ?", medicion.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &mediciones[0], nil
+}
+
+// ObtenerMediciones devuelve el historial de mediciones del estudiante en todas sus
+// matrículas, de la más antigua a la más reciente.
+func (s *HealthService) ObtenerMediciones(estudianteID uint) ([]dtos.MedicionDTO, error) {
+	return s.listar("m.estudiante_id = ?", estudianteID)
+}
+
+func (s *HealthService) EliminarMedicion(id uint) error {
+	result := s.db.Delete(&health.MedicionAntropometrica{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("Error al eliminar la medición: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("Medición no encontrada")
+	}
+	return nil
+}
+
+func (s *HealthService) listar(condicion string, valor any) ([]dtos.MedicionDTO, error) {
+	var filas []filaMedicion
+	err := s.db.Raw(consultaMediciones+" WHERE "+condicion+" ORDER BY ma.fecha, ma.id", valor).Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener las mediciones: %v", err)
+	}
+
+	response := make([]dtos.MedicionDTO, len(filas))
+	for i, f := range filas {
+		evaluacion, _ := growth.EvaluarIMC(f.IMC, f.FechaNacimiento, f.Fecha, f.GeneroNacimiento)
+		response[i] = dtos.MedicionDTO{
+			ID:             f.ID,
+			MatriculaID:    f.MatriculaID,
+			PeriodoLectivo: f.PeriodoLectivo,
+			Curso:          f.Curso,
+			Fecha:          f.Fecha,
+			PesoKg:         f.PesoKg,
+			TallaCm:        f.TallaCm,
+			IMC:            f.IMC,
+			EdadMeses:      evaluacion.EdadMeses,
+			Edad:           growth.TextoEdad(evaluacion.EdadMeses),
+			ZScoreIMC:      evaluacion.ZScore,
+			Clasificacion:  evaluacion.Clasificacion,
+			Observacion:    f.Observacion,
+			NombreUsuario:  f.NombreUsuario,
+		}
+	}
+	return response, nil
+}
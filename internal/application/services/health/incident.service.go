@@ -0,0 +1,494 @@
+package services
+
+import (
+	dtos "dece/internal/application/dtos/health"
+	"dece/internal/domain/academic"
+	"dece/internal/domain/common"
+	"dece/internal/domain/documents"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/health"
+	"dece/internal/domain/tracking"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var nombresMes = []string{
+	"", "Enero", "Febrero", "Marzo", "Abril", "Mayo", "Junio",
+	"Julio", "Agosto", "Septiembre", "Octubre", "Noviembre", "Diciembre",
+}
+
+type filaIncidente struct {
+	health.IncidenteEnfermeria
+	EstudianteID   uint
+	Cedula         string
+	Estudiante     string
+	Curso          string
+	PeriodoLectivo string
+	MotivoLlamado  string
+}
+
+// El curso es el de la fecha del incidente, no el actual, por si el estudiante cambió de paralelo
+var consultaIncidentes = `
+	SELECT ie.*, e.id as estudiante_id, e.cedula, e.apellidos || ' ' || e.nombres as estudiante,
+		ne.nombre || ' ' || c.paralelo as curso, pl.nombre as periodo_lectivo,
+		COALESCE(la.motivo, '') as motivo_llamado
+	FROM incidentes_enfermeria ie
+	JOIN matriculas m ON ie.matricula_id = m.id
+	JOIN estudiantes e ON m.estudiante_id = e.id
+	JOIN cursos c ON c.id = ` + enrollment.CursoEnFechaSQL("m", "ie.fecha") + `
+	JOIN nivel_educativos ne ON c.nivel_id = ne.id
+	JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+	LEFT JOIN llamados_atencion la ON la.id = ie.llamado_atencion_id`
+
+func (s *HealthService) ListarCatalogosIncidente() dtos.CatalogosIncidenteDTO {
+	return dtos.CatalogosIncidenteDTO{
+		Tipos:              health.TiposIncidente,
+		Gravedades:         health.GravedadesIncidente,
+		Lugares:            health.LugaresIncidente,
+		MediosNotificacion: health.MediosNotificacion,
+	}
+}
+
+// GuardarIncidente registra o corrige una atención de enfermería. Si se vincula a un llamado
+// de atención, este debe ser de la misma matrícula.
+func (s *HealthService) GuardarIncidente(datos dtos.GuardarIncidenteDTO) (*dtos.IncidenteDTO, error) {
+	fecha, err := time.Parse("2006-01-02", strings.TrimSpace(datos.Fecha))
+	if err != nil {
+		return nil, errors.New("La fecha del incidente es inválida, use el formato AAAA-MM-DD")
+	}
+	if fecha.After(time.Now()) {
+		return nil, errors.New("La fecha del incidente no puede ser futura")
+	}
+	if _, err := time.Parse("15:04", strings.TrimSpace(datos.Hora)); err != nil {
+		return nil, errors.New("La hora del incidente es inválida, use el formato HH:MM")
+	}
+	if !contiene(health.TiposIncidente, datos.Tipo) {
+		return nil, errors.New("Seleccione un tipo de incidente válido")
+	}
+	if !contiene(health.GravedadesIncidente, datos.Gravedad) {
+		return nil, errors.New("Seleccione la gravedad del incidente")
+	}
+	if strings.TrimSpace(datos.Lugar) == "" {
+		return nil, errors.New("El lugar del incidente es obligatorio")
+	}
+	if strings.TrimSpace(datos.Descripcion) == "" {
+		return nil, errors.New("La descripción del incidente es obligatoria")
+	}
+	if datos.RepresentanteNotificado && strings.TrimSpace(datos.HoraNotificacion) != "" {
+		if _, err := time.Parse("15:04", strings.TrimSpace(datos.HoraNotificacion)); err != nil {
+			return nil, errors.New("La hora de notificación es inválida, use el formato HH:MM")
+		}
+	}
+	if datos.DerivadoCentroSalud && strings.TrimSpace(datos.CentroSalud) == "" {
+		return nil, errors.New("Indique el centro de salud al que se derivó al estudiante")
+	}
+
+	var mat enrollment.Matricula
+	if err := s.db.First(&mat, datos.MatriculaID).Error; err != nil {
+		return nil, errors.New("Matrícula no encontrada")
+	}
+	var llamadoID *uint
+	if datos.LlamadoAtencionID > 0 {
+		var llamado tracking.LlamadoAtencion
+		if err := s.db.First(&llamado, datos.LlamadoAtencionID).Error; err != nil {
+			return nil, errors.New("Llamado de atención no encontrado")
+		}
+		if llamado.MatriculaID != mat.ID {
+			return nil, errors.New("El llamado de atención no pertenece a la matrícula del estudiante")
+		}
+		llamadoID = &llamado.ID
+	}
+
+	incidente := health.IncidenteEnfermeria{
+		Adjuntos: common.JSONMap[[]tracking.Evidencia]{Data: []tracking.Evidencia{}},
+	}
+	if datos.ID > 0 {
+		if err := s.db.First(&incidente, datos.ID).Error; err != nil {
+			return nil, errors.New("Incidente no encontrado")
+		}
+		if incidente.MatriculaID != mat.ID {
+			return nil, errors.New("El incidente pertenece a otra matrícula")
+		}
+	} else {
+		incidente.FechaRegistro = time.Now().Format("2006-01-02 15:04:05")
+		if usuario, err := s.auth.ObtenerUsuarioSesion(); err == nil {
+			incidente.UsuarioID = usuario.ID
+			incidente.NombreUsuario = usuario.NombreCompleto
+		}
+	}
+
+	incidente.MatriculaID = mat.ID
+	incidente.LlamadoAtencionID = llamadoID
+	incidente.Fecha = fecha.Format("2006-01-02")
+	incidente.Hora = strings.TrimSpace(datos.Hora)
+	incidente.Lugar = strings.TrimSpace(datos.Lugar)
+	incidente.Tipo = datos.Tipo
+	incidente.Gravedad = datos.Gravedad
+	incidente.Descripcion = strings.TrimSpace(datos.Descripcion)
+	incidente.PrimerosAuxilios = strings.TrimSpace(datos.PrimerosAuxilios)
+	incidente.AtendidoPor = strings.TrimSpace(datos.AtendidoPor)
+	incidente.RepresentanteNotificado = datos.RepresentanteNotificado
+	incidente.MedioNotificacion, incidente.HoraNotificacion, incidente.PersonaNotificada = "", "", ""
+	if datos.RepresentanteNotificado {
+		incidente.MedioNotificacion = strings.TrimSpace(datos.MedioNotificacion)
+		incidente.HoraNotificacion = strings.TrimSpace(datos.HoraNotificacion)
+		incidente.PersonaNotificada = strings.TrimSpace(datos.PersonaNotificada)
+	}
+	incidente.DerivadoCentroSalud = datos.DerivadoCentroSalud
+	incidente.CentroSalud, incidente.Traslado = "", ""
+	if datos.DerivadoCentroSalud {
+		incidente.CentroSalud = strings.TrimSpace(datos.CentroSalud)
+		incidente.Traslado = strings.TrimSpace(datos.Traslado)
+	}
+
+	if err := s.db.Save(&incidente).Error; err != nil {
+		return nil, fmt.Errorf("Error al guardar el incidente: %v", err)
+	}
+
+	incidentes, err := s.listarIncidentes("ie.id = ?", incidente.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &incidentes[0], nil
+}
+
+// ListarIncidentes devuelve los incidentes del estudiante en todas sus matrículas, del más
+// reciente al más antiguo.
+func (s *HealthService) ListarIncidentes(estudianteID uint) ([]dtos.IncidenteDTO, error) {
+	return s.listarIncidentes("m.estudiante_id = ?", estudianteID)
+}
+
+// ListarIncidentesPorFecha devuelve el registro de la enfermería entre dos fechas (AAAA-MM-DD).
+func (s *HealthService) ListarIncidentesPorFecha(desde, hasta string) ([]dtos.IncidenteDTO, error) {
+	if _, err := time.Parse("2006-01-02", desde); err != nil {
+		return nil, errors.New("La fecha inicial es inválida, use el formato AAAA-MM-DD")
+	}
+	if _, err := time.Parse("2006-01-02", hasta); err != nil {
+		return nil, errors.New("La fecha final es inválida, use el formato AAAA-MM-DD")
+	}
+	if desde > hasta {
+		return nil, errors.New("La fecha inicial es posterior a la final")
+	}
+	return s.listarIncidentes("ie.fecha BETWEEN ? AND ?", desde, hasta)
+}
+
+// EliminarIncidente borra el registro; sus adjuntos se conservan en el historial de versiones.
+func (s *HealthService) EliminarIncidente(id uint) error {
+	var incidente health.IncidenteEnfermeria
+	if err := s.db.First(&incidente, id).Error; err != nil {
+		return errors.New("Incidente no encontrado")
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, a := range incidente.Adjuntos.Data {
+			if err := s.versiones.Retirar(tx, documents.EntidadIncidente, incidente.ID, a.Ruta); err != nil {
+				return err
+			}
+		}
+		if err := tx.Delete(&incidente).Error; err != nil {
+			return fmt.Errorf("Error al eliminar el incidente: %v", err)
+		}
+		return nil
+	})
+}
+
+func (s *HealthService) SubirAdjuntoIncidente(incidenteID uint, rutaOrigen string, nombre string) (string, error) {
+	var incidente health.IncidenteEnfermeria
+	if err := s.db.First(&incidente, incidenteID).Error; err != nil {
+		return "", errors.New("Incidente no encontrado")
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.New("Error de sistema de archivos")
+	}
+
+	destinoDir := filepath.Join(homeDir, "Documents", "SistemaDECE", "Incidentes", fmt.Sprintf("INC-%d", incidente.ID))
+	if err := os.MkdirAll(destinoDir, 0755); err != nil {
+		return "", fmt.Errorf("Error al crear carpeta: %v", err)
+	}
+
+	ext := filepath.Ext(rutaOrigen)
+	if ext == "" {
+		ext = ".pdf"
+	}
+
+	safeName := nombre
+	if safeName == "" {
+		safeName = fmt.Sprintf("ADJ_%d", time.Now().UnixMilli())
+	}
+
+	replacer := strings.NewReplacer("<", "", ">", "", ":", "", "\"", "", "/", "", "\\", "", "|", "", "?", "", "*", "")
+	safeName = replacer.Replace(safeName)
+
+	rutaDestinoCompleta := filepath.Join(destinoDir, safeName+ext)
+	if _, err := os.Stat(rutaDestinoCompleta); err == nil {
+		rutaDestinoCompleta = filepath.Join(destinoDir, fmt.Sprintf("%s_%d%s", safeName, time.Now().UnixMilli(), ext))
+	}
+
+	src, err := os.Open(rutaOrigen)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(rutaDestinoCompleta)
+	if err != nil {
+		return "", err
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return "", err
+	}
+
+	lista := incidente.Adjuntos.Data
+	if lista == nil {
+		lista = []tracking.Evidencia{}
+	}
+
+	if nombre == "" {
+		nombre = fmt.Sprintf("Adjunto %d", len(lista)+1)
+	}
+
+	lista = append(lista, tracking.Evidencia{Nombre: nombre, Ruta: rutaDestinoCompleta})
+	incidente.Adjuntos = common.JSONMap[[]tracking.Evidencia]{Data: lista}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&incidente).Error; err != nil {
+			return err
+		}
+		_, err := s.versiones.Registrar(tx, documents.EntidadIncidente, incidente.ID, "adjunto", nombre, rutaDestinoCompleta, false)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("Adjunto guardado pero error al actualizar BD: %v", err)
+	}
+
+	return rutaDestinoCompleta, nil
+}
+
+func (s *HealthService) EliminarAdjuntoIncidente(incidenteID uint, ruta string) error {
+	var incidente health.IncidenteEnfermeria
+	if err := s.db.First(&incidente, incidenteID).Error; err != nil {
+		return errors.New("Incidente no encontrado")
+	}
+
+	nuevaLista := make([]tracking.Evidencia, 0, len(incidente.Adjuntos.Data))
+	for _, a := range incidente.Adjuntos.Data {
+		if a.Ruta != ruta {
+			nuevaLista = append(nuevaLista, a)
+		}
+	}
+
+	incidente.Adjuntos = common.JSONMap[[]tracking.Evidencia]{Data: nuevaLista}
+
+	// El archivo se conserva en el historial de versiones
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.versiones.Retirar(tx, documents.EntidadIncidente, incidente.ID, ruta); err != nil {
+			return err
+		}
+		return tx.Save(&incidente).Error
+	})
+	if err != nil {
+		return fmt.Errorf("Error al actualizar BD: %v", err)
+	}
+
+	return nil
+}
+
+// ObtenerEstadisticaIncidentes cuenta los incidentes del periodo lectivo (0 es el activo) por
+// mes, tipo, gravedad y lugar.
+func (s *HealthService) ObtenerEstadisticaIncidentes(periodoID uint) (*dtos.EstadisticaIncidentesDTO, error) {
+	var periodo academic.PeriodoLectivo
+	query := s.db
+	if periodoID == 0 {
+		query = query.Where("es_activo = ?", true)
+	} else {
+		query = query.Where("id = ?", periodoID)
+	}
+	if err := query.First(&periodo).Error; err != nil {
+		return nil, errors.New("Periodo lectivo no encontrado")
+	}
+
+	var incidentes []health.IncidenteEnfermeria
+	err := s.db.Raw(`
+		SELECT ie.*
+		FROM incidentes_enfermeria ie
+		JOIN matriculas m ON ie.matricula_id = m.id
+		JOIN cursos c ON m.curso_id = c.id
+		WHERE c.periodo_id = ?
+		ORDER BY ie.fecha`, periodo.ID).Scan(&incidentes).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener los incidentes: %v", err)
+	}
+
+	estadistica := &dtos.EstadisticaIncidentesDTO{
+		PeriodoLectivo: periodo.Nombre,
+		Desde:          periodo.FechaInicio,
+		Hasta:          periodo.FechaFin,
+		Meses:          []dtos.MesIncidentesDTO{},
+	}
+
+	meses := map[string]*dtos.MesIncidentesDTO{}
+	tiposPorMes := map[string]map[string]int{}
+	agregarMes := func(clave string) {
+		if _, ok := meses[clave]; ok {
+			return
+		}
+		t, err := time.Parse("2006-01", clave)
+		if err != nil {
+			return
+		}
+		meses[clave] = &dtos.MesIncidentesDTO{Mes: clave, Nombre: fmt.Sprintf("%s %d", nombresMes[t.Month()], t.Year())}
+		tiposPorMes[clave] = map[string]int{}
+	}
+	if inicio, err := time.Parse("2006-01-02", periodo.FechaInicio); err == nil {
+		if fin, err := time.Parse("2006-01-02", periodo.FechaFin); err == nil {
+			for t := inicio.AddDate(0, 0, 1-inicio.Day()); !t.After(fin); t = t.AddDate(0, 1, 0) {
+				agregarMes(t.Format("2006-01"))
+			}
+		}
+	}
+
+	porTipo, porGravedad, porLugar := map[string]int{}, map[string]int{}, map[string]int{}
+	for _, inc := range incidentes {
+		if len(inc.Fecha) < 7 {
+			continue
+		}
+		clave := inc.Fecha[:7]
+		agregarMes(clave)
+		mes, ok := meses[clave]
+		if !ok {
+			continue
+		}
+
+		estadistica.Total++
+		mes.Total++
+		tiposPorMes[clave][inc.Tipo]++
+		porTipo[inc.Tipo]++
+		porGravedad[inc.Gravedad]++
+		porLugar[inc.Lugar]++
+		if inc.Gravedad == health.GravedadIncidenteGrave {
+			mes.Graves++
+		}
+		if inc.RepresentanteNotificado {
+			mes.Notificados++
+			estadistica.Notificados++
+		}
+		if inc.DerivadoCentroSalud {
+			mes.Derivados++
+			estadistica.Derivados++
+		}
+		if inc.LlamadoAtencionID != nil {
+			estadistica.ConDisciplina++
+		}
+	}
+
+	claves := make([]string, 0, len(meses))
+	for clave := range meses {
+		claves = append(claves, clave)
+	}
+	sort.Strings(claves)
+	for _, clave := range claves {
+		mes := meses[clave]
+		mes.PorTipo = conteosIncidente(tiposPorMes[clave], health.TiposIncidente)
+		estadistica.Meses = append(estadistica.Meses, *mes)
+	}
+	estadistica.PorTipo = conteosIncidente(porTipo, health.TiposIncidente)
+	estadistica.PorGravedad = conteosIncidente(porGravedad, health.GravedadesIncidente)
+	estadistica.PorLugar = conteosIncidente(porLugar, nil)
+	return estadistica, nil
+}
+
+// conteosIncidente ordena según el catálogo dado o, sin catálogo, de mayor a menor.
+func conteosIncidente(conteos map[string]int, orden []string) []dtos.ConteoIncidenteDTO {
+	lista := []dtos.ConteoIncidenteDTO{}
+	if orden != nil {
+		for _, nombre := range orden {
+			if n := conteos[nombre]; n > 0 {
+				lista = append(lista, dtos.ConteoIncidenteDTO{Nombre: nombre, Total: n})
+			}
+		}
+		return lista
+	}
+	for nombre, n := range conteos {
+		lista = append(lista, dtos.ConteoIncidenteDTO{Nombre: nombre, Total: n})
+	}
+	sort.Slice(lista, func(i, j int) bool {
+		if lista[i].Total != lista[j].Total {
+			return lista[i].Total > lista[j].Total
+		}
+		return lista[i].Nombre < lista[j].Nombre
+	})
+	return lista
+}
+
+func (s *HealthService) listarIncidentes(condicion string, valores ...any) ([]dtos.IncidenteDTO, error) {
+	var filas []filaIncidente
+	err := s.db.Raw(consultaIncidentes+" WHERE "+condicion+" ORDER BY ie.fecha DESC, ie.hora DESC, ie.id DESC", valores...).Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener los incidentes: %v", err)
+	}
+
+	response := make([]dtos.IncidenteDTO, len(filas))
+	for i, f := range filas {
+		llamadoID := uint(0)
+		if f.LlamadoAtencionID != nil {
+			llamadoID = *f.LlamadoAtencionID
+		}
+		adjuntos := make([]dtos.AdjuntoIncidenteDTO, len(f.Adjuntos.Data))
+		for j, a := range f.Adjuntos.Data {
+			adjuntos[j] = dtos.AdjuntoIncidenteDTO{Nombre: a.Nombre, Ruta: a.Ruta}
+		}
+		response[i] = dtos.IncidenteDTO{
+			GuardarIncidenteDTO: dtos.GuardarIncidenteDTO{
+				ID:                      f.ID,
+				MatriculaID:             f.MatriculaID,
+				LlamadoAtencionID:       llamadoID,
+				Fecha:                   f.Fecha,
+				Hora:                    f.Hora,
+				Lugar:                   f.Lugar,
+				Tipo:                    f.Tipo,
+				Gravedad:                f.Gravedad,
+				Descripcion:             f.Descripcion,
+				PrimerosAuxilios:        f.PrimerosAuxilios,
+				AtendidoPor:             f.AtendidoPor,
+				RepresentanteNotificado: f.RepresentanteNotificado,
+				MedioNotificacion:       f.MedioNotificacion,
+				HoraNotificacion:        f.HoraNotificacion,
+				PersonaNotificada:       f.PersonaNotificada,
+				DerivadoCentroSalud:     f.DerivadoCentroSalud,
+				CentroSalud:             f.CentroSalud,
+				Traslado:                f.Traslado,
+			},
+			EstudianteID:   f.EstudianteID,
+			Cedula:         f.Cedula,
+			Estudiante:     f.Estudiante,
+			Curso:          f.Curso,
+			PeriodoLectivo: f.PeriodoLectivo,
+			MotivoLlamado:  f.MotivoLlamado,
+			Adjuntos:       adjuntos,
+			NombreUsuario:  f.NombreUsuario,
+			FechaRegistro:  f.FechaRegistro,
+		}
+	}
+	return response, nil
+}
+
+func contiene(lista []string, valor string) bool {
+	for _, v := range lista {
+		if v == valor {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,450 @@
+package services
+
+import (
+	dtos "dece/internal/application/dtos/health"
+	growth "dece/internal/application/helpers/growth"
+	"dece/internal/domain/health"
+	"dece/internal/domain/student"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (s *HealthService) ListarVacunas() ([]health.Vacuna, error) {
+	var vacunas []health.Vacuna
+	if err := s.db.Order("nombre").Find(&vacunas).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener las vacunas: %v", err)
+	}
+	return vacunas, nil
+}
+
+func (s *HealthService) GuardarVacuna(datos dtos.GuardarVacunaDTO) (*health.Vacuna, error) {
+	datos.Nombre = strings.TrimSpace(datos.Nombre)
+	if datos.Nombre == "" {
+		return nil, errors.New("El nombre de la vacuna es obligatorio")
+	}
+
+	var existe int64
+	s.db.Model(&health.Vacuna{}).Where("LOWER(nombre) = LOWER(?) AND id <> ?", datos.Nombre, datos.ID).Count(&existe)
+	if existe > 0 {
+		return nil, fmt.Errorf("Ya existe la vacuna %s", datos.Nombre)
+	}
+
+	vacuna := health.Vacuna{ID: datos.ID}
+	if datos.ID > 0 {
+		if err := s.db.First(&vacuna, datos.ID).Error; err != nil {
+			return nil, errors.New("Vacuna no encontrada")
+		}
+	}
+	vacuna.Nombre = datos.Nombre
+	vacuna.Alias = limpiarAlias(datos.Alias)
+	vacuna.Descripcion = strings.TrimSpace(datos.Descripcion)
+	vacuna.Activa = datos.Activa
+
+	if err := s.db.Save(&vacuna).Error; err != nil {
+		return nil, fmt.Errorf("Error al guardar la vacuna: %v", err)
+	}
+	return &vacuna, nil
+}
+
+// EliminarVacuna borra la vacuna y sus dosis del esquema. Si ya hay dosis aplicadas
+// registradas solo puede desactivarse.
+func (s *HealthService) EliminarVacuna(id uint) error {
+	var aplicadas int64
+	s.db.Model(&health.DosisVacuna{}).Where("vacuna_id = ?", id).Count(&aplicadas)
+	if aplicadas > 0 {
+		return fmt.Errorf("La vacuna tiene %d dosis registradas; desactívela en lugar de eliminarla", aplicadas)
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("vacuna_id = ?", id).Delete(&health.EsquemaVacuna{}).Error; err != nil {
+			return fmt.Errorf("Error al eliminar el esquema de la vacuna: %v", err)
+		}
+		result := tx.Delete(&health.Vacuna{}, id)
+		if result.Error != nil {
+			return fmt.Errorf("Error al eliminar la vacuna: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("Vacuna no encontrada")
+		}
+		return nil
+	})
+}
+
+// ListarEsquema devuelve el esquema configurado ordenado por la edad de cada dosis.
+func (s *HealthService) ListarEsquema() ([]health.EsquemaVacuna, error) {
+	var esquema []health.EsquemaVacuna
+	err := s.db.Preload("Vacuna").
+		Joins("JOIN vacunas ON vacunas.id = esquema_vacunas.vacuna_id").
+		Order("esquema_vacunas.edad_meses, vacunas.nombre, esquema_vacunas.numero_dosis").
+		Find(&esquema).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener el esquema de vacunación: %v", err)
+	}
+	return esquema, nil
+}
+
+func (s *HealthService) GuardarEsquemaDosis(datos dtos.GuardarEsquemaDTO) (*health.EsquemaVacuna, error) {
+	if datos.NumeroDosis < 1 {
+		return nil, errors.New("El número de dosis debe ser mayor a cero")
+	}
+	if datos.EdadMeses < 0 || datos.EdadMeses > growth.MesesFinReferencia {
+		return nil, fmt.Errorf("La edad de la dosis debe estar entre 0 y %d meses", growth.MesesFinReferencia)
+	}
+	datos.Sexo = strings.ToUpper(strings.TrimSpace(datos.Sexo))
+	if datos.Sexo != "" && datos.Sexo != "M" && datos.Sexo != "F" {
+		return nil, errors.New("El sexo de la dosis debe ser M, F o vacío para todos")
+	}
+
+	var vacuna health.Vacuna
+	if err := s.db.First(&vacuna, datos.VacunaID).Error; err != nil {
+		return nil, errors.New("Vacuna no encontrada")
+	}
+	var existe int64
+	s.db.Model(&health.EsquemaVacuna{}).
+		Where("vacuna_id = ? AND numero_dosis = ? AND id <> ?", datos.VacunaID, datos.NumeroDosis, datos.ID).
+		Count(&existe)
+	if existe > 0 {
+		return nil, fmt.Errorf("La dosis %d de %s ya está en el esquema", datos.NumeroDosis, vacuna.Nombre)
+	}
+
+	dosis := health.EsquemaVacuna{
+		ID:          datos.ID,
+		VacunaID:    datos.VacunaID,
+		NumeroDosis: datos.NumeroDosis,
+		EdadMeses:   datos.EdadMeses,
+		Sexo:        datos.Sexo,
+		Descripcion: strings.TrimSpace(datos.Descripcion),
+	}
+	if err := s.db.Save(&dosis).Error; err != nil {
+		return nil, fmt.Errorf("Error al guardar la dosis del esquema: %v", err)
+	}
+	dosis.Vacuna = vacuna
+	return &dosis, nil
+}
+
+func (s *HealthService) EliminarEsquemaDosis(id uint) error {
+	result := s.db.Delete(&health.EsquemaVacuna{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("Error al eliminar la dosis del esquema: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("Dosis del esquema no encontrada")
+	}
+	return nil
+}
+
+func (s *HealthService) ListarTiposControl() ([]health.TipoControlMedico, error) {
+	var tipos []health.TipoControlMedico
+	if err := s.db.Order("nombre").Find(&tipos).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener los tipos de control: %v", err)
+	}
+	return tipos, nil
+}
+
+func (s *HealthService) GuardarTipoControl(datos dtos.GuardarTipoControlDTO) (*health.TipoControlMedico, error) {
+	datos.Nombre = strings.TrimSpace(datos.Nombre)
+	if datos.Nombre == "" {
+		return nil, errors.New("El nombre del control es obligatorio")
+	}
+	if datos.PeriodicidadMeses < 0 {
+		return nil, errors.New("La periodicidad no puede ser negativa")
+	}
+
+	var existe int64
+	s.db.Model(&health.TipoControlMedico{}).Where("LOWER(nombre) = LOWER(?) AND id <> ?", datos.Nombre, datos.ID).Count(&existe)
+	if existe > 0 {
+		return nil, fmt.Errorf("Ya existe el control %s", datos.Nombre)
+	}
+
+	tipo := health.TipoControlMedico{
+		ID:                datos.ID,
+		Nombre:            datos.Nombre,
+		Alias:             limpiarAlias(datos.Alias),
+		PeriodicidadMeses: datos.PeriodicidadMeses,
+		Activo:            datos.Activo,
+	}
+	if err := s.db.Save(&tipo).Error; err != nil {
+		return nil, fmt.Errorf("Error al guardar el tipo de control: %v", err)
+	}
+	return &tipo, nil
+}
+
+func (s *HealthService) EliminarTipoControl(id uint) error {
+	var registrados int64
+	s.db.Model(&health.ControlMedico{}).Where("tipo_control_id = ?", id).Count(&registrados)
+	if registrados > 0 {
+		return fmt.Errorf("El control tiene %d registros; desactívelo en lugar de eliminarlo", registrados)
+	}
+	result := s.db.Delete(&health.TipoControlMedico{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("Error al eliminar el tipo de control: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("Tipo de control no encontrado")
+	}
+	return nil
+}
+
+func (s *HealthService) RegistrarDosis(datos dtos.RegistrarDosisDTO) (*health.DosisVacuna, error) {
+	var est student.Estudiante
+	if err := s.db.First(&est, datos.EstudianteID).Error; err != nil {
+		return nil, errors.New("Estudiante no encontrado")
+	}
+	fecha, err := validarFechaSalud(datos.Fecha, est.FechaNacimiento)
+	if err != nil {
+		return nil, err
+	}
+	if datos.NumeroDosis < 1 {
+		return nil, errors.New("El número de dosis debe ser mayor a cero")
+	}
+	var vacuna health.Vacuna
+	if err := s.db.First(&vacuna, datos.VacunaID).Error; err != nil {
+		return nil, errors.New("Vacuna no encontrada")
+	}
+
+	var previa health.DosisVacuna
+	if s.db.Where("estudiante_id = ? AND vacuna_id = ? AND numero_dosis = ?", est.ID, vacuna.ID, datos.NumeroDosis).First(&previa).Error == nil {
+		return nil, fmt.Errorf("La dosis %d de %s ya está registrada con fecha %s", datos.NumeroDosis, vacuna.Nombre, previa.Fecha)
+	}
+
+	dosis := health.DosisVacuna{
+		EstudianteID:    est.ID,
+		VacunaID:        vacuna.ID,
+		NumeroDosis:     datos.NumeroDosis,
+		Fecha:           fecha,
+		Lote:            strings.TrimSpace(datos.Lote),
+		Establecimiento: strings.TrimSpace(datos.Establecimiento),
+		Observacion:     strings.TrimSpace(datos.Observacion),
+		Origen:          health.OrigenManual,
+		FechaRegistro:   time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if usuario, err := s.auth.ObtenerUsuarioSesion(); err == nil {
+		dosis.UsuarioID = usuario.ID
+		dosis.NombreUsuario = usuario.NombreCompleto
+	}
+	if err := s.db.Create(&dosis).Error; err != nil {
+		return nil, fmt.Errorf("Error al registrar la dosis: %v", err)
+	}
+	return &dosis, nil
+}
+
+func (s *HealthService) EliminarDosis(id uint) error {
+	result := s.db.Delete(&health.DosisVacuna{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("Error al eliminar la dosis: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("Dosis no encontrada")
+	}
+	return nil
+}
+
+func (s *HealthService) RegistrarControl(datos dtos.RegistrarControlDTO) (*health.ControlMedico, error) {
+	var est student.Estudiante
+	if err := s.db.First(&est, datos.EstudianteID).Error; err != nil {
+		return nil, errors.New("Estudiante no encontrado")
+	}
+	fecha, err := validarFechaSalud(datos.Fecha, est.FechaNacimiento)
+	if err != nil {
+		return nil, err
+	}
+	var tipo health.TipoControlMedico
+	if err := s.db.First(&tipo, datos.TipoControlID).Error; err != nil {
+		return nil, errors.New("Tipo de control no encontrado")
+	}
+
+	control := health.ControlMedico{
+		EstudianteID:    est.ID,
+		TipoControlID:   tipo.ID,
+		Fecha:           fecha,
+		Profesional:     strings.TrimSpace(datos.Profesional),
+		Establecimiento: strings.TrimSpace(datos.Establecimiento),
+		Resultado:       strings.TrimSpace(datos.Resultado),
+		Observacion:     strings.TrimSpace(datos.Observacion),
+		Origen:          health.OrigenManual,
+		FechaRegistro:   time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if usuario, err := s.auth.ObtenerUsuarioSesion(); err == nil {
+		control.UsuarioID = usuario.ID
+		control.NombreUsuario = usuario.NombreCompleto
+	}
+	if err := s.db.Create(&control).Error; err != nil {
+		return nil, fmt.Errorf("Error al registrar el control: %v", err)
+	}
+	control.TipoControl = tipo
+	return &control, nil
+}
+
+func (s *HealthService) EliminarControl(id uint) error {
+	result := s.db.Delete(&health.ControlMedico{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("Error al eliminar el control: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("Control no encontrado")
+	}
+	return nil
+}
+
+// ObtenerCarnetVacunacion compara las dosis registradas del estudiante con el esquema de las
+// vacunas activas para su edad y sexo, y revisa que los controles activos estén al día.
+func (s *HealthService) ObtenerCarnetVacunacion(estudianteID uint) (*dtos.CarnetVacunacionDTO, error) {
+	var est student.Estudiante
+	if err := s.db.First(&est, estudianteID).Error; err != nil {
+		return nil, errors.New("Estudiante no encontrado")
+	}
+	hoy := time.Now().Format("2006-01-02")
+
+	carnet := &dtos.CarnetVacunacionDTO{
+		EstudianteID:    est.ID,
+		Cedula:          est.Cedula,
+		Estudiante:      est.Apellidos + " " + est.Nombres,
+		FechaNacimiento: est.FechaNacimiento,
+		Dosis:           []dtos.DosisCarnetDTO{},
+		EstadoControles: []dtos.EstadoControlDTO{},
+		Controles:       []dtos.ControlCarnetDTO{},
+	}
+
+	var esquema []health.EsquemaVacuna
+	err := s.db.Preload("Vacuna").
+		Joins("JOIN vacunas ON vacunas.id = esquema_vacunas.vacuna_id").
+		Where("vacunas.activa = ?", true).
+		Order("esquema_vacunas.edad_meses, vacunas.nombre, esquema_vacunas.numero_dosis").
+		Find(&esquema).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener el esquema de vacunación: %v", err)
+	}
+	var aplicadas []health.DosisVacuna
+	if err := s.db.Where("estudiante_id = ?", est.ID).Order("fecha, id").Find(&aplicadas).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener las dosis: %v", err)
+	}
+	var vacunas []health.Vacuna
+	s.db.Find(&vacunas)
+	nombres := make(map[uint]string, len(vacunas))
+	for _, v := range vacunas {
+		nombres[v.ID] = v.Nombre
+	}
+
+	edadMeses, err := growth.EdadEnMeses(est.FechaNacimiento, hoy)
+	if err != nil {
+		// Sin fecha de nacimiento no se puede saber qué dosis son exigibles
+		edadMeses = -1
+		carnet.Advertencia = "El estudiante no tiene una fecha de nacimiento válida; no se pueden calcular las dosis pendientes"
+	} else {
+		carnet.Edad = growth.TextoEdad(edadMeses)
+	}
+
+	enEsquema := map[uint]bool{}
+	for _, d := range health.EvaluarEsquema(esquema, aplicadas, edadMeses, est.GeneroNacimiento) {
+		item := dtos.DosisCarnetDTO{
+			VacunaID:    d.Esquema.VacunaID,
+			Vacuna:      d.Esquema.Vacuna.Nombre,
+			NumeroDosis: d.Esquema.NumeroDosis,
+			Descripcion: d.Esquema.Descripcion,
+			EdadMeses:   d.Esquema.EdadMeses,
+			Estado:      d.Estado,
+		}
+		if d.Aplicada != nil {
+			enEsquema[d.Aplicada.ID] = true
+			completarDosisCarnet(&item, *d.Aplicada)
+		}
+		if d.Estado == health.EstadoDosisPendiente {
+			carnet.DosisPendientes++
+		}
+		carnet.Dosis = append(carnet.Dosis, item)
+	}
+	for _, a := range aplicadas {
+		if enEsquema[a.ID] {
+			continue
+		}
+		item := dtos.DosisCarnetDTO{
+			VacunaID:    a.VacunaID,
+			Vacuna:      nombres[a.VacunaID],
+			NumeroDosis: a.NumeroDosis,
+			Descripcion: "Fuera del esquema",
+			EdadMeses:   -1,
+			Estado:      health.EstadoDosisAplicada,
+		}
+		completarDosisCarnet(&item, a)
+		carnet.Dosis = append(carnet.Dosis, item)
+	}
+
+	var controles []health.ControlMedico
+	if err := s.db.Preload("TipoControl").Where("estudiante_id = ?", est.ID).Order("fecha DESC, id DESC").Find(&controles).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener los controles: %v", err)
+	}
+	ultimo := map[uint]string{}
+	for _, c := range controles {
+		if _, ok := ultimo[c.TipoControlID]; !ok {
+			ultimo[c.TipoControlID] = c.Fecha
+		}
+		carnet.Controles = append(carnet.Controles, dtos.ControlCarnetDTO{
+			ID:              c.ID,
+			TipoControlID:   c.TipoControlID,
+			Tipo:            c.TipoControl.Nombre,
+			Fecha:           c.Fecha,
+			Profesional:     c.Profesional,
+			Establecimiento: c.Establecimiento,
+			Resultado:       c.Resultado,
+			Observacion:     c.Observacion,
+			Origen:          c.Origen,
+		})
+	}
+
+	var tipos []health.TipoControlMedico
+	s.db.Where("activo = ?", true).Order("nombre").Find(&tipos)
+	for _, t := range tipos {
+		estado := dtos.EstadoControlDTO{
+			TipoControlID:     t.ID,
+			Tipo:              t.Nombre,
+			PeriodicidadMeses: t.PeriodicidadMeses,
+			UltimaFecha:       ultimo[t.ID],
+			AlDia:             t.AlDia(ultimo[t.ID], hoy),
+		}
+		if !estado.AlDia {
+			carnet.ControlesPendientes++
+		}
+		carnet.EstadoControles = append(carnet.EstadoControles, estado)
+	}
+
+	carnet.Completo = carnet.DosisPendientes == 0 && carnet.ControlesPendientes == 0 && edadMeses >= 0
+	return carnet, nil
+}
+
+func completarDosisCarnet(item *dtos.DosisCarnetDTO, d health.DosisVacuna) {
+	item.DosisID = d.ID
+	item.Fecha = d.Fecha
+	item.Lote = d.Lote
+	item.Establecimiento = d.Establecimiento
+	item.Origen = d.Origen
+}
+
+// validarFechaSalud exige una fecha AAAA-MM-DD que no sea futura ni anterior al nacimiento.
+func validarFechaSalud(fecha, fechaNacimiento string) (string, error) {
+	fecha = strings.TrimSpace(fecha)
+	t, err := time.Parse("2006-01-02", fecha)
+	if err != nil {
+		return "", errors.New("La fecha es inválida, use el formato AAAA-MM-DD")
+	}
+	if t.After(time.Now()) {
+		return "", errors.New("La fecha no puede ser futura")
+	}
+	if nacimiento, err := time.Parse("2006-01-02", fechaNacimiento); err == nil && t.Before(nacimiento) {
+		return "", errors.New("La fecha es anterior al nacimiento del estudiante")
+	}
+	return fecha, nil
+}
+
+func limpiarAlias(alias string) string {
+	var partes []string
+	for _, a := range strings.Split(alias, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			partes = append(partes, a)
+		}
+	}
+	return strings.Join(partes, ",")
+}
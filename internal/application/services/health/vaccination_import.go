@@ -0,0 +1,371 @@
+package services
+
+import (
+	dtos "dece/internal/application/dtos/health"
+	identity "dece/internal/application/helpers/identity"
+	spreadsheet "dece/internal/application/helpers/spreadsheet"
+	"dece/internal/domain/health"
+	"dece/internal/domain/student"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"gorm.io/gorm"
+)
+
+// Columnas reconocidas en las hojas de las brigadas de salud
+const (
+	columnaCedula          = "cedula"
+	columnaVacuna          = "vacuna"
+	columnaDosis           = "dosis"
+	columnaFecha           = "fecha"
+	columnaLote            = "lote"
+	columnaEstablecimiento = "establecimiento"
+	columnaControl         = "control"
+	columnaProfesional     = "profesional"
+	columnaResultado       = "resultado"
+	columnaObservacion     = "observacion"
+)
+
+// ImportarSaludExcel registra las dosis y los controles de una hoja de brigada (Excel o CSV).
+// Cada fila es una dosis si trae la columna de vacuna o un control si trae la de control; las
+// dosis ya registradas se cuentan como duplicadas y no se modifican.
+func (s *HealthService) ImportarSaludExcel() (*dtos.ResultadoImportacionSaludDTO, error) {
+	if s.ctx == nil {
+		return nil, errors.New("contexto no inicializado")
+	}
+
+	filePath, err := runtime.OpenFileDialog(s.ctx, runtime.OpenDialogOptions{
+		Title: "Seleccionar Hoja de la Brigada de Salud",
+		Filters: []runtime.FileFilter{
+			{DisplayName: "Excel o CSV", Pattern: "*.xlsx;*.xlsm;*.csv"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if filePath == "" {
+		return nil, nil // Usuario canceló
+	}
+
+	return s.importarArchivoSalud(filePath)
+}
+
+func (s *HealthService) importarArchivoSalud(filePath string) (*dtos.ResultadoImportacionSaludDTO, error) {
+	hojas, err := spreadsheet.LeerHojas(filePath, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var vacunas []health.Vacuna
+	s.db.Find(&vacunas)
+	var esquema []health.EsquemaVacuna
+	s.db.Order("vacuna_id, numero_dosis").Find(&esquema)
+	var tipos []health.TipoControlMedico
+	s.db.Find(&tipos)
+
+	resultado := &dtos.ResultadoImportacionSaludDTO{
+		Archivo: filepath.Base(filePath),
+		Errores: []dtos.ErrorImportacionSaludDTO{},
+	}
+	usuarioID, nombreUsuario := uint(0), ""
+	if usuario, err := s.auth.ObtenerUsuarioSesion(); err == nil {
+		usuarioID, nombreUsuario = usuario.ID, usuario.NombreCompleto
+	}
+	ahora := time.Now().Format("2006-01-02 15:04:05")
+	estudiantes := map[string]*student.Estudiante{}
+	hojasReconocidas := 0
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		for _, hoja := range hojas {
+			inicio, columnas := detectarColumnasSalud(hoja.Filas)
+			if inicio < 0 {
+				continue
+			}
+			hojasReconocidas++
+
+			for i := inicio + 1; i < len(hoja.Filas); i++ {
+				fila := hoja.Filas[i]
+				celda := func(campo string) string {
+					if j, ok := columnas[campo]; ok && j < len(fila) {
+						return strings.TrimSpace(fila[j])
+					}
+					return ""
+				}
+				cedula := identity.NormalizarCedula(celda(columnaCedula))
+				if cedula == "" {
+					continue
+				}
+				resultado.FilasLeidas++
+				registrarError := func(mensaje string) {
+					resultado.Errores = append(resultado.Errores, dtos.ErrorImportacionSaludDTO{
+						Hoja: hoja.Nombre, Fila: i + 1, Cedula: cedula, Mensaje: mensaje,
+					})
+				}
+
+				est, ok := estudiantes[cedula]
+				if !ok {
+					var e student.Estudiante
+					if tx.Where("cedula = ?", cedula).First(&e).Error == nil {
+						est = &e
+					}
+					estudiantes[cedula] = est
+				}
+				if est == nil {
+					registrarError("No existe un estudiante con esta cédula")
+					continue
+				}
+
+				fecha, err := fechaHojaSalud(celda(columnaFecha))
+				if err == nil {
+					fecha, err = validarFechaSalud(fecha, est.FechaNacimiento)
+				}
+				if err != nil {
+					registrarError(err.Error())
+					continue
+				}
+
+				nombreVacuna, nombreControl := celda(columnaVacuna), celda(columnaControl)
+				switch {
+				case nombreVacuna != "":
+					vacuna := buscarVacuna(vacunas, nombreVacuna)
+					if vacuna == nil {
+						registrarError(fmt.Sprintf("Vacuna no reconocida: %s", nombreVacuna))
+						continue
+					}
+					numero, err := resolverDosis(celda(columnaDosis), vacuna.ID, esquema)
+					if err != nil {
+						registrarError(fmt.Sprintf("%s: %v", vacuna.Nombre, err))
+						continue
+					}
+					var existe int64
+					tx.Model(&health.DosisVacuna{}).
+						Where("estudiante_id = ? AND vacuna_id = ? AND numero_dosis = ?", est.ID, vacuna.ID, numero).
+						Count(&existe)
+					if existe > 0 {
+						resultado.Duplicadas++
+						continue
+					}
+					dosis := health.DosisVacuna{
+						EstudianteID:    est.ID,
+						VacunaID:        vacuna.ID,
+						NumeroDosis:     numero,
+						Fecha:           fecha,
+						Lote:            celda(columnaLote),
+						Establecimiento: celda(columnaEstablecimiento),
+						Observacion:     celda(columnaObservacion),
+						Origen:          health.OrigenImportacion,
+						UsuarioID:       usuarioID,
+						NombreUsuario:   nombreUsuario,
+						FechaRegistro:   ahora,
+					}
+					if err := tx.Create(&dosis).Error; err != nil {
+						return fmt.Errorf("Error al registrar la dosis de la fila %d: %v", i+1, err)
+					}
+					resultado.DosisRegistradas++
+
+				case nombreControl != "":
+					tipo := buscarTipoControl(tipos, nombreControl)
+					if tipo == nil {
+						registrarError(fmt.Sprintf("Control no reconocido: %s", nombreControl))
+						continue
+					}
+					var existe int64
+					tx.Model(&health.ControlMedico{}).
+						Where("estudiante_id = ? AND tipo_control_id = ? AND fecha = ?", est.ID, tipo.ID, fecha).
+						Count(&existe)
+					if existe > 0 {
+						resultado.Duplicadas++
+						continue
+					}
+					control := health.ControlMedico{
+						EstudianteID:    est.ID,
+						TipoControlID:   tipo.ID,
+						Fecha:           fecha,
+						Profesional:     celda(columnaProfesional),
+						Establecimiento: celda(columnaEstablecimiento),
+						Resultado:       celda(columnaResultado),
+						Observacion:     celda(columnaObservacion),
+						Origen:          health.OrigenImportacion,
+						UsuarioID:       usuarioID,
+						NombreUsuario:   nombreUsuario,
+						FechaRegistro:   ahora,
+					}
+					if err := tx.Create(&control).Error; err != nil {
+						return fmt.Errorf("Error al registrar el control de la fila %d: %v", i+1, err)
+					}
+					resultado.ControlesRegistrados++
+
+				default:
+					registrarError("La fila no indica la vacuna ni el control")
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if hojasReconocidas == 0 {
+		return nil, errors.New("No se encontró una fila de encabezados con cédula, fecha y vacuna o control")
+	}
+	return resultado, nil
+}
+
+// columnaSalud reconoce los encabezados habituales de las hojas del MSP y de las brigadas. El
+// orden importa: "Fecha de vacunación" es la fecha y "Lote de la vacuna" es el lote.
+func columnaSalud(encabezado string) string {
+	v := normalizarNombreColumna(encabezado)
+	switch {
+	case v == "" || strings.Contains(v, "nacimiento"):
+		return ""
+	case strings.Contains(v, "cedula") || strings.Contains(v, "identificacion") || v == "ci":
+		return columnaCedula
+	case strings.Contains(v, "fecha"):
+		return columnaFecha
+	case strings.Contains(v, "dosis"):
+		return columnaDosis
+	case strings.Contains(v, "lote"):
+		return columnaLote
+	case strings.Contains(v, "establecimiento") || strings.Contains(v, "unidad operativa") || strings.Contains(v, "centro de salud"):
+		return columnaEstablecimiento
+	case strings.Contains(v, "vacunador"):
+		return columnaProfesional
+	case strings.Contains(v, "vacuna") || strings.Contains(v, "biologico"):
+		return columnaVacuna
+	case strings.Contains(v, "control") || strings.Contains(v, "tamizaje"):
+		return columnaControl
+	case strings.Contains(v, "profesional") || strings.Contains(v, "responsable") || strings.Contains(v, "medico"):
+		return columnaProfesional
+	case strings.Contains(v, "resultado") || strings.Contains(v, "diagnostico"):
+		return columnaResultado
+	case strings.Contains(v, "observacion"):
+		return columnaObservacion
+	}
+	return ""
+}
+
+// detectarColumnasSalud busca la primera fila con cédula, fecha y vacuna o control.
+func detectarColumnasSalud(filas [][]string) (int, map[string]int) {
+	for i, fila := range filas {
+		columnas := map[string]int{}
+		for j, celda := range fila {
+			if campo := columnaSalud(celda); campo != "" {
+				if _, existe := columnas[campo]; !existe {
+					columnas[campo] = j
+				}
+			}
+		}
+		_, tieneCedula := columnas[columnaCedula]
+		_, tieneFecha := columnas[columnaFecha]
+		_, tieneVacuna := columnas[columnaVacuna]
+		_, tieneControl := columnas[columnaControl]
+		if tieneCedula && tieneFecha && (tieneVacuna || tieneControl) {
+			return i, columnas
+		}
+	}
+	return -1, nil
+}
+
+func normalizarNombreColumna(valor string) string {
+	valor = strings.ToLower(strings.TrimSpace(valor))
+	valor = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ñ", "n", "_", " ", ".", "").Replace(valor)
+	return strings.Join(strings.Fields(valor), " ")
+}
+
+func buscarVacuna(vacunas []health.Vacuna, nombre string) *health.Vacuna {
+	for i := range vacunas {
+		if health.CoincideNombre(nombre, vacunas[i].Nombre, vacunas[i].Alias) {
+			return &vacunas[i]
+		}
+	}
+	return nil
+}
+
+func buscarTipoControl(tipos []health.TipoControlMedico, nombre string) *health.TipoControlMedico {
+	for i := range tipos {
+		if health.CoincideNombre(nombre, tipos[i].Nombre, tipos[i].Alias) {
+			return &tipos[i]
+		}
+	}
+	return nil
+}
+
+var (
+	ordinalesDosis = map[string]int{
+		"primera": 1, "primer": 1, "segunda": 2, "segundo": 2, "tercera": 3, "tercer": 3,
+		"cuarta": 4, "cuarto": 4, "quinta": 5, "quinto": 5, "unica": 1, "unico": 1,
+	}
+	numeroEnTexto = regexp.MustCompile(`\d+`)
+)
+
+// resolverDosis interpreta la columna de dosis ("2", "2da", "segunda", "única", "refuerzo 1",
+// "R2") con el número de dosis del esquema. Los refuerzos se cuentan entre las dosis del
+// esquema de la vacuna descritas como refuerzo.
+func resolverDosis(texto string, vacunaID uint, esquema []health.EsquemaVacuna) (int, error) {
+	v := normalizarNombreColumna(texto)
+	var delaVacuna []health.EsquemaVacuna
+	for _, e := range esquema {
+		if e.VacunaID == vacunaID {
+			delaVacuna = append(delaVacuna, e)
+		}
+	}
+
+	if v == "" {
+		if len(delaVacuna) <= 1 {
+			return 1, nil
+		}
+		return 0, errors.New("falta el número de dosis")
+	}
+
+	numero := 0
+	if n := numeroEnTexto.FindString(v); n != "" {
+		numero, _ = strconv.Atoi(n)
+	} else {
+		for _, palabra := range strings.Fields(v) {
+			if n, ok := ordinalesDosis[palabra]; ok {
+				numero = n
+				break
+			}
+		}
+	}
+
+	if strings.Contains(v, "refuerzo") || (strings.HasPrefix(v, "r") && numero > 0 && len(v) <= 3) {
+		if numero == 0 {
+			numero = 1
+		}
+		k := 0
+		for _, e := range delaVacuna {
+			if e.EsRefuerzo() {
+				k++
+				if k == numero {
+					return e.NumeroDosis, nil
+				}
+			}
+		}
+		return 0, fmt.Errorf("el esquema no tiene el refuerzo %d", numero)
+	}
+
+	if numero < 1 {
+		return 0, fmt.Errorf("dosis no reconocida: %s", texto)
+	}
+	return numero, nil
+}
+
+// fechaHojaSalud acepta los formatos habituales y el número de serie de Excel.
+func fechaHojaSalud(valor string) (string, error) {
+	valor = strings.TrimSpace(valor)
+	if valor == "" {
+		return "", errors.New("La fila no tiene fecha")
+	}
+	fecha, ok := spreadsheet.NormalizarFecha(valor, "")
+	if !ok {
+		return "", fmt.Errorf("Fecha no reconocida: %s", valor)
+	}
+	return fecha, nil
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	dto "dece/internal/application/dtos/management"
+	queryDTO "dece/internal/application/dtos/query"
+	queryHelper "dece/internal/application/helpers/query"
 	"dece/internal/application/services/sync"
 	"dece/internal/domain/academic"
 	"dece/internal/domain/common"
@@ -13,6 +15,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,6 +37,22 @@ func (s *ManagementService) Startup(ctx context.Context) {
 }
 
 func (s *ManagementService) AgendarCita(input dto.AgendarCitaDTO) (*management.Convocatoria, error) {
+	cita, err := RegistrarCita(s.db, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.sync != nil {
+		go s.sync.SyncNuevaCita(cita.ID)
+	}
+
+	return cita, nil
+}
+
+// RegistrarCita valida y guarda la cita con db, que puede ser una transacción abierta por quien
+// agenda la cita como parte de otra operación. No la sincroniza: eso queda a cargo de quien
+// confirma la transacción.
+func RegistrarCita(db *gorm.DB, input dto.AgendarCitaDTO) (*management.Convocatoria, error) {
 	layout := "2006-01-02 15:04"
 	fechaParsed, err := time.ParseInLocation(layout, input.FechaCita, time.Local)
 
@@ -52,35 +71,79 @@ func (s *ManagementService) AgendarCita(input dto.AgendarCitaDTO) (*management.C
 		CitaCompletada: false,
 	}
 
-	if err := s.db.Create(&cita).Error; err != nil {
+	if err := db.Create(&cita).Error; err != nil {
 		return nil, fmt.Errorf("Error al agendar cita: %v", err)
 	}
 
-	if s.sync != nil {
-		go s.sync.SyncNuevaCita(cita.ID)
-	}
-
 	return &cita, nil
 }
 
+var especificacionCitas = queryHelper.Especificacion{
+	Campos: map[string]queryHelper.Campo{
+		"fecha_cita":    {Columna: "convocatoria.fecha_cita", Tipo: queryHelper.CampoFecha, Ordenable: true},
+		"completada":    {Columna: "convocatoria.cita_completada", Tipo: queryHelper.CampoBooleano, Ordenable: true},
+		"entidad":       {Columna: "convocatoria.entidad", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"matricula_id":  {Columna: "convocatoria.matricula_id", Tipo: queryHelper.CampoNumero},
+		"estudiante_id": {Columna: "matriculas.estudiante_id", Tipo: queryHelper.CampoNumero},
+		"curso_id":      {Columna: "matriculas.curso_id", Tipo: queryHelper.CampoNumero},
+		"estudiante":    {Columna: "estudiantes.apellidos", Tipo: queryHelper.CampoTexto, Ordenable: true},
+	},
+	Busqueda: []string{
+		"estudiantes.apellidos",
+		"estudiantes.nombres",
+		"estudiantes.cedula",
+		"convocatoria.entidad",
+		"convocatoria.motivo",
+	},
+	OrdenPorDefecto: "fecha_cita",
+	Desempate:       "convocatoria.id",
+}
+
+// ListarCitas alimenta la agenda completa (pendientes o de un día) con sus contadores.
 func (s *ManagementService) ListarCitas(filtro dto.FiltroCitasDTO) ([]dto.CitaResumenDTO, error) {
 	var citas []management.Convocatoria
 
-	query := s.db.Model(&management.Convocatoria{}).
-		Preload("Matricula.Estudiante").
-		Preload("Matricula.Curso.Nivel").
-		Order("fecha_cita ASC")
-
+	consulta := queryDTO.ConsultaDTO{}
 	if filtro.Tipo == "pendientes" {
-		query = query.Where("cita_completada = ?", false)
+		consulta.Filtros = append(consulta.Filtros, queryDTO.FiltroDTO{Campo: "completada", Operador: queryDTO.OperadorIgual, Valor: "false"})
 	} else if filtro.Tipo == "rango" && filtro.FechaSolo != "" {
-		query = query.Where("fecha_cita LIKE ?", filtro.FechaSolo+"%")
+		consulta.Filtros = append(consulta.Filtros, queryDTO.FiltroDTO{Campo: "fecha_cita", Operador: queryDTO.OperadorIgual, Valor: filtro.FechaSolo})
 	}
 
+	query, err := queryHelper.Filtrar(s.consultaCitas(), especificacionCitas, consulta)
+	if err != nil {
+		return nil, err
+	}
+	if query, err = queryHelper.Ordenar(query, especificacionCitas, consulta); err != nil {
+		return nil, err
+	}
 	if err := query.Find(&citas).Error; err != nil {
 		return nil, err
 	}
 
+	return mapearCitas(citas), nil
+}
+
+func (s *ManagementService) ConsultarCitas(consulta queryDTO.ConsultaDTO) (*dto.CitasPaginadasDTO, error) {
+	var citas []management.Convocatoria
+
+	paginacion, err := queryHelper.Paginar(s.consultaCitas(), especificacionCitas, consulta, &citas)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CitasPaginadasDTO{Items: mapearCitas(citas), Paginacion: paginacion}, nil
+}
+
+func (s *ManagementService) consultaCitas() *gorm.DB {
+	return s.db.Model(&management.Convocatoria{}).
+		Preload("Matricula.Estudiante").
+		Preload("Matricula.Curso.Nivel").
+		Joins("LEFT JOIN matriculas ON matriculas.id = convocatoria.matricula_id").
+		Joins("LEFT JOIN estudiantes ON estudiantes.id = matriculas.estudiante_id")
+}
+
+func mapearCitas(citas []management.Convocatoria) []dto.CitaResumenDTO {
 	response := make([]dto.CitaResumenDTO, len(citas))
 	layout := "2006-01-02 15:04"
 	now := time.Now()
@@ -136,7 +199,7 @@ func (s *ManagementService) ListarCitas(filtro dto.FiltroCitasDTO) ([]dto.CitaRe
 		}
 	}
 
-	return response, nil
+	return response
 }
 
 func (s *ManagementService) MarcarCompletada(id uint, completada bool) error {
@@ -268,22 +331,57 @@ func (s *ManagementService) ActualizarCita(input dto.ActualizarCitaDTO) (*manage
 	return &cita, nil
 }
 
-func (s *ManagementService) ListarCapacitaciones() ([]dto.CapacitacionResumenDTO, error) {
-	var capacitaciones []management.Capacitacion
+var especificacionCapacitaciones = queryHelper.Especificacion{
+	Campos: map[string]queryHelper.Campo{
+		"periodo_id":     {Columna: "periodo_id", Tipo: queryHelper.CampoNumero},
+		"fecha":          {Columna: "fecha", Tipo: queryHelper.CampoFecha, Ordenable: true},
+		"tema":           {Columna: "tema", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"grupo_objetivo": {Columna: "json_extract(detalle_audiencia, '$.grupo_objetivo')", Tipo: queryHelper.CampoTexto, Ordenable: true},
+	},
+	Busqueda:              []string{"tema", "json_extract(detalle_audiencia, '$.grupo_objetivo')"},
+	OrdenPorDefecto:       "fecha",
+	DescendentePorDefecto: true,
+	Desempate:             "id",
+}
 
+// ListarCapacitaciones devuelve las capacitaciones del periodo activo.
+func (s *ManagementService) ListarCapacitaciones() ([]dto.CapacitacionResumenDTO, error) {
 	var periodoActivo academic.PeriodoLectivo
 	if err := s.db.Where("es_activo = ?", true).First(&periodoActivo).Error; err != nil {
 		return []dto.CapacitacionResumenDTO{}, nil
 	}
 
-	result := s.db.Where("periodo_id = ?", periodoActivo.ID).
-		Order("fecha DESC").
-		Find(&capacitaciones)
+	var capacitaciones []management.Capacitacion
+	consulta := queryDTO.ConsultaDTO{
+		Filtros: []queryDTO.FiltroDTO{{Campo: "periodo_id", Operador: queryDTO.OperadorIgual, Valor: strconv.FormatUint(uint64(periodoActivo.ID), 10)}},
+	}
+	query, err := queryHelper.Filtrar(s.db.Model(&management.Capacitacion{}), especificacionCapacitaciones, consulta)
+	if err != nil {
+		return nil, err
+	}
+	if query, err = queryHelper.Ordenar(query, especificacionCapacitaciones, consulta); err != nil {
+		return nil, err
+	}
+	if err := query.Find(&capacitaciones).Error; err != nil {
+		return nil, err
+	}
 
-	if result.Error != nil {
-		return nil, result.Error
+	return mapearCapacitaciones(capacitaciones), nil
+}
+
+// ConsultarCapacitaciones pagina las capacitaciones de cualquier periodo (filtro periodo_id).
+func (s *ManagementService) ConsultarCapacitaciones(consulta queryDTO.ConsultaDTO) (*dto.CapacitacionesPaginadasDTO, error) {
+	var capacitaciones []management.Capacitacion
+
+	paginacion, err := queryHelper.Paginar(s.db.Model(&management.Capacitacion{}), especificacionCapacitaciones, consulta, &capacitaciones)
+	if err != nil {
+		return nil, err
 	}
 
+	return &dto.CapacitacionesPaginadasDTO{Items: mapearCapacitaciones(capacitaciones), Paginacion: paginacion}, nil
+}
+
+func mapearCapacitaciones(capacitaciones []management.Capacitacion) []dto.CapacitacionResumenDTO {
 	response := make([]dto.CapacitacionResumenDTO, len(capacitaciones))
 	for i, c := range capacitaciones {
 		detalles := c.DetalleAudiencia.Data
@@ -299,7 +397,7 @@ func (s *ManagementService) ListarCapacitaciones() ([]dto.CapacitacionResumenDTO
 		}
 	}
 
-	return response, nil
+	return response
 }
 
 func (s *ManagementService) ObtenerCapacitacion(id uint) (*dto.GuardarCapacitacionDTO, error) {
@@ -519,9 +617,13 @@ func (s *ManagementService) VerificarAlertas() ([]dto.AlertaDashboardDTO, error)
 	var citas []management.Convocatoria
 	var alertas []dto.AlertaDashboardDTO
 
+	// La ventana de aviso (fecha de la cita menos dias_alerta) se evalúa en SQL para no cargar
+	// todas las citas pendientes
 	err := s.db.Preload("Matricula.Estudiante").
 		Preload("Matricula.Curso.Nivel").
 		Where("cita_completada = ?", false).
+		Where("datetime(fecha_cita, printf('-%d days', dias_alerta)) <= datetime('now', 'localtime')").
+		Order("fecha_cita ASC").
 		Find(&citas).Error
 
 	if err != nil {
@@ -529,7 +631,6 @@ func (s *ManagementService) VerificarAlertas() ([]dto.AlertaDashboardDTO, error)
 	}
 
 	layout := "2006-01-02 15:04"
-	ahora := time.Now()
 
 	for _, c := range citas {
 		fechaCita, err := time.Parse(layout, c.FechaCita)
@@ -537,62 +638,56 @@ func (s *ManagementService) VerificarAlertas() ([]dto.AlertaDashboardDTO, error)
 			continue
 		}
 
-		fechaInicioAlerta := fechaCita.AddDate(0, 0, -c.DiasAlerta)
-
-		esAlertaActiva := ahora.After(fechaInicioAlerta)
-
-		if esAlertaActiva {
-			diasRestantes := int(time.Until(fechaCita).Hours() / 24)
-			nombreEst := "Desconocido"
-			cursoEst := "S/C"
+		diasRestantes := int(time.Until(fechaCita).Hours() / 24)
+		nombreEst := "Desconocido"
+		cursoEst := "S/C"
 
-			if c.Matricula.ID != 0 {
-				if c.Matricula.Estudiante.ID != 0 {
-					nombreEst = fmt.Sprintf("%s %s", c.Matricula.Estudiante.Apellidos, c.Matricula.Estudiante.Nombres)
-				}
+		if c.Matricula.ID != 0 {
+			if c.Matricula.Estudiante.ID != 0 {
+				nombreEst = fmt.Sprintf("%s %s", c.Matricula.Estudiante.Apellidos, c.Matricula.Estudiante.Nombres)
+			}
 
-				parts := make([]string, 0, 3)
-				if c.Matricula.Curso.Nivel.ID != 0 {
-					nivel := c.Matricula.Curso.Nivel.NombreCompleto
-					if strings.TrimSpace(nivel) == "" {
-						nivel = c.Matricula.Curso.Nivel.Nombre
-					}
-					if strings.TrimSpace(nivel) != "" {
-						parts = append(parts, nivel)
-					}
-				}
-				if strings.TrimSpace(c.Matricula.Curso.Paralelo) != "" {
-					parts = append(parts, c.Matricula.Curso.Paralelo)
-				}
-				if strings.TrimSpace(c.Matricula.Curso.Jornada) != "" {
-					parts = append(parts, c.Matricula.Curso.Jornada)
+			parts := make([]string, 0, 3)
+			if c.Matricula.Curso.Nivel.ID != 0 {
+				nivel := c.Matricula.Curso.Nivel.NombreCompleto
+				if strings.TrimSpace(nivel) == "" {
+					nivel = c.Matricula.Curso.Nivel.Nombre
 				}
-				if len(parts) > 0 {
-					cursoEst = strings.Join(parts, " ")
+				if strings.TrimSpace(nivel) != "" {
+					parts = append(parts, nivel)
 				}
 			}
-
-			nivel := "Media"
-			color := "blue"
-
-			if diasRestantes < 0 {
-				nivel = "Atrasada"
-				color = "red"
-			} else if diasRestantes <= 1 {
-				nivel = "Alta"
-				color = "orange"
+			if strings.TrimSpace(c.Matricula.Curso.Paralelo) != "" {
+				parts = append(parts, c.Matricula.Curso.Paralelo)
+			}
+			if strings.TrimSpace(c.Matricula.Curso.Jornada) != "" {
+				parts = append(parts, c.Matricula.Curso.Jornada)
 			}
+			if len(parts) > 0 {
+				cursoEst = strings.Join(parts, " ")
+			}
+		}
 
-			alertas = append(alertas, dto.AlertaDashboardDTO{
-				ID:            c.ID,
-				Titulo:        fmt.Sprintf("Cita: %s", c.Entidad),
-				Descripcion:   fmt.Sprintf("%s (%s)", nombreEst, cursoEst),
-				FechaHora:     c.FechaCita,
-				DiasRestantes: diasRestantes,
-				NivelUrgencia: nivel,
-				Color:         color,
-			})
+		nivel := "Media"
+		color := "blue"
+
+		if diasRestantes < 0 {
+			nivel = "Atrasada"
+			color = "red"
+		} else if diasRestantes <= 1 {
+			nivel = "Alta"
+			color = "orange"
 		}
+
+		alertas = append(alertas, dto.AlertaDashboardDTO{
+			ID:            c.ID,
+			Titulo:        fmt.Sprintf("Cita: %s", c.Entidad),
+			Descripcion:   fmt.Sprintf("%s (%s)", nombreEst, cursoEst),
+			FechaHora:     c.FechaCita,
+			DiasRestantes: diasRestantes,
+			NivelUrgencia: nivel,
+			Color:         color,
+		})
 	}
 
 	return alertas, nil
@@ -783,6 +783,14 @@ func (s *TemplateService) GenerarCertificado(plantillaID uint, estudianteID uint
 		return "", fmt.Errorf("error al generar certificado: %v", err)
 	}
 
+	s.db.Create(&management.CertificadoEmitido{
+		PlantillaID:     plantilla.ID,
+		EstudianteID:    estudiante.ID,
+		NombrePlantilla: plantilla.Nombre,
+		RutaArchivo:     outputPath,
+		FechaEmision:    time.Now().Format("2006-01-02 15:04:05"),
+	})
+
 	// Abrir el archivo generado
 	var cmd *exec.Cmd
 	switch runtime.GOOS {
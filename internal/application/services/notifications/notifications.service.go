@@ -87,6 +87,7 @@ func (s *NotificationsService) schedulerLoop(ctx context.Context) {
 			return
 		case <-t.C:
 			_, _ = s.GenerarResumenAlertasCitas("admin", slot, nextTime)
+			_, _ = s.GenerarRecordatorioHitosProtocolo("admin", slot, nextTime)
 		}
 	}
 }
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"dece/internal/domain/notifications"
+	"dece/internal/domain/tracking"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const notifTipoHitosProtocolo = "hitos_protocolo"
+
+// GenerarRecordatorioHitosProtocolo avisa de los hitos de protocolos abiertos que vencieron o
+// vencen dentro de los próximos días. El mensaje no nombra el hito ni el tipo de protocolo
+// porque la condición del estudiante es confidencial; el detalle está en el módulo de
+// seguimiento.
+func (s *NotificationsService) GenerarRecordatorioHitosProtocolo(rolDestino string, momento string, scheduledAt time.Time) (*notifications.Notificacion, error) {
+	if strings.TrimSpace(rolDestino) == "" {
+		rolDestino = "admin"
+	}
+	momento = strings.TrimSpace(momento)
+	if momento == "" {
+		return nil, errors.New("Momento requerido (00:00|07:00|17:00)")
+	}
+
+	fechaProgramada := scheduledAt.Format("2006-01-02")
+	limite := scheduledAt.AddDate(0, 0, tracking.DiasAvisoHito).Format("2006-01-02")
+
+	var hitos []notifications.HitoAlertaItem
+	err := s.db.Raw(`
+		SELECT h.id as hito_id, h.protocolo_id, h.fecha_programada,
+			h.fecha_programada < ? as vencido,
+			e.apellidos || ' ' || e.nombres as estudiante, ne.nombre || ' ' || c.paralelo as curso
+		FROM hitos_protocolo h
+		JOIN protocolos_maternidad p ON h.protocolo_id = p.id
+		JOIN matriculas m ON p.matricula_id = m.id
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		JOIN cursos c ON m.curso_id = c.id
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		WHERE p.estado = ? AND (h.fecha_cumplida IS NULL OR h.fecha_cumplida = '') AND h.fecha_programada <= ?
+		ORDER BY h.fecha_programada, e.apellidos`, fechaProgramada, tracking.EstadoProtocoloAbierto, limite).Scan(&hitos).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(hitos) == 0 {
+		return nil, nil
+	}
+
+	vencidos := 0
+	for _, h := range hitos {
+		if h.Vencido {
+			vencidos++
+		}
+	}
+
+	titulo := fmt.Sprintf("%d hito(s) de protocolo por atender", len(hitos))
+	if vencidos > 0 {
+		titulo = fmt.Sprintf("%d hito(s) de protocolo por atender, %d vencido(s)", len(hitos), vencidos)
+	}
+
+	var mensajeBuilder strings.Builder
+	fmt.Fprintf(&mensajeBuilder, "Resumen %s (%s)\n\n", fechaProgramada, momento)
+	for i, h := range hitos {
+		if i >= 50 {
+			fmt.Fprintf(&mensajeBuilder, "... y %d más\n", len(hitos)-i)
+			break
+		}
+		estado := "pendiente"
+		if h.Vencido {
+			estado = "vencido"
+		}
+		fmt.Fprintf(&mensajeBuilder, "%d. %s • %s (%s) • seguimiento %s\n", i+1, h.FechaProgramada, h.Estudiante, h.Curso, estado)
+	}
+
+	meta := notifications.NotificacionMetadata{Total: len(hitos), Items: []notifications.CitaAlertaItem{}, Hitos: hitos}
+
+	var existing notifications.Notificacion
+	err = s.db.Where("tipo = ? AND rol_destino = ? AND fecha_programada = ? AND momento = ?", notifTipoHitosProtocolo, rolDestino, fechaProgramada, momento).First(&existing).Error
+	if err == nil {
+		existing.Titulo = titulo
+		existing.Mensaje = mensajeBuilder.String()
+		existing.Leida = false
+		existing.Metadata.Data = meta
+		if err := s.db.Save(&existing).Error; err != nil {
+			return nil, err
+		}
+		return &existing, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	n := notifications.Notificacion{
+		Tipo:            notifTipoHitosProtocolo,
+		RolDestino:      rolDestino,
+		FechaProgramada: fechaProgramada,
+		Momento:         momento,
+		Titulo:          titulo,
+		Mensaje:         mensajeBuilder.String(),
+	}
+	n.Metadata.Data = meta
+	if err := s.db.Create(&n).Error; err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
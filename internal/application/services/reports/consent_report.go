@@ -0,0 +1,167 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/student"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ObtenerConsentimientosPendientesCurso lista a los estudiantes vigentes del curso a los que les
+// falta algún consentimiento (o lo tienen vencido o revocado), con el total por tipo.
+func (s *ReportService) ObtenerConsentimientosPendientesCurso(cursoID uint) (*dtos.ConsentimientosCursoDTO, error) {
+	var curso struct {
+		ID             uint
+		Curso          string
+		Jornada        string
+		PeriodoLectivo string
+	}
+	err := s.db.Raw(`
+		SELECT c.id, ne.nombre || ' ' || c.paralelo as curso, c.jornada, pl.nombre as periodo_lectivo
+		FROM cursos c
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE c.id = ?`, cursoID).Scan(&curso).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo el curso: %v", err)
+	}
+	if curso.ID == 0 {
+		return nil, errors.New("El curso seleccionado no existe")
+	}
+
+	var estudiantes []struct {
+		ID         uint
+		Cedula     string
+		Estudiante string
+	}
+	err = s.db.Raw(`
+		SELECT e.id, e.cedula, e.apellidos || ' ' || e.nombres as estudiante
+		FROM matriculas m
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		WHERE m.curso_id = ? AND m.estado IN ?
+		ORDER BY e.apellidos, e.nombres`, cursoID, enrollment.EstadosVigentes).Scan(&estudiantes).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo los estudiantes del curso: %v", err)
+	}
+	ids := make([]uint, len(estudiantes))
+	for i, e := range estudiantes {
+		ids[i] = e.ID
+	}
+
+	porEstudiante := map[uint][]student.Consentimiento{}
+	if len(ids) > 0 {
+		var lista []student.Consentimiento
+		if err := s.db.Where("estudiante_id IN ?", ids).Find(&lista).Error; err != nil {
+			return nil, fmt.Errorf("Error obteniendo los consentimientos: %v", err)
+		}
+		for _, c := range lista {
+			porEstudiante[c.EstudianteID] = append(porEstudiante[c.EstudianteID], c)
+		}
+	}
+
+	hoy := time.Now().Format("2006-01-02")
+	reporte := &dtos.ConsentimientosCursoDTO{
+		CursoID:          curso.ID,
+		Curso:            curso.Curso,
+		Jornada:          curso.Jornada,
+		PeriodoLectivo:   curso.PeriodoLectivo,
+		FechaCorte:       hoy,
+		TotalEstudiantes: len(estudiantes),
+		PorTipo:          []dtos.ConteoPendienteDTO{},
+		Estudiantes:      []dtos.EstudianteConsentimientoDTO{},
+	}
+	porTipo := map[string]int{}
+
+	for _, e := range estudiantes {
+		pendiente := dtos.EstudianteConsentimientoDTO{
+			EstudianteID: e.ID,
+			Cedula:       e.Cedula,
+			Estudiante:   e.Estudiante,
+			Faltantes:    []string{},
+			PorVencer:    []string{},
+		}
+		for _, tipo := range student.TiposConsentimiento {
+			c, estado := student.ConsentimientoVigente(porEstudiante[e.ID], tipo, hoy)
+			switch estado {
+			case student.EstadoConsentimientoVigente:
+			case student.EstadoConsentimientoPorVencer:
+				pendiente.PorVencer = append(pendiente.PorVencer, fmt.Sprintf("%s (%s)", tipo, c.FechaVencimiento))
+			case student.EstadoConsentimientoFalta:
+				pendiente.Faltantes = append(pendiente.Faltantes, tipo)
+				porTipo[tipo]++
+			default:
+				pendiente.Faltantes = append(pendiente.Faltantes, fmt.Sprintf("%s (%s)", tipo, strings.ToLower(estado)))
+				porTipo[tipo]++
+			}
+		}
+
+		if len(pendiente.Faltantes) == 0 && len(pendiente.PorVencer) == 0 {
+			reporte.Completos++
+			continue
+		}
+		reporte.Estudiantes = append(reporte.Estudiantes, pendiente)
+	}
+
+	// Se listan todos los tipos, aunque no falten, para que el informe muestre la cobertura completa
+	for _, tipo := range student.TiposConsentimiento {
+		reporte.PorTipo = append(reporte.PorTipo, dtos.ConteoPendienteDTO{Nombre: tipo, Estudiantes: porTipo[tipo]})
+	}
+	return reporte, nil
+}
+
+func (s *ReportService) GenerarConsentimientosPendientesCursoPDF(cursoID uint) (string, error) {
+	reporte, err := s.ObtenerConsentimientosPendientesCurso(cursoID)
+	if err != nil {
+		return "", err
+	}
+
+	m := maroto.New(configNutricion())
+	m.AddRow(12,
+		text.NewCol(12, "CONSENTIMIENTOS INFORMADOS PENDIENTES", props.Text{Size: 16, Style: fontstyle.Bold, Align: align.Center}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("%s (%s) - %s | Corte al %s", reporte.Curso, reporte.Jornada, reporte.PeriodoLectivo, reporte.FechaCorte), props.Text{Size: 10, Style: fontstyle.Italic, Align: align.Center}),
+	)
+	m.AddRow(4)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("Estudiantes: %d | Con todos los consentimientos: %d | Con pendientes: %d", reporte.TotalEstudiantes, reporte.Completos, len(reporte.Estudiantes)), props.Text{Size: 9, Style: fontstyle.Bold}),
+	)
+	tablaPendientesPDF(m, "Consentimiento faltante", reporte.PorTipo)
+
+	m.AddRow(6)
+	m.AddRow(7,
+		text.NewCol(4, "Estudiante", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(5, "Faltan", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(3, "Por vencer", props.Text{Style: fontstyle.Bold, Size: 8}),
+	)
+	for _, e := range reporte.Estudiantes {
+		faltantes := strings.Join(e.Faltantes, "; ")
+		porVencer := strings.Join(e.PorVencer, "; ")
+		lineas := max(2, (len(faltantes)+59)/60, (len(porVencer)+35)/36)
+		m.AddRow(float64(3+3*lineas),
+			text.NewCol(4, fmt.Sprintf("%s\n%s", e.Estudiante, e.Cedula), props.Text{Size: 8}),
+			text.NewCol(5, valorOGuion(faltantes), props.Text{Size: 7}),
+			text.NewCol(3, valorOGuion(porVencer), props.Text{Size: 7}),
+		)
+	}
+	if len(reporte.Estudiantes) == 0 {
+		m.AddRow(10, text.NewCol(12, "Todos los estudiantes del curso tienen sus consentimientos vigentes.", props.Text{Style: fontstyle.Italic, Align: align.Center}))
+	}
+
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | Por vencer: dentro de %d días", time.Now().Format("2006-01-02 15:04"), student.DiasAvisoConsentimiento), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+	return guardarPDFSalud(m, fmt.Sprintf("Consentimientos_%s_%s.pdf", strings.ReplaceAll(reporte.Curso, " ", "_"), time.Now().Format("20060102_150405")))
+}
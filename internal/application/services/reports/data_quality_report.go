@@ -0,0 +1,230 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	identity "dece/internal/application/helpers/identity"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/faculty"
+	"dece/internal/domain/security"
+	"dece/internal/domain/student"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ObtenerReporteCalidadIdentidad recorre todos los campos de identidad de la base y devuelve
+// los documentos que no pasan la validación de cédula, RUC o documento extranjero.
+func (s *ReportService) ObtenerReporteCalidadIdentidad() ([]dtos.DocumentoInvalidoDTO, error) {
+	invalidos := make([]dtos.DocumentoInvalidoDTO, 0)
+
+	var estudiantes []student.Estudiante
+	if err := s.db.Find(&estudiantes).Error; err != nil {
+		return nil, fmt.Errorf("error leyendo estudiantes: %v", err)
+	}
+	for _, e := range estudiantes {
+		nac := e.InfoNacionalidad.Data
+		if err := identity.ValidarIdentidadEstudiante(e.Cedula, nac.EsExtranjero, nac.PasaporteOrDNI); err != nil {
+			invalidos = append(invalidos, dtos.DocumentoInvalidoDTO{
+				Entidad:    "Estudiante",
+				RegistroID: e.ID,
+				Titular:    e.Apellidos + " " + e.Nombres,
+				Campo:      "cedula",
+				Valor:      e.Cedula,
+				Problema:   err.Error(),
+			})
+		}
+	}
+
+	var familiares []student.Familiar
+	if err := s.db.Where("cedula <> ''").Find(&familiares).Error; err != nil {
+		return nil, fmt.Errorf("error leyendo familiares: %v", err)
+	}
+	for _, f := range familiares {
+		if err := identity.ValidarIdentificacion(f.Cedula); err != nil {
+			invalidos = append(invalidos, dtos.DocumentoInvalidoDTO{
+				Entidad:    "Familiar",
+				RegistroID: f.ID,
+				Titular:    f.NombresCompletos,
+				Campo:      "cedula",
+				Valor:      f.Cedula,
+				Problema:   err.Error(),
+				Referencia: fmt.Sprintf("Estudiante ID %d", f.EstudianteID),
+			})
+		}
+	}
+
+	var docentes []faculty.Docente
+	if err := s.db.Find(&docentes).Error; err != nil {
+		return nil, fmt.Errorf("error leyendo docentes: %v", err)
+	}
+	for _, d := range docentes {
+		if err := identity.ValidarIdentificacion(d.Cedula); err != nil {
+			invalidos = append(invalidos, dtos.DocumentoInvalidoDTO{
+				Entidad:    "Docente",
+				RegistroID: d.ID,
+				Titular:    d.NombresCompletos,
+				Campo:      "cedula",
+				Valor:      d.Cedula,
+				Problema:   err.Error(),
+			})
+		}
+	}
+
+	var configInst security.ConfiguracionInstitucional
+	if err := s.db.First(&configInst, 1).Error; err == nil {
+		a := configInst.Autoridades.Data
+		autoridades := []struct {
+			cargo string
+			datos security.Autoridad
+		}{
+			{"Rector", a.Rector},
+			{"Subdirector matutina", a.SubdirectorMatutina},
+			{"Subdirector vespertina", a.SubdirectorVespertina},
+			{"Inspector general", a.InspectorGeneral},
+			{"Subinspector", a.Subinspector},
+			{"Coordinador DECE", a.CoordinadorDECE},
+			{"Analista DECE 1", a.AnalistaDECE1},
+			{"Analista DECE 2", a.AnalistaDECE2},
+		}
+		for _, au := range autoridades {
+			if strings.TrimSpace(au.datos.Cedula) == "" {
+				continue
+			}
+			if err := identity.ValidarIdentificacion(au.datos.Cedula); err != nil {
+				invalidos = append(invalidos, dtos.DocumentoInvalidoDTO{
+					Entidad:    "Autoridad",
+					RegistroID: configInst.ID,
+					Titular:    au.datos.Apellidos + " " + au.datos.Nombres,
+					Campo:      "cedula",
+					Valor:      au.datos.Cedula,
+					Problema:   err.Error(),
+					Referencia: au.cargo,
+				})
+			}
+		}
+	}
+
+	var matriculas []enrollment.Matricula
+	if err := s.db.Select("id", "estudiante_id", "condicion_genero").
+		Where("json_extract(condicion_genero, '$.detalle_padres_pareja.cedula') <> ''").
+		Find(&matriculas).Error; err != nil {
+		return nil, fmt.Errorf("error leyendo matrículas: %v", err)
+	}
+	for _, m := range matriculas {
+		padres := m.CondicionGenero.Data.DetallePadresPareja
+		if padres == nil {
+			continue
+		}
+		if err := identity.ValidarIdentificacion(padres.Cedula); err != nil {
+			invalidos = append(invalidos, dtos.DocumentoInvalidoDTO{
+				Entidad:    "Padres de pareja",
+				RegistroID: m.ID,
+				Titular:    padres.Apellidos + " " + padres.Nombres,
+				Campo:      "condicion_genero.detalle_padres_pareja.cedula",
+				Valor:      padres.Cedula,
+				Problema:   err.Error(),
+				Referencia: fmt.Sprintf("Matrícula ID %d", m.ID),
+			})
+		}
+	}
+
+	return invalidos, nil
+}
+
+func (s *ReportService) GenerarReporteCalidadIdentidadPDF() (string, error) {
+	data, err := s.ObtenerReporteCalidadIdentidad()
+	if err != nil {
+		return "", err
+	}
+
+	cfg := config.NewBuilder().
+		WithPageNumber().
+		WithLeftMargin(15).
+		WithTopMargin(15).
+		WithRightMargin(15).
+		Build()
+
+	m := maroto.New(cfg)
+
+	m.AddRow(12,
+		text.NewCol(12, "CALIDAD DE DATOS: DOCUMENTOS DE IDENTIDAD", props.Text{
+			Size:  14,
+			Style: fontstyle.Bold,
+			Align: align.Center,
+		}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("Registros con documento inválido: %d", len(data)), props.Text{
+			Size:  10,
+			Style: fontstyle.Italic,
+			Align: align.Center,
+		}),
+	)
+	m.AddRow(5)
+	m.AddRow(1, text.NewCol(12, "__________________________________________________________________________________________________________", props.Text{Size: 6}))
+	m.AddRow(5)
+
+	m.AddRow(10,
+		text.NewCol(2, "Entidad", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(3, "Titular", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(2, "Documento", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(5, "Problema", props.Text{Style: fontstyle.Bold, Size: 8}),
+	)
+
+	if len(data) > 0 {
+		for _, row := range data {
+			entidad := row.Entidad
+			if row.Referencia != "" {
+				entidad = fmt.Sprintf("%s\n%s", row.Entidad, row.Referencia)
+			}
+			m.AddRow(10,
+				text.NewCol(2, entidad, props.Text{Size: 7}),
+				text.NewCol(3, row.Titular, props.Text{Size: 7}),
+				text.NewCol(2, row.Valor, props.Text{Size: 7, Style: fontstyle.Bold}),
+				text.NewCol(5, row.Problema, props.Text{Size: 7}),
+			)
+			m.AddRow(2, text.NewCol(12, "- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -", props.Text{Size: 2, Align: align.Center, Color: &props.Color{Red: 200, Green: 200, Blue: 200}}))
+		}
+	} else {
+		m.AddRow(10, text.NewCol(12, "Todos los documentos de identidad son válidos.", props.Text{Style: fontstyle.Italic, Align: align.Center}))
+	}
+
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | DECE - Gestión Estudiantil", time.Now().Format("2006-01-02 15:04")), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+
+	document, err := m.Generate()
+	if err != nil {
+		return "", err
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	savePath := filepath.Join(homeDir, "Documents", "SistemaDECE", "Reportes")
+	if err := os.MkdirAll(savePath, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	fileName := fmt.Sprintf("Calidad_Identidad_%s.pdf", time.Now().Format("20060102_150405"))
+	fullPath := filepath.Join(savePath, fileName)
+
+	if err := document.Save(fullPath); err != nil {
+		return "", err
+	}
+
+	return fullPath, nil
+}
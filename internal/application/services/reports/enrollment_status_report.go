@@ -0,0 +1,109 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	"dece/internal/domain/enrollment"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ObtenerMatriculadosAFecha reconstruye el estado de cada matrícula a la fecha indicada a partir
+// de su historial de transiciones. Se consideran los periodos que cubren esa fecha; cursoID = 0
+// incluye todos los cursos.
+func (s *ReportService) ObtenerMatriculadosAFecha(fecha string, cursoID uint) (*dtos.MatriculadosFechaDTO, error) {
+	if fecha == "" {
+		fecha = time.Now().Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", fecha); err != nil {
+		return nil, errors.New("Fecha inválida, use el formato AAAA-MM-DD")
+	}
+
+	var filas []struct {
+		MatriculaID    uint
+		EstudianteID   uint
+		Cedula         string
+		Estudiante     string
+		CursoID        uint
+		Curso          string
+		Jornada        string
+		PeriodoLectivo string
+		Estado         string
+		DesdeFecha     string
+	}
+
+	// La transición vigente es la última con fecha menor o igual a la consultada; si no hay
+	// ninguna, la matrícula aún no existía ese día. El curso también es el de esa fecha
+	query := `
+		SELECT m.id as matricula_id, e.id as estudiante_id, e.cedula,
+			e.apellidos || ' ' || e.nombres as estudiante,
+			c.id as curso_id, ne.nombre || ' ' || c.paralelo as curso, c.jornada,
+			pl.nombre as periodo_lectivo,
+			t.estado_nuevo as estado, t.fecha as desde_fecha
+		FROM matriculas m
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		JOIN cursos c ON c.id = ` + enrollment.CursoEnFechaSQL("m", "?") + `
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		JOIN transiciones_matricula t ON t.id = (
+			SELECT t2.id FROM transiciones_matricula t2
+			WHERE t2.matricula_id = m.id AND t2.fecha <= ?
+			ORDER BY t2.fecha DESC, t2.id DESC
+			LIMIT 1)
+		WHERE pl.fecha_inicio <= ? AND (COALESCE(pl.fecha_fin, '') = '' OR pl.fecha_fin >= ?)`
+	args := []interface{}{fecha, fecha, fecha, fecha}
+	if cursoID > 0 {
+		query += " AND c.id = ?"
+		args = append(args, cursoID)
+	}
+	query += " ORDER BY ne.orden, c.paralelo, c.jornada, e.apellidos, e.nombres"
+
+	if err := s.db.Raw(query, args...).Scan(&filas).Error; err != nil {
+		return nil, fmt.Errorf("Error reconstruyendo matrículas a la fecha: %v", err)
+	}
+
+	cuentaComoMatriculado := map[string]bool{}
+	for _, e := range enrollment.EstadosVigentes {
+		cuentaComoMatriculado[e] = true
+	}
+	for _, e := range enrollment.EstadosConcluidos {
+		cuentaComoMatriculado[e] = true
+	}
+
+	reporte := &dtos.MatriculadosFechaDTO{
+		Fecha:       fecha,
+		PorEstado:   []dtos.ConteoEstadoDTO{},
+		Estudiantes: []dtos.MatriculadoFechaDTO{},
+	}
+	indiceEstado := map[string]int{}
+
+	for _, f := range filas {
+		reporte.PeriodoLectivo = f.PeriodoLectivo
+
+		i, ok := indiceEstado[f.Estado]
+		if !ok {
+			i = len(reporte.PorEstado)
+			indiceEstado[f.Estado] = i
+			reporte.PorEstado = append(reporte.PorEstado, dtos.ConteoEstadoDTO{Estado: f.Estado})
+		}
+		reporte.PorEstado[i].Total++
+
+		if !cuentaComoMatriculado[f.Estado] {
+			continue
+		}
+		reporte.Estudiantes = append(reporte.Estudiantes, dtos.MatriculadoFechaDTO{
+			MatriculaID:  f.MatriculaID,
+			EstudianteID: f.EstudianteID,
+			Cedula:       f.Cedula,
+			Estudiante:   f.Estudiante,
+			CursoID:      f.CursoID,
+			Curso:        f.Curso,
+			Jornada:      f.Jornada,
+			Estado:       f.Estado,
+			DesdeFecha:   f.DesdeFecha,
+		})
+	}
+	reporte.Total = len(reporte.Estudiantes)
+
+	return reporte, nil
+}
@@ -0,0 +1,184 @@
+package reports
+
+import (
+	"bytes"
+	growth "dece/internal/application/helpers/growth"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Dimensiones del gráfico en píxeles y márgenes del área de trazado
+const (
+	anchoGrafico    = 900
+	altoGrafico     = 420
+	margenIzquierdo = 50
+	margenDerecho   = 20
+	margenSuperior  = 15
+	margenInferior  = 35
+)
+
+// Curvas de referencia que se trazan: mediana, ±1 DE (riesgo/sobrepeso) y ±2 DE, ±3 DE
+var curvasGrafico = []struct {
+	Z     float64
+	Color color.RGBA
+}{
+	{-3, color.RGBA{180, 30, 30, 255}},
+	{-2, color.RGBA{230, 140, 0, 255}},
+	{0, color.RGBA{40, 150, 60, 255}},
+	{1, color.RGBA{230, 200, 0, 255}},
+	{2, color.RGBA{230, 140, 0, 255}},
+	{3, color.RGBA{180, 30, 30, 255}},
+}
+
+type puntoGrafico struct {
+	EdadMeses float64
+	IMC       float64
+}
+
+type lienzoGrafico struct {
+	img                   *image.RGBA
+	edadMin, edadMax      float64
+	imcMin, imcMax        float64
+	x0, y0, anchoU, altoU int
+}
+
+func (l *lienzoGrafico) punto(edad, imc float64) (int, int) {
+	x := l.x0 + int(math.Round((edad-l.edadMin)/(l.edadMax-l.edadMin)*float64(l.anchoU)))
+	y := l.y0 + l.altoU - int(math.Round((imc-l.imcMin)/(l.imcMax-l.imcMin)*float64(l.altoU)))
+	return x, y
+}
+
+// graficoCrecimientoPNG dibuja el IMC del estudiante por edad sobre las curvas de la OMS de su
+// sexo. Si no hay tablas de referencia solo se trazan las mediciones.
+func graficoCrecimientoPNG(puntos []puntoGrafico, sexo string) ([]byte, error) {
+	if len(puntos) == 0 {
+		return nil, fmt.Errorf("no hay mediciones para graficar")
+	}
+
+	l := &lienzoGrafico{
+		img:     image.NewRGBA(image.Rect(0, 0, anchoGrafico, altoGrafico)),
+		edadMin: math.Inf(1), edadMax: math.Inf(-1),
+		imcMin: math.Inf(1), imcMax: math.Inf(-1),
+		x0: margenIzquierdo, y0: margenSuperior,
+		anchoU: anchoGrafico - margenIzquierdo - margenDerecho,
+		altoU:  altoGrafico - margenSuperior - margenInferior,
+	}
+	draw.Draw(l.img, l.img.Bounds(), image.White, image.Point{}, draw.Src)
+
+	for _, p := range puntos {
+		l.edadMin = math.Min(l.edadMin, p.EdadMeses)
+		l.edadMax = math.Max(l.edadMax, p.EdadMeses)
+		l.imcMin = math.Min(l.imcMin, p.IMC)
+		l.imcMax = math.Max(l.imcMax, p.IMC)
+	}
+	// Al menos dos años de ventana, alineada a años cumplidos
+	l.edadMin = math.Max(0, math.Floor(l.edadMin/12-0.5)*12)
+	l.edadMax = math.Max(math.Ceil(l.edadMax/12+0.5)*12, l.edadMin+24)
+
+	curvas := make([][]growth.PuntoCurva, len(curvasGrafico))
+	for i, c := range curvasGrafico {
+		curvas[i] = growth.CurvaReferencia(sexo, c.Z, l.edadMin, math.Min(l.edadMax, growth.MesesFinReferencia))
+		for _, p := range curvas[i] {
+			l.imcMin = math.Min(l.imcMin, p.IMC)
+			l.imcMax = math.Max(l.imcMax, p.IMC)
+		}
+	}
+	l.imcMin = math.Max(0, math.Floor(l.imcMin/2-0.5)*2)
+	l.imcMax = math.Ceil(l.imcMax/2+0.5) * 2
+
+	rejilla := color.RGBA{225, 225, 225, 255}
+	ejes := color.RGBA{90, 90, 90, 255}
+	pasoEdad := 12.0
+	if l.edadMax-l.edadMin > 144 {
+		pasoEdad = 24
+	}
+	for edad := l.edadMin; edad <= l.edadMax; edad += pasoEdad {
+		x, _ := l.punto(edad, l.imcMin)
+		lineaGrafico(l.img, x, l.y0, x, l.y0+l.altoU, rejilla, 1)
+		textoGrafico(l.img, x-6, l.y0+l.altoU+18, fmt.Sprintf("%.0f", edad/12), ejes)
+	}
+	for imc := l.imcMin; imc <= l.imcMax; imc += 2 {
+		_, y := l.punto(l.edadMin, imc)
+		lineaGrafico(l.img, l.x0, y, l.x0+l.anchoU, y, rejilla, 1)
+		textoGrafico(l.img, l.x0-24, y+4, fmt.Sprintf("%.0f", imc), ejes)
+	}
+	lineaGrafico(l.img, l.x0, l.y0, l.x0, l.y0+l.altoU, ejes, 1)
+	lineaGrafico(l.img, l.x0, l.y0+l.altoU, l.x0+l.anchoU, l.y0+l.altoU, ejes, 1)
+
+	for i, curva := range curvas {
+		for j := 1; j < len(curva); j++ {
+			xa, ya := l.punto(curva[j-1].EdadMeses, curva[j-1].IMC)
+			xb, yb := l.punto(curva[j].EdadMeses, curva[j].IMC)
+			lineaGrafico(l.img, xa, ya, xb, yb, curvasGrafico[i].Color, 1)
+		}
+	}
+
+	azul := color.RGBA{30, 80, 170, 255}
+	for i, p := range puntos {
+		x, y := l.punto(p.EdadMeses, p.IMC)
+		if i > 0 {
+			xa, ya := l.punto(puntos[i-1].EdadMeses, puntos[i-1].IMC)
+			lineaGrafico(l.img, xa, ya, x, y, azul, 2)
+		}
+		draw.Draw(l.img, image.Rect(x-4, y-4, x+5, y+5), image.NewUniform(azul), image.Point{}, draw.Src)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, l.img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// lineaGrafico traza una línea con el algoritmo de Bresenham, con el grosor en píxeles.
+func lineaGrafico(img *image.RGBA, x0, y0, x1, y1 int, c color.Color, grosor int) {
+	dx := int(math.Abs(float64(x1 - x0)))
+	dy := -int(math.Abs(float64(y1 - y0)))
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		for ox := 0; ox < grosor; ox++ {
+			for oy := 0; oy < grosor; oy++ {
+				img.Set(x0+ox, y0+oy, c)
+			}
+		}
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// textoGrafico escribe con la fuente básica, que solo cubre ASCII: los rótulos con tildes van
+// en el PDF, fuera de la imagen.
+func textoGrafico(img *image.RGBA, x, y int, texto string, c color.Color) {
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(c),
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(texto)
+}
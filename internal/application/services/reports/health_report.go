@@ -0,0 +1,505 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	"dece/internal/domain/common"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/student"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Los contactos de la ficha se completan con los familiares hasta este número
+const maxContactosEmergencia = 3
+
+type FilaSaludMatricula struct {
+	EstudianteID    uint
+	Cedula          string
+	Estudiante      string
+	FechaNacimiento string
+	Curso           string
+	Jornada         string
+	Antropometria   common.JSONMap[enrollment.Antropometria] `gorm:"type:text"`
+	DatosSalud      common.JSONMap[enrollment.DatosSalud]    `gorm:"type:text"`
+}
+
+const consultaSaludMatricula = `
+	SELECT e.id as estudiante_id, e.cedula,
+		e.apellidos || ' ' || e.nombres as estudiante, e.fecha_nacimiento,
+		ne.nombre || ' ' || c.paralelo as curso, c.jornada,
+		m.antropometria, m.datos_salud
+	FROM matriculas m
+	JOIN estudiantes e ON m.estudiante_id = e.id
+	JOIN cursos c ON m.curso_id = c.id
+	JOIN nivel_educativos ne ON c.nivel_id = ne.id
+	JOIN periodo_lectivos pl ON c.periodo_id = pl.id`
+
+// ObtenerTarjetaEmergencia arma la tarjeta con la ficha de la matrícula del periodo activo o,
+// si el estudiante no está matriculado en él, de la más reciente.
+func (s *ReportService) ObtenerTarjetaEmergencia(estudianteID uint) (*dtos.TarjetaEmergenciaDTO, error) {
+	var filas []FilaSaludMatricula
+	err := s.db.Raw(consultaSaludMatricula+`
+		WHERE e.id = ?
+		ORDER BY pl.es_activo DESC, pl.fecha_inicio DESC, m.id DESC
+		LIMIT 1`, estudianteID).Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo la ficha de salud: %v", err)
+	}
+	if len(filas) == 0 {
+		return nil, errors.New("El estudiante no tiene matrículas registradas")
+	}
+	f := filas[0]
+
+	familiares, err := s.familiaresContacto([]uint{estudianteID})
+	if err != nil {
+		return nil, err
+	}
+
+	institucion := ""
+	if configInst, err := s.instService.ObtenerConfiguracion(); err == nil {
+		institucion = configInst.Nombre
+	}
+
+	return &dtos.TarjetaEmergenciaDTO{
+		EstudianteID:    f.EstudianteID,
+		Cedula:          f.Cedula,
+		Estudiante:      f.Estudiante,
+		FechaNacimiento: f.FechaNacimiento,
+		Curso:           f.Curso,
+		Jornada:         f.Jornada,
+		Institucion:     institucion,
+		ResumenSaludDTO: resumenSalud(f.DatosSalud.Data, f.Antropometria.Data, familiares[estudianteID]),
+	}, nil
+}
+
+// ObtenerNominaSaludCurso lista a los estudiantes vigentes del curso con los datos de salud
+// que un docente necesita ante una emergencia, primero los que requieren atención.
+func (s *ReportService) ObtenerNominaSaludCurso(cursoID uint) (*dtos.NominaSaludCursoDTO, error) {
+	var curso struct {
+		ID             uint
+		Curso          string
+		Jornada        string
+		PeriodoLectivo string
+		Tutor          string
+	}
+	err := s.db.Raw(`
+		SELECT c.id, ne.nombre || ' ' || c.paralelo as curso, c.jornada,
+			pl.nombre as periodo_lectivo, COALESCE(d.nombres_completos, '') as tutor
+		FROM cursos c
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		LEFT JOIN docentes d ON c.tutor_id = d.id
+		WHERE c.id = ?`, cursoID).Scan(&curso).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo el curso: %v", err)
+	}
+	if curso.ID == 0 {
+		return nil, errors.New("El curso seleccionado no existe")
+	}
+
+	var filas []FilaSaludMatricula
+	err = s.db.Raw(consultaSaludMatricula+`
+		WHERE m.curso_id = ? AND m.estado IN ?
+		ORDER BY e.apellidos, e.nombres`, cursoID, enrollment.EstadosVigentes).Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo estudiantes del curso: %v", err)
+	}
+
+	ids := make([]uint, len(filas))
+	for i, f := range filas {
+		ids[i] = f.EstudianteID
+	}
+	familiares, err := s.familiaresContacto(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	nomina := &dtos.NominaSaludCursoDTO{
+		CursoID:          curso.ID,
+		Curso:            curso.Curso,
+		Jornada:          curso.Jornada,
+		PeriodoLectivo:   curso.PeriodoLectivo,
+		Tutor:            curso.Tutor,
+		TotalEstudiantes: len(filas),
+		Estudiantes:      []dtos.EstudianteNominaSaludDTO{},
+	}
+	var sinAtencion []dtos.EstudianteNominaSaludDTO
+	for _, f := range filas {
+		e := dtos.EstudianteNominaSaludDTO{
+			EstudianteID:    f.EstudianteID,
+			Cedula:          f.Cedula,
+			Estudiante:      f.Estudiante,
+			ResumenSaludDTO: resumenSalud(f.DatosSalud.Data, f.Antropometria.Data, familiares[f.EstudianteID]),
+		}
+		if e.RequiereAtencion {
+			nomina.ConAtencion++
+			nomina.Estudiantes = append(nomina.Estudiantes, e)
+		} else {
+			sinAtencion = append(sinAtencion, e)
+		}
+	}
+	nomina.Estudiantes = append(nomina.Estudiantes, sinAtencion...)
+
+	return nomina, nil
+}
+
+// familiaresContacto devuelve, por estudiante, los familiares vivos con teléfono, primero el
+// representante legal.
+func (s *ReportService) familiaresContacto(estudianteIDs []uint) (map[uint][]student.Familiar, error) {
+	porEstudiante := map[uint][]student.Familiar{}
+	if len(estudianteIDs) == 0 {
+		return porEstudiante, nil
+	}
+	var familiares []student.Familiar
+	err := s.db.Where("estudiante_id IN ? AND fallecido = ? AND telefono_personal <> ''", estudianteIDs, false).
+		Order("es_representante_legal DESC, vive_con_estudiante DESC, id").
+		Find(&familiares).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo familiares: %v", err)
+	}
+	for _, f := range familiares {
+		porEstudiante[f.EstudianteID] = append(porEstudiante[f.EstudianteID], f)
+	}
+	return porEstudiante, nil
+}
+
+func resumenSalud(salud enrollment.DatosSalud, antropometria enrollment.Antropometria, familiares []student.Familiar) dtos.ResumenSaludDTO {
+	r := dtos.ResumenSaludDTO{
+		TipoSangre:          antropometria.TipoSangre,
+		Alergias:            salud.Alergias,
+		CondicionesCronicas: salud.CondicionesCronicas,
+		Medicaciones:        salud.Medicaciones,
+		ContactosEmergencia: salud.ContactosEmergencia,
+		SeguroMedico:        salud.SeguroMedico,
+	}
+
+	if len(r.Alergias) == 0 && salud.TieneAlergias && strings.TrimSpace(salud.DetalleAlergia) != "" {
+		r.Alergias = []enrollment.Alergia{{Alergeno: salud.DetalleAlergia}}
+	}
+	if len(r.CondicionesCronicas) == 0 && salud.TieneEnfermedad && strings.TrimSpace(salud.DetalleEnfermedad) != "" {
+		r.CondicionesCronicas = []enrollment.CondicionCronica{{Nombre: salud.DetalleEnfermedad}}
+	}
+	if salud.TieneDiscapacidad {
+		r.Discapacidad = salud.DetalleDiscapacidad
+		if r.Discapacidad == "" {
+			r.Discapacidad = "Sí"
+		}
+	}
+
+	for _, f := range familiares {
+		if len(r.ContactosEmergencia) >= maxContactosEmergencia {
+			break
+		}
+		repetido := false
+		for _, c := range r.ContactosEmergencia {
+			if c.Telefono == f.TelefonoPersonal {
+				repetido = true
+				break
+			}
+		}
+		if !repetido {
+			r.ContactosEmergencia = append(r.ContactosEmergencia, enrollment.ContactoEmergencia{
+				Nombres:    f.NombresCompletos,
+				Parentesco: f.Parentesco,
+				Telefono:   f.TelefonoPersonal,
+			})
+		}
+	}
+
+	if r.Alergias == nil {
+		r.Alergias = []enrollment.Alergia{}
+	}
+	if r.CondicionesCronicas == nil {
+		r.CondicionesCronicas = []enrollment.CondicionCronica{}
+	}
+	if r.Medicaciones == nil {
+		r.Medicaciones = []enrollment.Medicacion{}
+	}
+	if r.ContactosEmergencia == nil {
+		r.ContactosEmergencia = []enrollment.ContactoEmergencia{}
+	}
+
+	r.RequiereAtencion = len(r.CondicionesCronicas) > 0 || r.Discapacidad != ""
+	for _, a := range r.Alergias {
+		// El texto libre no tiene severidad; se trata como grave por precaución
+		if a.EsCritica() || a.Severidad == "" {
+			r.RequiereAtencion = true
+		}
+	}
+	for _, m := range r.Medicaciones {
+		if m.EnInstitucion {
+			r.RequiereAtencion = true
+		}
+	}
+	return r
+}
+
+func textoAlergia(a enrollment.Alergia) string {
+	texto := a.Alergeno
+	if a.Severidad != "" {
+		texto += " (" + a.Severidad + ")"
+	}
+	if a.Reaccion != "" {
+		texto += ": " + a.Reaccion
+	}
+	return texto
+}
+
+func textoMedicacion(m enrollment.Medicacion) string {
+	texto := fmt.Sprintf("%s %s", m.Medicamento, m.Dosis)
+	if m.Frecuencia != "" {
+		texto += ", " + m.Frecuencia
+	}
+	if m.EnInstitucion {
+		texto += " (en la institución)"
+	}
+	return texto
+}
+
+func textoContacto(c enrollment.ContactoEmergencia) string {
+	if c.Parentesco == "" {
+		return fmt.Sprintf("%s: %s", c.Nombres, c.Telefono)
+	}
+	return fmt.Sprintf("%s (%s): %s", c.Nombres, c.Parentesco, c.Telefono)
+}
+
+func textoSeguro(s enrollment.SeguroMedico) string {
+	if !s.TieneSeguro {
+		return "Sin seguro médico registrado"
+	}
+	texto := s.Aseguradora
+	if s.NumeroPoliza != "" {
+		texto += ", póliza " + s.NumeroPoliza
+	}
+	if s.Telefono != "" {
+		texto += ", tel. " + s.Telefono
+	}
+	return texto
+}
+
+func (s *ReportService) GenerarTarjetaEmergenciaPDF(estudianteID uint) (string, error) {
+	tarjeta, err := s.ObtenerTarjetaEmergencia(estudianteID)
+	if err != nil {
+		return "", err
+	}
+
+	cfg := config.NewBuilder().
+		WithLeftMargin(15).
+		WithTopMargin(15).
+		WithRightMargin(15).
+		Build()
+
+	m := maroto.New(cfg)
+	rojo := &props.Color{Red: 180, Green: 30, Blue: 30}
+
+	m.AddRow(8,
+		text.NewCol(12, tarjeta.Institucion, props.Text{Size: 10, Style: fontstyle.Bold, Align: align.Center}),
+	)
+	m.AddRow(12,
+		text.NewCol(12, "TARJETA DE EMERGENCIA MÉDICA", props.Text{Size: 16, Style: fontstyle.Bold, Align: align.Center, Color: rojo}),
+	)
+	m.AddRow(5)
+
+	m.AddRow(8,
+		text.NewCol(2, "Estudiante:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(6, tarjeta.Estudiante),
+		text.NewCol(2, "Cédula:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(2, tarjeta.Cedula),
+	)
+	m.AddRow(8,
+		text.NewCol(2, "F. Nacimiento:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(2, tarjeta.FechaNacimiento),
+		text.NewCol(2, "Curso:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(4, fmt.Sprintf("%s (%s)", tarjeta.Curso, tarjeta.Jornada)),
+		text.NewCol(2, fmt.Sprintf("Sangre: %s", valorOGuion(tarjeta.TipoSangre)), props.Text{Style: fontstyle.Bold, Color: rojo}),
+	)
+
+	seccionSalud(m, "ALERGIAS", len(tarjeta.Alergias) == 0, "Sin alergias registradas", func() {
+		for _, a := range tarjeta.Alergias {
+			estilo := props.Text{Size: 9}
+			if a.EsCritica() || a.Severidad == "" {
+				estilo = props.Text{Size: 9, Style: fontstyle.Bold, Color: rojo}
+			}
+			m.AddRow(6, text.NewCol(12, "• "+textoAlergia(a), estilo))
+			if a.Actuacion != "" {
+				m.AddRow(6, text.NewCol(12, "   Qué hacer: "+a.Actuacion, props.Text{Size: 8, Style: fontstyle.Italic}))
+			}
+		}
+	})
+
+	seccionSalud(m, "CONDICIONES CRÓNICAS", len(tarjeta.CondicionesCronicas) == 0 && tarjeta.Discapacidad == "", "Sin condiciones registradas", func() {
+		for _, c := range tarjeta.CondicionesCronicas {
+			m.AddRow(6, text.NewCol(12, "• "+c.Nombre, props.Text{Size: 9, Style: fontstyle.Bold}))
+			if c.Actuacion != "" {
+				m.AddRow(6, text.NewCol(12, "   Qué hacer: "+c.Actuacion, props.Text{Size: 8, Style: fontstyle.Italic}))
+			}
+		}
+		if tarjeta.Discapacidad != "" {
+			m.AddRow(6, text.NewCol(12, "• Discapacidad: "+tarjeta.Discapacidad, props.Text{Size: 9}))
+		}
+	})
+
+	seccionSalud(m, "MEDICACIÓN ACTUAL", len(tarjeta.Medicaciones) == 0, "Sin medicación registrada", func() {
+		for _, med := range tarjeta.Medicaciones {
+			m.AddRow(6, text.NewCol(12, "• "+textoMedicacion(med), props.Text{Size: 9}))
+		}
+	})
+
+	seccionSalud(m, "CONTACTOS DE EMERGENCIA", len(tarjeta.ContactosEmergencia) == 0, "Sin contactos registrados", func() {
+		for _, c := range tarjeta.ContactosEmergencia {
+			m.AddRow(6, text.NewCol(12, "• "+textoContacto(c), props.Text{Size: 10, Style: fontstyle.Bold}))
+		}
+	})
+
+	seccionSalud(m, "SEGURO MÉDICO", false, "", func() {
+		m.AddRow(6, text.NewCol(12, textoSeguro(tarjeta.SeguroMedico), props.Text{Size: 9}))
+	})
+
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Datos de la ficha DECE al %s | Verifique con el representante ante cualquier duda", time.Now().Format("2006-01-02")), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+
+	return guardarPDFSalud(m, fmt.Sprintf("Tarjeta_Emergencia_%s_%s.pdf", tarjeta.Cedula, time.Now().Format("20060102_150405")))
+}
+
+func (s *ReportService) GenerarNominaSaludCursoPDF(cursoID uint) (string, error) {
+	nomina, err := s.ObtenerNominaSaludCurso(cursoID)
+	if err != nil {
+		return "", err
+	}
+
+	cfg := config.NewBuilder().
+		WithPageNumber().
+		WithLeftMargin(10).
+		WithTopMargin(15).
+		WithRightMargin(10).
+		Build()
+
+	m := maroto.New(cfg)
+
+	m.AddRow(12,
+		text.NewCol(12, "NÓMINA DE SALUD PARA EMERGENCIAS", props.Text{Size: 16, Style: fontstyle.Bold, Align: align.Center}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("%s (%s) - %s", nomina.Curso, nomina.Jornada, nomina.PeriodoLectivo), props.Text{Size: 10, Style: fontstyle.Italic, Align: align.Center}),
+	)
+	m.AddRow(8,
+		text.NewCol(6, fmt.Sprintf("Tutor: %s", valorOGuion(nomina.Tutor)), props.Text{Size: 9}),
+		text.NewCol(6, fmt.Sprintf("Estudiantes: %d | Requieren atención: %d", nomina.TotalEstudiantes, nomina.ConAtencion), props.Text{Size: 9, Style: fontstyle.Bold, Align: align.Right}),
+	)
+	m.AddRow(1, text.NewCol(12, "__________________________________________________________________________________________________________", props.Text{Size: 6}))
+	m.AddRow(4)
+
+	m.AddRow(7,
+		text.NewCol(3, "Estudiante", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(1, "Sangre", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(5, "Alergias / Condiciones / Medicación", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(3, "Contacto de emergencia", props.Text{Style: fontstyle.Bold, Size: 8}),
+	)
+
+	for _, e := range nomina.Estudiantes {
+		var detalles []string
+		for _, a := range e.Alergias {
+			detalles = append(detalles, "Alergia: "+textoAlergia(a))
+		}
+		for _, c := range e.CondicionesCronicas {
+			detalle := c.Nombre
+			if c.Actuacion != "" {
+				detalle += " - " + c.Actuacion
+			}
+			detalles = append(detalles, detalle)
+		}
+		for _, med := range e.Medicaciones {
+			detalles = append(detalles, textoMedicacion(med))
+		}
+		if e.Discapacidad != "" {
+			detalles = append(detalles, "Discapacidad: "+e.Discapacidad)
+		}
+		if len(detalles) == 0 {
+			detalles = []string{"-"}
+		}
+
+		contacto := "-"
+		if len(e.ContactosEmergencia) > 0 {
+			contacto = textoContacto(e.ContactosEmergencia[0])
+		}
+
+		estilo := props.Text{Size: 8}
+		if e.RequiereAtencion {
+			estilo = props.Text{Size: 8, Style: fontstyle.Bold, Color: &props.Color{Red: 180, Green: 30, Blue: 30}}
+		}
+		m.AddRow(float64(4+4*len(detalles)),
+			text.NewCol(3, e.Estudiante, estilo),
+			text.NewCol(1, valorOGuion(e.TipoSangre), props.Text{Size: 8}),
+			text.NewCol(5, strings.Join(detalles, "; "), props.Text{Size: 8}),
+			text.NewCol(3, contacto, props.Text{Size: 8}),
+		)
+	}
+
+	if len(nomina.Estudiantes) == 0 {
+		m.AddRow(10, text.NewCol(12, "El curso no tiene estudiantes matriculados.", props.Text{Style: fontstyle.Italic, Align: align.Center}))
+	}
+
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | Información confidencial, solo para atención de emergencias", time.Now().Format("2006-01-02 15:04")), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+
+	return guardarPDFSalud(m, fmt.Sprintf("Nomina_Salud_%s_%s.pdf", strings.ReplaceAll(nomina.Curso, " ", "_"), time.Now().Format("20060102_150405")))
+}
+
+func seccionSalud(m core.Maroto, titulo string, vacia bool, textoVacio string, filas func()) {
+	m.AddRow(4)
+	m.AddRow(8, text.NewCol(12, titulo, props.Text{Size: 11, Style: fontstyle.Bold, Color: &props.Color{Red: 50, Green: 50, Blue: 50}}))
+	if vacia {
+		m.AddRow(6, text.NewCol(12, textoVacio, props.Text{Size: 9, Style: fontstyle.Italic}))
+		return
+	}
+	filas()
+}
+
+func valorOGuion(valor string) string {
+	if strings.TrimSpace(valor) == "" {
+		return "-"
+	}
+	return valor
+}
+
+func guardarPDFSalud(m core.Maroto, fileName string) (string, error) {
+	document, err := m.Generate()
+	if err != nil {
+		return "", err
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	savePath := filepath.Join(homeDir, "Documents", "SistemaDECE", "Reportes")
+	if err := os.MkdirAll(savePath, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	fullPath := filepath.Join(savePath, fileName)
+	if err := document.Save(fullPath); err != nil {
+		return "", err
+	}
+	return fullPath, nil
+}
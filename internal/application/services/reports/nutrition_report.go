@@ -0,0 +1,490 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	growth "dece/internal/application/helpers/growth"
+	"dece/internal/domain/enrollment"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const avisoSinTablasOMS = "Las tablas de IMC para la edad de la OMS no están cargadas en el sistema; los menores de 19 años quedan sin clasificar"
+
+type filaNutricion struct {
+	EstudianteID    uint
+	Cedula          string
+	Estudiante      string
+	FechaNacimiento string
+	Sexo            string
+	Fecha           string
+	PesoKg          float64
+	TallaCm         float64
+	IMC             float64
+}
+
+// ObtenerReporteNutricionalCurso clasifica a los estudiantes vigentes del curso con la última
+// medición de su matrícula hasta la fecha de corte (AAAA-MM-DD; vacía es hoy).
+func (s *ReportService) ObtenerReporteNutricionalCurso(cursoID uint, fechaCorte string) (*dtos.ReporteNutricionalCursoDTO, error) {
+	fechaCorte, err := validarFechaCorte(fechaCorte)
+	if err != nil {
+		return nil, err
+	}
+
+	var curso struct {
+		ID             uint
+		Curso          string
+		Jornada        string
+		PeriodoLectivo string
+	}
+	err = s.db.Raw(`
+		SELECT c.id, ne.nombre || ' ' || c.paralelo as curso, c.jornada, pl.nombre as periodo_lectivo
+		FROM cursos c
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE c.id = ?`, cursoID).Scan(&curso).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo el curso: %v", err)
+	}
+	if curso.ID == 0 {
+		return nil, errors.New("El curso seleccionado no existe")
+	}
+
+	var filas []filaNutricion
+	err = s.db.Raw(`
+		SELECT e.id as estudiante_id, e.cedula, e.apellidos || ' ' || e.nombres as estudiante,
+			e.fecha_nacimiento, e.genero_nacimiento as sexo,
+			COALESCE(ma.fecha, '') as fecha, COALESCE(ma.peso_kg, 0) as peso_kg,
+			COALESCE(ma.talla_cm, 0) as talla_cm, COALESCE(ma.imc, 0) as imc
+		FROM matriculas m
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		LEFT JOIN mediciones_antropometricas ma ON ma.id = (
+			SELECT x.id FROM mediciones_antropometricas x
+			WHERE x.matricula_id = m.id AND x.fecha <= ?
+			ORDER BY x.fecha DESC, x.id DESC LIMIT 1)
+		WHERE m.curso_id = ? AND m.estado IN ?
+		ORDER BY e.apellidos, e.nombres`, fechaCorte, cursoID, enrollment.EstadosVigentes).Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo las mediciones del curso: %v", err)
+	}
+
+	reporte := &dtos.ReporteNutricionalCursoDTO{
+		CursoID:          curso.ID,
+		Curso:            curso.Curso,
+		Jornada:          curso.Jornada,
+		PeriodoLectivo:   curso.PeriodoLectivo,
+		FechaCorte:       fechaCorte,
+		TotalEstudiantes: len(filas),
+		Estudiantes:      make([]dtos.EstudianteNutricionDTO, len(filas)),
+		Advertencias:     []string{},
+	}
+
+	conteos := map[string]*dtos.ConteoNutricionalDTO{}
+	sinMedicion := 0
+	sinTablas := false
+	for i, f := range filas {
+		e := dtos.EstudianteNutricionDTO{
+			EstudianteID: f.EstudianteID,
+			Cedula:       f.Cedula,
+			Estudiante:   f.Estudiante,
+			Sexo:         f.Sexo,
+			Fecha:        f.Fecha,
+			PesoKg:       f.PesoKg,
+			TallaCm:      f.TallaCm,
+			IMC:          f.IMC,
+		}
+		if f.Fecha == "" {
+			sinMedicion++
+			reporte.Estudiantes[i] = e
+			continue
+		}
+
+		evaluacion, err := growth.EvaluarIMC(f.IMC, f.FechaNacimiento, f.Fecha, f.Sexo)
+		if err != nil {
+			reporte.Advertencias = append(reporte.Advertencias, fmt.Sprintf("%s: %v", f.Estudiante, err))
+		} else {
+			e.Edad = growth.TextoEdad(evaluacion.EdadMeses)
+		}
+		e.ZScoreIMC = evaluacion.ZScore
+		e.Clasificacion = evaluacion.Clasificacion
+		if e.Clasificacion == growth.ClasificacionSinReferencia && !growth.ReferenciaDisponible(f.Sexo) {
+			sinTablas = true
+		}
+		reporte.Estudiantes[i] = e
+		reporte.Evaluados++
+
+		c, ok := conteos[e.Clasificacion]
+		if !ok {
+			c = &dtos.ConteoNutricionalDTO{Clasificacion: e.Clasificacion}
+			conteos[e.Clasificacion] = c
+		}
+		sumarConteo(c, f.Sexo)
+	}
+
+	reporte.Conteos = conteosOrdenados(conteos)
+	if sinMedicion > 0 {
+		reporte.Advertencias = append(reporte.Advertencias, fmt.Sprintf("%d estudiante(s) sin medición hasta el %s", sinMedicion, fechaCorte))
+	}
+	if sinTablas {
+		reporte.Advertencias = append(reporte.Advertencias, avisoSinTablasOMS)
+	}
+	return reporte, nil
+}
+
+// ObtenerResumenNutricional reúne los reportes de todos los cursos del periodo activo.
+func (s *ReportService) ObtenerResumenNutricional(fechaCorte string) (*dtos.ResumenNutricionalDTO, error) {
+	fechaCorte, err := validarFechaCorte(fechaCorte)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursos []struct {
+		ID             uint
+		PeriodoLectivo string
+	}
+	err = s.db.Raw(`
+		SELECT c.id, pl.nombre as periodo_lectivo
+		FROM cursos c
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE pl.es_activo = 1
+		ORDER BY ne.orden, c.paralelo, c.jornada`).Scan(&cursos).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo los cursos: %v", err)
+	}
+	if len(cursos) == 0 {
+		return nil, errors.New("No hay cursos en el periodo lectivo activo")
+	}
+
+	resumen := &dtos.ResumenNutricionalDTO{
+		PeriodoLectivo: cursos[0].PeriodoLectivo,
+		FechaCorte:     fechaCorte,
+		Cursos:         []dtos.ReporteNutricionalCursoDTO{},
+		Advertencias:   []string{},
+	}
+	conteos := map[string]*dtos.ConteoNutricionalDTO{}
+	sinTablas := false
+	for _, c := range cursos {
+		reporte, err := s.ObtenerReporteNutricionalCurso(c.ID, fechaCorte)
+		if err != nil {
+			return nil, err
+		}
+		resumen.TotalEstudiantes += reporte.TotalEstudiantes
+		resumen.Evaluados += reporte.Evaluados
+		for _, rc := range reporte.Conteos {
+			total, ok := conteos[rc.Clasificacion]
+			if !ok {
+				total = &dtos.ConteoNutricionalDTO{Clasificacion: rc.Clasificacion}
+				conteos[rc.Clasificacion] = total
+			}
+			total.Hombres += rc.Hombres
+			total.Mujeres += rc.Mujeres
+			total.Total += rc.Total
+		}
+		for _, a := range reporte.Advertencias {
+			if a == avisoSinTablasOMS {
+				sinTablas = true
+			}
+		}
+		resumen.Cursos = append(resumen.Cursos, *reporte)
+	}
+
+	resumen.Conteos = conteosOrdenados(conteos)
+	if faltan := resumen.TotalEstudiantes - resumen.Evaluados; faltan > 0 {
+		resumen.Advertencias = append(resumen.Advertencias, fmt.Sprintf("%d estudiante(s) sin medición hasta el %s", faltan, fechaCorte))
+	}
+	if sinTablas {
+		resumen.Advertencias = append(resumen.Advertencias, avisoSinTablasOMS)
+	}
+	return resumen, nil
+}
+
+func validarFechaCorte(fecha string) (string, error) {
+	fecha = strings.TrimSpace(fecha)
+	if fecha == "" {
+		return time.Now().Format("2006-01-02"), nil
+	}
+	if _, err := time.Parse("2006-01-02", fecha); err != nil {
+		return "", errors.New("La fecha de corte es inválida, use el formato AAAA-MM-DD")
+	}
+	return fecha, nil
+}
+
+func sumarConteo(c *dtos.ConteoNutricionalDTO, sexo string) {
+	switch strings.ToUpper(sexo) {
+	case "M":
+		c.Hombres++
+	case "F":
+		c.Mujeres++
+	}
+	c.Total++
+}
+
+func conteosOrdenados(conteos map[string]*dtos.ConteoNutricionalDTO) []dtos.ConteoNutricionalDTO {
+	ordenados := []dtos.ConteoNutricionalDTO{}
+	for _, clasificacion := range growth.ClasificacionesIMC {
+		if c, ok := conteos[clasificacion]; ok {
+			ordenados = append(ordenados, *c)
+		}
+	}
+	return ordenados
+}
+
+func (s *ReportService) GenerarReporteNutricionalCursoPDF(cursoID uint, fechaCorte string) (string, error) {
+	reporte, err := s.ObtenerReporteNutricionalCurso(cursoID, fechaCorte)
+	if err != nil {
+		return "", err
+	}
+
+	m := maroto.New(configNutricion())
+	m.AddRow(12,
+		text.NewCol(12, "REPORTE NUTRICIONAL DEL CURSO", props.Text{Size: 16, Style: fontstyle.Bold, Align: align.Center}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("%s (%s) - %s | Corte al %s", reporte.Curso, reporte.Jornada, reporte.PeriodoLectivo, reporte.FechaCorte), props.Text{Size: 10, Style: fontstyle.Italic, Align: align.Center}),
+	)
+	m.AddRow(4)
+
+	tablaConteosPDF(m, reporte.Conteos, reporte.TotalEstudiantes, reporte.Evaluados)
+	advertenciasPDF(m, reporte.Advertencias)
+
+	m.AddRow(6)
+	m.AddRow(7,
+		text.NewCol(4, "Estudiante", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(1, "Fecha", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(1, "Edad", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(1, "Peso", props.Text{Style: fontstyle.Bold, Size: 8, Align: align.Right}),
+		text.NewCol(1, "Talla", props.Text{Style: fontstyle.Bold, Size: 8, Align: align.Right}),
+		text.NewCol(1, "IMC", props.Text{Style: fontstyle.Bold, Size: 8, Align: align.Right}),
+		text.NewCol(1, "Z", props.Text{Style: fontstyle.Bold, Size: 8, Align: align.Right}),
+		text.NewCol(2, "Clasificación", props.Text{Style: fontstyle.Bold, Size: 8}),
+	)
+	for _, e := range reporte.Estudiantes {
+		if e.Fecha == "" {
+			m.AddRow(6,
+				text.NewCol(4, e.Estudiante, props.Text{Size: 8}),
+				text.NewCol(8, "Sin medición", props.Text{Size: 8, Style: fontstyle.Italic}),
+			)
+			continue
+		}
+		estilo := props.Text{Size: 8}
+		if e.Clasificacion != growth.ClasificacionNormal && e.Clasificacion != growth.ClasificacionSinReferencia {
+			estilo = props.Text{Size: 8, Style: fontstyle.Bold, Color: &props.Color{Red: 180, Green: 30, Blue: 30}}
+		}
+		m.AddRow(6,
+			text.NewCol(4, e.Estudiante, props.Text{Size: 8}),
+			text.NewCol(1, e.Fecha, props.Text{Size: 7}),
+			text.NewCol(1, valorOGuion(e.Edad), props.Text{Size: 7}),
+			text.NewCol(1, fmt.Sprintf("%.1f", e.PesoKg), props.Text{Size: 8, Align: align.Right}),
+			text.NewCol(1, fmt.Sprintf("%.1f", e.TallaCm), props.Text{Size: 8, Align: align.Right}),
+			text.NewCol(1, fmt.Sprintf("%.1f", e.IMC), props.Text{Size: 8, Align: align.Right}),
+			text.NewCol(1, textoZScore(e.ZScoreIMC), props.Text{Size: 8, Align: align.Right}),
+			text.NewCol(2, e.Clasificacion, estilo),
+		)
+	}
+	if len(reporte.Estudiantes) == 0 {
+		m.AddRow(10, text.NewCol(12, "El curso no tiene estudiantes matriculados.", props.Text{Style: fontstyle.Italic, Align: align.Center}))
+	}
+
+	pieNutricion(m)
+	return guardarPDFSalud(m, fmt.Sprintf("Nutricion_%s_%s.pdf", strings.ReplaceAll(reporte.Curso, " ", "_"), time.Now().Format("20060102_150405")))
+}
+
+func (s *ReportService) GenerarResumenNutricionalPDF(fechaCorte string) (string, error) {
+	resumen, err := s.ObtenerResumenNutricional(fechaCorte)
+	if err != nil {
+		return "", err
+	}
+
+	institucion := ""
+	if configInst, err := s.instService.ObtenerConfiguracion(); err == nil {
+		institucion = configInst.Nombre
+	}
+
+	m := maroto.New(configNutricion())
+	m.AddRow(8,
+		text.NewCol(12, institucion, props.Text{Size: 10, Style: fontstyle.Bold, Align: align.Center}),
+	)
+	m.AddRow(12,
+		text.NewCol(12, "ESTADO NUTRICIONAL DE LA POBLACIÓN ESTUDIANTIL", props.Text{Size: 15, Style: fontstyle.Bold, Align: align.Center}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("%s | IMC para la edad (OMS) | Corte al %s", resumen.PeriodoLectivo, resumen.FechaCorte), props.Text{Size: 10, Style: fontstyle.Italic, Align: align.Center}),
+	)
+	m.AddRow(4)
+
+	tablaConteosPDF(m, resumen.Conteos, resumen.TotalEstudiantes, resumen.Evaluados)
+	advertenciasPDF(m, resumen.Advertencias)
+
+	m.AddRow(6)
+	m.AddRow(8, text.NewCol(12, "DETALLE POR CURSO", props.Text{Size: 11, Style: fontstyle.Bold, Color: &props.Color{Red: 50, Green: 50, Blue: 50}}))
+	m.AddRow(7,
+		text.NewCol(4, "Curso", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(1, "Total", props.Text{Style: fontstyle.Bold, Size: 8, Align: align.Right}),
+		text.NewCol(1, "Medidos", props.Text{Style: fontstyle.Bold, Size: 8, Align: align.Right}),
+		text.NewCol(6, "Clasificación", props.Text{Style: fontstyle.Bold, Size: 8}),
+	)
+	for _, c := range resumen.Cursos {
+		var detalle []string
+		for _, conteo := range c.Conteos {
+			detalle = append(detalle, fmt.Sprintf("%s: %d", conteo.Clasificacion, conteo.Total))
+		}
+		m.AddRow(6,
+			text.NewCol(4, fmt.Sprintf("%s (%s)", c.Curso, c.Jornada), props.Text{Size: 8}),
+			text.NewCol(1, fmt.Sprintf("%d", c.TotalEstudiantes), props.Text{Size: 8, Align: align.Right}),
+			text.NewCol(1, fmt.Sprintf("%d", c.Evaluados), props.Text{Size: 8, Align: align.Right}),
+			text.NewCol(6, valorOGuion(strings.Join(detalle, "; ")), props.Text{Size: 8}),
+		)
+	}
+
+	pieNutricion(m)
+	return guardarPDFSalud(m, fmt.Sprintf("Resumen_Nutricional_%s.pdf", time.Now().Format("20060102_150405")))
+}
+
+func configNutricion() *entity.Config {
+	return config.NewBuilder().
+		WithPageNumber().
+		WithLeftMargin(10).
+		WithTopMargin(15).
+		WithRightMargin(10).
+		Build()
+}
+
+func tablaConteosPDF(m core.Maroto, conteos []dtos.ConteoNutricionalDTO, total, evaluados int) {
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("Estudiantes: %d | Con medición: %d", total, evaluados), props.Text{Size: 9, Style: fontstyle.Bold}),
+	)
+	m.AddRow(7,
+		text.NewCol(6, "Clasificación", props.Text{Style: fontstyle.Bold, Size: 9}),
+		text.NewCol(2, "Hombres", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+		text.NewCol(2, "Mujeres", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+		text.NewCol(2, "Total", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+	)
+	for _, c := range conteos {
+		m.AddRow(6,
+			text.NewCol(6, c.Clasificacion, props.Text{Size: 9}),
+			text.NewCol(2, fmt.Sprintf("%d", c.Hombres), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, fmt.Sprintf("%d", c.Mujeres), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, fmt.Sprintf("%d", c.Total), props.Text{Size: 9, Align: align.Right}),
+		)
+	}
+	if len(conteos) == 0 {
+		m.AddRow(6, text.NewCol(12, "No hay mediciones registradas hasta la fecha de corte.", props.Text{Size: 9, Style: fontstyle.Italic}))
+	}
+}
+
+func advertenciasPDF(m core.Maroto, advertencias []string) {
+	for _, a := range advertencias {
+		m.AddRow(6, text.NewCol(12, "Nota: "+a, props.Text{Size: 8, Style: fontstyle.Italic, Color: &props.Color{Red: 150, Green: 90, Blue: 0}}))
+	}
+}
+
+func pieNutricion(m core.Maroto) {
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | Puntos de corte de la OMS: IMC para la edad hasta los 19 años", time.Now().Format("2006-01-02 15:04")), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+}
+
+func textoZScore(z *float64) string {
+	if z == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%+.2f", *z)
+}
+
+type medicionFicha struct {
+	Fecha   string
+	PesoKg  float64
+	TallaCm float64
+	IMC     float64
+	growth.EvaluacionIMC
+}
+
+// medicionesEstudiante devuelve todas las mediciones del estudiante, de todas sus matrículas,
+// evaluadas con la referencia de su sexo.
+func (s *ReportService) medicionesEstudiante(estudianteID uint, fechaNacimiento, sexo string) ([]medicionFicha, error) {
+	var mediciones []medicionFicha
+	err := s.db.Raw(`
+		SELECT ma.fecha, ma.peso_kg, ma.talla_cm, ma.imc
+		FROM mediciones_antropometricas ma
+		JOIN matriculas m ON ma.matricula_id = m.id
+		WHERE m.estudiante_id = ?
+		ORDER BY ma.fecha, ma.id`, estudianteID).Scan(&mediciones).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo las mediciones: %v", err)
+	}
+	for i := range mediciones {
+		mediciones[i].EvaluacionIMC, _ = growth.EvaluarIMC(mediciones[i].IMC, fechaNacimiento, mediciones[i].Fecha, sexo)
+	}
+	return mediciones, nil
+}
+
+func agregarCrecimientoPDF(m core.Maroto, mediciones []medicionFicha, sexo string) {
+	m.AddRow(10)
+
+	m.AddRow(10,
+		text.NewCol(12, "E. CRECIMIENTO Y ESTADO NUTRICIONAL", props.Text{
+			Size:  12,
+			Style: fontstyle.Bold,
+			Color: &props.Color{Red: 50, Green: 50, Blue: 50},
+		}),
+	)
+	m.AddRow(1, text.NewCol(12, "__________________________________________________________________________________________________________", props.Text{Size: 6}))
+	m.AddRow(5)
+
+	if len(mediciones) == 0 {
+		m.AddRow(8, text.NewCol(12, "No hay mediciones antropométricas registradas.", props.Text{Style: fontstyle.Italic}))
+		return
+	}
+
+	m.AddRow(7,
+		text.NewCol(2, "Fecha", props.Text{Style: fontstyle.Bold, Size: 9}),
+		text.NewCol(2, "Edad", props.Text{Style: fontstyle.Bold, Size: 9}),
+		text.NewCol(1, "Peso", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+		text.NewCol(1, "Talla", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+		text.NewCol(1, "IMC", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+		text.NewCol(2, "Puntaje z", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+		text.NewCol(3, "Clasificación", props.Text{Style: fontstyle.Bold, Size: 9}),
+	)
+	puntos := make([]puntoGrafico, 0, len(mediciones))
+	for _, med := range mediciones {
+		m.AddRow(6,
+			text.NewCol(2, med.Fecha, props.Text{Size: 9}),
+			text.NewCol(2, growth.TextoEdad(med.EdadMeses), props.Text{Size: 9}),
+			text.NewCol(1, fmt.Sprintf("%.1f", med.PesoKg), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(1, fmt.Sprintf("%.1f", med.TallaCm), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(1, fmt.Sprintf("%.1f", med.IMC), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, textoZScore(med.ZScore), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(3, med.Clasificacion, props.Text{Size: 9}),
+		)
+		puntos = append(puntos, puntoGrafico{EdadMeses: med.EdadMeses, IMC: med.IMC})
+	}
+
+	grafico, err := graficoCrecimientoPNG(puntos, sexo)
+	if err != nil {
+		return
+	}
+	m.AddRow(6)
+	m.AddRow(6, text.NewCol(12, "IMC (kg/m²) por edad (años)", props.Text{Size: 9, Style: fontstyle.Bold, Align: align.Center}))
+	m.AddRows(image.NewFromBytesRow(85, grafico, extension.Png, props.Rect{Center: true, Percent: 100}))
+	leyenda := "Puntos azules: mediciones del estudiante. Curvas OMS: verde mediana; amarilla +1 DE; naranja ±2 DE; roja ±3 DE."
+	if !growth.ReferenciaDisponible(sexo) {
+		leyenda = "Puntos azules: mediciones del estudiante. Sin curvas de referencia: las tablas de la OMS no están cargadas."
+	}
+	m.AddRow(6, text.NewCol(12, leyenda, props.Text{Size: 7, Style: fontstyle.Italic, Align: align.Center}))
+}
@@ -0,0 +1,258 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	studentSvc "dece/internal/application/services/student"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ObtenerReporteExtraedad lista, por curso del periodo activo, a los estudiantes cuya edad a la
+// fecha de referencia supera la edad máxima esperada del nivel. Sin fecha se usa el inicio
+// del periodo activo, que es el corte que pide el Ministerio.
+func (s *ReportService) ObtenerReporteExtraedad(fechaReferencia string) (*dtos.ReporteExtraedadDTO, error) {
+	if fechaReferencia == "" {
+		s.db.Table("periodo_lectivos").Select("fecha_inicio").Where("es_activo = ?", true).Scan(&fechaReferencia)
+	}
+	if fechaReferencia == "" {
+		fechaReferencia = time.Now().Format("2006-01-02")
+	}
+
+	referencia, err := time.Parse("2006-01-02", fechaReferencia)
+	if err != nil {
+		return nil, errors.New("Fecha de referencia inválida, use el formato AAAA-MM-DD")
+	}
+
+	var filas []struct {
+		EstudianteID    uint
+		Cedula          string
+		Estudiante      string
+		FechaNacimiento string
+		EsRepetidor     bool
+		CursoID         uint
+		Curso           string
+		Jornada         string
+		Nivel           string
+		EdadMinima      int
+		EdadMaxima      int
+	}
+
+	err = s.db.Raw(`
+		SELECT
+			e.id as estudiante_id, e.cedula,
+			e.apellidos || ' ' || e.nombres as estudiante,
+			e.fecha_nacimiento, m.es_repetidor,
+			c.id as curso_id, ne.nombre || ' ' || c.paralelo as curso, c.jornada,
+			ne.nombre as nivel, ne.edad_minima, ne.edad_maxima
+		FROM matriculas m
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		JOIN cursos c ON m.curso_id = c.id
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE pl.es_activo = 1 AND m.estado IN ('Matriculado', 'Reingreso')
+		ORDER BY ne.orden, c.paralelo, c.jornada, e.apellidos, e.nombres`).Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo estudiantes matriculados: %v", err)
+	}
+
+	reporte := &dtos.ReporteExtraedadDTO{
+		FechaReferencia:      fechaReferencia,
+		NivelesSinConfigurar: []string{},
+		Cursos:               []dtos.CursoExtraedadDTO{},
+	}
+
+	indiceCurso := map[uint]int{}
+	nivelesSinConfigurar := map[string]bool{}
+
+	for _, f := range filas {
+		if f.EdadMaxima == 0 {
+			if !nivelesSinConfigurar[f.Nivel] {
+				nivelesSinConfigurar[f.Nivel] = true
+				reporte.NivelesSinConfigurar = append(reporte.NivelesSinConfigurar, f.Nivel)
+			}
+			continue
+		}
+
+		idx, ok := indiceCurso[f.CursoID]
+		if !ok {
+			idx = len(reporte.Cursos)
+			indiceCurso[f.CursoID] = idx
+			reporte.Cursos = append(reporte.Cursos, dtos.CursoExtraedadDTO{
+				CursoID:       f.CursoID,
+				Curso:         f.Curso,
+				Jornada:       f.Jornada,
+				RangoEsperado: fmt.Sprintf("%d - %d años", f.EdadMinima, f.EdadMaxima),
+				Estudiantes:   []dtos.EstudianteExtraedadDTO{},
+			})
+		}
+		curso := &reporte.Cursos[idx]
+		curso.TotalEstudiantes++
+		reporte.TotalEstudiantes++
+
+		edad := studentSvc.CalcularEdadAl(f.FechaNacimiento, referencia)
+		if edad <= 0 {
+			reporte.SinFechaNacimiento++
+			continue
+		}
+		if edad <= f.EdadMaxima {
+			continue
+		}
+
+		curso.TotalExtraedad++
+		reporte.TotalExtraedad++
+		curso.Estudiantes = append(curso.Estudiantes, dtos.EstudianteExtraedadDTO{
+			EstudianteID:    f.EstudianteID,
+			Cedula:          f.Cedula,
+			Estudiante:      f.Estudiante,
+			FechaNacimiento: f.FechaNacimiento,
+			Edad:            edad,
+			EdadMaxima:      f.EdadMaxima,
+			AniosDesfase:    edad - f.EdadMaxima,
+			EsRepetidor:     f.EsRepetidor,
+		})
+	}
+
+	for i := range reporte.Cursos {
+		reporte.Cursos[i].Porcentaje = porcentaje(reporte.Cursos[i].TotalExtraedad, reporte.Cursos[i].TotalEstudiantes)
+	}
+	reporte.Porcentaje = porcentaje(reporte.TotalExtraedad, reporte.TotalEstudiantes)
+
+	return reporte, nil
+}
+
+func (s *ReportService) GenerarReporteExtraedadPDF(fechaReferencia string) (string, error) {
+	data, err := s.ObtenerReporteExtraedad(fechaReferencia)
+	if err != nil {
+		return "", err
+	}
+
+	cfg := config.NewBuilder().
+		WithPageNumber().
+		WithLeftMargin(15).
+		WithTopMargin(15).
+		WithRightMargin(15).
+		Build()
+
+	m := maroto.New(cfg)
+
+	m.AddRow(12,
+		text.NewCol(12, "REPORTE DE ESTUDIANTES CON EXTRAEDAD", props.Text{
+			Size:  16,
+			Style: fontstyle.Bold,
+			Align: align.Center,
+		}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("Edad calculada al %s", data.FechaReferencia), props.Text{
+			Size:  10,
+			Style: fontstyle.Italic,
+			Align: align.Center,
+		}),
+	)
+	m.AddRow(5)
+
+	m.AddRow(8,
+		text.NewCol(4, fmt.Sprintf("Estudiantes evaluados: %d", data.TotalEstudiantes), props.Text{Style: fontstyle.Bold, Size: 10}),
+		text.NewCol(4, fmt.Sprintf("Con extraedad: %d", data.TotalExtraedad), props.Text{Style: fontstyle.Bold, Size: 10}),
+		text.NewCol(4, fmt.Sprintf("Porcentaje: %.1f%%", data.Porcentaje), props.Text{Style: fontstyle.Bold, Size: 10}),
+	)
+	if len(data.NivelesSinConfigurar) > 0 {
+		m.AddRow(8,
+			text.NewCol(12, fmt.Sprintf("Niveles sin rango de edad configurado (no evaluados): %v", data.NivelesSinConfigurar), props.Text{
+				Size:  8,
+				Style: fontstyle.Italic,
+				Color: &props.Color{Red: 180, Green: 80, Blue: 0},
+			}),
+		)
+	}
+
+	m.AddRow(1, text.NewCol(12, "__________________________________________________________________________________________________________", props.Text{Size: 6}))
+	m.AddRow(5)
+
+	hayCasos := false
+	for _, curso := range data.Cursos {
+		if curso.TotalExtraedad == 0 {
+			continue
+		}
+		hayCasos = true
+
+		m.AddRow(9,
+			text.NewCol(6, fmt.Sprintf("%s (%s)", curso.Curso, curso.Jornada), props.Text{Style: fontstyle.Bold, Size: 10}),
+			text.NewCol(3, fmt.Sprintf("Esperado: %s", curso.RangoEsperado), props.Text{Size: 9}),
+			text.NewCol(3, fmt.Sprintf("%d de %d (%.1f%%)", curso.TotalExtraedad, curso.TotalEstudiantes, curso.Porcentaje), props.Text{Size: 9, Align: align.Right}),
+		)
+		m.AddRow(7,
+			text.NewCol(5, "Estudiante (Cédula)", props.Text{Style: fontstyle.Bold, Size: 8}),
+			text.NewCol(2, "F. Nacimiento", props.Text{Style: fontstyle.Bold, Size: 8}),
+			text.NewCol(1, "Edad", props.Text{Style: fontstyle.Bold, Size: 8}),
+			text.NewCol(2, "Desfase", props.Text{Style: fontstyle.Bold, Size: 8}),
+			text.NewCol(2, "Repetidor", props.Text{Style: fontstyle.Bold, Size: 8}),
+		)
+		for _, e := range curso.Estudiantes {
+			repetidor := "No"
+			if e.EsRepetidor {
+				repetidor = "Sí"
+			}
+			m.AddRow(7,
+				text.NewCol(5, fmt.Sprintf("%s (%s)", e.Estudiante, e.Cedula), props.Text{Size: 8}),
+				text.NewCol(2, e.FechaNacimiento, props.Text{Size: 8}),
+				text.NewCol(1, fmt.Sprintf("%d", e.Edad), props.Text{Size: 8}),
+				text.NewCol(2, fmt.Sprintf("+%d año(s)", e.AniosDesfase), props.Text{Size: 8}),
+				text.NewCol(2, repetidor, props.Text{Size: 8}),
+			)
+		}
+		m.AddRow(4, text.NewCol(12, "- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -", props.Text{Size: 6, Align: align.Center}))
+	}
+
+	if !hayCasos {
+		m.AddRow(10, text.NewCol(12, "No se encontraron estudiantes con extraedad.", props.Text{Style: fontstyle.Italic, Align: align.Center}))
+	}
+
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | DECE - Gestión Estudiantil", time.Now().Format("2006-01-02 15:04")), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+
+	document, err := m.Generate()
+	if err != nil {
+		return "", err
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	savePath := filepath.Join(homeDir, "Documents", "SistemaDECE", "Reportes")
+	if err := os.MkdirAll(savePath, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	fileName := fmt.Sprintf("Extraedad_%s.pdf", time.Now().Format("20060102_150405"))
+	fullPath := filepath.Join(savePath, fileName)
+
+	if err := document.Save(fullPath); err != nil {
+		return "", err
+	}
+
+	return fullPath, nil
+}
+
+func porcentaje(parte, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(parte)*1000/float64(total)) / 10
+}
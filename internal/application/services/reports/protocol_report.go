@@ -0,0 +1,235 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	"dece/internal/domain/academic"
+	"dece/internal/domain/tracking"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ObtenerInformeProtocolos reúne los protocolos de embarazo, maternidad y paternidad del periodo
+// (0 es el activo) y los que siguen abiertos de periodos anteriores. Es confidencial: solo la
+// coordinación del DECE (administrador) puede verlo.
+func (s *ReportService) ObtenerInformeProtocolos(periodoID uint) (*dtos.InformeProtocolosDTO, error) {
+	usuario, err := s.auth.ObtenerUsuarioSesion()
+	if err != nil || usuario.Rol != "admin" {
+		return nil, errors.New("Solo la coordinación del DECE puede ver el informe confidencial de protocolos")
+	}
+
+	var periodo academic.PeriodoLectivo
+	query := s.db
+	if periodoID == 0 {
+		query = query.Where("es_activo = ?", true)
+	} else {
+		query = query.Where("id = ?", periodoID)
+	}
+	if err := query.First(&periodo).Error; err != nil {
+		return nil, errors.New("Periodo lectivo no encontrado")
+	}
+
+	var filas []struct {
+		tracking.ProtocoloMaternidad
+		Estudiante     string
+		Cedula         string
+		Curso          string
+		PeriodoLectivo string
+	}
+	err = s.db.Raw(`
+		SELECT p.*, e.apellidos || ' ' || e.nombres as estudiante, e.cedula,
+			ne.nombre || ' ' || c.paralelo as curso, pl.nombre as periodo_lectivo
+		FROM protocolos_maternidad p
+		JOIN matriculas m ON p.matricula_id = m.id
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		JOIN cursos c ON m.curso_id = c.id
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE c.periodo_id = ? OR p.estado = ?
+		ORDER BY p.estado, e.apellidos, e.nombres, p.fecha_apertura`, periodo.ID, tracking.EstadoProtocoloAbierto).Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo los protocolos: %v", err)
+	}
+
+	ids := make([]uint, len(filas))
+	for i, f := range filas {
+		ids[i] = f.ID
+	}
+	hitos := map[uint][]tracking.HitoProtocolo{}
+	contactos := map[uint][]tracking.ContactoProtocolo{}
+	if len(ids) > 0 {
+		var lista []tracking.HitoProtocolo
+		if err := s.db.Where("protocolo_id IN ?", ids).Order("fecha_programada, id").Find(&lista).Error; err != nil {
+			return nil, fmt.Errorf("Error obteniendo los hitos: %v", err)
+		}
+		for _, h := range lista {
+			hitos[h.ProtocoloID] = append(hitos[h.ProtocoloID], h)
+		}
+		var listaContactos []tracking.ContactoProtocolo
+		if err := s.db.Where("protocolo_id IN ?", ids).Order("fecha, id").Find(&listaContactos).Error; err != nil {
+			return nil, fmt.Errorf("Error obteniendo los contactos: %v", err)
+		}
+		for _, c := range listaContactos {
+			contactos[c.ProtocoloID] = append(contactos[c.ProtocoloID], c)
+		}
+	}
+
+	hoy := time.Now().Format("2006-01-02")
+	informe := &dtos.InformeProtocolosDTO{
+		PeriodoLectivo: periodo.Nombre,
+		FechaCorte:     hoy,
+		Total:          len(filas),
+		PorTipo:        []dtos.ConteoProtocoloDTO{},
+		Protocolos:     []dtos.ProtocoloInformeDTO{},
+	}
+	if configInst, err := s.instService.ObtenerConfiguracion(); err == nil {
+		c := configInst.Autoridades.CoordinadorDECE
+		informe.Coordinador = strings.TrimSpace(c.Nombres + " " + c.Apellidos)
+	}
+
+	porTipo := map[string]*dtos.ConteoProtocoloDTO{}
+	for _, tipo := range tracking.TiposProtocolo {
+		porTipo[tipo] = &dtos.ConteoProtocoloDTO{Tipo: tipo}
+	}
+
+	for _, f := range filas {
+		p := dtos.ProtocoloInformeDTO{
+			ID:             f.ID,
+			Estudiante:     f.Estudiante,
+			Cedula:         f.Cedula,
+			Curso:          f.Curso,
+			PeriodoLectivo: f.PeriodoLectivo,
+			Tipo:           f.Tipo,
+			Estado:         f.Estado,
+			FechaApertura:  f.FechaApertura,
+			FechaCierre:    f.FechaCierre,
+			MotivoCierre:   f.MotivoCierre,
+			HitosTotal:     len(hitos[f.ID]),
+			Pendientes:     []string{},
+			Contactos:      []dtos.ContactoInformeDTO{},
+		}
+		for _, h := range hitos[f.ID] {
+			switch h.Estado(hoy) {
+			case tracking.EstadoHitoCumplido:
+				p.HitosCumplidos++
+			case tracking.EstadoHitoVencido:
+				p.HitosVencidos++
+				p.Pendientes = append(p.Pendientes, fmt.Sprintf("%s: %s (vencido)", h.FechaProgramada, h.Tipo))
+			case tracking.EstadoHitoProximo:
+				p.Pendientes = append(p.Pendientes, fmt.Sprintf("%s: %s", h.FechaProgramada, h.Tipo))
+			}
+		}
+		for _, c := range contactos[f.ID] {
+			p.Contactos = append(p.Contactos, dtos.ContactoInformeDTO{Fecha: c.Fecha, Modalidad: c.Modalidad, Resumen: c.Resumen, Acuerdos: c.Acuerdos})
+		}
+
+		conteo, ok := porTipo[f.Tipo]
+		if !ok {
+			conteo = &dtos.ConteoProtocoloDTO{Tipo: f.Tipo}
+			porTipo[f.Tipo] = conteo
+		}
+		if f.Estado == tracking.EstadoProtocoloAbierto {
+			informe.Abiertos++
+			informe.HitosVencidos += p.HitosVencidos
+			conteo.Abiertos++
+		} else {
+			conteo.Cerrados++
+		}
+		informe.Protocolos = append(informe.Protocolos, p)
+	}
+	for _, tipo := range tracking.TiposProtocolo {
+		informe.PorTipo = append(informe.PorTipo, *porTipo[tipo])
+	}
+	return informe, nil
+}
+
+func (s *ReportService) GenerarInformeProtocolosPDF(periodoID uint) (string, error) {
+	informe, err := s.ObtenerInformeProtocolos(periodoID)
+	if err != nil {
+		return "", err
+	}
+
+	m := maroto.New(configNutricion())
+	m.AddRow(8,
+		text.NewCol(12, "CONFIDENCIAL", props.Text{Size: 10, Style: fontstyle.Bold, Align: align.Center, Color: &props.Color{Red: 180, Green: 0, Blue: 0}}),
+	)
+	m.AddRow(12,
+		text.NewCol(12, "INFORME DE PROTOCOLOS DE EMBARAZO, MATERNIDAD Y PATERNIDAD", props.Text{Size: 14, Style: fontstyle.Bold, Align: align.Center}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("%s | Corte al %s", informe.PeriodoLectivo, informe.FechaCorte), props.Text{Size: 10, Style: fontstyle.Italic, Align: align.Center}),
+	)
+	m.AddRow(4)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("Protocolos: %d | Abiertos: %d | Hitos vencidos: %d", informe.Total, informe.Abiertos, informe.HitosVencidos), props.Text{Size: 9, Style: fontstyle.Bold}),
+	)
+	m.AddRow(7,
+		text.NewCol(8, "Tipo", props.Text{Style: fontstyle.Bold, Size: 9}),
+		text.NewCol(2, "Abiertos", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+		text.NewCol(2, "Cerrados", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+	)
+	for _, c := range informe.PorTipo {
+		m.AddRow(6,
+			text.NewCol(8, c.Tipo, props.Text{Size: 9}),
+			text.NewCol(2, fmt.Sprintf("%d", c.Abiertos), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, fmt.Sprintf("%d", c.Cerrados), props.Text{Size: 9, Align: align.Right}),
+		)
+	}
+
+	for _, p := range informe.Protocolos {
+		m.AddRow(6)
+		m.AddRow(8,
+			text.NewCol(8, fmt.Sprintf("%s (%s)", p.Estudiante, p.Cedula), props.Text{Size: 10, Style: fontstyle.Bold}),
+			text.NewCol(4, fmt.Sprintf("%s - %s", p.Tipo, p.Estado), props.Text{Size: 9, Style: fontstyle.Bold, Align: align.Right}),
+		)
+		estado := fmt.Sprintf("Abierto el %s", p.FechaApertura)
+		if p.Estado == tracking.EstadoProtocoloCerrado {
+			estado += fmt.Sprintf(", cerrado el %s: %s", p.FechaCierre, p.MotivoCierre)
+		}
+		m.AddRow(6,
+			text.NewCol(12, fmt.Sprintf("%s (%s) | %s | Hitos cumplidos: %d de %d, vencidos: %d", p.Curso, p.PeriodoLectivo, estado, p.HitosCumplidos, p.HitosTotal, p.HitosVencidos), props.Text{Size: 8}),
+		)
+		if p.Estado == tracking.EstadoProtocoloAbierto && len(p.Pendientes) > 0 {
+			pendientes := strings.Join(p.Pendientes, "; ")
+			m.AddRow(float64(3+3*max(1, (len(pendientes)+119)/120)),
+				text.NewCol(12, "Pendiente: "+pendientes, props.Text{Size: 8, Color: &props.Color{Red: 150, Green: 90, Blue: 0}}),
+			)
+		}
+		if len(p.Contactos) == 0 {
+			m.AddRow(6, text.NewCol(12, "Sin contactos registrados.", props.Text{Size: 8, Style: fontstyle.Italic}))
+		}
+		for _, c := range p.Contactos {
+			detalle := c.Resumen
+			if c.Acuerdos != "" {
+				detalle += " Acuerdos: " + c.Acuerdos
+			}
+			m.AddRow(float64(3+3*max(1, (len(detalle)+89)/90)),
+				text.NewCol(3, fmt.Sprintf("%s\n%s", c.Fecha, c.Modalidad), props.Text{Size: 7}),
+				text.NewCol(9, detalle, props.Text{Size: 8}),
+			)
+		}
+	}
+	if len(informe.Protocolos) == 0 {
+		m.AddRow(10, text.NewCol(12, "No hay protocolos registrados en el periodo.", props.Text{Style: fontstyle.Italic, Align: align.Center}))
+	}
+
+	m.AddRow(20)
+	m.AddRow(6, text.NewCol(12, "______________________________", props.Text{Align: align.Center}))
+	m.AddRow(6, text.NewCol(12, valorOGuion(informe.Coordinador), props.Text{Size: 9, Align: align.Center}))
+	m.AddRow(6, text.NewCol(12, "Coordinación DECE", props.Text{Size: 9, Style: fontstyle.Bold, Align: align.Center}))
+
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | Documento confidencial: no difundir", time.Now().Format("2006-01-02 15:04")), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+	return guardarPDFSalud(m, fmt.Sprintf("Protocolos_Confidencial_%s.pdf", time.Now().Format("20060102_150405")))
+}
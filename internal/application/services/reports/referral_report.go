@@ -0,0 +1,223 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/tracking"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func (s *ReportService) ObtenerEstadisticasRemisiones(fechaInicio, fechaFin string) (*dtos.EstadisticasRemisionesDTO, error) {
+	reporte := &dtos.EstadisticasRemisionesDTO{
+		FechaInicio: fechaInicio,
+		FechaFin:    fechaFin,
+	}
+
+	convertidas := fmt.Sprintf("SUM(CASE WHEN r.estado IN ('%s', '%s') THEN 1 ELSE 0 END)", tracking.EstadoRemisionConvertidaCaso, tracking.EstadoRemisionConvertidaCita)
+
+	queryCurso := `
+		SELECT
+			ne.nombre || ' ' || c.paralelo as curso,
+			COUNT(r.id) as cantidad,
+			` + convertidas + ` as convertidas
+		FROM remisiones r
+		JOIN matriculas m ON r.matricula_id = m.id
+		JOIN cursos c ON c.id = ` + enrollment.CursoEnFechaSQL("m", "r.fecha_remision") + `
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		WHERE r.fecha_remision BETWEEN ? AND ?
+		GROUP BY c.id
+		ORDER BY cantidad DESC;`
+
+	if err := s.db.Raw(queryCurso, fechaInicio, fechaFin).Scan(&reporte.PorCurso).Error; err != nil {
+		return nil, fmt.Errorf("error en remisiones por curso: %v", err)
+	}
+
+	queryDocente := `
+		SELECT
+			d.nombres_completos as docente,
+			COUNT(r.id) as cantidad,
+			` + convertidas + ` as convertidas
+		FROM remisiones r
+		JOIN docentes d ON r.docente_id = d.id
+		WHERE r.fecha_remision BETWEEN ? AND ?
+		GROUP BY d.id
+		ORDER BY cantidad DESC;`
+
+	if err := s.db.Raw(queryDocente, fechaInicio, fechaFin).Scan(&reporte.PorDocente).Error; err != nil {
+		return nil, fmt.Errorf("error en remisiones por docente: %v", err)
+	}
+
+	queryEstado := `
+		SELECT estado, COUNT(*) as cantidad
+		FROM remisiones
+		WHERE fecha_remision BETWEEN ? AND ?
+		GROUP BY estado;`
+
+	if err := s.db.Raw(queryEstado, fechaInicio, fechaFin).Scan(&reporte.PorEstado).Error; err != nil {
+		return nil, fmt.Errorf("error en remisiones por estado: %v", err)
+	}
+
+	queryCategoria := `
+		SELECT categoria, COUNT(*) as cantidad
+		FROM remisiones
+		WHERE fecha_remision BETWEEN ? AND ?
+		GROUP BY categoria
+		ORDER BY cantidad DESC;`
+
+	if err := s.db.Raw(queryCategoria, fechaInicio, fechaFin).Scan(&reporte.PorCategoria).Error; err != nil {
+		return nil, fmt.Errorf("error en remisiones por categoría: %v", err)
+	}
+
+	for _, e := range reporte.PorEstado {
+		reporte.Total += e.Cantidad
+	}
+
+	return reporte, nil
+}
+
+// GenerarAcuseRemisionPDF genera el acuse de recibo que se entrega al docente que remitió.
+func (s *ReportService) GenerarAcuseRemisionPDF(remisionID uint) (string, error) {
+	var r tracking.Remision
+
+	if err := s.db.Preload("Docente").
+		Preload("Matricula.Estudiante").
+		Preload("Matricula.Curso.Nivel").
+		First(&r, remisionID).Error; err != nil {
+		return "", errors.New("Remisión no encontrada")
+	}
+
+	configInst, _ := s.instService.ObtenerConfiguracion()
+
+	cfg := config.NewBuilder().
+		WithPageNumber().
+		WithLeftMargin(15).
+		WithTopMargin(15).
+		WithRightMargin(15).
+		Build()
+
+	m := maroto.New(cfg)
+
+	if configInst != nil {
+		m.AddRow(10,
+			text.NewCol(12, configInst.Nombre, props.Text{
+				Size:  14,
+				Style: fontstyle.Bold,
+				Align: align.Center,
+			}),
+		)
+	}
+	m.AddRow(12,
+		text.NewCol(12, "ACUSE DE RECIBO DE REMISIÓN AL DECE", props.Text{
+			Size:  14,
+			Style: fontstyle.Bold,
+			Align: align.Center,
+		}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("Código: %s", r.CodigoRemision), props.Text{
+			Size:  10,
+			Style: fontstyle.Italic,
+			Align: align.Center,
+		}),
+	)
+	m.AddRow(5)
+
+	m.AddRow(10,
+		text.NewCol(12, "A. DATOS DE LA REMISIÓN", props.Text{
+			Size:  12,
+			Style: fontstyle.Bold,
+			Color: &props.Color{Red: 50, Green: 50, Blue: 50},
+		}),
+	)
+	m.AddRow(1, text.NewCol(12, "__________________________________________________________________________________________________________", props.Text{Size: 6}))
+	m.AddRow(5)
+
+	m.AddRow(8,
+		text.NewCol(3, "Docente que remite:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(9, r.Docente.NombresCompletos),
+	)
+	m.AddRow(8,
+		text.NewCol(3, "Estudiante:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(5, fmt.Sprintf("%s %s", r.Matricula.Estudiante.Apellidos, r.Matricula.Estudiante.Nombres)),
+		text.NewCol(2, "Curso:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(2, fmt.Sprintf("%s %s", r.Matricula.Curso.Nivel.Nombre, r.Matricula.Curso.Paralelo)),
+	)
+	m.AddRow(8,
+		text.NewCol(3, "Fecha de remisión:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(5, r.FechaRemision),
+		text.NewCol(2, "Urgencia:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(2, r.Urgencia),
+	)
+	m.AddRow(8,
+		text.NewCol(3, "Categoría:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(9, r.Categoria),
+	)
+	m.AddRow(8,
+		text.NewCol(3, "Estado actual:", props.Text{Style: fontstyle.Bold}),
+		text.NewCol(9, r.Estado),
+	)
+	m.AddRow(5)
+
+	m.AddRow(8, text.NewCol(12, "Descripción reportada:", props.Text{Style: fontstyle.Bold}))
+	m.AddRow(20, text.NewCol(12, r.Descripcion, props.Text{Size: 9}))
+	m.AddRow(10)
+
+	m.AddRow(12,
+		text.NewCol(12, "El Departamento de Consejería Estudiantil deja constancia de haber recibido la presente remisión, "+
+			"la cual será evaluada y atendida conforme a los protocolos vigentes. La información es de carácter confidencial.", props.Text{
+			Size:  9,
+			Style: fontstyle.Italic,
+		}),
+	)
+	m.AddRow(30)
+
+	m.AddRow(8,
+		text.NewCol(6, "______________________________", props.Text{Align: align.Center}),
+		text.NewCol(6, "______________________________", props.Text{Align: align.Center}),
+	)
+	m.AddRow(8,
+		text.NewCol(6, "Recibido por DECE", props.Text{Align: align.Center, Size: 9}),
+		text.NewCol(6, r.Docente.NombresCompletos, props.Text{Align: align.Center, Size: 9}),
+	)
+
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | DECE - Gestión Estudiantil", time.Now().Format("2006-01-02 15:04")), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+
+	document, err := m.Generate()
+	if err != nil {
+		return "", err
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	savePath := filepath.Join(homeDir, "Documents", "SistemaDECE", "Reportes")
+	if err := os.MkdirAll(savePath, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	fileName := fmt.Sprintf("Acuse_%s_%s.pdf", r.CodigoRemision, time.Now().Format("150405"))
+	fullPath := filepath.Join(savePath, fileName)
+
+	if err := document.Save(fullPath); err != nil {
+		return "", err
+	}
+
+	return fullPath, nil
+}
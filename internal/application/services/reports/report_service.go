@@ -4,6 +4,7 @@ import (
 	dtos "dece/internal/application/dtos/reports"
 	faculty "dece/internal/application/services/faculty"
 	security "dece/internal/application/services/security"
+	"dece/internal/domain/enrollment"
 	"fmt"
 	"os"
 	"os/exec"
@@ -24,13 +25,15 @@ type ReportService struct {
 	db             *gorm.DB
 	instService    *security.InstitutionService
 	teacherService *faculty.TeacherService
+	auth           *security.AuthService
 }
 
-func NewReportService(db *gorm.DB, instService *security.InstitutionService, teacherService *faculty.TeacherService) *ReportService {
+func NewReportService(db *gorm.DB, instService *security.InstitutionService, teacherService *faculty.TeacherService, auth *security.AuthService) *ReportService {
 	return &ReportService{
 		db:             db,
 		instService:    instService,
 		teacherService: teacherService,
+		auth:           auth,
 	}
 }
 
@@ -307,7 +310,7 @@ func (s *ReportService) ObtenerReporteEstadistico(fechaInicio, fechaFin string)
 			COUNT(la.id) as total_faltas
 		FROM llamados_atencion la
 		JOIN matriculas m ON la.matricula_id = m.id
-		JOIN cursos c ON m.curso_id = c.id
+		JOIN cursos c ON c.id = ` + enrollment.CursoEnFechaSQL("m", "la.fecha") + `
 		JOIN nivel_educativos ne ON c.nivel_id = ne.id
 		WHERE la.fecha BETWEEN ? AND ?
 		GROUP BY c.id
@@ -653,6 +656,22 @@ func (s *ReportService) GenerarReporteFichaEstudiantil(cedula string) (string, e
 		m.AddRow(8, text.NewCol(12, "No se registran casos sensibles.", props.Text{Style: fontstyle.Italic}))
 	}
 
+	var estudianteID uint
+	s.db.Table("estudiantes").Select("id").Where("cedula = ?", cedula).Scan(&estudianteID)
+	if estudianteID != 0 {
+		mediciones, err := s.medicionesEstudiante(estudianteID, ficha.DatosPersonales.FechaNacimiento, ficha.DatosPersonales.GeneroNacimiento)
+		if err != nil {
+			return "", err
+		}
+		agregarCrecimientoPDF(m, mediciones, ficha.DatosPersonales.GeneroNacimiento)
+
+		linea, err := s.ObtenerLineaTiempoEstudiante(estudianteID, dtos.FiltroLineaTiempoDTO{})
+		if err != nil {
+			return "", err
+		}
+		agregarLineaTiempoPDF(m, linea)
+	}
+
 	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | DECE - Gestión Estudiantil", time.Now().Format("2006-01-02 15:04")), props.Text{
 		Size:  8,
 		Align: align.Center,
@@ -706,7 +725,7 @@ func (s *ReportService) ObtenerReporteNominaVulnerabilidad(filtroTipoCaso string
 		LEFT JOIN cursos c ON m.curso_id = c.id
 		LEFT JOIN nivel_educativos ne ON c.nivel_id = ne.id
 		WHERE pl.es_activo = 1  
-		AND m.estado = 'Matriculado'
+		AND m.estado IN ('Matriculado', 'Reingreso')
 		AND cs.tipo_caso LIKE ? 
 		ORDER BY c.nivel_id, c.paralelo, e.apellidos;`
 
@@ -976,7 +995,7 @@ func (s *ReportService) ObtenerReporteDerivaciones(fechaInicio, fechaFin string)
 	LEFT JOIN cursos c ON m.curso_id = c.id
 	LEFT JOIN nivel_educativos ne ON c.nivel_id = ne.id
 	WHERE pl.es_activo = 1 
-	AND m.estado = 'Matriculado'
+	AND m.estado IN ('Matriculado', 'Reingreso')
 	-- FILTRO CLAVE: Solo aquellos que tienen una entidad de derivación registrada
 	AND cs.entidad_derivacion IS NOT NULL 
 	AND cs.entidad_derivacion != ''
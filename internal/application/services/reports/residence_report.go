@@ -0,0 +1,434 @@
+package reports
+
+import (
+	"crypto/rand"
+	dtos "dece/internal/application/dtos/reports"
+	"dece/internal/domain/academic"
+	"dece/internal/domain/common"
+	"dece/internal/domain/enrollment"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const sinParroquia = "Sin parroquia registrada"
+
+type residencia struct {
+	MatriculaID     uint
+	Cedula          string
+	Estudiante      string
+	Curso           string
+	Jornada         string
+	DireccionActual string
+	Ubicacion       common.JSONMap[enrollment.UbicacionDomicilio]
+	CasosAbiertos   int
+}
+
+// residencias devuelve las matrículas vigentes del periodo (0 es el activo) y, si cursoID no es
+// 0, solo las de ese curso.
+func (s *ReportService) residencias(periodoID, cursoID uint) (academic.PeriodoLectivo, []residencia, error) {
+	var periodo academic.PeriodoLectivo
+	query := s.db
+	if periodoID == 0 {
+		query = query.Where("es_activo = ?", true)
+	} else {
+		query = query.Where("id = ?", periodoID)
+	}
+	if err := query.First(&periodo).Error; err != nil {
+		return periodo, nil, errors.New("Periodo lectivo no encontrado")
+	}
+
+	filtroCurso := ""
+	args := []any{periodo.ID, enrollment.EstadosVigentes}
+	if cursoID > 0 {
+		filtroCurso = "AND c.id = ?"
+		args = append(args, cursoID)
+	}
+
+	var filas []residencia
+	err := s.db.Raw(`
+		SELECT m.id as matricula_id, e.cedula, e.apellidos || ' ' || e.nombres as estudiante,
+			ne.nombre || ' ' || c.paralelo as curso, c.jornada, m.direccion_actual,
+			COALESCE(m.ubicacion, '{}') as ubicacion,
+			(SELECT COUNT(*) FROM casos_sensibles cs WHERE cs.estudiante_id = e.id AND cs.estado <> 'Cerrado') as casos_abiertos
+		FROM matriculas m
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		JOIN cursos c ON m.curso_id = c.id
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		WHERE c.periodo_id = ? AND m.estado IN ? `+filtroCurso+`
+		ORDER BY ne.id, c.paralelo, e.apellidos, e.nombres`, args...).Scan(&filas).Error
+	if err != nil {
+		return periodo, nil, fmt.Errorf("Error obteniendo las direcciones de los estudiantes: %v", err)
+	}
+	return periodo, filas, nil
+}
+
+// ObtenerResidencias resume dónde viven los estudiantes del periodo por parroquia y barrio,
+// para planificar visitas domiciliarias y ubicar los sectores con más casos abiertos.
+func (s *ReportService) ObtenerResidencias(periodoID, cursoID uint) (*dtos.ResidenciasDTO, error) {
+	periodo, filas, err := s.residencias(periodoID, cursoID)
+	if err != nil {
+		return nil, err
+	}
+
+	reporte := &dtos.ResidenciasDTO{
+		PeriodoLectivo:   periodo.Nombre,
+		TotalEstudiantes: len(filas),
+		PorParroquia:     []dtos.ConteoUbicacionDTO{},
+		PorBarrio:        []dtos.ConteoUbicacionDTO{},
+	}
+	if cursoID > 0 && len(filas) > 0 {
+		reporte.Curso = filas[0].Curso
+	}
+
+	porParroquia := map[string]*dtos.ConteoUbicacionDTO{}
+	porBarrio := map[[2]string]*dtos.ConteoUbicacionDTO{}
+	for _, f := range filas {
+		u := f.Ubicacion.Data
+		parroquia := u.Parroquia
+		if parroquia == "" {
+			parroquia = sinParroquia
+			reporte.SinParroquia++
+		}
+		if u.TieneCoordenadas() {
+			reporte.ConCoordenadas++
+		}
+
+		conteos := []*dtos.ConteoUbicacionDTO{}
+		if porParroquia[parroquia] == nil {
+			porParroquia[parroquia] = &dtos.ConteoUbicacionDTO{Parroquia: parroquia}
+		}
+		conteos = append(conteos, porParroquia[parroquia])
+		if u.BarrioRecinto != "" {
+			clave := [2]string{parroquia, u.BarrioRecinto}
+			if porBarrio[clave] == nil {
+				porBarrio[clave] = &dtos.ConteoUbicacionDTO{Parroquia: parroquia, BarrioRecinto: u.BarrioRecinto}
+			}
+			conteos = append(conteos, porBarrio[clave])
+		}
+		for _, c := range conteos {
+			c.Estudiantes++
+			if u.TieneCoordenadas() {
+				c.ConCoordenadas++
+			}
+			if f.CasosAbiertos > 0 {
+				c.CasosAbiertos++
+			}
+		}
+	}
+
+	for _, c := range porParroquia {
+		reporte.PorParroquia = append(reporte.PorParroquia, *c)
+	}
+	for _, c := range porBarrio {
+		reporte.PorBarrio = append(reporte.PorBarrio, *c)
+	}
+	ordenarConteosUbicacion(reporte.PorParroquia)
+	ordenarConteosUbicacion(reporte.PorBarrio)
+	return reporte, nil
+}
+
+// ordenarConteosUbicacion deja primero los sectores con más estudiantes y al final los que no
+// tienen parroquia registrada.
+func ordenarConteosUbicacion(conteos []dtos.ConteoUbicacionDTO) {
+	sort.Slice(conteos, func(i, j int) bool {
+		a, b := conteos[i], conteos[j]
+		if (a.Parroquia == sinParroquia) != (b.Parroquia == sinParroquia) {
+			return b.Parroquia == sinParroquia
+		}
+		if a.Estudiantes != b.Estudiantes {
+			return a.Estudiantes > b.Estudiantes
+		}
+		if a.Parroquia != b.Parroquia {
+			return a.Parroquia < b.Parroquia
+		}
+		return a.BarrioRecinto < b.BarrioRecinto
+	})
+}
+
+func (s *ReportService) GenerarResidenciasPDF(periodoID, cursoID uint) (string, error) {
+	reporte, err := s.ObtenerResidencias(periodoID, cursoID)
+	if err != nil {
+		return "", err
+	}
+
+	subtitulo := reporte.PeriodoLectivo
+	if reporte.Curso != "" {
+		subtitulo = reporte.Curso + " - " + subtitulo
+	}
+
+	m := maroto.New(configNutricion())
+	m.AddRow(12,
+		text.NewCol(12, "DISTRIBUCIÓN DE RESIDENCIAS POR SECTOR", props.Text{Size: 16, Style: fontstyle.Bold, Align: align.Center}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, subtitulo, props.Text{Size: 10, Style: fontstyle.Italic, Align: align.Center}),
+	)
+	m.AddRow(4)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("Estudiantes: %d | Con coordenadas: %d | Sin parroquia registrada: %d", reporte.TotalEstudiantes, reporte.ConCoordenadas, reporte.SinParroquia), props.Text{Size: 9, Style: fontstyle.Bold}),
+	)
+
+	tablaUbicacionPDF(m, "Parroquia", reporte.PorParroquia, false)
+	tablaUbicacionPDF(m, "Barrio / Recinto", reporte.PorBarrio, true)
+	if len(reporte.PorParroquia) == 0 {
+		m.AddRow(10, text.NewCol(12, "No hay estudiantes matriculados en el periodo.", props.Text{Style: fontstyle.Italic, Align: align.Center}))
+	}
+
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | Casos abiertos: estudiantes con al menos un caso DECE sin cerrar", time.Now().Format("2006-01-02 15:04")), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+	return guardarPDFSalud(m, fmt.Sprintf("Residencias_%s.pdf", time.Now().Format("20060102_150405")))
+}
+
+func tablaUbicacionPDF(m core.Maroto, titulo string, conteos []dtos.ConteoUbicacionDTO, conBarrio bool) {
+	if len(conteos) == 0 {
+		return
+	}
+	m.AddRow(6)
+	m.AddRow(7,
+		text.NewCol(6, titulo, props.Text{Style: fontstyle.Bold, Size: 9}),
+		text.NewCol(2, "Estudiantes", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+		text.NewCol(2, "Con coordenadas", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+		text.NewCol(2, "Casos abiertos", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+	)
+	for _, c := range conteos {
+		nombre := c.Parroquia
+		if conBarrio {
+			nombre = fmt.Sprintf("%s (%s)", c.BarrioRecinto, c.Parroquia)
+		}
+		m.AddRow(6,
+			text.NewCol(6, nombre, props.Text{Size: 9}),
+			text.NewCol(2, fmt.Sprintf("%d", c.Estudiantes), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, fmt.Sprintf("%d", c.ConCoordenadas), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, fmt.Sprintf("%d", c.CasosAbiertos), props.Text{Size: 9, Align: align.Right}),
+		)
+	}
+}
+
+// puntoResidencia es una vivienda lista para exportar. Si se seudonimiza, un código aleatorio de
+// esa exportación reemplaza al nombre y la cédula, se omite la dirección y las coordenadas se
+// redondean a unos 100 metros; el código no se puede relacionar con el estudiante ni con otra
+// exportación.
+type puntoResidencia struct {
+	Codigo     string
+	Cedula     string
+	Estudiante string
+	Curso      string
+	Jornada    string
+	Direccion  string
+	Parroquia  string
+	Barrio     string
+	Latitud    float64
+	Longitud   float64
+}
+
+func (s *ReportService) puntosResidencia(periodoID, cursoID uint, seudonimizar bool) ([]puntoResidencia, int, error) {
+	_, filas, err := s.residencias(periodoID, cursoID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	puntos := []puntoResidencia{}
+	sinCoordenadas := 0
+	usados := map[string]bool{}
+	for _, f := range filas {
+		u := f.Ubicacion.Data
+		if !u.TieneCoordenadas() {
+			sinCoordenadas++
+			continue
+		}
+		p := puntoResidencia{
+			Codigo:    fmt.Sprintf("EST-%05d", f.MatriculaID),
+			Curso:     f.Curso,
+			Jornada:   f.Jornada,
+			Parroquia: u.Parroquia,
+			Barrio:    u.BarrioRecinto,
+			Latitud:   *u.Latitud,
+			Longitud:  *u.Longitud,
+		}
+		if seudonimizar {
+			codigo, err := codigoSeudonimo(usados)
+			if err != nil {
+				return nil, 0, err
+			}
+			p.Codigo = codigo
+			p.Latitud = math.Round(p.Latitud*1000) / 1000
+			p.Longitud = math.Round(p.Longitud*1000) / 1000
+		} else {
+			p.Cedula = f.Cedula
+			p.Estudiante = f.Estudiante
+			p.Direccion = f.DireccionActual
+		}
+		puntos = append(puntos, p)
+	}
+	if seudonimizar {
+		// El orden de la consulta (curso y apellidos) también identificaría a los estudiantes
+		sort.Slice(puntos, func(i, j int) bool { return puntos[i].Codigo < puntos[j].Codigo })
+	}
+	return puntos, sinCoordenadas, nil
+}
+
+// codigoSeudonimo genera un código aleatorio que no esté en usados y lo registra.
+func codigoSeudonimo(usados map[string]bool) (string, error) {
+	for {
+		b := make([]byte, 4)
+		if _, err := rand.Read(b); err != nil {
+			return "", fmt.Errorf("Error al generar el código seudónimo: %v", err)
+		}
+		codigo := "EST-" + strings.ToUpper(hex.EncodeToString(b))
+		if !usados[codigo] {
+			usados[codigo] = true
+			return codigo, nil
+		}
+	}
+}
+
+// ExportarResidenciasGeoJSON guarda las viviendas con coordenadas del periodo (0 es el activo)
+// como FeatureCollection GeoJSON para abrirlas en herramientas SIG.
+func (s *ReportService) ExportarResidenciasGeoJSON(periodoID, cursoID uint, seudonimizar bool) (*dtos.ExportacionResidenciasDTO, error) {
+	puntos, sinCoordenadas, err := s.puntosResidencia(periodoID, cursoID, seudonimizar)
+	if err != nil {
+		return nil, err
+	}
+
+	type feature struct {
+		Type       string         `json:"type"`
+		Geometry   map[string]any `json:"geometry"`
+		Properties map[string]any `json:"properties"`
+	}
+	coleccion := struct {
+		Type     string    `json:"type"`
+		Features []feature `json:"features"`
+	}{Type: "FeatureCollection", Features: []feature{}}
+
+	for _, p := range puntos {
+		propiedades := map[string]any{
+			"codigo":    p.Codigo,
+			"curso":     p.Curso,
+			"jornada":   p.Jornada,
+			"parroquia": p.Parroquia,
+			"barrio":    p.Barrio,
+		}
+		if !seudonimizar {
+			propiedades["cedula"] = p.Cedula
+			propiedades["estudiante"] = p.Estudiante
+			propiedades["direccion"] = p.Direccion
+		}
+		coleccion.Features = append(coleccion.Features, feature{
+			Type: "Feature",
+			// GeoJSON ordena las coordenadas como longitud, latitud
+			Geometry:   map[string]any{"type": "Point", "coordinates": []float64{p.Longitud, p.Latitud}},
+			Properties: propiedades,
+		})
+	}
+
+	contenido, err := json.MarshalIndent(coleccion, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("Error al generar el GeoJSON: %v", err)
+	}
+	ruta, err := guardarExportacionResidencias(contenido, "geojson", seudonimizar)
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.ExportacionResidenciasDTO{Ruta: ruta, Exportados: len(puntos), SinCoordenadas: sinCoordenadas, Seudonimizado: seudonimizar}, nil
+}
+
+// ExportarResidenciasKML guarda las mismas viviendas que ExportarResidenciasGeoJSON en KML, el
+// formato que abren Google Earth y los GPS de campo.
+func (s *ReportService) ExportarResidenciasKML(periodoID, cursoID uint, seudonimizar bool) (*dtos.ExportacionResidenciasDTO, error) {
+	puntos, sinCoordenadas, err := s.puntosResidencia(periodoID, cursoID, seudonimizar)
+	if err != nil {
+		return nil, err
+	}
+
+	type dato struct {
+		Nombre string `xml:"name,attr"`
+		Valor  string `xml:"value"`
+	}
+	type placemark struct {
+		Nombre      string `xml:"name"`
+		Descripcion string `xml:"description"`
+		Datos       []dato `xml:"ExtendedData>Data"`
+		Coordenadas string `xml:"Point>coordinates"`
+	}
+	type kml struct {
+		XMLName    xml.Name    `xml:"http://www.opengis.net/kml/2.2 kml"`
+		Nombre     string      `xml:"Document>name"`
+		Placemarks []placemark `xml:"Document>Placemark"`
+	}
+
+	documento := kml{Nombre: "Residencias de estudiantes"}
+	for _, p := range puntos {
+		pm := placemark{
+			Nombre:      p.Codigo,
+			Descripcion: strings.TrimSpace(p.Curso + " " + p.Jornada),
+			Datos: []dato{
+				{Nombre: "curso", Valor: p.Curso},
+				{Nombre: "jornada", Valor: p.Jornada},
+				{Nombre: "parroquia", Valor: p.Parroquia},
+				{Nombre: "barrio", Valor: p.Barrio},
+			},
+			Coordenadas: fmt.Sprintf("%s,%s", formatoCoordenada(p.Longitud), formatoCoordenada(p.Latitud)),
+		}
+		if !seudonimizar {
+			pm.Nombre = p.Estudiante
+			pm.Descripcion = strings.TrimSpace(fmt.Sprintf("%s - %s", pm.Descripcion, p.Direccion))
+			pm.Datos = append([]dato{{Nombre: "codigo", Valor: p.Codigo}, {Nombre: "cedula", Valor: p.Cedula}}, pm.Datos...)
+		}
+		documento.Placemarks = append(documento.Placemarks, pm)
+	}
+
+	contenido, err := xml.MarshalIndent(documento, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("Error al generar el KML: %v", err)
+	}
+	ruta, err := guardarExportacionResidencias(append([]byte(xml.Header), contenido...), "kml", seudonimizar)
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.ExportacionResidenciasDTO{Ruta: ruta, Exportados: len(puntos), SinCoordenadas: sinCoordenadas, Seudonimizado: seudonimizar}, nil
+}
+
+func formatoCoordenada(valor float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.6f", valor), "0"), ".")
+}
+
+func guardarExportacionResidencias(contenido []byte, extension string, seudonimizar bool) (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	savePath := filepath.Join(homeDir, "Documents", "SistemaDECE", "Reportes")
+	if err := os.MkdirAll(savePath, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	prefijo := "Residencias"
+	if seudonimizar {
+		prefijo = "Residencias_Seudonimizadas"
+	}
+	fullPath := filepath.Join(savePath, fmt.Sprintf("%s_%s.%s", prefijo, time.Now().Format("20060102_150405"), extension))
+	if err := os.WriteFile(fullPath, contenido, 0644); err != nil {
+		return "", fmt.Errorf("Error al guardar la exportación: %v", err)
+	}
+	return fullPath, nil
+}
@@ -0,0 +1,496 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	"dece/internal/domain/common"
+	"dece/internal/domain/documents"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/student"
+	"dece/internal/domain/tracking"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ordenTipoEvento desempata eventos del mismo instante: la matrícula va antes que lo que ocurre
+// dentro de ella y el retiro al final.
+var ordenTipoEvento = map[string]int{
+	dtos.EventoMatricula:    0,
+	dtos.EventoCambioCurso:  1,
+	dtos.EventoCaso:         2,
+	dtos.EventoEvidencia:    3,
+	dtos.EventoIncidente:    4,
+	dtos.EventoDisciplina:   5,
+	dtos.EventoConvocatoria: 6,
+	dtos.EventoCertificado:  7,
+	dtos.EventoRetiro:       8,
+	dtos.EventoEstado:       9,
+}
+
+var etiquetaTipoEvento = map[string]string{
+	dtos.EventoMatricula:    "Matrícula",
+	dtos.EventoRetiro:       "Retiro",
+	dtos.EventoDisciplina:   "Disciplina",
+	dtos.EventoCaso:         "Caso",
+	dtos.EventoEvidencia:    "Evidencia",
+	dtos.EventoConvocatoria: "Cita",
+	dtos.EventoCertificado:  "Certificado",
+	dtos.EventoEstado:       "Estado",
+	dtos.EventoCambioCurso:  "Cambio de curso",
+	dtos.EventoIncidente:    "Enfermería",
+}
+
+// FilaEventoPeriodo son las columnas comunes de cada consulta de la línea de tiempo. Es
+// exportada porque GORM no llena campos de estructuras embebidas no exportadas.
+type FilaEventoPeriodo struct {
+	ID             uint
+	Fecha          string
+	PeriodoID      uint
+	PeriodoLectivo string
+}
+
+// ObtenerLineaTiempoEstudiante reúne en un solo feed cronológico la matrícula, cambios de curso
+// y de estado, retiros, llamados de atención, casos y sus evidencias, citas, incidentes de
+// enfermería y certificados del estudiante.
+func (s *ReportService) ObtenerLineaTiempoEstudiante(estudianteID uint, filtro dtos.FiltroLineaTiempoDTO) (*dtos.LineaTiempoDTO, error) {
+	var est student.Estudiante
+	if err := s.db.First(&est, estudianteID).Error; err != nil {
+		return nil, errors.New("Estudiante no encontrado")
+	}
+
+	incluir := func(tipo string) bool {
+		if len(filtro.Tipos) == 0 {
+			return true
+		}
+		for _, t := range filtro.Tipos {
+			if t == tipo {
+				return true
+			}
+		}
+		return false
+	}
+
+	var eventos []dtos.EventoLineaTiempoDTO
+
+	if incluir(dtos.EventoMatricula) {
+		var filas []struct {
+			FilaEventoPeriodo
+			Estado      string
+			EsRepetidor bool
+			Curso       string
+			Jornada     string
+		}
+		err := s.db.Raw(`
+			SELECT m.id, m.fecha_registro as fecha, m.estado, m.es_repetidor,
+				ne.nombre || ' ' || c.paralelo as curso, c.jornada,
+				pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM matriculas m
+			JOIN cursos c ON c.id = `+enrollment.CursoEnFechaSQL("m", "m.fecha_registro")+`
+			JOIN nivel_educativos ne ON c.nivel_id = ne.id
+			JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+			WHERE m.estudiante_id = ?`, estudianteID).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo matrículas: %v", err)
+		}
+		for _, f := range filas {
+			detalle := fmt.Sprintf("Jornada %s", f.Jornada)
+			if f.EsRepetidor {
+				detalle += " · Repetidor"
+			}
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoMatricula,
+				fmt.Sprintf("Matrícula en %s", f.Curso), detalle, f.Estado))
+		}
+	}
+
+	if incluir(dtos.EventoRetiro) {
+		var filas []struct {
+			FilaEventoPeriodo
+			Motivo           string
+			NuevaInstitucion string
+			Observaciones    string
+		}
+		err := s.db.Raw(`
+			SELECT r.id, r.fecha_retiro as fecha, r.motivo, r.nueva_institucion, r.observaciones,
+				pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM retiro_estudiantes r
+			JOIN matriculas m ON r.matricula_id = m.id
+			JOIN cursos c ON m.curso_id = c.id
+			JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+			WHERE m.estudiante_id = ?`, estudianteID).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo retiros: %v", err)
+		}
+		for _, f := range filas {
+			detalle := f.Observaciones
+			estado := enrollment.EstadoRetirado
+			if f.NuevaInstitucion != "" {
+				detalle = strings.TrimSpace(fmt.Sprintf("Destino: %s. %s", f.NuevaInstitucion, f.Observaciones))
+				estado = enrollment.EstadoTrasladado
+			}
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoRetiro,
+				fmt.Sprintf("Retiro: %s", f.Motivo), detalle, estado))
+		}
+	}
+
+	if incluir(dtos.EventoEstado) {
+		var filas []struct {
+			FilaEventoPeriodo
+			EstadoAnterior string
+			EstadoNuevo    string
+			Motivo         string
+			NombreUsuario  string
+		}
+		// Los retiros con acta ya aparecen como evento de retiro. Si el cambio se registró el
+		// mismo día se usa la hora de registro para ordenarlo respecto a los demás eventos
+		err := s.db.Raw(`
+			SELECT t.id,
+				CASE WHEN t.fecha = substr(t.fecha_registro, 1, 10) THEN t.fecha_registro ELSE t.fecha END as fecha,
+				t.estado_anterior, t.estado_nuevo, t.motivo, t.nombre_usuario,
+				pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM transiciones_matricula t
+			JOIN matriculas m ON t.matricula_id = m.id
+			JOIN cursos c ON m.curso_id = c.id
+			JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+			WHERE m.estudiante_id = ? AND t.estado_anterior <> ''
+				AND NOT (t.estado_nuevo IN (?, ?) AND EXISTS (SELECT 1 FROM retiro_estudiantes r WHERE r.matricula_id = t.matricula_id))`,
+			estudianteID, enrollment.EstadoRetirado, enrollment.EstadoTrasladado).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo cambios de estado: %v", err)
+		}
+		for _, f := range filas {
+			detalle := f.Motivo
+			if f.NombreUsuario != "" {
+				detalle = strings.TrimSpace(fmt.Sprintf("%s (registrado por %s)", f.Motivo, f.NombreUsuario))
+			}
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoEstado,
+				fmt.Sprintf("Cambio de estado: %s → %s", f.EstadoAnterior, f.EstadoNuevo), detalle, f.EstadoNuevo))
+		}
+	}
+
+	if incluir(dtos.EventoCambioCurso) {
+		var filas []struct {
+			FilaEventoPeriodo
+			CursoAnterior string
+			CursoNuevo    string
+			Motivo        string
+			NombreUsuario string
+		}
+		err := s.db.Raw(`
+			SELECT cc.id,
+				CASE WHEN cc.fecha = substr(cc.fecha_registro, 1, 10) THEN cc.fecha_registro ELSE cc.fecha END as fecha,
+				nea.nombre || ' ' || ca.paralelo as curso_anterior,
+				nen.nombre || ' ' || cn.paralelo as curso_nuevo,
+				cc.motivo, cc.nombre_usuario,
+				pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM cambios_curso cc
+			JOIN matriculas m ON cc.matricula_id = m.id
+			JOIN cursos ca ON cc.curso_anterior_id = ca.id
+			JOIN nivel_educativos nea ON ca.nivel_id = nea.id
+			JOIN cursos cn ON cc.curso_nuevo_id = cn.id
+			JOIN nivel_educativos nen ON cn.nivel_id = nen.id
+			JOIN periodo_lectivos pl ON cn.periodo_id = pl.id
+			WHERE m.estudiante_id = ?`, estudianteID).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo cambios de curso: %v", err)
+		}
+		for _, f := range filas {
+			detalle := f.Motivo
+			if f.NombreUsuario != "" {
+				detalle = strings.TrimSpace(fmt.Sprintf("%s (registrado por %s)", f.Motivo, f.NombreUsuario))
+			}
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoCambioCurso,
+				fmt.Sprintf("Cambio de %s a %s", f.CursoAnterior, f.CursoNuevo), detalle, ""))
+		}
+	}
+
+	if incluir(dtos.EventoDisciplina) {
+		var filas []struct {
+			FilaEventoPeriodo
+			Motivo             string
+			RepresentanteFirmo bool
+			DetalleSancion     common.JSONMap[tracking.DetalleSancion] `gorm:"type:text"`
+		}
+		err := s.db.Raw(`
+			SELECT la.id, la.fecha, la.motivo, la.representante_firmo, la.detalle_sancion,
+				pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM llamados_atencion la
+			JOIN matriculas m ON la.matricula_id = m.id
+			JOIN cursos c ON m.curso_id = c.id
+			JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+			WHERE m.estudiante_id = ?`, estudianteID).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo llamados de atención: %v", err)
+		}
+		for _, f := range filas {
+			sancion := f.DetalleSancion.Data
+			detalle := ""
+			estado := "Acta pendiente de firma"
+			if f.RepresentanteFirmo {
+				estado = "Acta firmada"
+			}
+			if sancion.MedidaDisciplinaria != "" {
+				detalle = fmt.Sprintf("Medida: %s", sancion.MedidaDisciplinaria)
+				switch {
+				case sancion.CumplioMedida:
+					estado = "Medida cumplida"
+				case sancion.MotivoIncumplimiento != "":
+					estado = "Medida incumplida"
+					detalle += fmt.Sprintf(" (%s)", sancion.MotivoIncumplimiento)
+				default:
+					estado = "Medida en curso"
+				}
+			}
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoDisciplina,
+				fmt.Sprintf("Llamado de atención: %s", f.Motivo), detalle, estado))
+		}
+	}
+
+	if incluir(dtos.EventoCaso) {
+		var filas []struct {
+			FilaEventoPeriodo
+			CodigoCaso        string
+			TipoCaso          string
+			Estado            string
+			EntidadDerivacion string
+		}
+		err := s.db.Raw(`
+			SELECT cs.id, cs.fecha_deteccion as fecha, cs.codigo_caso, cs.tipo_caso, cs.estado,
+				cs.entidad_derivacion, pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM casos_sensibles cs
+			LEFT JOIN periodo_lectivos pl ON cs.periodo_id = pl.id
+			WHERE cs.estudiante_id = ?`, estudianteID).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo casos: %v", err)
+		}
+		for _, f := range filas {
+			detalle := ""
+			if f.EntidadDerivacion != "" {
+				detalle = fmt.Sprintf("Derivado a: %s", f.EntidadDerivacion)
+			}
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoCaso,
+				fmt.Sprintf("Caso %s: %s", f.CodigoCaso, f.TipoCaso), detalle, f.Estado))
+		}
+	}
+
+	if incluir(dtos.EventoEvidencia) {
+		var filas []struct {
+			FilaEventoPeriodo
+			Nombre        string
+			Vigente       bool
+			NombreUsuario string
+			CodigoCaso    string
+		}
+		err := s.db.Raw(`
+			SELECT vd.id, vd.fecha_subida as fecha, vd.nombre, vd.vigente, vd.nombre_usuario,
+				cs.codigo_caso, pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM versiones_documentos vd
+			JOIN casos_sensibles cs ON vd.entidad_id = cs.id
+			LEFT JOIN periodo_lectivos pl ON cs.periodo_id = pl.id
+			WHERE vd.entidad = ? AND cs.estudiante_id = ?`, documents.EntidadCasoSensible, estudianteID).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo evidencias: %v", err)
+		}
+		for _, f := range filas {
+			detalle := ""
+			if f.NombreUsuario != "" {
+				detalle = fmt.Sprintf("Subida por %s", f.NombreUsuario)
+			}
+			estado := "Vigente"
+			if !f.Vigente {
+				estado = "Retirada"
+			}
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoEvidencia,
+				fmt.Sprintf("Evidencia en caso %s: %s", f.CodigoCaso, f.Nombre), detalle, estado))
+		}
+	}
+
+	if incluir(dtos.EventoIncidente) {
+		var filas []struct {
+			FilaEventoPeriodo
+			Tipo                    string
+			Gravedad                string
+			Lugar                   string
+			RepresentanteNotificado bool
+			DerivadoCentroSalud     bool
+			CentroSalud             string
+		}
+		err := s.db.Raw(`
+			SELECT ie.id, ie.fecha || ' ' || ie.hora as fecha, ie.tipo, ie.gravedad, ie.lugar,
+				ie.representante_notificado, ie.derivado_centro_salud, ie.centro_salud,
+				pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM incidentes_enfermeria ie
+			JOIN matriculas m ON ie.matricula_id = m.id
+			JOIN cursos c ON m.curso_id = c.id
+			JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+			WHERE m.estudiante_id = ?`, estudianteID).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo incidentes de enfermería: %v", err)
+		}
+		for _, f := range filas {
+			detalle := f.Lugar
+			if f.DerivadoCentroSalud {
+				detalle += fmt.Sprintf(". Derivado a: %s", f.CentroSalud)
+			}
+			estado := "Representante sin notificar"
+			if f.RepresentanteNotificado {
+				estado = "Representante notificado"
+			}
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoIncidente,
+				fmt.Sprintf("Incidente (%s): %s", f.Gravedad, f.Tipo), detalle, estado))
+		}
+	}
+
+	if incluir(dtos.EventoConvocatoria) {
+		var filas []struct {
+			FilaEventoPeriodo
+			Entidad        string
+			Motivo         string
+			CitaCompletada bool
+		}
+		err := s.db.Raw(`
+			SELECT cv.id, cv.fecha_cita as fecha, cv.entidad, cv.motivo, cv.cita_completada,
+				pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM convocatoria cv
+			JOIN matriculas m ON cv.matricula_id = m.id
+			JOIN cursos c ON m.curso_id = c.id
+			JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+			WHERE m.estudiante_id = ?`, estudianteID).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo citas: %v", err)
+		}
+		ahora := time.Now().Format("2006-01-02 15:04")
+		for _, f := range filas {
+			estado := "Programada"
+			if f.CitaCompletada {
+				estado = "Completada"
+			} else if normalizarFechaEvento(f.Fecha) < ahora {
+				estado = "No realizada"
+			}
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoConvocatoria,
+				fmt.Sprintf("Cita: %s", f.Entidad), f.Motivo, estado))
+		}
+	}
+
+	if incluir(dtos.EventoCertificado) {
+		// Los certificados no pertenecen a una matrícula; el periodo se deduce por la fecha de emisión
+		var filas []struct {
+			FilaEventoPeriodo
+			NombrePlantilla string
+		}
+		err := s.db.Raw(`
+			SELECT ce.id, ce.fecha_emision as fecha, ce.nombre_plantilla,
+				pl.id as periodo_id, pl.nombre as periodo_lectivo
+			FROM certificados_emitidos ce
+			LEFT JOIN periodo_lectivos pl ON pl.id = (
+				SELECT p.id FROM periodo_lectivos p
+				WHERE substr(ce.fecha_emision, 1, 10) BETWEEN p.fecha_inicio AND p.fecha_fin
+				ORDER BY p.fecha_inicio DESC LIMIT 1)
+			WHERE ce.estudiante_id = ?`, estudianteID).Scan(&filas).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo certificados: %v", err)
+		}
+		for _, f := range filas {
+			eventos = append(eventos, nuevoEvento(f.FilaEventoPeriodo, dtos.EventoCertificado,
+				fmt.Sprintf("Certificado: %s", f.NombrePlantilla), "", "Emitido"))
+		}
+	}
+
+	if filtro.PeriodoID != 0 {
+		filtrados := eventos[:0]
+		for _, e := range eventos {
+			if e.PeriodoID == filtro.PeriodoID {
+				filtrados = append(filtrados, e)
+			}
+		}
+		eventos = filtrados
+	}
+
+	sort.SliceStable(eventos, func(i, j int) bool {
+		fi, fj := normalizarFechaEvento(eventos[i].Fecha), normalizarFechaEvento(eventos[j].Fecha)
+		if fi != fj {
+			return fi < fj
+		}
+		return ordenTipoEvento[eventos[i].Tipo] < ordenTipoEvento[eventos[j].Tipo]
+	})
+
+	if eventos == nil {
+		eventos = []dtos.EventoLineaTiempoDTO{}
+	}
+
+	return &dtos.LineaTiempoDTO{
+		EstudianteID: est.ID,
+		Cedula:       est.Cedula,
+		Estudiante:   fmt.Sprintf("%s %s", est.Apellidos, est.Nombres),
+		Eventos:      eventos,
+	}, nil
+}
+
+func nuevoEvento(f FilaEventoPeriodo, tipo, titulo, detalle, estado string) dtos.EventoLineaTiempoDTO {
+	return dtos.EventoLineaTiempoDTO{
+		Fecha:          f.Fecha,
+		Tipo:           tipo,
+		Titulo:         titulo,
+		Detalle:        detalle,
+		Estado:         estado,
+		ReferenciaID:   f.ID,
+		PeriodoID:      f.PeriodoID,
+		PeriodoLectivo: f.PeriodoLectivo,
+	}
+}
+
+// normalizarFechaEvento deja las fechas comparables como texto; las citas llegan del
+// formulario con "T" entre fecha y hora.
+func normalizarFechaEvento(fecha string) string {
+	return strings.Replace(strings.TrimSpace(fecha), "T", " ", 1)
+}
+
+// agregarLineaTiempoPDF añade la línea de tiempo como anexo de la ficha acumulativa.
+func agregarLineaTiempoPDF(m core.Maroto, linea *dtos.LineaTiempoDTO) {
+	m.AddRow(10)
+
+	m.AddRow(10,
+		text.NewCol(12, "F. ANEXO: LÍNEA DE TIEMPO", props.Text{
+			Size:  12,
+			Style: fontstyle.Bold,
+			Color: &props.Color{Red: 50, Green: 50, Blue: 50},
+		}),
+	)
+	m.AddRow(1, text.NewCol(12, "__________________________________________________________________________________________________________", props.Text{Size: 6}))
+	m.AddRow(5)
+
+	if linea == nil || len(linea.Eventos) == 0 {
+		m.AddRow(8, text.NewCol(12, "No hay eventos registrados.", props.Text{Style: fontstyle.Italic}))
+		return
+	}
+
+	m.AddRow(7,
+		text.NewCol(2, "Fecha", props.Text{Style: fontstyle.Bold, Size: 9}),
+		text.NewCol(2, "Tipo", props.Text{Style: fontstyle.Bold, Size: 9}),
+		text.NewCol(6, "Evento", props.Text{Style: fontstyle.Bold, Size: 9}),
+		text.NewCol(2, "Estado", props.Text{Style: fontstyle.Bold, Size: 9}),
+	)
+
+	for _, e := range linea.Eventos {
+		fecha := normalizarFechaEvento(e.Fecha)
+		if len(fecha) > 10 {
+			fecha = fecha[:10]
+		}
+		descripcion := e.Titulo
+		if e.Detalle != "" {
+			descripcion += " — " + e.Detalle
+		}
+		m.AddRow(8,
+			text.NewCol(2, fecha, props.Text{Size: 8}),
+			text.NewCol(2, etiquetaTipoEvento[e.Tipo], props.Text{Size: 8}),
+			text.NewCol(6, descripcion, props.Text{Size: 8}),
+			text.NewCol(2, e.Estado, props.Text{Size: 8, Style: fontstyle.Italic}),
+		)
+	}
+}
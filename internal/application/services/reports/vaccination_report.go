@@ -0,0 +1,261 @@
+package reports
+
+import (
+	dtos "dece/internal/application/dtos/reports"
+	growth "dece/internal/application/helpers/growth"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/health"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ObtenerPendientesVacunacionCurso lista a los estudiantes vigentes del curso a los que les
+// faltan dosis del esquema para su edad o controles médicos al día, con los totales por vacuna
+// y por control para organizar la brigada.
+func (s *ReportService) ObtenerPendientesVacunacionCurso(cursoID uint) (*dtos.PendientesSaludCursoDTO, error) {
+	var curso struct {
+		ID             uint
+		Curso          string
+		Jornada        string
+		PeriodoLectivo string
+	}
+	err := s.db.Raw(`
+		SELECT c.id, ne.nombre || ' ' || c.paralelo as curso, c.jornada, pl.nombre as periodo_lectivo
+		FROM cursos c
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE c.id = ?`, cursoID).Scan(&curso).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo el curso: %v", err)
+	}
+	if curso.ID == 0 {
+		return nil, errors.New("El curso seleccionado no existe")
+	}
+
+	var estudiantes []struct {
+		ID              uint
+		Cedula          string
+		Estudiante      string
+		FechaNacimiento string
+		Sexo            string
+	}
+	err = s.db.Raw(`
+		SELECT e.id, e.cedula, e.apellidos || ' ' || e.nombres as estudiante,
+			e.fecha_nacimiento, e.genero_nacimiento as sexo
+		FROM matriculas m
+		JOIN estudiantes e ON m.estudiante_id = e.id
+		WHERE m.curso_id = ? AND m.estado IN ?
+		ORDER BY e.apellidos, e.nombres`, cursoID, enrollment.EstadosVigentes).Scan(&estudiantes).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo los estudiantes del curso: %v", err)
+	}
+	ids := make([]uint, len(estudiantes))
+	for i, e := range estudiantes {
+		ids[i] = e.ID
+	}
+
+	var esquema []health.EsquemaVacuna
+	err = s.db.Preload("Vacuna").
+		Joins("JOIN vacunas ON vacunas.id = esquema_vacunas.vacuna_id").
+		Where("vacunas.activa = ?", true).
+		Order("esquema_vacunas.edad_meses, vacunas.nombre, esquema_vacunas.numero_dosis").
+		Find(&esquema).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo el esquema de vacunación: %v", err)
+	}
+	var tipos []health.TipoControlMedico
+	s.db.Where("activo = ?", true).Order("nombre").Find(&tipos)
+
+	dosisPorEstudiante := map[uint][]health.DosisVacuna{}
+	ultimoControl := map[uint]map[uint]string{}
+	if len(ids) > 0 {
+		var dosis []health.DosisVacuna
+		if err := s.db.Where("estudiante_id IN ?", ids).Find(&dosis).Error; err != nil {
+			return nil, fmt.Errorf("Error obteniendo las dosis registradas: %v", err)
+		}
+		for _, d := range dosis {
+			dosisPorEstudiante[d.EstudianteID] = append(dosisPorEstudiante[d.EstudianteID], d)
+		}
+
+		var controles []struct {
+			EstudianteID  uint
+			TipoControlID uint
+			Fecha         string
+		}
+		err := s.db.Raw(`
+			SELECT estudiante_id, tipo_control_id, MAX(fecha) as fecha
+			FROM controles_medicos
+			WHERE estudiante_id IN ?
+			GROUP BY estudiante_id, tipo_control_id`, ids).Scan(&controles).Error
+		if err != nil {
+			return nil, fmt.Errorf("Error obteniendo los controles registrados: %v", err)
+		}
+		for _, c := range controles {
+			if ultimoControl[c.EstudianteID] == nil {
+				ultimoControl[c.EstudianteID] = map[uint]string{}
+			}
+			ultimoControl[c.EstudianteID][c.TipoControlID] = c.Fecha
+		}
+	}
+
+	hoy := time.Now().Format("2006-01-02")
+	reporte := &dtos.PendientesSaludCursoDTO{
+		CursoID:          curso.ID,
+		Curso:            curso.Curso,
+		Jornada:          curso.Jornada,
+		PeriodoLectivo:   curso.PeriodoLectivo,
+		FechaCorte:       hoy,
+		TotalEstudiantes: len(estudiantes),
+		PorVacuna:        []dtos.ConteoPendienteDTO{},
+		PorControl:       []dtos.ConteoPendienteDTO{},
+		Estudiantes:      []dtos.EstudiantePendienteSaludDTO{},
+		Advertencias:     []string{},
+	}
+	porVacuna := map[string]int{}
+	porControl := map[string]int{}
+	if len(esquema) == 0 {
+		reporte.Advertencias = append(reporte.Advertencias, "No hay un esquema de vacunación configurado con vacunas activas")
+	}
+
+	for _, e := range estudiantes {
+		pendiente := dtos.EstudiantePendienteSaludDTO{
+			EstudianteID:        e.ID,
+			Cedula:              e.Cedula,
+			Estudiante:          e.Estudiante,
+			DosisPendientes:     []string{},
+			ControlesPendientes: []string{},
+		}
+
+		edadMeses, err := growth.EdadEnMeses(e.FechaNacimiento, hoy)
+		if err != nil {
+			reporte.Advertencias = append(reporte.Advertencias, fmt.Sprintf("%s: sin fecha de nacimiento válida, no se revisaron sus vacunas", e.Estudiante))
+		} else {
+			pendiente.Edad = growth.TextoEdad(edadMeses)
+			vacunasFaltantes := map[string]bool{}
+			for _, d := range health.EvaluarEsquema(esquema, dosisPorEstudiante[e.ID], edadMeses, e.Sexo) {
+				if d.Estado != health.EstadoDosisPendiente {
+					continue
+				}
+				pendiente.DosisPendientes = append(pendiente.DosisPendientes, d.Esquema.Etiqueta())
+				vacunasFaltantes[d.Esquema.Vacuna.Nombre] = true
+			}
+			for nombre := range vacunasFaltantes {
+				porVacuna[nombre]++
+			}
+		}
+
+		for _, t := range tipos {
+			if !t.AlDia(ultimoControl[e.ID][t.ID], hoy) {
+				pendiente.ControlesPendientes = append(pendiente.ControlesPendientes, t.Nombre)
+				porControl[t.Nombre]++
+			}
+		}
+
+		if len(pendiente.DosisPendientes) == 0 && len(pendiente.ControlesPendientes) == 0 {
+			if err == nil {
+				reporte.Completos++
+			}
+			continue
+		}
+		reporte.Estudiantes = append(reporte.Estudiantes, pendiente)
+	}
+
+	reporte.PorVacuna = conteosPendientes(porVacuna)
+	reporte.PorControl = conteosPendientes(porControl)
+	return reporte, nil
+}
+
+// conteosPendientes ordena de mayor a menor número de estudiantes.
+func conteosPendientes(conteos map[string]int) []dtos.ConteoPendienteDTO {
+	ordenados := make([]dtos.ConteoPendienteDTO, 0, len(conteos))
+	for nombre, n := range conteos {
+		ordenados = append(ordenados, dtos.ConteoPendienteDTO{Nombre: nombre, Estudiantes: n})
+	}
+	sort.Slice(ordenados, func(i, j int) bool {
+		if ordenados[i].Estudiantes != ordenados[j].Estudiantes {
+			return ordenados[i].Estudiantes > ordenados[j].Estudiantes
+		}
+		return ordenados[i].Nombre < ordenados[j].Nombre
+	})
+	return ordenados
+}
+
+func (s *ReportService) GenerarPendientesVacunacionCursoPDF(cursoID uint) (string, error) {
+	reporte, err := s.ObtenerPendientesVacunacionCurso(cursoID)
+	if err != nil {
+		return "", err
+	}
+
+	m := maroto.New(configNutricion())
+	m.AddRow(12,
+		text.NewCol(12, "VACUNAS Y CONTROLES PENDIENTES", props.Text{Size: 16, Style: fontstyle.Bold, Align: align.Center}),
+	)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("%s (%s) - %s | Corte al %s", reporte.Curso, reporte.Jornada, reporte.PeriodoLectivo, reporte.FechaCorte), props.Text{Size: 10, Style: fontstyle.Italic, Align: align.Center}),
+	)
+	m.AddRow(4)
+	m.AddRow(8,
+		text.NewCol(12, fmt.Sprintf("Estudiantes: %d | Al día: %d | Con pendientes: %d", reporte.TotalEstudiantes, reporte.Completos, len(reporte.Estudiantes)), props.Text{Size: 9, Style: fontstyle.Bold}),
+	)
+	tablaPendientesPDF(m, "Vacuna", reporte.PorVacuna)
+	tablaPendientesPDF(m, "Control", reporte.PorControl)
+	advertenciasPDF(m, reporte.Advertencias)
+
+	m.AddRow(6)
+	m.AddRow(7,
+		text.NewCol(4, "Estudiante", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(1, "Edad", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(5, "Dosis pendientes", props.Text{Style: fontstyle.Bold, Size: 8}),
+		text.NewCol(2, "Controles", props.Text{Style: fontstyle.Bold, Size: 8}),
+	)
+	for _, e := range reporte.Estudiantes {
+		dosis := strings.Join(e.DosisPendientes, "; ")
+		controles := strings.Join(e.ControlesPendientes, "; ")
+		// Las listas largas ocupan varias líneas en la misma fila
+		lineas := max(1, (len(dosis)+59)/60, (len(controles)+23)/24)
+		m.AddRow(float64(3+3*lineas),
+			text.NewCol(4, fmt.Sprintf("%s\n%s", e.Estudiante, e.Cedula), props.Text{Size: 8}),
+			text.NewCol(1, valorOGuion(e.Edad), props.Text{Size: 7}),
+			text.NewCol(5, valorOGuion(dosis), props.Text{Size: 7}),
+			text.NewCol(2, valorOGuion(controles), props.Text{Size: 7}),
+		)
+	}
+	if len(reporte.Estudiantes) == 0 {
+		m.AddRow(10, text.NewCol(12, "Ningún estudiante del curso tiene dosis o controles pendientes.", props.Text{Style: fontstyle.Italic, Align: align.Center}))
+	}
+
+	m.RegisterFooter(text.NewRow(10, fmt.Sprintf("Generado el: %s | Esquema de vacunación configurado en el sistema", time.Now().Format("2006-01-02 15:04")), props.Text{
+		Size:  8,
+		Align: align.Center,
+		Style: fontstyle.Italic,
+		Color: &props.Color{Red: 100, Green: 100, Blue: 100},
+	}))
+	return guardarPDFSalud(m, fmt.Sprintf("Vacunacion_%s_%s.pdf", strings.ReplaceAll(reporte.Curso, " ", "_"), time.Now().Format("20060102_150405")))
+}
+
+func tablaPendientesPDF(m core.Maroto, titulo string, conteos []dtos.ConteoPendienteDTO) {
+	if len(conteos) == 0 {
+		return
+	}
+	m.AddRow(4)
+	m.AddRow(7,
+		text.NewCol(9, titulo, props.Text{Style: fontstyle.Bold, Size: 9}),
+		text.NewCol(3, "Estudiantes", props.Text{Style: fontstyle.Bold, Size: 9, Align: align.Right}),
+	)
+	for _, c := range conteos {
+		m.AddRow(6,
+			text.NewCol(9, c.Nombre, props.Text{Size: 9}),
+			text.NewCol(3, fmt.Sprintf("%d", c.Estudiantes), props.Text{Size: 9, Align: align.Right}),
+		)
+	}
+}
@@ -2,11 +2,13 @@ package services
 
 import (
 	securityDTO "dece/internal/application/dtos/security"
+	identity "dece/internal/application/helpers/identity"
 	securityHelper "dece/internal/application/helpers/security"
 	"dece/internal/domain/common"
 	"dece/internal/domain/security"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -64,6 +66,28 @@ func (s *InstitutionService) ObtenerConfiguracion() (*securityDTO.ConfiguracionI
 func (s *InstitutionService) GuardarConfiguracion(input securityDTO.ConfiguracionInstitucionalDTO) error {
 	fmt.Println("Input ", input)
 
+	autoridades := []struct {
+		cargo string
+		datos securityDTO.AutoridadDTO
+	}{
+		{"Rector", input.Autoridades.Rector},
+		{"Subdirector matutina", input.Autoridades.SubdirectorMatutina},
+		{"Subdirector vespertina", input.Autoridades.SubdirectorVespertina},
+		{"Inspector general", input.Autoridades.InspectorGeneral},
+		{"Subinspector", input.Autoridades.Subinspector},
+		{"Coordinador DECE", input.Autoridades.CoordinadorDECE},
+		{"Analista DECE 1", input.Autoridades.AnalistaDECE1},
+		{"Analista DECE 2", input.Autoridades.AnalistaDECE2},
+	}
+	for _, a := range autoridades {
+		if strings.TrimSpace(a.datos.Cedula) == "" {
+			continue
+		}
+		if err := identity.ValidarIdentificacion(a.datos.Cedula); err != nil {
+			return fmt.Errorf("%s: %v", a.cargo, err)
+		}
+	}
+
 	configModel := security.ConfiguracionInstitucional{
 		ID:                 1,
 		Nombre:             input.Nombre,
@@ -3,11 +3,11 @@ package services
 import (
 	"context"
 	usuarioDTO "dece/internal/application/dtos/security"
+	photo "dece/internal/application/helpers/photo"
 	"dece/internal/domain/security"
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -125,46 +125,27 @@ func (s *UserService) SubirFotoPerfil(userID uint) (string, error) {
 		return "", errors.New("No se seleccionó ningún archivo")
 	}
 
-	// Crear directorio de fotos de perfil
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return "", errors.New("Error al acceder al sistema de archivos")
-	}
-	destinoDir := filepath.Join(homeDir, "Documents", "SistemaDECE", "Profiles")
-	if err := os.MkdirAll(destinoDir, 0755); err != nil {
-		return "", fmt.Errorf("Error al crear carpeta: %v", err)
-	}
-
-	// Generar nombre único
-	ext := strings.ToLower(filepath.Ext(filePath))
-	if ext == "" {
-		ext = ".jpg"
-	}
-	nuevoNombre := fmt.Sprintf("profile_%d_%d%s", userID, time.Now().Unix(), ext)
-	rutaDestino := filepath.Join(destinoDir, nuevoNombre)
-
-	// Copiar archivo
-	src, err := os.Open(filePath)
+	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return "", fmt.Errorf("Error al abrir imagen: %v", err)
 	}
-	defer src.Close()
 
-	dst, err := os.Create(rutaDestino)
+	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return "", fmt.Errorf("Error al guardar imagen: %v", err)
+		return "", errors.New("Error al acceder al sistema de archivos")
 	}
-	defer dst.Close()
+	destinoDir := filepath.Join(homeDir, "Documents", "SistemaDECE", "Profiles")
 
-	if _, err := io.Copy(dst, src); err != nil {
-		return "", fmt.Errorf("Error al copiar imagen: %v", err)
+	// Se normaliza a un avatar cuadrado sin metadatos EXIF, con su miniatura
+	nombreBase := fmt.Sprintf("profile_%d_%d", userID, time.Now().Unix())
+	rutaDestino, err := photo.GuardarFoto(data, photo.FormatoFotoPerfil, destinoDir, nombreBase)
+	if err != nil {
+		return "", err
 	}
 
 	// Eliminar foto anterior si existe
-	if user.FotoPerfil != "" {
-		if _, err := os.Stat(user.FotoPerfil); err == nil {
-			os.Remove(user.FotoPerfil)
-		}
+	if user.FotoPerfil != "" && user.FotoPerfil != rutaDestino {
+		photo.EliminarFoto(user.FotoPerfil)
 	}
 
 	// Actualizar en BD
@@ -177,6 +158,15 @@ func (s *UserService) SubirFotoPerfil(userID uint) (string, error) {
 
 // ObtenerFotoPerfilBase64 lee la imagen del disco y la retorna como base64 para usar en <img src>.
 func (s *UserService) ObtenerFotoPerfilBase64(userID uint) (string, error) {
+	return s.leerFotoPerfilBase64(userID, false)
+}
+
+// ObtenerMiniaturaPerfilBase64 retorna la miniatura del avatar, para la barra superior y listados.
+func (s *UserService) ObtenerMiniaturaPerfilBase64(userID uint) (string, error) {
+	return s.leerFotoPerfilBase64(userID, true)
+}
+
+func (s *UserService) leerFotoPerfilBase64(userID uint, miniatura bool) (string, error) {
 	var user security.Usuario
 	if err := s.db.First(&user, userID).Error; err != nil {
 		return "", errors.New("Usuario no encontrado")
@@ -186,12 +176,17 @@ func (s *UserService) ObtenerFotoPerfilBase64(userID uint) (string, error) {
 		return "", nil
 	}
 
-	data, err := os.ReadFile(user.FotoPerfil)
+	ruta := user.FotoPerfil
+	if miniatura {
+		ruta = photo.MiniaturaOFoto(user.FotoPerfil)
+	}
+
+	data, err := os.ReadFile(ruta)
 	if err != nil {
 		return "", nil // Si no existe el archivo, retornar vacío silenciosamente
 	}
 
-	ext := strings.ToLower(filepath.Ext(user.FotoPerfil))
+	ext := strings.ToLower(filepath.Ext(ruta))
 	mimeType := "image/jpeg"
 	switch ext {
 	case ".png":
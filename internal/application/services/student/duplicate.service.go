@@ -0,0 +1,559 @@
+package services
+
+import (
+	studentDTO "dece/internal/application/dtos/student"
+	enrollmentSvc "dece/internal/application/services/enrollment"
+	"dece/internal/domain/common"
+	"dece/internal/domain/documents"
+	"dece/internal/domain/student"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const umbralSimilitudNombres = 0.85
+
+type DuplicateService struct {
+	db    *gorm.DB
+	ciclo *enrollmentSvc.CicloMatricula
+}
+
+func NewDuplicateService(db *gorm.DB, ciclo *enrollmentSvc.CicloMatricula) *DuplicateService {
+	return &DuplicateService{db: db, ciclo: ciclo}
+}
+
+// BuscarPosiblesDuplicados compara las fichas por nombre y fecha de nacimiento, cédulas casi
+// idénticas (un dígito distinto o dos dígitos invertidos) y representante legal compartido.
+func (s *DuplicateService) BuscarPosiblesDuplicados() ([]studentDTO.PosibleDuplicadoDTO, error) {
+	var filas []studentDTO.EstudianteDuplicadoDTO
+
+	err := s.db.Table("estudiantes e").
+		Select("e.id, e.cedula, e.apellidos, e.nombres, e.fecha_nacimiento, (SELECT COUNT(*) FROM matriculas m WHERE m.estudiante_id = e.id) as total_matriculas").
+		Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al leer estudiantes: %v", err)
+	}
+
+	porID := make(map[uint]studentDTO.EstudianteDuplicadoDTO, len(filas))
+	porCedula := make(map[string]uint, len(filas))
+	porFecha := make(map[string][]uint)
+	porComodin := make(map[string][]uint)
+
+	for _, f := range filas {
+		porID[f.ID] = f
+		cedula := strings.TrimSpace(f.Cedula)
+		if cedula != "" {
+			porCedula[cedula] = f.ID
+			for i := range cedula {
+				clave := cedula[:i] + "*" + cedula[i+1:]
+				porComodin[clave] = append(porComodin[clave], f.ID)
+			}
+		}
+		if f.FechaNacimiento != "" {
+			porFecha[f.FechaNacimiento] = append(porFecha[f.FechaNacimiento], f.ID)
+		}
+	}
+
+	pares := make(map[[2]uint]*studentDTO.PosibleDuplicadoDTO)
+	agregar := func(a, b uint, similitud float64, motivo string) {
+		if a == b {
+			return
+		}
+		if a > b {
+			a, b = b, a
+		}
+		clave := [2]uint{a, b}
+		par, ok := pares[clave]
+		if !ok {
+			par = &studentDTO.PosibleDuplicadoDTO{
+				EstudianteA: porID[a],
+				EstudianteB: porID[b],
+			}
+			pares[clave] = par
+		}
+		for _, m := range par.Motivos {
+			if m == motivo {
+				return
+			}
+		}
+		par.Motivos = append(par.Motivos, motivo)
+		if similitud > par.Similitud {
+			par.Similitud = similitud
+		}
+	}
+
+	for _, ids := range porFecha {
+		for i := 0; i < len(ids); i++ {
+			for j := i + 1; j < len(ids); j++ {
+				sim := similitudNombres(porID[ids[i]], porID[ids[j]])
+				if sim >= umbralSimilitudNombres {
+					agregar(ids[i], ids[j], sim, "Nombres similares y misma fecha de nacimiento")
+				}
+			}
+		}
+	}
+
+	for _, ids := range porComodin {
+		for i := 0; i < len(ids); i++ {
+			for j := i + 1; j < len(ids); j++ {
+				agregar(ids[i], ids[j], 0.9, "Cédulas difieren en un dígito")
+			}
+		}
+	}
+
+	for cedula, id := range porCedula {
+		b := []byte(cedula)
+		for i := 0; i+1 < len(b); i++ {
+			if b[i] == b[i+1] {
+				continue
+			}
+			b[i], b[i+1] = b[i+1], b[i]
+			if otro, ok := porCedula[string(b)]; ok {
+				agregar(id, otro, 0.9, "Cédulas con dígitos invertidos")
+			}
+			b[i], b[i+1] = b[i+1], b[i]
+		}
+	}
+
+	var representantes []struct {
+		Cedula       string
+		EstudianteID uint
+	}
+	err = s.db.Model(&student.Familiar{}).
+		Select("cedula, estudiante_id").
+		Where("es_representante_legal = ? AND cedula <> ''", true).
+		Scan(&representantes).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al leer representantes: %v", err)
+	}
+
+	porRepresentante := make(map[string][]uint)
+	for _, r := range representantes {
+		porRepresentante[r.Cedula] = append(porRepresentante[r.Cedula], r.EstudianteID)
+	}
+	for _, ids := range porRepresentante {
+		for i := 0; i < len(ids); i++ {
+			for j := i + 1; j < len(ids); j++ {
+				a, b := porID[ids[i]], porID[ids[j]]
+				// Los hermanos comparten representante; solo interesa si además el nombre coincide
+				sim := similitudTexto(normalizarNombre(a.Nombres), normalizarNombre(b.Nombres))
+				if sim >= umbralSimilitudNombres || (a.FechaNacimiento != "" && a.FechaNacimiento == b.FechaNacimiento) {
+					agregar(ids[i], ids[j], similitudNombres(a, b), "Comparten representante legal")
+				}
+			}
+		}
+	}
+
+	response := make([]studentDTO.PosibleDuplicadoDTO, 0, len(pares))
+	for _, p := range pares {
+		response = append(response, *p)
+	}
+
+	sort.Slice(response, func(i, j int) bool {
+		if len(response[i].Motivos) != len(response[j].Motivos) {
+			return len(response[i].Motivos) > len(response[j].Motivos)
+		}
+		return response[i].Similitud > response[j].Similitud
+	})
+
+	return response, nil
+}
+
+// FusionarEstudiantes mueve matrículas, familiares, casos, consentimientos, certificados
+// emitidos, vacunas, controles médicos y documentos (con su historial de versiones) de la ficha
+// duplicada a la ficha conservada y elimina la duplicada, todo en una sola transacción.
+func (s *DuplicateService) FusionarEstudiantes(input studentDTO.FusionarEstudiantesDTO) (*student.FusionEstudiante, error) {
+	if input.ConservarID == 0 || input.EliminarID == 0 || input.ConservarID == input.EliminarID {
+		return nil, errors.New("Debe seleccionar dos estudiantes distintos para fusionar")
+	}
+
+	var fusion student.FusionEstudiante
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var conservado, eliminado student.Estudiante
+		if err := tx.Preload("Familiares").First(&conservado, input.ConservarID).Error; err != nil {
+			return errors.New("Estudiante a conservar no encontrado")
+		}
+		if err := tx.Preload("Familiares").First(&eliminado, input.EliminarID).Error; err != nil {
+			return errors.New("Estudiante duplicado no encontrado")
+		}
+
+		var conflictos int64
+		tx.Raw(`
+			SELECT COUNT(*)
+			FROM matriculas m1
+			JOIN cursos c1 ON m1.curso_id = c1.id
+			JOIN matriculas m2 ON m2.estudiante_id = ?
+			JOIN cursos c2 ON m2.curso_id = c2.id
+			WHERE m1.estudiante_id = ? AND c1.periodo_id = c2.periodo_id`,
+			conservado.ID, eliminado.ID).Scan(&conflictos)
+		if conflictos > 0 {
+			return errors.New("Ambos estudiantes tienen matrícula en el mismo periodo lectivo. Resuelva esa matrícula antes de fusionar")
+		}
+
+		// Una dosis solo puede registrarse una vez por estudiante; si ambas fichas la tienen hay
+		// que decidir cuál es la correcta antes de unirlas
+		var dosisRepetidas []string
+		tx.Raw(`
+			SELECT v.nombre || ' dosis ' || d1.numero_dosis
+			FROM dosis_vacunas d1
+			JOIN dosis_vacunas d2 ON d2.estudiante_id = ? AND d2.vacuna_id = d1.vacuna_id AND d2.numero_dosis = d1.numero_dosis
+			JOIN vacunas v ON v.id = d1.vacuna_id
+			WHERE d1.estudiante_id = ?
+			ORDER BY v.nombre, d1.numero_dosis`,
+			conservado.ID, eliminado.ID).Scan(&dosisRepetidas)
+		if len(dosisRepetidas) > 0 {
+			return fmt.Errorf("Ambos estudiantes tienen registrada la misma dosis (%s). Elimine la dosis repetida de una de las fichas antes de fusionar", strings.Join(dosisRepetidas, ", "))
+		}
+
+		detalle := student.DetalleFusion{
+			CamposHeredados: map[string]string{},
+		}
+
+		tx.Table("matriculas").Where("estudiante_id = ?", eliminado.ID).Pluck("id", &detalle.MatriculaIDs)
+		tx.Table("casos_sensibles").Where("estudiante_id = ?", eliminado.ID).Pluck("id", &detalle.CasoIDs)
+		tx.Table("consentimientos").Where("estudiante_id = ?", eliminado.ID).Pluck("id", &detalle.ConsentimientoIDs)
+		tx.Table("certificados_emitidos").Where("estudiante_id = ?", eliminado.ID).Pluck("id", &detalle.CertificadoIDs)
+		tx.Table("dosis_vacunas").Where("estudiante_id = ?", eliminado.ID).Pluck("id", &detalle.DosisVacunaIDs)
+		tx.Table("controles_medicos").Where("estudiante_id = ?", eliminado.ID).Pluck("id", &detalle.ControlMedicoIDs)
+		tx.Model(&documents.VersionDocumento{}).Where("entidad = ? AND entidad_id = ?", documents.EntidadEstudiante, eliminado.ID).Pluck("id", &detalle.VersionDocumentoIDs)
+		tx.Model(&documents.VersionDocumento{}).
+			Where("entidad = ? AND entidad_id = ? AND vigente = ?", documents.EntidadEstudiante, eliminado.ID, true).
+			Where("tipo_documento IN (?)", tx.Model(&documents.VersionDocumento{}).Select("tipo_documento").
+				Where("entidad = ? AND entidad_id = ? AND vigente = ?", documents.EntidadEstudiante, conservado.ID, true)).
+			Pluck("id", &detalle.VersionesRetiradasIDs)
+
+		cedulasConservado := map[string]uint{}
+		for _, f := range conservado.Familiares {
+			if f.Cedula != "" {
+				cedulasConservado[f.Cedula] = f.ID
+			}
+		}
+		for _, f := range eliminado.Familiares {
+			if f.Cedula != "" && cedulasConservado[f.Cedula] > 0 {
+				detalle.FamiliaresDescartados = append(detalle.FamiliaresDescartados, f)
+			} else {
+				detalle.FamiliarIDs = append(detalle.FamiliarIDs, f.ID)
+			}
+		}
+
+		valoresConservado := camposHeredables(conservado)
+		updates := map[string]interface{}{}
+		for columna, valor := range camposHeredables(eliminado) {
+			if valoresConservado[columna] == "" && valor != "" {
+				detalle.CamposHeredados[columna] = valor
+				updates[columna] = valor
+			}
+		}
+
+		eliminado.Familiares = nil
+		detalle.EstudianteEliminado = eliminado
+
+		if len(detalle.MatriculaIDs) > 0 {
+			if err := tx.Table("matriculas").Where("id IN ?", detalle.MatriculaIDs).Update("estudiante_id", conservado.ID).Error; err != nil {
+				return fmt.Errorf("Error al reasignar matrículas: %v", err)
+			}
+		}
+		if len(detalle.CasoIDs) > 0 {
+			if err := tx.Table("casos_sensibles").Where("id IN ?", detalle.CasoIDs).Update("estudiante_id", conservado.ID).Error; err != nil {
+				return fmt.Errorf("Error al reasignar casos: %v", err)
+			}
+		}
+		if len(detalle.ConsentimientoIDs) > 0 {
+			if err := tx.Table("consentimientos").Where("id IN ?", detalle.ConsentimientoIDs).Update("estudiante_id", conservado.ID).Error; err != nil {
+				return fmt.Errorf("Error al reasignar consentimientos: %v", err)
+			}
+		}
+		if len(detalle.CertificadoIDs) > 0 {
+			if err := tx.Table("certificados_emitidos").Where("id IN ?", detalle.CertificadoIDs).Update("estudiante_id", conservado.ID).Error; err != nil {
+				return fmt.Errorf("Error al reasignar certificados emitidos: %v", err)
+			}
+		}
+		if len(detalle.DosisVacunaIDs) > 0 {
+			if err := tx.Table("dosis_vacunas").Where("id IN ?", detalle.DosisVacunaIDs).Update("estudiante_id", conservado.ID).Error; err != nil {
+				return fmt.Errorf("Error al reasignar dosis de vacunas: %v", err)
+			}
+		}
+		if len(detalle.ControlMedicoIDs) > 0 {
+			if err := tx.Table("controles_medicos").Where("id IN ?", detalle.ControlMedicoIDs).Update("estudiante_id", conservado.ID).Error; err != nil {
+				return fmt.Errorf("Error al reasignar controles médicos: %v", err)
+			}
+		}
+		if len(detalle.VersionDocumentoIDs) > 0 {
+			if err := tx.Model(&documents.VersionDocumento{}).Where("id IN ?", detalle.VersionDocumentoIDs).Update("entidad_id", conservado.ID).Error; err != nil {
+				return fmt.Errorf("Error al reasignar versiones de documentos: %v", err)
+			}
+		}
+		if len(detalle.VersionesRetiradasIDs) > 0 {
+			// La ficha conservada mantiene su documento vigente; el de la duplicada pasa al historial
+			if err := tx.Model(&documents.VersionDocumento{}).Where("id IN ?", detalle.VersionesRetiradasIDs).
+				Updates(map[string]interface{}{"vigente": false, "fecha_retiro": time.Now().Format("2006-01-02 15:04:05")}).Error; err != nil {
+				return fmt.Errorf("Error al retirar versiones de documentos: %v", err)
+			}
+		}
+		if len(detalle.FamiliarIDs) > 0 {
+			if err := tx.Model(&student.Familiar{}).Where("id IN ?", detalle.FamiliarIDs).Update("estudiante_id", conservado.ID).Error; err != nil {
+				return fmt.Errorf("Error al reasignar familiares: %v", err)
+			}
+		}
+		for _, f := range detalle.FamiliaresDescartados {
+			if err := tx.Delete(&student.Familiar{}, f.ID).Error; err != nil {
+				return fmt.Errorf("Error al depurar familiares repetidos: %v", err)
+			}
+			// Los consentimientos que firmó pasan al mismo familiar en la ficha conservada
+			if err := tx.Model(&student.Consentimiento{}).Where("familiar_id = ?", f.ID).Update("familiar_id", cedulasConservado[f.Cedula]).Error; err != nil {
+				return fmt.Errorf("Error al reasignar consentimientos: %v", err)
+			}
+		}
+
+		if err := tx.Table("matriculas").Where("json_extract(condicion_genero, '$.pareja_id') = ?", eliminado.ID).Pluck("id", &detalle.ParejaMatriculaIDs).Error; err != nil {
+			return fmt.Errorf("Error al buscar referencias de pareja: %v", err)
+		}
+		if err := s.ciclo.ReasignarPareja(tx, detalle.ParejaMatriculaIDs, eliminado.ID, conservado.ID); err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&student.Estudiante{}, eliminado.ID).Error; err != nil {
+			return fmt.Errorf("Error al eliminar la ficha duplicada: %v", err)
+		}
+
+		if len(updates) > 0 {
+			if err := tx.Model(&student.Estudiante{}).Where("id = ?", conservado.ID).UpdateColumns(updates).Error; err != nil {
+				return fmt.Errorf("Error al completar la ficha conservada: %v", err)
+			}
+		}
+
+		fusion = student.FusionEstudiante{
+			EstudianteConservadoID: conservado.ID,
+			EstudianteEliminadoID:  eliminado.ID,
+			CedulaEliminada:        eliminado.Cedula,
+			UsuarioID:              input.UsuarioID,
+			Motivo:                 input.Motivo,
+			FechaFusion:            time.Now().Format("2006-01-02 15:04:05"),
+			Detalle:                common.JSONMap[student.DetalleFusion]{Data: detalle},
+		}
+
+		if err := tx.Create(&fusion).Error; err != nil {
+			return fmt.Errorf("Error al registrar la fusión: %v", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &fusion, nil
+}
+
+// RevertirFusion recrea la ficha eliminada con su ID original y le devuelve lo que se le movió.
+func (s *DuplicateService) RevertirFusion(fusionID uint) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var fusion student.FusionEstudiante
+		if err := tx.First(&fusion, fusionID).Error; err != nil {
+			return errors.New("Registro de fusión no encontrado")
+		}
+		if fusion.Revertida {
+			return errors.New("Esta fusión ya fue revertida")
+		}
+
+		var posteriores int64
+		tx.Model(&student.FusionEstudiante{}).
+			Where("id > ? AND revertida = ? AND (estudiante_eliminado_id = ? OR estudiante_conservado_id = ?)", fusion.ID, false, fusion.EstudianteConservadoID, fusion.EstudianteConservadoID).
+			Count(&posteriores)
+		if posteriores > 0 {
+			return errors.New("Existen fusiones posteriores sobre el mismo estudiante. Reviértalas primero")
+		}
+
+		detalle := fusion.Detalle.Data
+
+		var count int64
+		tx.Model(&student.Estudiante{}).Where("cedula = ? OR id = ?", fusion.CedulaEliminada, fusion.EstudianteEliminadoID).Count(&count)
+		if count > 0 {
+			return fmt.Errorf("La cédula %s o el ID original ya están en uso por otra ficha", fusion.CedulaEliminada)
+		}
+
+		restaurado := detalle.EstudianteEliminado
+		restaurado.Familiares = nil
+		if err := tx.Create(&restaurado).Error; err != nil {
+			return fmt.Errorf("Error al restaurar la ficha eliminada: %v", err)
+		}
+
+		if len(detalle.MatriculaIDs) > 0 {
+			if err := tx.Table("matriculas").Where("id IN ?", detalle.MatriculaIDs).Update("estudiante_id", restaurado.ID).Error; err != nil {
+				return fmt.Errorf("Error al devolver matrículas: %v", err)
+			}
+		}
+		if len(detalle.CasoIDs) > 0 {
+			if err := tx.Table("casos_sensibles").Where("id IN ?", detalle.CasoIDs).Update("estudiante_id", restaurado.ID).Error; err != nil {
+				return fmt.Errorf("Error al devolver casos: %v", err)
+			}
+		}
+		if len(detalle.ConsentimientoIDs) > 0 {
+			if err := tx.Table("consentimientos").Where("id IN ?", detalle.ConsentimientoIDs).Update("estudiante_id", restaurado.ID).Error; err != nil {
+				return fmt.Errorf("Error al devolver consentimientos: %v", err)
+			}
+		}
+		if len(detalle.CertificadoIDs) > 0 {
+			if err := tx.Table("certificados_emitidos").Where("id IN ?", detalle.CertificadoIDs).Update("estudiante_id", restaurado.ID).Error; err != nil {
+				return fmt.Errorf("Error al devolver certificados emitidos: %v", err)
+			}
+		}
+		if len(detalle.DosisVacunaIDs) > 0 {
+			if err := tx.Table("dosis_vacunas").Where("id IN ?", detalle.DosisVacunaIDs).Update("estudiante_id", restaurado.ID).Error; err != nil {
+				return fmt.Errorf("Error al devolver dosis de vacunas: %v", err)
+			}
+		}
+		if len(detalle.ControlMedicoIDs) > 0 {
+			if err := tx.Table("controles_medicos").Where("id IN ?", detalle.ControlMedicoIDs).Update("estudiante_id", restaurado.ID).Error; err != nil {
+				return fmt.Errorf("Error al devolver controles médicos: %v", err)
+			}
+		}
+		if len(detalle.VersionDocumentoIDs) > 0 {
+			if err := tx.Model(&documents.VersionDocumento{}).Where("id IN ?", detalle.VersionDocumentoIDs).Update("entidad_id", restaurado.ID).Error; err != nil {
+				return fmt.Errorf("Error al devolver versiones de documentos: %v", err)
+			}
+		}
+		if len(detalle.VersionesRetiradasIDs) > 0 {
+			if err := tx.Model(&documents.VersionDocumento{}).Where("id IN ?", detalle.VersionesRetiradasIDs).
+				Updates(map[string]interface{}{"vigente": true, "fecha_retiro": ""}).Error; err != nil {
+				return fmt.Errorf("Error al restaurar versiones de documentos: %v", err)
+			}
+		}
+		if len(detalle.FamiliarIDs) > 0 {
+			if err := tx.Model(&student.Familiar{}).Where("id IN ?", detalle.FamiliarIDs).Update("estudiante_id", restaurado.ID).Error; err != nil {
+				return fmt.Errorf("Error al devolver familiares: %v", err)
+			}
+		}
+		for _, f := range detalle.FamiliaresDescartados {
+			f.EstudianteID = restaurado.ID
+			if err := tx.Create(&f).Error; err != nil {
+				return fmt.Errorf("Error al restaurar familiares: %v", err)
+			}
+			if len(detalle.ConsentimientoIDs) > 0 {
+				if err := tx.Model(&student.Consentimiento{}).Where("id IN ? AND firmante_cedula = ?", detalle.ConsentimientoIDs, f.Cedula).Update("familiar_id", f.ID).Error; err != nil {
+					return fmt.Errorf("Error al devolver consentimientos: %v", err)
+				}
+			}
+		}
+
+		// Solo vuelven a la ficha restaurada las parejas que la fusión cambió y que nadie
+		// reasignó después
+		if err := s.ciclo.ReasignarPareja(tx, detalle.ParejaMatriculaIDs, fusion.EstudianteConservadoID, restaurado.ID); err != nil {
+			return err
+		}
+
+		// Solo se limpian los campos heredados que nadie modificó después de la fusión
+		heredables := camposHeredables(student.Estudiante{})
+		for columna, valor := range detalle.CamposHeredados {
+			if _, ok := heredables[columna]; !ok {
+				return fmt.Errorf("El registro de fusión contiene un campo heredado no válido: %s", columna)
+			}
+			if err := tx.Model(&student.Estudiante{}).
+				Where("id = ? AND "+columna+" = ?", fusion.EstudianteConservadoID, valor).
+				UpdateColumn(columna, "").Error; err != nil {
+				return fmt.Errorf("Error al limpiar los campos heredados: %v", err)
+			}
+		}
+
+		fusion.Revertida = true
+		fusion.FechaReversion = time.Now().Format("2006-01-02 15:04:05")
+		if err := tx.Save(&fusion).Error; err != nil {
+			return fmt.Errorf("Error al actualizar el registro de fusión: %v", err)
+		}
+
+		return nil
+	})
+}
+
+// camposHeredables son las columnas que la ficha conservada toma de la eliminada cuando las
+// tiene vacías, con su valor en e.
+func camposHeredables(e student.Estudiante) map[string]string {
+	return map[string]string{
+		"ruta_foto":               e.RutaFoto,
+		"ruta_cedula":             e.RutaCedula,
+		"ruta_partida_nacimiento": e.RutaPartidaNacimiento,
+		"fecha_nacimiento":        e.FechaNacimiento,
+		"correo_electronico":      e.CorreoElectronico,
+		"genero_nacimiento":       e.GeneroNacimiento,
+	}
+}
+
+func (s *DuplicateService) ListarFusiones() ([]studentDTO.FusionResumenDTO, error) {
+	var fusiones []student.FusionEstudiante
+
+	if err := s.db.Order("fecha_fusion DESC").Find(&fusiones).Error; err != nil {
+		return nil, fmt.Errorf("Error al listar fusiones: %v", err)
+	}
+
+	response := make([]studentDTO.FusionResumenDTO, len(fusiones))
+	for i, f := range fusiones {
+		var conservado student.Estudiante
+		s.db.Select("apellidos", "nombres").First(&conservado, f.EstudianteConservadoID)
+
+		eliminado := f.Detalle.Data.EstudianteEliminado
+
+		response[i] = studentDTO.FusionResumenDTO{
+			ID:                     f.ID,
+			EstudianteConservadoID: f.EstudianteConservadoID,
+			EstudianteConservado:   strings.TrimSpace(conservado.Apellidos + " " + conservado.Nombres),
+			EstudianteEliminadoID:  f.EstudianteEliminadoID,
+			EstudianteEliminado:    strings.TrimSpace(eliminado.Apellidos + " " + eliminado.Nombres),
+			CedulaEliminada:        f.CedulaEliminada,
+			Motivo:                 f.Motivo,
+			FechaFusion:            f.FechaFusion,
+			MatriculasMovidas:      len(f.Detalle.Data.MatriculaIDs),
+			FamiliaresMovidos:      len(f.Detalle.Data.FamiliarIDs),
+			CasosMovidos:           len(f.Detalle.Data.CasoIDs),
+			Revertida:              f.Revertida,
+			FechaReversion:         f.FechaReversion,
+		}
+	}
+
+	return response, nil
+}
+
+func similitudNombres(a, b studentDTO.EstudianteDuplicadoDTO) float64 {
+	return similitudTexto(
+		normalizarNombre(a.Apellidos+" "+a.Nombres),
+		normalizarNombre(b.Apellidos+" "+b.Nombres),
+	)
+}
+
+func normalizarNombre(s string) string {
+	replacer := strings.NewReplacer("Á", "A", "É", "E", "Í", "I", "Ó", "O", "Ú", "U", "Ü", "U", "Ñ", "N")
+	return strings.Join(strings.Fields(replacer.Replace(strings.ToUpper(s))), " ")
+}
+
+// similitudTexto devuelve 1 - distancia de Levenshtein normalizada.
+func similitudTexto(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			costo := 1
+			if ra[i-1] == rb[j-1] {
+				costo = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+costo)
+		}
+		prev, curr = curr, prev
+	}
+
+	return 1 - float64(prev[len(rb)])/float64(max(len(ra), len(rb)))
+}
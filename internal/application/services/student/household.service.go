@@ -0,0 +1,465 @@
+package services
+
+import (
+	studentDTO "dece/internal/application/dtos/student"
+	"dece/internal/domain/student"
+	"dece/internal/domain/tracking"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type HouseholdService struct {
+	db *gorm.DB
+}
+
+func NewHouseholdService(db *gorm.DB) *HouseholdService {
+	return &HouseholdService{db: db}
+}
+
+// DetectarHogares agrupa a los estudiantes que comparten la cédula de algún familiar o que
+// están registrados como pareja en la ficha de matrícula. Solo devuelve los grupos que aún
+// no están completos en un mismo hogar.
+func (s *HouseholdService) DetectarHogares() ([]studentDTO.HogarSugeridoDTO, error) {
+	padre := map[uint]uint{}
+	var raiz func(id uint) uint
+	raiz = func(id uint) uint {
+		p, ok := padre[id]
+		if !ok || p == id {
+			padre[id] = id
+			return id
+		}
+		r := raiz(p)
+		padre[id] = r
+		return r
+	}
+	unir := func(a, b uint) {
+		ra, rb := raiz(a), raiz(b)
+		if ra != rb {
+			padre[rb] = ra
+		}
+	}
+
+	motivos := map[[2]uint][]string{}
+	agregarMotivo := func(a, b uint, motivo string) {
+		if a > b {
+			a, b = b, a
+		}
+		motivos[[2]uint{a, b}] = append(motivos[[2]uint{a, b}], motivo)
+	}
+
+	var familiares []struct {
+		Cedula           string
+		NombresCompletos string
+		EstudianteID     uint
+	}
+	err := s.db.Model(&student.Familiar{}).
+		Select("cedula, nombres_completos, estudiante_id").
+		Where("cedula <> '' AND estudiante_id > 0").
+		Order("estudiante_id").
+		Scan(&familiares).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al leer familiares: %v", err)
+	}
+
+	porCedula := map[string][]uint{}
+	nombreFamiliar := map[string]string{}
+	for _, f := range familiares {
+		cedula := strings.TrimSpace(f.Cedula)
+		ids := porCedula[cedula]
+		if len(ids) > 0 && ids[len(ids)-1] == f.EstudianteID {
+			continue
+		}
+		porCedula[cedula] = append(ids, f.EstudianteID)
+		nombreFamiliar[cedula] = f.NombresCompletos
+	}
+	for cedula, ids := range porCedula {
+		for i := 1; i < len(ids); i++ {
+			unir(ids[0], ids[i])
+			agregarMotivo(ids[0], ids[i], fmt.Sprintf("Comparten familiar %s (%s)", nombreFamiliar[cedula], cedula))
+		}
+	}
+
+	var parejas []struct {
+		EstudianteID uint
+		ParejaID     uint
+	}
+	err = s.db.Table("matriculas").
+		Select("matriculas.estudiante_id, json_extract(matriculas.condicion_genero, '$.pareja_id') as pareja_id").
+		Joins("JOIN estudiantes e ON e.id = json_extract(matriculas.condicion_genero, '$.pareja_id')").
+		Where("json_extract(matriculas.condicion_genero, '$.pareja_id') > 0").
+		Scan(&parejas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al leer parejas registradas: %v", err)
+	}
+	for _, p := range parejas {
+		if p.EstudianteID == p.ParejaID {
+			continue
+		}
+		unir(p.EstudianteID, p.ParejaID)
+		agregarMotivo(p.EstudianteID, p.ParejaID, "Registrados como pareja en la ficha de matrícula")
+	}
+
+	grupos := map[uint][]uint{}
+	for id := range padre {
+		r := raiz(id)
+		grupos[r] = append(grupos[r], id)
+	}
+
+	var estudiantes []student.Estudiante
+	if err := s.db.Select("id", "cedula", "apellidos", "nombres", "fecha_nacimiento", "hogar_id").
+		Where("id IN ?", mapKeys(padre)).Find(&estudiantes).Error; err != nil {
+		return nil, fmt.Errorf("Error al leer estudiantes: %v", err)
+	}
+	porID := make(map[uint]student.Estudiante, len(estudiantes))
+	for _, e := range estudiantes {
+		porID[e.ID] = e
+	}
+
+	sugerencias := make([]studentDTO.HogarSugeridoDTO, 0)
+	for _, ids := range grupos {
+		if len(ids) < 2 {
+			continue
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		sugerencia := studentDTO.HogarSugeridoDTO{}
+		completo := true
+		for i, id := range ids {
+			e, ok := porID[id]
+			if !ok {
+				continue
+			}
+			if e.HogarID == nil {
+				completo = false
+			} else if sugerencia.HogarID == 0 {
+				sugerencia.HogarID = *e.HogarID
+			} else if *e.HogarID != sugerencia.HogarID {
+				completo = false
+			}
+			sugerencia.Estudiantes = append(sugerencia.Estudiantes, studentDTO.EstudianteDuplicadoDTO{
+				ID:              e.ID,
+				Cedula:          e.Cedula,
+				Apellidos:       e.Apellidos,
+				Nombres:         e.Nombres,
+				FechaNacimiento: e.FechaNacimiento,
+			})
+			for _, otro := range ids[i+1:] {
+				sugerencia.Motivos = append(sugerencia.Motivos, motivos[[2]uint{id, otro}]...)
+			}
+		}
+		if completo || len(sugerencia.Estudiantes) < 2 {
+			continue
+		}
+		sugerencias = append(sugerencias, sugerencia)
+	}
+
+	sort.Slice(sugerencias, func(i, j int) bool {
+		return sugerencias[i].Estudiantes[0].Apellidos < sugerencias[j].Estudiantes[0].Apellidos
+	})
+
+	return sugerencias, nil
+}
+
+func mapKeys(m map[uint]uint) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// VincularHogar crea un hogar (o usa el indicado) y le asigna los estudiantes. Si alguno ya
+// pertenecía a otro hogar, ese hogar se une al destino.
+func (s *HouseholdService) VincularHogar(input studentDTO.VincularHogarDTO) (*student.Hogar, error) {
+	if len(input.EstudianteIDs) == 0 {
+		return nil, errors.New("Debe seleccionar al menos un estudiante")
+	}
+
+	var hogar student.Hogar
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var estudiantes []student.Estudiante
+		if err := tx.Select("id", "apellidos", "hogar_id").Where("id IN ?", input.EstudianteIDs).Find(&estudiantes).Error; err != nil {
+			return err
+		}
+		if len(estudiantes) != len(input.EstudianteIDs) {
+			return errors.New("Uno o más estudiantes no existen")
+		}
+
+		if input.HogarID > 0 {
+			if err := tx.First(&hogar, input.HogarID).Error; err != nil {
+				return errors.New("Hogar no encontrado")
+			}
+		} else {
+			for _, e := range estudiantes {
+				if e.HogarID != nil {
+					if err := tx.First(&hogar, *e.HogarID).Error; err == nil {
+						break
+					}
+				}
+			}
+		}
+
+		if hogar.ID == 0 {
+			hogar = student.Hogar{
+				Nombre:        strings.TrimSpace(input.Nombre),
+				Observacion:   input.Observacion,
+				FechaCreacion: time.Now().Format("2006-01-02 15:04:05"),
+			}
+			if hogar.Nombre == "" {
+				hogar.Nombre = "Familia " + primerApellido(estudiantes[0].Apellidos)
+			}
+			if err := tx.Create(&hogar).Error; err != nil {
+				return fmt.Errorf("Error al crear hogar: %v", err)
+			}
+		} else if strings.TrimSpace(input.Nombre) != "" || input.Observacion != "" {
+			if strings.TrimSpace(input.Nombre) != "" {
+				hogar.Nombre = strings.TrimSpace(input.Nombre)
+			}
+			if input.Observacion != "" {
+				hogar.Observacion = input.Observacion
+			}
+			if err := tx.Save(&hogar).Error; err != nil {
+				return fmt.Errorf("Error al actualizar hogar: %v", err)
+			}
+		}
+
+		absorbidos := map[uint]bool{}
+		for _, e := range estudiantes {
+			if e.HogarID != nil && *e.HogarID != hogar.ID {
+				absorbidos[*e.HogarID] = true
+			}
+		}
+		for id := range absorbidos {
+			if err := tx.Model(&student.Estudiante{}).Where("hogar_id = ?", id).Update("hogar_id", hogar.ID).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&student.Hogar{}, id).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&student.Estudiante{}).Where("id IN ?", input.EstudianteIDs).Update("hogar_id", hogar.ID).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &hogar, nil
+}
+
+func primerApellido(apellidos string) string {
+	partes := strings.Fields(apellidos)
+	if len(partes) == 0 {
+		return ""
+	}
+	return partes[0]
+}
+
+// DesvincularDeHogar saca al estudiante de su hogar. Un hogar que queda con un solo
+// estudiante se elimina.
+func (s *HouseholdService) DesvincularDeHogar(estudianteID uint) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var est student.Estudiante
+		if err := tx.Select("id", "hogar_id").First(&est, estudianteID).Error; err != nil {
+			return errors.New("Estudiante no encontrado")
+		}
+		if est.HogarID == nil {
+			return nil
+		}
+		hogarID := *est.HogarID
+
+		if err := tx.Model(&est).Update("hogar_id", nil).Error; err != nil {
+			return err
+		}
+
+		var restantes int64
+		tx.Model(&student.Estudiante{}).Where("hogar_id = ?", hogarID).Count(&restantes)
+		if restantes <= 1 {
+			if err := tx.Model(&student.Estudiante{}).Where("hogar_id = ?", hogarID).Update("hogar_id", nil).Error; err != nil {
+				return err
+			}
+			return tx.Delete(&student.Hogar{}, hogarID).Error
+		}
+		return nil
+	})
+}
+
+func (s *HouseholdService) ListarHogares() ([]studentDTO.HogarResumenDTO, error) {
+	var response []studentDTO.HogarResumenDTO
+
+	err := s.db.Table("hogares h").
+		Select("h.id, h.nombre, COUNT(e.id) as total_estudiantes, GROUP_CONCAT(e.apellidos || ' ' || e.nombres, ', ') as estudiantes").
+		Joins("LEFT JOIN estudiantes e ON e.hogar_id = h.id").
+		Group("h.id, h.nombre").
+		Order("h.nombre ASC").
+		Scan(&response).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al listar hogares: %v", err)
+	}
+
+	return response, nil
+}
+
+func (s *HouseholdService) ObtenerHogarDeEstudiante(estudianteID uint) (*studentDTO.HogarDetalleDTO, error) {
+	var est student.Estudiante
+	if err := s.db.Select("id", "hogar_id").First(&est, estudianteID).Error; err != nil {
+		return nil, errors.New("Estudiante no encontrado")
+	}
+	if est.HogarID == nil {
+		return nil, nil
+	}
+	return s.ObtenerHogar(*est.HogarID)
+}
+
+// ObtenerHogar arma la vista del hogar: cada hermano con su matrícula del periodo activo y sus
+// casos abiertos, y los familiares compartidos agrupados por cédula.
+func (s *HouseholdService) ObtenerHogar(hogarID uint) (*studentDTO.HogarDetalleDTO, error) {
+	var hogar student.Hogar
+	if err := s.db.First(&hogar, hogarID).Error; err != nil {
+		return nil, errors.New("Hogar no encontrado")
+	}
+
+	response := &studentDTO.HogarDetalleDTO{
+		ID:            hogar.ID,
+		Nombre:        hogar.Nombre,
+		Observacion:   hogar.Observacion,
+		FechaCreacion: hogar.FechaCreacion,
+		Miembros:      make([]studentDTO.MiembroHogarDTO, 0),
+		Familiares:    make([]studentDTO.FamiliarHogarDTO, 0),
+	}
+
+	var estudiantes []student.Estudiante
+	if err := s.db.Preload("Familiares").Where("hogar_id = ?", hogar.ID).Order("fecha_nacimiento ASC").Find(&estudiantes).Error; err != nil {
+		return nil, fmt.Errorf("Error al leer estudiantes del hogar: %v", err)
+	}
+
+	porCedula := map[string]*studentDTO.FamiliarHogarDTO{}
+	orden := make([]string, 0)
+
+	for _, e := range estudiantes {
+		miembro := studentDTO.MiembroHogarDTO{
+			EstudianteID:    e.ID,
+			Cedula:          e.Cedula,
+			Apellidos:       e.Apellidos,
+			Nombres:         e.Nombres,
+			FechaNacimiento: e.FechaNacimiento,
+			RutaFoto:        e.RutaFoto,
+			CasosAbiertos:   make([]studentDTO.CasoHogarDTO, 0),
+		}
+
+		var matricula struct {
+			ID      uint
+			Curso   string
+			Jornada string
+			Estado  string
+		}
+		s.db.Table("matriculas m").
+			Select("m.id, n.nombre || ' ' || c.paralelo as curso, c.jornada, m.estado").
+			Joins("JOIN cursos c ON c.id = m.curso_id").
+			Joins("JOIN nivel_educativos n ON n.id = c.nivel_id").
+			Joins("JOIN periodo_lectivos p ON p.id = c.periodo_id").
+			Where("m.estudiante_id = ? AND p.es_activo = ?", e.ID, true).
+			Order("m.id DESC").
+			Limit(1).
+			Scan(&matricula)
+		miembro.MatriculaID = matricula.ID
+		miembro.Curso = matricula.Curso
+		miembro.Jornada = matricula.Jornada
+		miembro.EstadoMatricula = matricula.Estado
+
+		s.db.Table("casos_sensibles").
+			Select("id, codigo_caso, tipo_caso, estado, fecha_deteccion").
+			Where("estudiante_id = ? AND estado <> ?", e.ID, tracking.EstadoCasoCerrado).
+			Order("fecha_deteccion DESC").
+			Scan(&miembro.CasosAbiertos)
+
+		response.Miembros = append(response.Miembros, miembro)
+
+		for _, f := range e.Familiares {
+			clave := strings.TrimSpace(f.Cedula)
+			if clave == "" {
+				clave = fmt.Sprintf("sin-cedula-%d", f.ID)
+			}
+			agrupado, ok := porCedula[clave]
+			if !ok {
+				agrupado = &studentDTO.FamiliarHogarDTO{
+					Cedula:           f.Cedula,
+					NombresCompletos: f.NombresCompletos,
+					Parentesco:       f.Parentesco,
+					TelefonoPersonal: f.TelefonoPersonal,
+				}
+				porCedula[clave] = agrupado
+				orden = append(orden, clave)
+			}
+			if f.EsRepresentanteLegal {
+				agrupado.EsRepresentanteLegal = true
+			}
+			agrupado.FamiliarIDs = append(agrupado.FamiliarIDs, f.ID)
+			agrupado.EstudianteIDs = append(agrupado.EstudianteIDs, e.ID)
+		}
+	}
+
+	for _, clave := range orden {
+		response.Familiares = append(response.Familiares, *porCedula[clave])
+	}
+
+	return response, nil
+}
+
+// ActualizarTelefonoFamiliar cambia el teléfono de un familiar y lo replica en la ficha de cada
+// hermano del hogar donde aparece la misma persona (misma cédula). Devuelve cuántos registros
+// se actualizaron.
+func (s *HouseholdService) ActualizarTelefonoFamiliar(familiarID uint, telefono string) (int, error) {
+	telefono = strings.TrimSpace(telefono)
+
+	var familiar student.Familiar
+	if err := s.db.First(&familiar, familiarID).Error; err != nil {
+		return 0, errors.New("Familiar no encontrado")
+	}
+
+	var actualizados int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&familiar).Update("telefono_personal", telefono)
+		if result.Error != nil {
+			return result.Error
+		}
+		actualizados = result.RowsAffected
+
+		n, err := propagarTelefonoHogar(tx, familiar.EstudianteID, familiar.Cedula, telefono)
+		actualizados += n
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Error al actualizar teléfono: %v", err)
+	}
+
+	return int(actualizados), nil
+}
+
+// propagarTelefonoHogar copia el teléfono a los registros de la misma persona en las fichas de
+// los demás estudiantes del hogar.
+func propagarTelefonoHogar(tx *gorm.DB, estudianteID uint, cedula string, telefono string) (int64, error) {
+	cedula = strings.TrimSpace(cedula)
+	if cedula == "" {
+		return 0, nil
+	}
+
+	var est student.Estudiante
+	if err := tx.Select("id", "hogar_id").First(&est, estudianteID).Error; err != nil || est.HogarID == nil {
+		return 0, nil
+	}
+
+	result := tx.Model(&student.Familiar{}).
+		Where("cedula = ? AND estudiante_id <> ? AND telefono_personal <> ?", cedula, estudianteID, telefono).
+		Where("estudiante_id IN (?)", tx.Model(&student.Estudiante{}).Select("id").Where("hogar_id = ?", *est.HogarID)).
+		Update("telefono_personal", telefono)
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,286 @@
+package services
+
+import (
+	studentDTO "dece/internal/application/dtos/student"
+	spreadsheet "dece/internal/application/helpers/spreadsheet"
+	"dece/internal/domain/common"
+	"dece/internal/domain/student"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	campoCedula                  = "cedula"
+	campoNombresCompletos        = "nombres_completos"
+	campoApellidos               = "apellidos"
+	campoNombres                 = "nombres"
+	campoCorreo                  = "correo"
+	campoFechaNacimiento         = "fecha_nacimiento"
+	campoGenero                  = "genero"
+	campoNacionalidad            = "nacionalidad"
+	campoPasaporte               = "pasaporte_odni"
+	campoDireccion               = "direccion"
+	campoRepresentanteCedula     = "representante_cedula"
+	campoRepresentanteNombres    = "representante_nombres"
+	campoRepresentanteParentesco = "representante_parentesco"
+	campoRepresentanteTelefono   = "representante_telefono"
+)
+
+var camposImportacion = []studentDTO.CampoImportacionDTO{
+	{Campo: campoCedula, Etiqueta: "Cédula", Obligatorio: true},
+	{Campo: campoNombresCompletos, Etiqueta: "Nombres completos (apellidos y nombres)"},
+	{Campo: campoApellidos, Etiqueta: "Apellidos"},
+	{Campo: campoNombres, Etiqueta: "Nombres"},
+	{Campo: campoCorreo, Etiqueta: "Correo electrónico"},
+	{Campo: campoFechaNacimiento, Etiqueta: "Fecha de nacimiento"},
+	{Campo: campoGenero, Etiqueta: "Género / Sexo"},
+	{Campo: campoNacionalidad, Etiqueta: "Nacionalidad"},
+	{Campo: campoPasaporte, Etiqueta: "Pasaporte / DNI"},
+	{Campo: campoDireccion, Etiqueta: "Dirección domiciliaria"},
+	{Campo: campoRepresentanteCedula, Etiqueta: "Cédula del representante"},
+	{Campo: campoRepresentanteNombres, Etiqueta: "Nombres del representante"},
+	{Campo: campoRepresentanteParentesco, Etiqueta: "Parentesco del representante"},
+	{Campo: campoRepresentanteTelefono, Etiqueta: "Teléfono del representante"},
+}
+
+func normalizarEncabezado(valor string) string {
+	valor = strings.ToLower(strings.TrimSpace(valor))
+	valor = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ñ", "n", "_", " ", ".", "").Replace(valor)
+	return strings.Join(strings.Fields(valor), " ")
+}
+
+// campoPorDefecto reconoce los encabezados habituales cuando no se usa un perfil.
+func campoPorDefecto(encabezado string) string {
+	v := normalizarEncabezado(encabezado)
+
+	if strings.Contains(v, "representante") {
+		switch {
+		case strings.Contains(v, "cedula") || strings.Contains(v, "identificacion"):
+			return campoRepresentanteCedula
+		case strings.Contains(v, "telefono") || strings.Contains(v, "celular"):
+			return campoRepresentanteTelefono
+		case strings.Contains(v, "parentesco") || strings.Contains(v, "relacion"):
+			return campoRepresentanteParentesco
+		case strings.Contains(v, "nombre") || v == "representante" || v == "representante legal":
+			return campoRepresentanteNombres
+		}
+		return ""
+	}
+
+	switch {
+	case strings.Contains(v, "cedula") || v == "identificacion" || v == "numero de identificacion":
+		return campoCedula
+	case v == "nombres completos" || v == "nombre completo" || v == "nombres y apellidos" || v == "apellidos y nombres" || v == "estudiante":
+		return campoNombresCompletos
+	case v == "nombres" || v == "nombre":
+		return campoNombres
+	case v == "apellidos" || v == "apellido":
+		return campoApellidos
+	case strings.Contains(v, "correo") || v == "email" || v == "cuenta" || v == "e-mail" || v == "mail":
+		return campoCorreo
+	case strings.Contains(v, "nacimiento") || v == "fecha nac":
+		return campoFechaNacimiento
+	case v == "genero" || v == "sexo":
+		return campoGenero
+	case strings.Contains(v, "nacionalidad") || v == "pais" || v == "pais de origen":
+		return campoNacionalidad
+	case strings.Contains(v, "pasaporte") || v == "dni":
+		return campoPasaporte
+	case strings.Contains(v, "direccion") || strings.Contains(v, "domicilio"):
+		return campoDireccion
+	}
+
+	return ""
+}
+
+// detectarEncabezado busca la fila de encabezados y devuelve el índice de columna de cada campo.
+func detectarEncabezado(filas [][]string, perfil *student.PerfilImportacion) (int, map[string]int) {
+	porEncabezado := map[string]string{}
+	if perfil != nil {
+		for campo, encabezado := range perfil.Columnas.Data {
+			if encabezado != "" {
+				porEncabezado[normalizarEncabezado(encabezado)] = campo
+			}
+		}
+	}
+
+	mapear := func(fila []string) map[string]int {
+		columnas := map[string]int{}
+		for j, celda := range fila {
+			var campo string
+			if perfil != nil && len(porEncabezado) > 0 {
+				campo = porEncabezado[normalizarEncabezado(celda)]
+			} else {
+				campo = campoPorDefecto(celda)
+			}
+			if campo != "" {
+				if _, existe := columnas[campo]; !existe {
+					columnas[campo] = j
+				}
+			}
+		}
+		return columnas
+	}
+
+	valido := func(c map[string]int) bool {
+		_, tieneCedula := c[campoCedula]
+		_, tieneUnido := c[campoNombresCompletos]
+		_, tieneNombres := c[campoNombres]
+		_, tieneApellidos := c[campoApellidos]
+		return tieneCedula && (tieneUnido || (tieneNombres && tieneApellidos))
+	}
+
+	if perfil != nil && perfil.FilaEncabezado > 0 {
+		i := perfil.FilaEncabezado - 1
+		if i < len(filas) {
+			if c := mapear(filas[i]); valido(c) {
+				return i, c
+			}
+		}
+		return -1, nil
+	}
+
+	for i, fila := range filas {
+		if c := mapear(fila); valido(c) {
+			return i, c
+		}
+	}
+
+	return -1, nil
+}
+
+func leerTablasImportacion(filePath string, perfil *student.PerfilImportacion) ([]spreadsheet.Hoja, error) {
+	if perfil == nil {
+		return spreadsheet.LeerHojas(filePath, "", nil)
+	}
+	return spreadsheet.LeerHojas(filePath, perfil.Delimitador, perfil.Hojas.Data)
+}
+
+// normalizarFecha convierte la fecha al formato 2006-01-02. Acepta el formato del perfil,
+// los formatos habituales y el número de serie de Excel.
+func normalizarFecha(valor string, formatoPerfil string) (string, error) {
+	if strings.TrimSpace(valor) == "" {
+		return "", nil
+	}
+	fecha, ok := spreadsheet.NormalizarFecha(valor, formatoPerfil)
+	if !ok {
+		return "", fmt.Errorf("Fecha de nacimiento no reconocida: %s", strings.TrimSpace(valor))
+	}
+	return fecha, nil
+}
+
+func normalizarGenero(valor string) string {
+	switch normalizarEncabezado(valor) {
+	case "m", "masculino", "hombre", "h":
+		return "M"
+	case "f", "femenino", "mujer":
+		return "F"
+	}
+	return ""
+}
+
+func esNacionalidadEcuatoriana(valor string) bool {
+	v := normalizarEncabezado(valor)
+	return v == "ecuatoriana" || v == "ecuatoriano" || v == "ecuador" || v == "ec"
+}
+
+func (s *StudentService) ListarCamposImportacion() []studentDTO.CampoImportacionDTO {
+	return camposImportacion
+}
+
+func (s *StudentService) ListarPerfilesImportacion() ([]studentDTO.PerfilImportacionDTO, error) {
+	var perfiles []student.PerfilImportacion
+
+	if err := s.db.Order("nombre ASC").Find(&perfiles).Error; err != nil {
+		return nil, fmt.Errorf("Error al listar perfiles de importación: %v", err)
+	}
+
+	response := make([]studentDTO.PerfilImportacionDTO, len(perfiles))
+	for i, p := range perfiles {
+		response[i] = studentDTO.PerfilImportacionDTO{
+			ID:             p.ID,
+			Nombre:         p.Nombre,
+			Descripcion:    p.Descripcion,
+			Delimitador:    p.Delimitador,
+			FilaEncabezado: p.FilaEncabezado,
+			FormatoFecha:   p.FormatoFecha,
+			Hojas:          p.Hojas.Data,
+			Columnas:       p.Columnas.Data,
+		}
+	}
+
+	return response, nil
+}
+
+func (s *StudentService) GuardarPerfilImportacion(input studentDTO.PerfilImportacionDTO) (*student.PerfilImportacion, error) {
+	input.Nombre = strings.TrimSpace(input.Nombre)
+	if input.Nombre == "" {
+		return nil, errors.New("El perfil debe tener un nombre")
+	}
+
+	validos := map[string]bool{}
+	for _, c := range camposImportacion {
+		validos[c.Campo] = true
+	}
+
+	columnas := map[string]string{}
+	for campo, encabezado := range input.Columnas {
+		if !validos[campo] {
+			return nil, fmt.Errorf("Campo de importación desconocido: %s", campo)
+		}
+		if strings.TrimSpace(encabezado) != "" {
+			columnas[campo] = strings.TrimSpace(encabezado)
+		}
+	}
+
+	if _, ok := columnas[campoCedula]; !ok {
+		return nil, errors.New("El perfil debe indicar la columna de cédula")
+	}
+	_, unido := columnas[campoNombresCompletos]
+	_, nombres := columnas[campoNombres]
+	_, apellidos := columnas[campoApellidos]
+	if !unido && !(nombres && apellidos) {
+		return nil, errors.New("El perfil debe indicar nombres completos, o nombres y apellidos por separado")
+	}
+
+	var count int64
+	query := s.db.Model(&student.PerfilImportacion{}).Where("nombre = ?", input.Nombre)
+	if input.ID > 0 {
+		query = query.Where("id <> ?", input.ID)
+	}
+	query.Count(&count)
+	if count > 0 {
+		return nil, fmt.Errorf("Ya existe un perfil con el nombre %s", input.Nombre)
+	}
+
+	perfil := student.PerfilImportacion{
+		ID:                 input.ID,
+		Nombre:             input.Nombre,
+		Descripcion:        input.Descripcion,
+		Delimitador:        input.Delimitador,
+		FilaEncabezado:     input.FilaEncabezado,
+		FormatoFecha:       input.FormatoFecha,
+		Hojas:              common.JSONMap[[]string]{Data: input.Hojas},
+		Columnas:           common.JSONMap[map[string]string]{Data: columnas},
+		FechaActualizacion: time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	if err := s.db.Save(&perfil).Error; err != nil {
+		return nil, fmt.Errorf("Error al guardar perfil de importación: %v", err)
+	}
+
+	return &perfil, nil
+}
+
+func (s *StudentService) EliminarPerfilImportacion(id uint) error {
+	result := s.db.Delete(&student.PerfilImportacion{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("Perfil de importación no encontrado")
+	}
+	return nil
+}
@@ -2,32 +2,38 @@ package services
 
 import (
 	"context"
+	queryDTO "dece/internal/application/dtos/query"
 	studentDTO "dece/internal/application/dtos/student"
+	identity "dece/internal/application/helpers/identity"
+	photo "dece/internal/application/helpers/photo"
+	queryHelper "dece/internal/application/helpers/query"
+	consentSvc "dece/internal/application/services/consent"
+	documentSvc "dece/internal/application/services/documents"
+	enrollmentSvc "dece/internal/application/services/enrollment"
 	"dece/internal/domain/common"
-	"dece/internal/domain/enrollment"
+	"dece/internal/domain/documents"
 	"dece/internal/domain/student"
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"io"
 	"mime"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
-	"github.com/wailsapp/wails/v2/pkg/runtime"
-	"github.com/xuri/excelize/v2"
 	"gorm.io/gorm"
 )
 
 type StudentService struct {
-	ctx context.Context
-	db  *gorm.DB
+	ctx       context.Context
+	db        *gorm.DB
+	versiones *documentSvc.Versionador
+	ciclo     *enrollmentSvc.CicloMatricula
 }
 
-func NewStudentService(db *gorm.DB) *StudentService {
-	return &StudentService{db: db}
+func NewStudentService(db *gorm.DB, versiones *documentSvc.Versionador, ciclo *enrollmentSvc.CicloMatricula) *StudentService {
+	return &StudentService{db: db, versiones: versiones, ciclo: ciclo}
 }
 
 func (s *StudentService) SetContext(ctx context.Context) {
@@ -43,9 +49,11 @@ type ImportRowError struct {
 
 // ImportResult contiene el resultado detallado de la importación
 type ImportResult struct {
+	LoteID       uint             `json:"loteId"`
 	TotalFilas   int              `json:"totalFilas"`
 	Creados      int              `json:"creados"`
 	Actualizados int              `json:"actualizados"`
+	SinCambios   int              `json:"sinCambios"`
 	Omitidos     int              `json:"omitidos"`
 	Errores      []ImportRowError `json:"errores"`
 }
@@ -77,251 +85,13 @@ func separarNombresCompletos(nombresCompletos string) (apellidos, nombres string
 	}
 }
 
-func (s *StudentService) ImportarEstudiantes(cursoID uint) (*ImportResult, error) {
-	if s.ctx == nil {
-		return nil, errors.New("contexto no inicializado")
-	}
-
-	filePath, err := runtime.OpenFileDialog(s.ctx, runtime.OpenDialogOptions{
-		Title: "Seleccionar Archivo Excel",
-		Filters: []runtime.FileFilter{
-			{DisplayName: "Archivos Excel", Pattern: "*.xlsx;*.xlsm"},
-		},
-	})
-	if err != nil {
-		return nil, err
-	}
-	if filePath == "" {
-		return nil, nil // Usuario canceló
-	}
-
-	f, err := excelize.OpenFile(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("error al abrir el archivo Excel: %v", err)
-	}
-	defer f.Close()
-
-	sheetName := f.GetSheetName(0)
-	rows, err := f.GetRows(sheetName)
-	if err != nil {
-		return nil, fmt.Errorf("error al leer las filas del Excel: %v", err)
-	}
-
-	// === DETECCIÓN FLEXIBLE DE COLUMNAS ===
-	idxCedula, idxNombresCompletos, idxNombres, idxApellidos, idxCorreo := -1, -1, -1, -1, -1
-	headerRowIndex := -1
-
-	for i, row := range rows {
-		foundCedula := false
-		for j, cell := range row {
-			val := strings.ToLower(strings.TrimSpace(cell))
-			// Quitar tildes para comparación
-			valNorm := strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u").Replace(val)
-
-			switch {
-			case strings.Contains(valNorm, "cedula"):
-				idxCedula = j
-				foundCedula = true
-			case valNorm == "nombres completos" || valNorm == "nombre completo" || valNorm == "nombres y apellidos" || valNorm == "apellidos y nombres":
-				idxNombresCompletos = j
-			case valNorm == "nombres" || valNorm == "nombre":
-				idxNombres = j
-			case valNorm == "apellidos" || valNorm == "apellido":
-				idxApellidos = j
-			case strings.Contains(valNorm, "correo") || valNorm == "email" || valNorm == "cuenta" || valNorm == "e-mail" || valNorm == "mail":
-				idxCorreo = j
-			}
-		}
-
-		// Validamos que al menos tengamos cédula y algún campo de nombre
-		tieneNombreSeparado := idxNombres >= 0 && idxApellidos >= 0
-		tieneNombreUnido := idxNombresCompletos >= 0
-
-		if foundCedula && (tieneNombreSeparado || tieneNombreUnido) {
-			headerRowIndex = i
-			break
-		}
-	}
-
-	if headerRowIndex == -1 {
-		colsRequeridas := "CÉDULA + (NOMBRES COMPLETOS | NOMBRES + APELLIDOS)"
-		return nil, fmt.Errorf("no se encontraron las columnas requeridas: %s. Verifique los encabezados del Excel", colsRequeridas)
-	}
-
-	modoUnido := idxNombresCompletos >= 0 && (idxNombres < 0 || idxApellidos < 0)
-
-	// === PROCESAMIENTO DE FILAS ===
-	totalFilas := len(rows) - (headerRowIndex + 1)
-	result := &ImportResult{
-		TotalFilas: totalFilas,
-		Errores:    make([]ImportRowError, 0),
-	}
-
-	processedCount := 0
-
-	for i := headerRowIndex + 1; i < len(rows); i++ {
-		processedCount++
-		filaExcel := i + 1 // Número de fila visible en Excel (1-indexed)
-
-		// Emitir progreso cada 5 filas o en la última
-		if processedCount%5 == 0 || processedCount == totalFilas {
-			runtime.EventsEmit(s.ctx, "student:import_progress", map[string]int{
-				"current":      processedCount,
-				"total":        totalFilas,
-				"creados":      result.Creados,
-				"actualizados": result.Actualizados,
-				"errores":      len(result.Errores),
-			})
-		}
-
-		row := rows[i]
-		getVal := func(idx int) string {
-			if idx >= 0 && idx < len(row) {
-				return strings.TrimSpace(row[idx])
-			}
-			return ""
-		}
-
-		cedula := getVal(idxCedula)
-		correo := getVal(idxCorreo)
-
-		// --- Validación: Cédula vacía → omitir (fila vacía) ---
-		if cedula == "" {
-			result.Omitidos++
-			continue
-		}
-
-		// --- Resolver apellidos y nombres ---
-		var apellidos, nombres string
-		if modoUnido {
-			nombresCompletos := getVal(idxNombresCompletos)
-			if nombresCompletos == "" {
-				result.Errores = append(result.Errores, ImportRowError{
-					Fila:    filaExcel,
-					Cedula:  cedula,
-					Detalle: "El campo 'Nombres Completos' está vacío",
-				})
-				continue
-			}
-			apellidos, nombres = separarNombresCompletos(nombresCompletos)
-		} else {
-			apellidos = strings.ToUpper(strings.TrimSpace(getVal(idxApellidos)))
-			nombres = strings.ToUpper(strings.TrimSpace(getVal(idxNombres)))
-		}
-
-		if apellidos == "" && nombres == "" {
-			result.Errores = append(result.Errores, ImportRowError{
-				Fila:    filaExcel,
-				Cedula:  cedula,
-				Detalle: "No se pudo obtener nombres ni apellidos",
-			})
-			continue
-		}
-
-		// --- UPSERT: Buscar por cédula y crear o actualizar ---
-		var estudianteID uint
-		var existente student.Estudiante
-		dbErr := s.db.Where("cedula = ?", cedula).First(&existente).Error
-
-		if dbErr == nil {
-			// Ya existe -> Actualizar
-			estudianteID = existente.ID
-			updates := map[string]interface{}{}
-			if apellidos != "" {
-				updates["apellidos"] = apellidos
-			}
-			if nombres != "" {
-				updates["nombres"] = nombres
-			}
-			if correo != "" {
-				updates["correo_electronico"] = correo
-			}
-
-			if len(updates) > 0 {
-				if err := s.db.Model(&existente).Updates(updates).Error; err != nil {
-					result.Errores = append(result.Errores, ImportRowError{
-						Fila:    filaExcel,
-						Cedula:  cedula,
-						Detalle: fmt.Sprintf("Error al actualizar: %v", err),
-					})
-					// A pesar del error de actualización, intentamos continuar si tenemos ID,
-					// pero si falló update es riesgoso. Continuemos.
-				}
-			}
-			result.Actualizados++
-
-		} else if errors.Is(dbErr, gorm.ErrRecordNotFound) {
-			// No existe -> Crear
-			nuevo := student.Estudiante{
-				Cedula:            cedula,
-				Apellidos:         apellidos,
-				Nombres:           nombres,
-				CorreoElectronico: correo,
-				InfoNacionalidad:  common.JSONMap[student.InfoNacionalidad]{Data: student.InfoNacionalidad{EsExtranjero: false}},
-				GeneroNacimiento:  "M",
-			}
-			if err := s.db.Create(&nuevo).Error; err != nil {
-				result.Errores = append(result.Errores, ImportRowError{
-					Fila:    filaExcel,
-					Cedula:  cedula,
-					Detalle: fmt.Sprintf("Error al crear: %v", err),
-				})
-				continue
-			}
-			estudianteID = nuevo.ID
-			result.Creados++
-
-		} else {
-			// Error inesperado
-			result.Errores = append(result.Errores, ImportRowError{
-				Fila:    filaExcel,
-				Cedula:  cedula,
-				Detalle: fmt.Sprintf("Error de consulta: %v", dbErr),
-			})
-			continue
-		}
-
-		// --- MATRICULACIÓN AUTOMÁTICA (Si se seleccionó curso) ---
-		if cursoID > 0 && estudianteID > 0 {
-			var matriculaExistente enrollment.Matricula
-			// Verificar si ya está matriculado en ESTE curso
-			errMat := s.db.Where("estudiante_id = ? AND curso_id = ?", estudianteID, cursoID).First(&matriculaExistente).Error
-
-			if errors.Is(errMat, gorm.ErrRecordNotFound) {
-				// Crear matrícula
-				nuevaMatricula := enrollment.Matricula{
-					EstudianteID:  estudianteID,
-					CursoID:       cursoID,
-					Estado:        "Matriculado",
-					FechaRegistro: time.Now().Format("2006-01-02 15:04:05"),
-				}
-				if err := s.db.Create(&nuevaMatricula).Error; err != nil {
-					// No bloqueamos el proceso, pero registramos el error como warning o error de fila
-					// Podríamos agregarlo a errores, aunque el estudiante se creó bien.
-					// Decisión: Agregarlo como error con detalle "Estudiante OK pero falló matriculación"
-					result.Errores = append(result.Errores, ImportRowError{
-						Fila:    filaExcel,
-						Cedula:  cedula,
-						Detalle: fmt.Sprintf("Estudiante procesado pero error al matricular: %v", err),
-					})
-				}
-			}
-		}
-	}
-
-	// Emitir progreso final
-	runtime.EventsEmit(s.ctx, "student:import_progress", map[string]int{
-		"current":      totalFilas,
-		"total":        totalFilas,
-		"creados":      result.Creados,
-		"actualizados": result.Actualizados,
-		"errores":      len(result.Errores),
-	})
-
-	return result, nil
+func CaclularEdad(fechaNacimiento string) int {
+	return CalcularEdadAl(fechaNacimiento, time.Now())
 }
 
-func CaclularEdad(fechaNacimiento string) int {
+// CalcularEdadAl devuelve los años cumplidos a una fecha de corte (la edad "al 1 de
+// septiembre" que usa el Ministerio, por ejemplo).
+func CalcularEdadAl(fechaNacimiento string, referencia time.Time) int {
 	if fechaNacimiento == "" {
 		return 0
 	}
@@ -331,37 +101,60 @@ func CaclularEdad(fechaNacimiento string) int {
 		return 0
 	}
 
-	hoy := time.Now()
-	edad := hoy.Year() - nacimiento.Year()
+	edad := referencia.Year() - nacimiento.Year()
 
-	if hoy.Month() < nacimiento.Month() ||
-		(hoy.Month() == nacimiento.Month() && hoy.Day() < nacimiento.Day()) {
+	if referencia.Month() < nacimiento.Month() ||
+		(referencia.Month() == nacimiento.Month() && referencia.Day() < nacimiento.Day()) {
 		edad--
 	}
 
 	return edad
 }
 
+// especificacionEstudiantes define los campos por los que se puede filtrar y ordenar el listado.
+var especificacionEstudiantes = queryHelper.Especificacion{
+	Campos: map[string]queryHelper.Campo{
+		"cedula":           {Columna: "estudiantes.cedula", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"apellidos":        {Columna: "estudiantes.apellidos", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"nombres":          {Columna: "estudiantes.nombres", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"fecha_nacimiento": {Columna: "estudiantes.fecha_nacimiento", Tipo: queryHelper.CampoFecha, Ordenable: true},
+		"fecha_creacion":   {Columna: "estudiantes.fecha_creacion", Tipo: queryHelper.CampoFecha, Ordenable: true},
+		"genero":           {Columna: "estudiantes.genero_nacimiento", Tipo: queryHelper.CampoTexto},
+		"hogar_id":         {Columna: "estudiantes.hogar_id", Tipo: queryHelper.CampoNumero},
+		"es_extranjero":    {Columna: "json_extract(estudiantes.info_nacionalidad, '$.es_extranjero')", Tipo: queryHelper.CampoBooleano},
+	},
+	Busqueda: []string{
+		"estudiantes.cedula",
+		"estudiantes.apellidos",
+		"estudiantes.nombres",
+		"json_extract(estudiantes.info_nacionalidad, '$.pasaporte_odni')",
+	},
+	OrdenPorDefecto: "apellidos",
+	Desempate:       "estudiantes.id",
+}
+
+// BuscarEstudiantes es la búsqueda rápida de los selectores: devuelve como máximo una página
+// de coincidencias. El listado general usa ConsultarEstudiantes.
 func (s *StudentService) BuscarEstudiantes(query string) ([]studentDTO.EstudianteListaDTO, error) {
-	var estudiantes []student.Estudiante
-	query = strings.TrimSpace(query)
-	likeQuery := "%" + query + "%"
+	resultado, err := s.ConsultarEstudiantes(queryDTO.ConsultaDTO{
+		Busqueda:     query,
+		TamanoPagina: 50,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultado.Items, nil
+}
 
-	result := s.db.
-		Where("cedula LIKE ?", likeQuery).
-		Or("apellidos LIKE ?", likeQuery).
-		Or("nombres LIKE ?", likeQuery).
-		Or("json_extract(info_nacionalidad, '$.pasaporte_odni') LIKE ?", likeQuery).
-		Order("apellidos ASC").
-		Limit(3000).
-		Find(&estudiantes)
+func (s *StudentService) ConsultarEstudiantes(consulta queryDTO.ConsultaDTO) (*studentDTO.EstudiantesPaginadosDTO, error) {
+	var estudiantes []student.Estudiante
 
-	if result.Error != nil {
-		return nil, result.Error
+	paginacion, err := queryHelper.Paginar(s.db.Model(&student.Estudiante{}), especificacionEstudiantes, consulta, &estudiantes)
+	if err != nil {
+		return nil, err
 	}
 
 	response := make([]studentDTO.EstudianteListaDTO, len(estudiantes))
-	print(estudiantes)
 	for i, e := range estudiantes {
 		response[i] = studentDTO.EstudianteListaDTO{
 			ID:                    e.ID,
@@ -381,9 +174,8 @@ func (s *StudentService) BuscarEstudiantes(query string) ([]studentDTO.Estudiant
 			},
 		}
 	}
-	print(response)
 
-	return response, nil
+	return &studentDTO.EstudiantesPaginadosDTO{Items: response, Paginacion: paginacion}, nil
 }
 
 func (s *StudentService) ObtenerEstudiante(id uint) (*student.Estudiante, error) {
@@ -398,6 +190,19 @@ func (s *StudentService) ObtenerEstudiante(id uint) (*student.Estudiante, error)
 func (s *StudentService) GuardarEstudiante(input studentDTO.GuardarEstudianteDTO) (*student.Estudiante, error) {
 	var estGuardado *student.Estudiante
 
+	input.Cedula = strings.TrimSpace(input.Cedula)
+	if err := identity.ValidarIdentidadEstudiante(input.Cedula, input.EsExtranjero, input.PasaporteOrDNI); err != nil {
+		return nil, err
+	}
+	for _, f := range input.Familiares {
+		if strings.TrimSpace(f.Cedula) == "" {
+			continue
+		}
+		if err := identity.ValidarIdentificacion(f.Cedula); err != nil {
+			return nil, fmt.Errorf("Familiar %s: %v", f.NombresCompletos, err)
+		}
+	}
+
 	err := s.db.Transaction(func(tx *gorm.DB) error {
 
 		var count int64
@@ -439,7 +244,7 @@ func (s *StudentService) GuardarEstudiante(input studentDTO.GuardarEstudianteDTO
 		for i, f := range input.Familiares {
 			listaFamiliares[i] = student.Familiar{
 				ID:                   f.ID,
-				Cedula:               f.Cedula,
+				Cedula:               strings.TrimSpace(f.Cedula),
 				NombresCompletos:     strings.ToUpper(f.NombresCompletos),
 				Parentesco:           f.Parentesco,
 				EsRepresentanteLegal: f.EsRepresentanteLegal,
@@ -459,10 +264,33 @@ func (s *StudentService) GuardarEstudiante(input studentDTO.GuardarEstudianteDTO
 
 		est.Familiares = listaFamiliares
 
+		telefonosAnteriores := map[uint]string{}
+		if input.ID > 0 {
+			var anterior student.Estudiante
+			if err := tx.Preload("Familiares").Select("id", "hogar_id").First(&anterior, input.ID).Error; err == nil {
+				est.HogarID = anterior.HogarID
+				for _, f := range anterior.Familiares {
+					telefonosAnteriores[f.ID] = f.TelefonoPersonal
+				}
+			}
+		}
+
 		if err := tx.Save(&est).Error; err != nil {
 			return fmt.Errorf("Error al guardar ficha completa: %v", err)
 		}
 
+		// El teléfono de un padre compartido se mantiene igual en las fichas de sus hermanos
+		if est.HogarID != nil {
+			for _, f := range est.Familiares {
+				if anterior, ok := telefonosAnteriores[f.ID]; ok && anterior == f.TelefonoPersonal {
+					continue
+				}
+				if _, err := propagarTelefonoHogar(tx, est.ID, f.Cedula, f.TelefonoPersonal); err != nil {
+					return fmt.Errorf("Error al actualizar teléfono en el hogar: %v", err)
+				}
+			}
+		}
+
 		estGuardado = &est
 		return nil
 	})
@@ -474,134 +302,79 @@ func (s *StudentService) GuardarEstudiante(input studentDTO.GuardarEstudianteDTO
 	return estGuardado, nil
 }
 
-func (s *StudentService) GuardarFoto(id uint, rutaOrigen string) (string, error) {
-	var est student.Estudiante
-
-	if err := s.db.First(&est, id).Error; err != nil {
-		return "", errors.New("Estudiante no encontrado")
-	}
-
-	homeDir, err := os.UserHomeDir()
+func (s *StudentService) GuardarFoto(id uint, rutaOrigen string) (*studentDTO.FotoGuardadaDTO, error) {
+	data, err := os.ReadFile(rutaOrigen)
 	if err != nil {
-		return "", errors.New("No se pudo acceder a la carpeta del usuario")
+		return nil, fmt.Errorf("Error al leer imagen original: %v", err)
 	}
 
-	destinoDir := filepath.Join(homeDir, "Documents", "SistemaDECE", "FotosEstudiantes")
-
-	if err := os.MkdirAll(destinoDir, 0755); err != nil {
-		return "", fmt.Errorf("Error al crear carpeta de fotos: %v", err)
-	}
-
-	ext := filepath.Ext(rutaOrigen)
-	if ext == "" {
-		ext = ".jpg"
-	}
-
-	nuevoNombre := fmt.Sprintf("%s_%d%s", est.Cedula, time.Now().Unix(), ext)
-	rutaDestinoCompleta := filepath.Join(destinoDir, nuevoNombre)
+	return s.guardarFotoProcesada(id, data)
+}
 
-	srcFile, err := os.Open(rutaOrigen)
-	if err != nil {
-		return "", fmt.Errorf("Error al leer imagen original: %v", err)
+func (s *StudentService) GuardarFotoBase64(id uint, dataURL string, filename string) (*studentDTO.FotoGuardadaDTO, error) {
+	payload := dataURL
+	if _, after, ok := strings.Cut(dataURL, "base64,"); ok {
+		payload = after
 	}
-	defer srcFile.Close()
 
-	dstFile, err := os.Create(rutaDestinoCompleta)
+	decoded, err := base64.StdEncoding.DecodeString(payload)
 	if err != nil {
-		return "", fmt.Errorf("Error al crear imagen destino: %v", err)
+		return nil, fmt.Errorf("Error al decodificar base64: %v", err)
 	}
-	defer dstFile.Close()
 
-	if _, err := io.Copy(dstFile, srcFile); err != nil {
-		return "", fmt.Errorf("Error al copiar imagen: %v", err)
-	}
-
-	if est.RutaFoto != "" {
-		if _, err := os.Stat(est.RutaFoto); err == nil {
-			os.Remove(est.RutaFoto)
-		}
-	}
-
-	if err := s.db.Model(&est).Update("ruta_foto", rutaDestinoCompleta).Error; err != nil {
-		return "", fmt.Errorf("Imagen copiada pero error al actualizar BD: %v", err)
-	}
-
-	return rutaDestinoCompleta, nil
+	return s.guardarFotoProcesada(id, decoded)
 }
 
-func (s *StudentService) GuardarFotoBase64(id uint, dataURL string, filename string) (string, error) {
+// guardarFotoProcesada normaliza la imagen (orientación, recorte 3:4, sin EXIF), guarda la
+// foto con su miniatura y reemplaza la anterior del estudiante. El uso de imagen no bloquea:
+// sin consentimiento la foto se guarda con una advertencia.
+func (s *StudentService) guardarFotoProcesada(id uint, data []byte) (*studentDTO.FotoGuardadaDTO, error) {
 	var est student.Estudiante
 
 	if err := s.db.First(&est, id).Error; err != nil {
-		return "", errors.New("Estudiante no encontrado")
+		return nil, errors.New("Estudiante no encontrado")
 	}
 
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return "", errors.New("No se pudo acceder a la carpeta del usuario")
+		return nil, errors.New("No se pudo acceder a la carpeta del usuario")
 	}
 
 	destinoDir := filepath.Join(homeDir, "Documents", "SistemaDECE", "FotosEstudiantes")
+	nombreBase := fmt.Sprintf("%s_%d", est.Cedula, time.Now().Unix())
 
-	if err := os.MkdirAll(destinoDir, 0755); err != nil {
-		return "", fmt.Errorf("Error al crear carpeta de fotos: %v", err)
-	}
-
-	ext := filepath.Ext(filename)
-	if ext == "" {
-		if strings.HasPrefix(dataURL, "data:") {
-			parts := strings.SplitN(dataURL, ";", 2)
-			if len(parts) > 0 {
-				mime := strings.TrimPrefix(parts[0], "data:")
-				switch mime {
-				case "image/png":
-					ext = ".png"
-				case "image/jpeg":
-					ext = ".jpg"
-				case "image/jpg":
-					ext = ".jpg"
-				case "image/gif":
-					ext = ".gif"
-				default:
-					ext = ".jpg"
-				}
-			}
-		} else {
-			ext = ".jpg"
-		}
-	}
-
-	payload := dataURL
-	if _, after, ok := strings.Cut(dataURL, "base64,"); ok {
-		payload = after
-	}
-
-	decoded, err := base64.StdEncoding.DecodeString(payload)
+	rutaDestinoCompleta, err := photo.GuardarFoto(data, photo.FormatoFotoEstudiante, destinoDir, nombreBase)
 	if err != nil {
-		return "", fmt.Errorf("Error al decodificar base64: %v", err)
+		return nil, err
 	}
 
-	nuevoNombre := fmt.Sprintf("%s_%d%s", est.Cedula, time.Now().Unix(), ext)
-	rutaDestinoCompleta := filepath.Join(destinoDir, nuevoNombre)
-
-	if err := os.WriteFile(rutaDestinoCompleta, decoded, 0644); err != nil {
-		return "", fmt.Errorf("Error al escribir imagen destino: %v", err)
+	if est.RutaFoto != "" && est.RutaFoto != rutaDestinoCompleta {
+		photo.EliminarFoto(est.RutaFoto)
 	}
 
-	if est.RutaFoto != "" {
-		if _, err := os.Stat(est.RutaFoto); err == nil {
-			os.Remove(est.RutaFoto)
-		}
+	if err := s.db.Model(&est).Update("ruta_foto", rutaDestinoCompleta).Error; err != nil {
+		return nil, fmt.Errorf("Imagen guardada pero error al actualizar BD: %v", err)
 	}
 
-	if err := s.db.Model(&est).Update("ruta_foto", rutaDestinoCompleta).Error; err != nil {
-		return "", fmt.Errorf("Imagen guardada pero error al actualizar BD: %v", err)
+	// La foto ya quedó guardada; si no se pudo verificar el consentimiento, se avisa igual
+	advertencia, err := consentSvc.Verificar(s.db, est.ID, student.ConsentimientoImagen, "usar la foto del estudiante")
+	if err != nil {
+		advertencia = err.Error()
 	}
 
-	return rutaDestinoCompleta, nil
+	return &studentDTO.FotoGuardadaDTO{Ruta: rutaDestinoCompleta, Advertencia: advertencia}, nil
 }
 
 func (s *StudentService) ObtenerFotoBase64(id uint) (string, error) {
+	return s.leerFotoBase64(id, false)
+}
+
+// ObtenerMiniaturaBase64 devuelve la miniatura de la foto, pensada para listados y reportes.
+func (s *StudentService) ObtenerMiniaturaBase64(id uint) (string, error) {
+	return s.leerFotoBase64(id, true)
+}
+
+func (s *StudentService) leerFotoBase64(id uint, miniatura bool) (string, error) {
 	var est student.Estudiante
 
 	if err := s.db.First(&est, id).Error; err != nil {
@@ -612,12 +385,17 @@ func (s *StudentService) ObtenerFotoBase64(id uint) (string, error) {
 		return "", errors.New("Estudiante no tiene foto")
 	}
 
-	data, err := os.ReadFile(est.RutaFoto)
+	ruta := est.RutaFoto
+	if miniatura {
+		ruta = photo.MiniaturaOFoto(est.RutaFoto)
+	}
+
+	data, err := os.ReadFile(ruta)
 	if err != nil {
 		return "", fmt.Errorf("Error leyendo archivo: %v", err)
 	}
 
-	ext := strings.ToLower(filepath.Ext(est.RutaFoto))
+	ext := strings.ToLower(filepath.Ext(ruta))
 	mimeType := mime.TypeByExtension(ext)
 	if mimeType == "" {
 		mimeType = "image/jpeg"
@@ -657,7 +435,7 @@ func (s *StudentService) GuardarDocumentoPDF(id uint, tipoDocumento string, base
 	}
 
 	safeTipo := strings.ToLower(tipoDocumento)
-	nuevoNombre := fmt.Sprintf("%s_%s_%d.pdf", est.Cedula, safeTipo, time.Now().Unix())
+	nuevoNombre := fmt.Sprintf("%s_%s_%d.pdf", est.Cedula, safeTipo, time.Now().UnixNano())
 	rutaDestinoCompleta := filepath.Join(destinoDir, nuevoNombre)
 
 	if err := os.WriteFile(rutaDestinoCompleta, decoded, 0644); err != nil {
@@ -677,13 +455,18 @@ func (s *StudentService) GuardarDocumentoPDF(id uint, tipoDocumento string, base
 		return "", errors.New("Tipo de documento inválido")
 	}
 
-	if oldPath != "" {
-		if _, err := os.Stat(oldPath); err == nil {
-			os.Remove(oldPath)
+	// El documento anterior se conserva como versión histórica
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.versiones.Adoptar(tx, documents.EntidadEstudiante, est.ID, safeTipo, oldPath); err != nil {
+			return err
 		}
-	}
-
-	if err := s.db.Model(&est).UpdateColumns(updates).Error; err != nil {
+		if err := tx.Model(&est).UpdateColumns(updates).Error; err != nil {
+			return err
+		}
+		_, err := s.versiones.Registrar(tx, documents.EntidadEstudiante, est.ID, safeTipo, nuevoNombre, rutaDestinoCompleta, true)
+		return err
+	})
+	if err != nil {
 		return "", fmt.Errorf("Documento guardado pero error al actualizar BD: %v", err)
 	}
 
@@ -726,13 +509,17 @@ func (s *StudentService) ObtenerDocumentoPDF(id uint, tipo string) (string, erro
 	return dataURL, nil
 }
 
+// EliminarFamiliar borra al familiar. Los consentimientos que firmó conservan sus datos de
+// firmante y solo pierden el vínculo.
 func (s *StudentService) EliminarFamiliar(id uint) error {
-	result := s.db.Delete(&student.Familiar{}, id)
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return errors.New("Familiar no encontrado")
-	}
-	return nil
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&student.Familiar{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("Familiar no encontrado")
+		}
+		return tx.Model(&student.Consentimiento{}).Where("familiar_id = ?", id).Update("familiar_id", nil).Error
+	})
 }
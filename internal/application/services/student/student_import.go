@@ -0,0 +1,936 @@
+package services
+
+import (
+	studentDTO "dece/internal/application/dtos/student"
+	identity "dece/internal/application/helpers/identity"
+	"dece/internal/domain/common"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/faculty"
+	"dece/internal/domain/student"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"gorm.io/gorm"
+)
+
+// filaLeida es una fila del archivo ya interpretada, antes de compararla con la base.
+type filaLeida struct {
+	Hoja      string
+	Fila      int
+	Cedula    string
+	Apellidos string
+	Nombres   string
+	Correo    string
+
+	FechaNacimiento string
+	Genero          string
+	Nacionalidad    string
+	PasaporteOrDNI  string
+	Direccion       string
+
+	RepresentanteCedula     string
+	RepresentanteNombres    string
+	RepresentanteParentesco string
+	RepresentanteTelefono   string
+
+	Error string
+}
+
+// leerArchivoImportacion interpreta un CSV o todas las hojas de un Excel. Cada hoja detecta
+// su propio encabezado; las hojas sin las columnas requeridas se ignoran.
+func leerArchivoImportacion(filePath string, perfil *student.PerfilImportacion) ([]filaLeida, int, error) {
+	tablas, err := leerTablasImportacion(filePath, perfil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	formatoFecha := ""
+	if perfil != nil {
+		formatoFecha = perfil.FormatoFecha
+	}
+
+	filas := make([]filaLeida, 0)
+	totalFilas := 0
+	hojasValidas := 0
+
+	for _, tabla := range tablas {
+		headerRowIndex, columnas := detectarEncabezado(tabla.Filas, perfil)
+		if headerRowIndex == -1 {
+			continue
+		}
+		hojasValidas++
+
+		_, tieneNombres := columnas[campoNombres]
+		_, tieneApellidos := columnas[campoApellidos]
+		modoUnido := !(tieneNombres && tieneApellidos)
+
+		totalFilas += len(tabla.Filas) - (headerRowIndex + 1)
+
+		for i := headerRowIndex + 1; i < len(tabla.Filas); i++ {
+			row := tabla.Filas[i]
+			getVal := func(campo string) string {
+				idx, ok := columnas[campo]
+				if ok && idx < len(row) {
+					return strings.TrimSpace(row[idx])
+				}
+				return ""
+			}
+
+			fila := filaLeida{
+				Hoja:                    tabla.Nombre,
+				Fila:                    i + 1, // Número de fila visible en Excel (1-indexed)
+				Cedula:                  identity.NormalizarCedula(getVal(campoCedula)),
+				Correo:                  getVal(campoCorreo),
+				Nacionalidad:            strings.ToUpper(getVal(campoNacionalidad)),
+				PasaporteOrDNI:          strings.ToUpper(getVal(campoPasaporte)),
+				Direccion:               getVal(campoDireccion),
+				RepresentanteCedula:     identity.NormalizarCedula(getVal(campoRepresentanteCedula)),
+				RepresentanteNombres:    strings.ToUpper(getVal(campoRepresentanteNombres)),
+				RepresentanteParentesco: getVal(campoRepresentanteParentesco),
+				RepresentanteTelefono:   getVal(campoRepresentanteTelefono),
+			}
+
+			if modoUnido {
+				nombresCompletos := getVal(campoNombresCompletos)
+				if nombresCompletos == "" && fila.Cedula != "" {
+					fila.Error = "El campo 'Nombres Completos' está vacío"
+				}
+				fila.Apellidos, fila.Nombres = separarNombresCompletos(nombresCompletos)
+			} else {
+				fila.Apellidos = strings.ToUpper(getVal(campoApellidos))
+				fila.Nombres = strings.ToUpper(getVal(campoNombres))
+			}
+
+			if fila.Error == "" && fila.Apellidos == "" && fila.Nombres == "" && fila.Cedula != "" {
+				fila.Error = "No se pudo obtener nombres ni apellidos"
+			}
+
+			if genero := getVal(campoGenero); genero != "" {
+				fila.Genero = normalizarGenero(genero)
+				if fila.Genero == "" && fila.Error == "" {
+					fila.Error = fmt.Sprintf("Género no reconocido: %s", genero)
+				}
+			}
+
+			fecha, err := normalizarFecha(getVal(campoFechaNacimiento), formatoFecha)
+			if err != nil && fila.Error == "" {
+				fila.Error = err.Error()
+			}
+			fila.FechaNacimiento = fecha
+
+			filas = append(filas, fila)
+		}
+	}
+
+	if hojasValidas == 0 {
+		colsRequeridas := "CÉDULA + (NOMBRES COMPLETOS | NOMBRES + APELLIDOS)"
+		if perfil != nil {
+			return nil, 0, fmt.Errorf("ninguna hoja coincide con el perfil %s. Verifique los encabezados del archivo", perfil.Nombre)
+		}
+		return nil, 0, fmt.Errorf("no se encontraron las columnas requeridas: %s. Verifique los encabezados del archivo", colsRequeridas)
+	}
+
+	return filas, totalFilas, nil
+}
+
+// PrevisualizarImportacion lee el archivo con la detección automática de columnas.
+func (s *StudentService) PrevisualizarImportacion(cursoID uint) (*studentDTO.VistaPreviaImportacionDTO, error) {
+	return s.PrevisualizarImportacionConPerfil(cursoID, 0)
+}
+
+// PrevisualizarImportacionConPerfil lee el Excel o CSV y registra un lote pendiente con lo que
+// se crearía, actualizaría y matricularía, sin tocar estudiantes, familiares ni matrículas.
+func (s *StudentService) PrevisualizarImportacionConPerfil(cursoID uint, perfilID uint) (*studentDTO.VistaPreviaImportacionDTO, error) {
+	if s.ctx == nil {
+		return nil, errors.New("contexto no inicializado")
+	}
+
+	filePath, err := runtime.OpenFileDialog(s.ctx, runtime.OpenDialogOptions{
+		Title: "Seleccionar Archivo de Estudiantes",
+		Filters: []runtime.FileFilter{
+			{DisplayName: "Excel o CSV", Pattern: "*.xlsx;*.xlsm;*.csv"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if filePath == "" {
+		return nil, nil // Usuario canceló
+	}
+
+	return s.previsualizarArchivo(filePath, cursoID, perfilID)
+}
+
+func (s *StudentService) previsualizarArchivo(filePath string, cursoID uint, perfilID uint) (*studentDTO.VistaPreviaImportacionDTO, error) {
+	var curso faculty.Curso
+	if cursoID > 0 {
+		if err := s.db.First(&curso, cursoID).Error; err != nil {
+			return nil, errors.New("El curso seleccionado no existe")
+		}
+	}
+
+	var perfil *student.PerfilImportacion
+	if perfilID > 0 {
+		perfil = &student.PerfilImportacion{}
+		if err := s.db.First(perfil, perfilID).Error; err != nil {
+			return nil, errors.New("El perfil de importación no existe")
+		}
+	}
+
+	leidas, totalFilas, err := leerArchivoImportacion(filePath, perfil)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &studentDTO.VistaPreviaImportacionDTO{
+		Archivo:    filepath.Base(filePath),
+		CursoID:    cursoID,
+		TotalFilas: totalFilas,
+		Filas:      make([]student.FilaImportacion, 0, len(leidas)),
+	}
+
+	vistas := map[string]string{}
+
+	// Cupos libres del curso; las filas que excedan se procesan sin matrícula
+	capacidad, ocupados := 0, 0
+	if cursoID > 0 {
+		if capacidad, ocupados, err = s.ciclo.CupoCurso(s.db, cursoID, 0); err != nil {
+			return nil, err
+		}
+		preview.CapacidadCurso = capacidad
+	}
+
+	for _, l := range leidas {
+		if l.Cedula == "" {
+			preview.Omitidos++
+			continue
+		}
+
+		fila := student.FilaImportacion{
+			Hoja:                    l.Hoja,
+			Fila:                    l.Fila,
+			Cedula:                  l.Cedula,
+			Apellidos:               l.Apellidos,
+			Nombres:                 l.Nombres,
+			Correo:                  l.Correo,
+			FechaNacimiento:         l.FechaNacimiento,
+			Genero:                  l.Genero,
+			Nacionalidad:            l.Nacionalidad,
+			PasaporteOrDNI:          l.PasaporteOrDNI,
+			Direccion:               l.Direccion,
+			RepresentanteCedula:     l.RepresentanteCedula,
+			RepresentanteNombres:    l.RepresentanteNombres,
+			RepresentanteParentesco: l.RepresentanteParentesco,
+			RepresentanteTelefono:   l.RepresentanteTelefono,
+			Error:                   l.Error,
+		}
+
+		var existente student.Estudiante
+		dbErr := s.db.Where("cedula = ?", l.Cedula).First(&existente).Error
+		if dbErr != nil && !errors.Is(dbErr, gorm.ErrRecordNotFound) && fila.Error == "" {
+			fila.Error = fmt.Sprintf("Error de consulta: %v", dbErr)
+		}
+
+		if fila.Error == "" {
+			nac := infoNacionalidadImportacion(existente.InfoNacionalidad.Data, fila)
+			if err := identity.ValidarIdentidadEstudiante(l.Cedula, nac.EsExtranjero, nac.PasaporteOrDNI); err != nil {
+				fila.Error = err.Error()
+			} else if origen, repetida := vistas[l.Cedula]; repetida {
+				fila.Error = fmt.Sprintf("Cédula repetida en el archivo (%s)", origen)
+			} else if l.RepresentanteCedula != "" {
+				if err := identity.ValidarIdentificacion(l.RepresentanteCedula); err != nil {
+					fila.Error = "Representante: " + err.Error()
+				} else if l.RepresentanteCedula == l.Cedula {
+					fila.Error = "La cédula del representante es la misma del estudiante"
+				}
+			}
+		}
+
+		if fila.Error != "" {
+			fila.Accion = student.AccionImportError
+			preview.Errores++
+			preview.Filas = append(preview.Filas, fila)
+			continue
+		}
+		vistas[l.Cedula] = fmt.Sprintf("hoja %s, fila %d", l.Hoja, l.Fila)
+
+		if dbErr == nil {
+			fila.EstudianteID = existente.ID
+			fila.Cambios = cambiosImportacion(existente, fila)
+
+			if fila.RepresentanteCedula != "" {
+				var familiar student.Familiar
+				if err := s.db.Where("estudiante_id = ? AND cedula = ?", existente.ID, fila.RepresentanteCedula).First(&familiar).Error; err == nil {
+					fila.FamiliarID = familiar.ID
+					fila.CambiosFamiliar = cambiosFamiliarImportacion(familiar, fila)
+				}
+			}
+
+			if cursoID > 0 {
+				fila.Matricular, fila.Observacion = s.evaluarMatriculaImportacion(existente.ID, curso)
+			}
+
+			// Si ya tiene matrícula, la dirección del archivo actualiza esa matrícula
+			if fila.Direccion != "" && !fila.Matricular {
+				if m := s.matriculaDireccionImportacion(s.db, existente.ID, cursoID); m != nil && m.DireccionActual != fila.Direccion {
+					fila.DireccionMatriculaID = m.ID
+					fila.DireccionAnterior = m.DireccionActual
+				}
+			}
+
+			familiarNuevo := fila.RepresentanteCedula != "" && fila.FamiliarID == 0
+			if len(fila.Cambios) > 0 || len(fila.CambiosFamiliar) > 0 || familiarNuevo || fila.DireccionMatriculaID > 0 {
+				fila.Accion = student.AccionImportActualizar
+				preview.Actualizaciones++
+			} else {
+				fila.Accion = student.AccionImportSinCambios
+				preview.SinCambios++
+			}
+		} else {
+			fila.Accion = student.AccionImportCrear
+			preview.Creaciones++
+			fila.Matricular = cursoID > 0
+		}
+
+		if fila.Matricular && capacidad > 0 {
+			if ocupados >= capacidad {
+				fila.Matricular = false
+				fila.Observacion = fmt.Sprintf("Sin cupo: el curso alcanzó su capacidad (%d); no se matricula", capacidad)
+				preview.SinCupo++
+			} else {
+				ocupados++
+			}
+		}
+		if fila.Matricular {
+			preview.Matriculas++
+		}
+
+		preview.Filas = append(preview.Filas, fila)
+	}
+
+	lote := student.LoteImportacion{
+		CursoID:       cursoID,
+		PerfilID:      perfilID,
+		Archivo:       preview.Archivo,
+		Estado:        student.EstadoLotePrevisualizado,
+		TotalFilas:    totalFilas,
+		Errores:       preview.Errores,
+		Filas:         common.JSONMap[[]student.FilaImportacion]{Data: preview.Filas},
+		FechaCreacion: time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	if err := s.db.Create(&lote).Error; err != nil {
+		return nil, fmt.Errorf("Error al registrar la vista previa: %v", err)
+	}
+
+	preview.LoteID = lote.ID
+	return preview, nil
+}
+
+// infoNacionalidadImportacion combina la nacionalidad del archivo con la ya registrada. Si el
+// archivo no trae la columna se conserva la del estudiante.
+func infoNacionalidadImportacion(actual student.InfoNacionalidad, fila student.FilaImportacion) student.InfoNacionalidad {
+	if fila.Nacionalidad != "" {
+		actual.EsExtranjero = !esNacionalidadEcuatoriana(fila.Nacionalidad)
+		if actual.EsExtranjero {
+			actual.PaisOrigen = fila.Nacionalidad
+		} else {
+			actual.PaisOrigen = ""
+		}
+	}
+	if fila.PasaporteOrDNI != "" {
+		actual.PasaporteOrDNI = fila.PasaporteOrDNI
+	}
+	return actual
+}
+
+// cambiosImportacion compara la fila con el estudiante existente. Los valores vacíos del
+// archivo nunca borran datos.
+func cambiosImportacion(existente student.Estudiante, fila student.FilaImportacion) []student.CambioCampo {
+	cambios := make([]student.CambioCampo, 0)
+
+	comparar := func(campo, anterior, nuevo string) {
+		if nuevo != "" && nuevo != anterior {
+			cambios = append(cambios, student.CambioCampo{Campo: campo, Anterior: anterior, Nuevo: nuevo})
+		}
+	}
+
+	comparar("apellidos", existente.Apellidos, fila.Apellidos)
+	comparar("nombres", existente.Nombres, fila.Nombres)
+	comparar("correo_electronico", existente.CorreoElectronico, fila.Correo)
+	comparar("fecha_nacimiento", existente.FechaNacimiento, fila.FechaNacimiento)
+	comparar("genero_nacimiento", existente.GeneroNacimiento, fila.Genero)
+
+	if fila.Nacionalidad != "" || fila.PasaporteOrDNI != "" {
+		anterior, _ := json.Marshal(existente.InfoNacionalidad.Data)
+		nuevo, _ := json.Marshal(infoNacionalidadImportacion(existente.InfoNacionalidad.Data, fila))
+		comparar("info_nacionalidad", string(anterior), string(nuevo))
+	}
+
+	return cambios
+}
+
+// cambiosFamiliarImportacion compara el representante del archivo con el familiar registrado.
+func cambiosFamiliarImportacion(existente student.Familiar, fila student.FilaImportacion) []student.CambioCampo {
+	cambios := make([]student.CambioCampo, 0)
+
+	comparar := func(campo, anterior, nuevo string) {
+		if nuevo != "" && nuevo != anterior {
+			cambios = append(cambios, student.CambioCampo{Campo: campo, Anterior: anterior, Nuevo: nuevo})
+		}
+	}
+
+	comparar("nombres_completos", existente.NombresCompletos, fila.RepresentanteNombres)
+	comparar("parentesco", existente.Parentesco, fila.RepresentanteParentesco)
+	comparar("telefono_personal", existente.TelefonoPersonal, fila.RepresentanteTelefono)
+	comparar("es_representante_legal", strconv.FormatBool(existente.EsRepresentanteLegal), "true")
+
+	return cambios
+}
+
+// matriculaDireccionImportacion devuelve la matrícula cuya dirección actualiza la importación:
+// la del curso indicado o, sin curso, la del periodo lectivo activo.
+func (s *StudentService) matriculaDireccionImportacion(tx *gorm.DB, estudianteID uint, cursoID uint) *enrollment.Matricula {
+	var m enrollment.Matricula
+
+	query := tx.Model(&enrollment.Matricula{}).Where("matriculas.estudiante_id = ?", estudianteID)
+	if cursoID > 0 {
+		query = query.Where("matriculas.curso_id = ?", cursoID)
+	} else {
+		query = query.
+			Joins("JOIN cursos c ON c.id = matriculas.curso_id").
+			Joins("JOIN periodo_lectivos p ON p.id = c.periodo_id").
+			Where("p.es_activo = ?", true)
+	}
+
+	if err := query.Order("matriculas.id DESC").First(&m).Error; err != nil {
+		return nil
+	}
+	return &m
+}
+
+func (s *StudentService) evaluarMatriculaImportacion(estudianteID uint, curso faculty.Curso) (bool, string) {
+	if estudianteID == 0 {
+		return true, ""
+	}
+
+	var enCurso int64
+	s.db.Model(&enrollment.Matricula{}).
+		Where("estudiante_id = ? AND curso_id = ?", estudianteID, curso.ID).
+		Count(&enCurso)
+	if enCurso > 0 {
+		return false, "Ya matriculado en este curso"
+	}
+
+	var enPeriodo int64
+	s.db.Table("matriculas").
+		Joins("JOIN cursos c ON c.id = matriculas.curso_id").
+		Where("matriculas.estudiante_id = ? AND c.periodo_id = ?", estudianteID, curso.PeriodoID).
+		Count(&enPeriodo)
+	if enPeriodo > 0 {
+		return false, "Ya matriculado en otro curso del periodo; no se matricula"
+	}
+
+	return true, ""
+}
+
+// ConfirmarImportacion aplica un lote previsualizado en una sola transacción y guarda los
+// valores anteriores de cada estudiante actualizado para poder revertirlo.
+func (s *StudentService) ConfirmarImportacion(loteID uint) (*ImportResult, error) {
+	var lote student.LoteImportacion
+	if err := s.db.First(&lote, loteID).Error; err != nil {
+		return nil, errors.New("Lote de importación no encontrado")
+	}
+	if lote.Estado != student.EstadoLotePrevisualizado {
+		return nil, fmt.Errorf("El lote ya fue %s", strings.ToLower(lote.Estado))
+	}
+
+	filas := lote.Filas.Data
+	result := &ImportResult{
+		LoteID:     lote.ID,
+		TotalFilas: lote.TotalFilas,
+		Errores:    make([]ImportRowError, 0),
+	}
+
+	agregarError := func(fila *student.FilaImportacion, detalle string) {
+		fila.Error = detalle
+		result.Errores = append(result.Errores, ImportRowError{Fila: fila.Fila, Cedula: fila.Cedula, Detalle: detalle})
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for i := range filas {
+			fila := &filas[i]
+
+			if i%5 == 0 || i == len(filas)-1 {
+				s.emitirProgresoImportacion(i+1, len(filas), result)
+			}
+
+			switch fila.Accion {
+			case student.AccionImportError:
+				result.Errores = append(result.Errores, ImportRowError{Fila: fila.Fila, Cedula: fila.Cedula, Detalle: fila.Error})
+				continue
+
+			case student.AccionImportCrear:
+				genero := fila.Genero
+				if genero == "" {
+					genero = "M"
+				}
+				nuevo := student.Estudiante{
+					Cedula:            fila.Cedula,
+					Apellidos:         fila.Apellidos,
+					Nombres:           fila.Nombres,
+					CorreoElectronico: fila.Correo,
+					FechaNacimiento:   fila.FechaNacimiento,
+					InfoNacionalidad:  common.JSONMap[student.InfoNacionalidad]{Data: infoNacionalidadImportacion(student.InfoNacionalidad{}, *fila)},
+					GeneroNacimiento:  genero,
+				}
+				if err := tx.Create(&nuevo).Error; err != nil {
+					agregarError(fila, fmt.Sprintf("Error al crear: %v", err))
+					fila.Accion = student.AccionImportError
+					continue
+				}
+				fila.EstudianteID = nuevo.ID
+				result.Creados++
+
+			case student.AccionImportActualizar:
+				var existente student.Estudiante
+				if err := tx.First(&existente, fila.EstudianteID).Error; err != nil {
+					agregarError(fila, "El estudiante ya no existe")
+					fila.Accion = student.AccionImportError
+					continue
+				}
+
+				// Se recalcula el diff por si la ficha cambió entre la vista previa y la confirmación
+				fila.Cambios = cambiosImportacion(existente, *fila)
+				updates := map[string]interface{}{}
+				for _, c := range fila.Cambios {
+					updates[c.Campo] = c.Nuevo
+				}
+				if _, ok := updates["info_nacionalidad"]; ok {
+					updates["info_nacionalidad"] = common.JSONMap[student.InfoNacionalidad]{Data: infoNacionalidadImportacion(existente.InfoNacionalidad.Data, *fila)}
+				}
+				if len(updates) > 0 {
+					if err := tx.Model(&existente).Updates(updates).Error; err != nil {
+						agregarError(fila, fmt.Sprintf("Error al actualizar: %v", err))
+						fila.Accion = student.AccionImportError
+						continue
+					}
+				}
+			}
+
+			if err := s.aplicarRepresentanteImportacion(tx, fila); err != nil {
+				agregarError(fila, err.Error())
+			}
+
+			fila.DireccionMatriculaID = 0
+			if fila.Direccion != "" && !fila.Matricular && fila.EstudianteID > 0 {
+				if m := s.matriculaDireccionImportacion(tx, fila.EstudianteID, lote.CursoID); m != nil && m.DireccionActual != fila.Direccion {
+					anterior := m.DireccionActual
+					if err := tx.Model(m).UpdateColumn("direccion_actual", fila.Direccion).Error; err != nil {
+						agregarError(fila, fmt.Sprintf("Error al actualizar la dirección: %v", err))
+					} else {
+						fila.DireccionMatriculaID = m.ID
+						fila.DireccionAnterior = anterior
+					}
+				}
+			}
+
+			if fila.Accion != student.AccionImportCrear {
+				if len(fila.Cambios) > 0 || len(fila.CambiosFamiliar) > 0 || fila.FamiliarCreado || fila.DireccionMatriculaID > 0 {
+					fila.Accion = student.AccionImportActualizar
+					result.Actualizados++
+				} else {
+					fila.Accion = student.AccionImportSinCambios
+					result.SinCambios++
+				}
+			}
+
+			if fila.Matricular && fila.EstudianteID > 0 {
+				var count int64
+				tx.Model(&enrollment.Matricula{}).Where("estudiante_id = ? AND curso_id = ?", fila.EstudianteID, lote.CursoID).Count(&count)
+				if count > 0 {
+					fila.Matricular = false
+					continue
+				}
+
+				// El cupo se vuelve a verificar porque pudo llenarse después de la vista previa
+				if err := s.ciclo.VerificarCupo(tx, lote.CursoID, 0); err != nil {
+					fila.Matricular = false
+					agregarError(fila, fmt.Sprintf("Estudiante procesado pero no matriculado: %v", err))
+					continue
+				}
+
+				nuevaMatricula := enrollment.Matricula{
+					EstudianteID:    fila.EstudianteID,
+					CursoID:         lote.CursoID,
+					Estado:          enrollment.EstadoMatriculado,
+					DireccionActual: fila.Direccion,
+					FechaRegistro:   time.Now().Format("2006-01-02 15:04:05"),
+				}
+				if err := tx.Create(&nuevaMatricula).Error; err != nil {
+					fila.Matricular = false
+					agregarError(fila, fmt.Sprintf("Estudiante procesado pero error al matricular: %v", err))
+					continue
+				}
+				if err := s.ciclo.Alta(tx, &nuevaMatricula, "Matrícula por importación masiva"); err != nil {
+					return err
+				}
+				fila.MatriculaID = nuevaMatricula.ID
+				lote.Matriculados++
+			}
+		}
+
+		lote.Estado = student.EstadoLoteAplicado
+		lote.Creados = result.Creados
+		lote.Actualizados = result.Actualizados
+		lote.Errores = len(result.Errores)
+		lote.FechaAplicacion = time.Now().Format("2006-01-02 15:04:05")
+		lote.Filas = common.JSONMap[[]student.FilaImportacion]{Data: filas}
+
+		return tx.Save(&lote).Error
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("Error al aplicar la importación: %v", err)
+	}
+
+	s.emitirProgresoImportacion(len(filas), len(filas), result)
+
+	return result, nil
+}
+
+// aplicarRepresentanteImportacion crea o actualiza el familiar representante legal de la fila
+// y deja en la fila lo necesario para revertirlo.
+func (s *StudentService) aplicarRepresentanteImportacion(tx *gorm.DB, fila *student.FilaImportacion) error {
+	fila.FamiliarID = 0
+	fila.FamiliarCreado = false
+	fila.CambiosFamiliar = nil
+
+	if fila.RepresentanteCedula == "" || fila.EstudianteID == 0 {
+		return nil
+	}
+
+	var familiar student.Familiar
+	err := tx.Where("estudiante_id = ? AND cedula = ?", fila.EstudianteID, fila.RepresentanteCedula).First(&familiar).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if fila.RepresentanteNombres == "" {
+			return errors.New("El representante no existe y el archivo no trae sus nombres")
+		}
+		familiar = student.Familiar{
+			EstudianteID:         fila.EstudianteID,
+			Cedula:               fila.RepresentanteCedula,
+			NombresCompletos:     fila.RepresentanteNombres,
+			Parentesco:           fila.RepresentanteParentesco,
+			TelefonoPersonal:     fila.RepresentanteTelefono,
+			EsRepresentanteLegal: true,
+		}
+		if err := tx.Create(&familiar).Error; err != nil {
+			return fmt.Errorf("Error al registrar el representante: %v", err)
+		}
+		fila.FamiliarID = familiar.ID
+		fila.FamiliarCreado = true
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error al consultar el representante: %v", err)
+	}
+
+	fila.FamiliarID = familiar.ID
+	fila.CambiosFamiliar = cambiosFamiliarImportacion(familiar, *fila)
+	if len(fila.CambiosFamiliar) == 0 {
+		return nil
+	}
+
+	updates := map[string]interface{}{}
+	for _, c := range fila.CambiosFamiliar {
+		updates[c.Campo] = c.Nuevo
+	}
+	updates["es_representante_legal"] = true
+
+	if err := tx.Model(&familiar).Updates(updates).Error; err != nil {
+		fila.CambiosFamiliar = nil
+		return fmt.Errorf("Error al actualizar el representante: %v", err)
+	}
+	return nil
+}
+
+func (s *StudentService) emitirProgresoImportacion(actual, total int, result *ImportResult) {
+	if s.ctx == nil {
+		return
+	}
+	runtime.EventsEmit(s.ctx, "student:import_progress", map[string]int{
+		"current":      actual,
+		"total":        total,
+		"creados":      result.Creados,
+		"actualizados": result.Actualizados,
+		"errores":      len(result.Errores),
+	})
+}
+
+func (s *StudentService) DescartarImportacion(loteID uint) error {
+	result := s.db.Where("id = ? AND estado = ?", loteID, student.EstadoLotePrevisualizado).Delete(&student.LoteImportacion{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("Solo se pueden descartar vistas previas no aplicadas")
+	}
+	return nil
+}
+
+// dependientesMatricula son los registros que impiden revertir la matrícula creada por un lote:
+// tabla y condición, donde @id es la matrícula. La transición inicial y la primera versión de
+// la ficha las crea la propia importación y no cuentan.
+var dependientesMatricula = [][2]string{
+	{"llamados_atencion", "matricula_id = @id"},
+	{"convocatoria", "matricula_id = @id"},
+	{"retiro_estudiantes", "matricula_id = @id"},
+	{"remisiones", "matricula_id = @id"},
+	{"transiciones_matricula", "matricula_id = @id AND estado_anterior <> ''"},
+	{"versiones_ficha", "matricula_id = @id AND version > 1"},
+	{"cambios_curso", "matricula_id = @id"},
+	{"reincorporaciones", "matricula_anterior_id = @id OR matricula_id = @id"},
+	{"mediciones_antropometricas", "matricula_id = @id"},
+	{"incidentes_enfermeria", "matricula_id = @id"},
+	{"protocolos_maternidad", "matricula_id = @id"},
+}
+
+// dependientesEstudiante son los registros que impiden eliminar un estudiante creado por el
+// lote: lo que se le registró después por fuera de la importación.
+var dependientesEstudiante = [][2]string{
+	{"matriculas", "estudiante_id = @id"},
+	{"casos_sensibles", "estudiante_id = @id"},
+	{"dosis_vacunas", "estudiante_id = @id"},
+	{"controles_medicos", "estudiante_id = @id"},
+	{"consentimientos", "estudiante_id = @id"},
+	{"certificados_emitidos", "estudiante_id = @id"},
+	{"versiones_documentos", "entidad = 'estudiante' AND entidad_id = @id"},
+	{"fusiones_estudiantes", "estudiante_conservado_id = @id"},
+}
+
+var (
+	consultaDependientesMatricula  = consultaDependientes(dependientesMatricula)
+	consultaDependientesEstudiante = consultaDependientes(dependientesEstudiante)
+)
+
+func consultaDependientes(tablas [][2]string) string {
+	conteos := make([]string, len(tablas))
+	for i, d := range tablas {
+		conteos[i] = fmt.Sprintf("(SELECT COUNT(*) FROM %s WHERE %s)", d[0], d[1])
+	}
+	return "SELECT " + strings.Join(conteos, " + ")
+}
+
+// RevertirImportacion deshace un lote aplicado: elimina sus matrículas y los estudiantes que
+// creó, y restaura los valores anteriores de los que actualizó. Si alguna matrícula o
+// estudiante ya tiene registros posteriores, no se revierte nada.
+func (s *StudentService) RevertirImportacion(loteID uint) (*studentDTO.ReversionImportacionDTO, error) {
+	var lote student.LoteImportacion
+	if err := s.db.First(&lote, loteID).Error; err != nil {
+		return nil, errors.New("Lote de importación no encontrado")
+	}
+	if lote.Estado != student.EstadoLoteAplicado {
+		return nil, errors.New("Solo se pueden revertir lotes aplicados")
+	}
+
+	response := &studentDTO.ReversionImportacionDTO{
+		LoteID:       lote.ID,
+		Advertencias: make([]string, 0),
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for _, fila := range lote.Filas.Data {
+			if fila.MatriculaID == 0 {
+				continue
+			}
+
+			var dependientes int64
+			if err := tx.Raw(consultaDependientesMatricula, map[string]interface{}{"id": fila.MatriculaID}).Scan(&dependientes).Error; err != nil {
+				return fmt.Errorf("Error al verificar la matrícula de la fila %d: %v", fila.Fila, err)
+			}
+			if dependientes > 0 {
+				return fmt.Errorf("La matrícula de la fila %d (%s) ya tiene registros asociados", fila.Fila, fila.Cedula)
+			}
+
+			if err := tx.Where("matricula_id = ?", fila.MatriculaID).Delete(&enrollment.TransicionMatricula{}).Error; err != nil {
+				return fmt.Errorf("Error al eliminar matrícula de la fila %d: %v", fila.Fila, err)
+			}
+			if err := tx.Where("matricula_id = ?", fila.MatriculaID).Delete(&enrollment.VersionFicha{}).Error; err != nil {
+				return fmt.Errorf("Error al eliminar matrícula de la fila %d: %v", fila.Fila, err)
+			}
+			result := tx.Delete(&enrollment.Matricula{}, fila.MatriculaID)
+			if result.Error != nil {
+				return fmt.Errorf("Error al eliminar matrícula de la fila %d: %v", fila.Fila, result.Error)
+			}
+			response.MatriculasEliminadas += int(result.RowsAffected)
+		}
+
+		for _, fila := range lote.Filas.Data {
+			switch fila.Accion {
+			case student.AccionImportCrear:
+				var est student.Estudiante
+				if err := tx.First(&est, fila.EstudianteID).Error; err != nil {
+					response.Advertencias = append(response.Advertencias, fmt.Sprintf("Fila %d: el estudiante %s ya no existe", fila.Fila, fila.Cedula))
+					continue
+				}
+
+				var referencias int64
+				if err := tx.Raw(consultaDependientesEstudiante, map[string]interface{}{"id": est.ID}).Scan(&referencias).Error; err != nil {
+					return fmt.Errorf("Error al verificar el estudiante %s (fila %d): %v", fila.Cedula, fila.Fila, err)
+				}
+				if referencias > 0 {
+					return fmt.Errorf("El estudiante %s (fila %d) ya tiene matrículas, casos, registros de salud, consentimientos, certificados, documentos o fusiones fuera de este lote", fila.Cedula, fila.Fila)
+				}
+
+				if err := tx.Where("estudiante_id = ?", est.ID).Delete(&student.Familiar{}).Error; err != nil {
+					return fmt.Errorf("Error al eliminar familiares de %s: %v", fila.Cedula, err)
+				}
+				if err := tx.Delete(&est).Error; err != nil {
+					return fmt.Errorf("Error al eliminar estudiante %s: %v", fila.Cedula, err)
+				}
+				response.EstudiantesEliminados++
+
+			case student.AccionImportActualizar:
+				restaurado := false
+				for _, c := range fila.Cambios {
+					if c.Campo == "info_nacionalidad" {
+						ok, err := restaurarNacionalidadImportacion(tx, fila.EstudianteID, c)
+						if err != nil {
+							return fmt.Errorf("Error al restaurar %s de %s: %v", c.Campo, fila.Cedula, err)
+						}
+						if !ok {
+							response.Advertencias = append(response.Advertencias, fmt.Sprintf("Fila %d: '%s' de %s se modificó después de la importación y no se restauró", fila.Fila, c.Campo, fila.Cedula))
+							continue
+						}
+						restaurado = true
+						continue
+					}
+
+					result := tx.Model(&student.Estudiante{}).
+						Where("id = ? AND "+c.Campo+" = ?", fila.EstudianteID, c.Nuevo).
+						UpdateColumn(c.Campo, c.Anterior)
+					if result.Error != nil {
+						return fmt.Errorf("Error al restaurar %s de %s: %v", c.Campo, fila.Cedula, result.Error)
+					}
+					if result.RowsAffected == 0 {
+						response.Advertencias = append(response.Advertencias, fmt.Sprintf("Fila %d: '%s' de %s se modificó después de la importación y no se restauró", fila.Fila, c.Campo, fila.Cedula))
+						continue
+					}
+					restaurado = true
+				}
+
+				if fila.FamiliarCreado {
+					result := tx.Delete(&student.Familiar{}, fila.FamiliarID)
+					if result.Error != nil {
+						return fmt.Errorf("Error al eliminar el representante de %s: %v", fila.Cedula, result.Error)
+					}
+					if result.RowsAffected > 0 {
+						restaurado = true
+					}
+				}
+				for _, c := range fila.CambiosFamiliar {
+					var anterior interface{} = c.Anterior
+					var nuevo interface{} = c.Nuevo
+					if c.Campo == "es_representante_legal" {
+						anterior, nuevo = c.Anterior == "true", c.Nuevo == "true"
+					}
+					result := tx.Model(&student.Familiar{}).
+						Where("id = ? AND "+c.Campo+" = ?", fila.FamiliarID, nuevo).
+						UpdateColumn(c.Campo, anterior)
+					if result.Error != nil {
+						return fmt.Errorf("Error al restaurar el representante de %s: %v", fila.Cedula, result.Error)
+					}
+					if result.RowsAffected == 0 {
+						response.Advertencias = append(response.Advertencias, fmt.Sprintf("Fila %d: '%s' del representante de %s se modificó después de la importación y no se restauró", fila.Fila, c.Campo, fila.Cedula))
+						continue
+					}
+					restaurado = true
+				}
+
+				if fila.DireccionMatriculaID > 0 {
+					result := tx.Model(&enrollment.Matricula{}).
+						Where("id = ? AND direccion_actual = ?", fila.DireccionMatriculaID, fila.Direccion).
+						UpdateColumn("direccion_actual", fila.DireccionAnterior)
+					if result.Error != nil {
+						return fmt.Errorf("Error al restaurar la dirección de %s: %v", fila.Cedula, result.Error)
+					}
+					if result.RowsAffected == 0 {
+						response.Advertencias = append(response.Advertencias, fmt.Sprintf("Fila %d: la dirección de %s se modificó después de la importación y no se restauró", fila.Fila, fila.Cedula))
+					} else {
+						restaurado = true
+					}
+				}
+
+				if restaurado {
+					response.EstudiantesRestaurados++
+				}
+			}
+		}
+
+		lote.Estado = student.EstadoLoteRevertido
+		lote.FechaReversion = time.Now().Format("2006-01-02 15:04:05")
+		return tx.Save(&lote).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// restaurarNacionalidadImportacion devuelve la nacionalidad anterior solo si el estudiante
+// conserva la que dejó la importación.
+func restaurarNacionalidadImportacion(tx *gorm.DB, estudianteID uint, cambio student.CambioCampo) (bool, error) {
+	var est student.Estudiante
+	if err := tx.Select("id", "info_nacionalidad").First(&est, estudianteID).Error; err != nil {
+		return false, nil
+	}
+
+	actual, _ := json.Marshal(est.InfoNacionalidad.Data)
+	if string(actual) != cambio.Nuevo {
+		return false, nil
+	}
+
+	var anterior student.InfoNacionalidad
+	if err := json.Unmarshal([]byte(cambio.Anterior), &anterior); err != nil {
+		return false, err
+	}
+
+	err := tx.Model(&est).UpdateColumn("info_nacionalidad", common.JSONMap[student.InfoNacionalidad]{Data: anterior}).Error
+	return err == nil, err
+}
+
+func (s *StudentService) ListarLotesImportacion() ([]studentDTO.LoteImportacionResumenDTO, error) {
+	var lotes []student.LoteImportacion
+
+	if err := s.db.Omit("filas").Order("id DESC").Find(&lotes).Error; err != nil {
+		return nil, fmt.Errorf("Error al listar importaciones: %v", err)
+	}
+
+	response := make([]studentDTO.LoteImportacionResumenDTO, len(lotes))
+	for i, l := range lotes {
+		response[i] = studentDTO.LoteImportacionResumenDTO{
+			ID:              l.ID,
+			Archivo:         l.Archivo,
+			CursoID:         l.CursoID,
+			Estado:          l.Estado,
+			TotalFilas:      l.TotalFilas,
+			Creados:         l.Creados,
+			Actualizados:    l.Actualizados,
+			Matriculados:    l.Matriculados,
+			Errores:         l.Errores,
+			FechaCreacion:   l.FechaCreacion,
+			FechaAplicacion: l.FechaAplicacion,
+			FechaReversion:  l.FechaReversion,
+		}
+	}
+
+	return response, nil
+}
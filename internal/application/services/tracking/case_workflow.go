@@ -0,0 +1,256 @@
+package services
+
+import (
+	dto "dece/internal/application/dtos/tracking"
+	consentSvc "dece/internal/application/services/consent"
+	"dece/internal/domain/student"
+	"dece/internal/domain/tracking"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (s *TrackingService) ListarCatalogosCaso() dto.CatalogosCasoDTO {
+	return dto.CatalogosCasoDTO{
+		Estados:       tracking.EstadosCaso,
+		Transiciones:  tracking.TransicionesCaso,
+		MotivosCierre: tracking.MotivosCierreCaso,
+	}
+}
+
+// CambiarEstadoCaso mueve el caso por su flujo. Solo se admiten las transiciones definidas,
+// siempre con una nota; derivar exige la entidad y cerrar exige el motivo y un resumen.
+func (s *TrackingService) CambiarEstadoCaso(input dto.CambiarEstadoCasoDTO) error {
+	var caso tracking.CasoSensible
+	if err := s.db.First(&caso, input.CasoID).Error; err != nil {
+		return errors.New("Caso no encontrado")
+	}
+
+	input.Nota = strings.TrimSpace(input.Nota)
+	if input.Nota == "" {
+		return errors.New("Escriba una nota que explique el cambio de estado")
+	}
+	actual := tracking.EstadoCaso(caso.Estado)
+	if input.Estado == actual {
+		return fmt.Errorf("El caso ya está en estado %s", actual)
+	}
+	if !tracking.TransicionCasoPermitida(actual, input.Estado) {
+		return fmt.Errorf("Un caso en estado %s no puede pasar a %s; puede pasar a: %s", actual, input.Estado, strings.Join(tracking.EstadosSiguientesCaso(actual), ", "))
+	}
+	if err := s.validarFechaCaso(&caso, input.Fecha); err != nil {
+		return err
+	}
+
+	var ultimo tracking.CambioEstadoCaso
+	s.db.Where("caso_id = ?", caso.ID).Order("fecha DESC, id DESC").Limit(1).Find(&ultimo)
+	if ultimo.ID > 0 && input.Fecha < ultimo.Fecha {
+		return fmt.Errorf("La fecha no puede ser anterior al último cambio de estado (%s)", ultimo.Fecha)
+	}
+
+	cambio := tracking.CambioEstadoCaso{
+		CasoID:         caso.ID,
+		EstadoAnterior: caso.Estado,
+		EstadoNuevo:    input.Estado,
+		Fecha:          input.Fecha,
+		Nota:           input.Nota,
+	}
+	campos := map[string]interface{}{"estado": input.Estado}
+
+	switch input.Estado {
+	case tracking.EstadoCasoDerivado:
+		if caso.EntidadDerivacion == "" {
+			return errors.New("Registre en el caso la entidad a la que se deriva antes de marcarlo como derivado")
+		}
+		// El consentimiento pudo vencer o revocarse desde que se registró la entidad
+		if consentSvc.DerivacionRequiereConsentimiento(caso.EntidadDerivacion) {
+			if err := consentSvc.Exigir(s.db, caso.EstudianteID, student.ConsentimientoDerivacion, "derivar a "+caso.EntidadDerivacion); err != nil {
+				return err
+			}
+		}
+	case tracking.EstadoCasoCerrado:
+		if !esMotivoCierreCaso(input.MotivoCierre) {
+			return errors.New("Seleccione el motivo del cierre")
+		}
+		cambio.MotivoCierre = input.MotivoCierre
+		cambio.ResumenCierre = strings.TrimSpace(input.ResumenCierre)
+		if cambio.ResumenCierre == "" {
+			return errors.New("Escriba el resumen del cierre: situación final y resultados de la intervención")
+		}
+		campos["fecha_cierre"] = cambio.Fecha
+		campos["motivo_cierre"] = cambio.MotivoCierre
+		campos["resumen_cierre"] = cambio.ResumenCierre
+	case tracking.EstadoCasoReabierto:
+		// El cierre anterior queda en el historial
+		campos["fecha_cierre"] = ""
+		campos["motivo_cierre"] = ""
+		campos["resumen_cierre"] = ""
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&caso).Updates(campos).Error; err != nil {
+			return fmt.Errorf("Error al cambiar el estado del caso: %v", err)
+		}
+		return s.registrarCambioEstado(tx, &cambio)
+	})
+}
+
+func (s *TrackingService) registrarCambioEstado(tx *gorm.DB, cambio *tracking.CambioEstadoCaso) error {
+	cambio.UsuarioID, cambio.NombreUsuario = s.usuarioSesion()
+	cambio.FechaRegistro = time.Now().Format("2006-01-02 15:04:05")
+	if err := tx.Create(cambio).Error; err != nil {
+		return fmt.Errorf("Error al registrar el cambio de estado: %v", err)
+	}
+	return nil
+}
+
+// ObtenerBitacoraCaso devuelve el historial de estados y la bitácora de intervenciones del
+// caso, ambos en orden cronológico.
+func (s *TrackingService) ObtenerBitacoraCaso(casoID uint) (*dto.BitacoraCasoDTO, error) {
+	var caso tracking.CasoSensible
+	if err := s.db.First(&caso, casoID).Error; err != nil {
+		return nil, errors.New("Caso no encontrado")
+	}
+
+	var cambios []tracking.CambioEstadoCaso
+	if err := s.db.Where("caso_id = ?", casoID).Order("fecha, id").Find(&cambios).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener el historial del caso: %v", err)
+	}
+	var intervenciones []tracking.IntervencionCaso
+	if err := s.db.Where("caso_id = ?", casoID).Order("fecha, id").Find(&intervenciones).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener las intervenciones del caso: %v", err)
+	}
+
+	bitacora := &dto.BitacoraCasoDTO{
+		CasoID:         caso.ID,
+		CodigoCaso:     caso.CodigoCaso,
+		Estado:         caso.Estado,
+		Cambios:        make([]dto.CambioEstadoCasoDTO, len(cambios)),
+		Intervenciones: make([]dto.IntervencionCasoDTO, len(intervenciones)),
+	}
+	for i, c := range cambios {
+		bitacora.Cambios[i] = dto.CambioEstadoCasoDTO{
+			ID:             c.ID,
+			EstadoAnterior: c.EstadoAnterior,
+			EstadoNuevo:    c.EstadoNuevo,
+			Fecha:          c.Fecha,
+			Nota:           c.Nota,
+			MotivoCierre:   c.MotivoCierre,
+			ResumenCierre:  c.ResumenCierre,
+			NombreUsuario:  c.NombreUsuario,
+			FechaRegistro:  c.FechaRegistro,
+		}
+	}
+	for i, iv := range intervenciones {
+		bitacora.Intervenciones[i] = mapearIntervencion(iv)
+	}
+	return bitacora, nil
+}
+
+func (s *TrackingService) RegistrarIntervencion(input dto.GuardarIntervencionDTO) (*dto.IntervencionCasoDTO, error) {
+	caso, err := s.casoNoCerrado(input.CasoID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validarFechaCaso(caso, input.Fecha); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Accion) == "" {
+		return nil, errors.New("Describa la acción realizada en la intervención")
+	}
+	if strings.TrimSpace(input.Participantes) == "" {
+		return nil, errors.New("Indique quiénes participaron en la intervención")
+	}
+
+	intervencion := tracking.IntervencionCaso{
+		CasoID:        caso.ID,
+		Fecha:         input.Fecha,
+		Participantes: strings.TrimSpace(input.Participantes),
+		Accion:        strings.TrimSpace(input.Accion),
+		Acuerdos:      strings.TrimSpace(input.Acuerdos),
+		ProximosPasos: strings.TrimSpace(input.ProximosPasos),
+		FechaRegistro: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	intervencion.UsuarioID, intervencion.NombreUsuario = s.usuarioSesion()
+	if err := s.db.Create(&intervencion).Error; err != nil {
+		return nil, fmt.Errorf("Error al registrar la intervención: %v", err)
+	}
+
+	response := mapearIntervencion(intervencion)
+	return &response, nil
+}
+
+// EliminarIntervencion quita una intervención registrada por error mientras el caso siga
+// abierto.
+func (s *TrackingService) EliminarIntervencion(id uint) error {
+	var intervencion tracking.IntervencionCaso
+	if err := s.db.First(&intervencion, id).Error; err != nil {
+		return errors.New("Intervención no encontrada")
+	}
+	if _, err := s.casoNoCerrado(intervencion.CasoID); err != nil {
+		return err
+	}
+	if err := s.db.Delete(&intervencion).Error; err != nil {
+		return fmt.Errorf("Error al eliminar la intervención: %v", err)
+	}
+	return nil
+}
+
+func (s *TrackingService) casoNoCerrado(id uint) (*tracking.CasoSensible, error) {
+	var caso tracking.CasoSensible
+	if err := s.db.First(&caso, id).Error; err != nil {
+		return nil, errors.New("Caso no encontrado")
+	}
+	if caso.Estado == tracking.EstadoCasoCerrado {
+		return nil, errors.New("El caso está cerrado; reábralo para registrar nuevas intervenciones")
+	}
+	return &caso, nil
+}
+
+// validarFechaCaso comprueba que la fecha de un cambio o una intervención no sea futura ni
+// anterior a la detección del caso.
+func (s *TrackingService) validarFechaCaso(caso *tracking.CasoSensible, fecha string) error {
+	f, err := time.Parse("2006-01-02", fecha)
+	if err != nil {
+		return errors.New("La fecha es inválida, use el formato AAAA-MM-DD")
+	}
+	if f.After(time.Now()) {
+		return errors.New("La fecha no puede ser futura")
+	}
+	if caso.FechaDeteccion != "" && fecha < caso.FechaDeteccion {
+		return fmt.Errorf("La fecha no puede ser anterior a la detección del caso (%s)", caso.FechaDeteccion)
+	}
+	return nil
+}
+
+func (s *TrackingService) usuarioSesion() (uint, string) {
+	usuario, err := s.auth.ObtenerUsuarioSesion()
+	if err != nil {
+		return 0, ""
+	}
+	return usuario.ID, usuario.NombreCompleto
+}
+
+func esMotivoCierreCaso(motivo string) bool {
+	for _, m := range tracking.MotivosCierreCaso {
+		if m == motivo {
+			return true
+		}
+	}
+	return false
+}
+
+func mapearIntervencion(iv tracking.IntervencionCaso) dto.IntervencionCasoDTO {
+	return dto.IntervencionCasoDTO{
+		ID:            iv.ID,
+		Fecha:         iv.Fecha,
+		Participantes: iv.Participantes,
+		Accion:        iv.Accion,
+		Acuerdos:      iv.Acuerdos,
+		ProximosPasos: iv.ProximosPasos,
+		NombreUsuario: iv.NombreUsuario,
+		FechaRegistro: iv.FechaRegistro,
+	}
+}
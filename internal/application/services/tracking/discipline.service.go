@@ -2,9 +2,16 @@ package services
 
 import (
 	"context"
+	queryDTO "dece/internal/application/dtos/query"
 	dto "dece/internal/application/dtos/tracking"
+	queryHelper "dece/internal/application/helpers/query"
+	consentSvc "dece/internal/application/services/consent"
+	documentSvc "dece/internal/application/services/documents"
+	securitySvc "dece/internal/application/services/security"
 	"dece/internal/domain/academic"
 	"dece/internal/domain/common"
+	"dece/internal/domain/documents"
+	"dece/internal/domain/student"
 	"dece/internal/domain/tracking"
 	"encoding/base64"
 	"errors"
@@ -12,6 +19,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,12 +28,14 @@ import (
 )
 
 type TrackingService struct {
-	db  *gorm.DB
-	ctx context.Context
+	db        *gorm.DB
+	ctx       context.Context
+	auth      *securitySvc.AuthService
+	versiones *documentSvc.Versionador
 }
 
-func NewTrackingService(db *gorm.DB) *TrackingService {
-	return &TrackingService{db: db}
+func NewTrackingService(db *gorm.DB, auth *securitySvc.AuthService, versiones *documentSvc.Versionador) *TrackingService {
+	return &TrackingService{db: db, auth: auth, versiones: versiones}
 }
 
 func (s *TrackingService) SetContext(ctx context.Context) {
@@ -151,7 +161,7 @@ func (s *TrackingService) SubirDocumentoDisciplina(llamadoID uint, tipoDoc strin
 		ext = ".pdf"
 	}
 
-	nuevoNombre := fmt.Sprintf("%s_%d_%d%s", tipoDoc, llamado.ID, time.Now().Unix(), ext)
+	nuevoNombre := fmt.Sprintf("%s_%d_%d%s", tipoDoc, llamado.ID, time.Now().UnixNano(), ext)
 	rutaDestinoCompleta := filepath.Join(destinoDir, nuevoNombre)
 
 	srcFile, err := os.Open(rutaOrigen)
@@ -187,13 +197,18 @@ func (s *TrackingService) SubirDocumentoDisciplina(llamadoID uint, tipoDoc strin
 		return "", errors.New("Tipo de documento inválido (use 'acta' o 'resolucion')")
 	}
 
-	if rutaAnterior != "" {
-		if _, err := os.Stat(rutaAnterior); err == nil {
-			os.Remove(rutaAnterior)
+	// El archivo anterior queda en el historial de versiones en lugar de borrarse
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.versiones.Adoptar(tx, documents.EntidadLlamadoAtencion, llamado.ID, tipoDoc, rutaAnterior); err != nil {
+			return err
 		}
-	}
-
-	if err := s.db.Save(&llamado).Error; err != nil {
+		if err := tx.Save(&llamado).Error; err != nil {
+			return err
+		}
+		_, err := s.versiones.Registrar(tx, documents.EntidadLlamadoAtencion, llamado.ID, tipoDoc, filepath.Base(rutaOrigen), rutaDestinoCompleta, true)
+		return err
+	})
+	if err != nil {
 		return "", fmt.Errorf("Archivo copiado pero error al guardar en BD: %v", err)
 	}
 
@@ -277,17 +292,55 @@ func (s *TrackingService) BuscarEstudiantesActivos(query string) ([]dto.Estudian
 	return resultados, nil
 }
 
+var especificacionCasos = queryHelper.Especificacion{
+	Campos: map[string]queryHelper.Campo{
+		"estudiante_id":      {Columna: "estudiante_id", Tipo: queryHelper.CampoNumero},
+		"periodo_id":         {Columna: "periodo_id", Tipo: queryHelper.CampoNumero},
+		"codigo_caso":        {Columna: "codigo_caso", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"tipo_caso":          {Columna: "tipo_caso", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"estado":             {Columna: "estado", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"entidad_derivacion": {Columna: "entidad_derivacion", Tipo: queryHelper.CampoTexto, Ordenable: true},
+		"fecha_deteccion":    {Columna: "fecha_deteccion", Tipo: queryHelper.CampoFecha, Ordenable: true},
+	},
+	Busqueda:              []string{"codigo_caso", "tipo_caso", "entidad_derivacion"},
+	OrdenPorDefecto:       "fecha_deteccion",
+	DescendentePorDefecto: true,
+	Desempate:             "id",
+}
+
+// ListarCasos devuelve todos los casos de un estudiante, que son pocos por definición.
 func (s *TrackingService) ListarCasos(estudianteID uint) ([]dto.CasoResumenDTO, error) {
 	var casos []tracking.CasoSensible
 
-	result := s.db.Where("estudiante_id = ?", estudianteID).
-		Order("fecha_deteccion DESC").
-		Find(&casos)
+	consulta := queryDTO.ConsultaDTO{
+		Filtros: []queryDTO.FiltroDTO{{Campo: "estudiante_id", Operador: queryDTO.OperadorIgual, Valor: strconv.FormatUint(uint64(estudianteID), 10)}},
+	}
+	query, err := queryHelper.Filtrar(s.db.Model(&tracking.CasoSensible{}), especificacionCasos, consulta)
+	if err != nil {
+		return nil, err
+	}
+	if query, err = queryHelper.Ordenar(query, especificacionCasos, consulta); err != nil {
+		return nil, err
+	}
+	if err := query.Find(&casos).Error; err != nil {
+		return nil, err
+	}
 
-	if result.Error != nil {
-		return nil, result.Error
+	return mapearCasos(casos), nil
+}
+
+func (s *TrackingService) ConsultarCasos(consulta queryDTO.ConsultaDTO) (*dto.CasosPaginadosDTO, error) {
+	var casos []tracking.CasoSensible
+
+	paginacion, err := queryHelper.Paginar(s.db.Model(&tracking.CasoSensible{}), especificacionCasos, consulta, &casos)
+	if err != nil {
+		return nil, err
 	}
 
+	return &dto.CasosPaginadosDTO{Items: mapearCasos(casos), Paginacion: paginacion}, nil
+}
+
+func mapearCasos(casos []tracking.CasoSensible) []dto.CasoResumenDTO {
 	response := make([]dto.CasoResumenDTO, len(casos))
 	for i, c := range casos {
 		evidencias := c.RutasDocumentos.Data
@@ -312,12 +365,16 @@ func (s *TrackingService) ListarCasos(estudianteID uint) ([]dto.CasoResumenDTO,
 			EntidadDerivacionDetalle: c.EntidadDerivacionDetalle,
 			Descripcion:              c.Descripcion,
 			Estado:                   c.Estado,
+			EstadosSiguientes:        tracking.EstadosSiguientesCaso(c.Estado),
+			FechaCierre:              c.FechaCierre,
+			MotivoCierre:             c.MotivoCierre,
+			ResumenCierre:            c.ResumenCierre,
 			TotalEvidencias:          len(evidencias),
 			RutasEvidencias:          evidenciasDTO,
 		}
 	}
 
-	return response, nil
+	return response
 }
 
 func (s *TrackingService) ObtenerCaso(id uint) (*dto.GuardarCasoDTO, error) {
@@ -340,37 +397,16 @@ func (s *TrackingService) ObtenerCaso(id uint) (*dto.GuardarCasoDTO, error) {
 
 func (s *TrackingService) CrearCaso(input dto.GuardarCasoDTO) (*tracking.CasoSensible, error) {
 	if input.ID == 0 {
-		var periodoActivo academic.PeriodoLectivo
-		if err := s.db.Where("es_activo = ?", true).First(&periodoActivo).Error; err != nil {
-			return nil, errors.New("No hay un periodo lectivo activo para registrar el caso")
-		}
-
-		year := time.Now().Year()
-		var count int64
-		likeStr := fmt.Sprintf("CASO-%d-%%", year)
-		s.db.Model(&tracking.CasoSensible{}).Where("codigo_caso LIKE ?", likeStr).Count(&count)
-
-		input.Estado = "Abierto"
-
-		codigoGenerado := fmt.Sprintf("CASO-%d-%03d", year, count+1)
-
-		caso := tracking.CasoSensible{
-			EstudianteID:             input.EstudianteID,
-			PeriodoID:                periodoActivo.ID,
-			CodigoCaso:               codigoGenerado,
-			TipoCaso:                 input.TipoCaso,
-			FechaDeteccion:           input.FechaDeteccion,
-			EntidadDerivacion:        input.EntidadDerivacion,
-			EntidadDerivacionDetalle: input.EntidadDerivacionDetalle,
-			Descripcion:              input.Descripcion,
-			Estado:                   input.Estado,
-			RutasDocumentos:          common.JSONMap[[]tracking.Evidencia]{Data: []tracking.Evidencia{}},
-		}
-
-		if err := s.db.Create(&caso).Error; err != nil {
+		var caso *tracking.CasoSensible
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			caso, err = s.abrirCaso(tx, input)
+			return err
+		})
+		if err != nil {
 			return nil, err
 		}
-		return &caso, nil
+		return caso, nil
 
 	} else {
 		var caso tracking.CasoSensible
@@ -378,12 +414,27 @@ func (s *TrackingService) CrearCaso(input dto.GuardarCasoDTO) (*tracking.CasoSen
 			return nil, errors.New("Caso no encontrado")
 		}
 
+		// Derivar a una entidad externa necesita la autorización del representante, salvo que la
+		// derivación sea obligatoria por ley
+		if caso.EntidadDerivacion != input.EntidadDerivacion && consentSvc.DerivacionRequiereConsentimiento(input.EntidadDerivacion) {
+			if err := consentSvc.Exigir(s.db, caso.EstudianteID, student.ConsentimientoDerivacion, "derivar a "+input.EntidadDerivacion); err != nil {
+				return nil, err
+			}
+		}
+
+		// El estado solo cambia por CambiarEstadoCaso, que deja la nota en el historial
+		if caso.Estado == tracking.EstadoCasoCerrado {
+			return nil, errors.New("El caso está cerrado; reábralo para modificar sus datos")
+		}
+		if caso.Estado == tracking.EstadoCasoDerivado && input.EntidadDerivacion == "" {
+			return nil, errors.New("El caso está derivado; indique la entidad de derivación")
+		}
+
 		caso.TipoCaso = input.TipoCaso
 		caso.FechaDeteccion = input.FechaDeteccion
 		caso.EntidadDerivacion = input.EntidadDerivacion
 		caso.EntidadDerivacionDetalle = input.EntidadDerivacionDetalle
 		caso.Descripcion = input.Descripcion
-		caso.Estado = input.Estado
 
 		if err := s.db.Save(&caso).Error; err != nil {
 			return nil, err
@@ -392,6 +443,55 @@ func (s *TrackingService) CrearCaso(input dto.GuardarCasoDTO) (*tracking.CasoSen
 	}
 }
 
+// abrirCaso registra un caso nuevo y su apertura en el historial dentro de tx, para que quien
+// lo abre como parte de otra operación (una remisión convertida) confirme ambas a la vez.
+func (s *TrackingService) abrirCaso(tx *gorm.DB, input dto.GuardarCasoDTO) (*tracking.CasoSensible, error) {
+	// Derivar a una entidad externa necesita la autorización del representante, salvo que la
+	// derivación sea obligatoria por ley
+	if consentSvc.DerivacionRequiereConsentimiento(input.EntidadDerivacion) {
+		if err := consentSvc.Exigir(tx, input.EstudianteID, student.ConsentimientoDerivacion, "derivar a "+input.EntidadDerivacion); err != nil {
+			return nil, err
+		}
+	}
+
+	var periodoActivo academic.PeriodoLectivo
+	if err := tx.Where("es_activo = ?", true).First(&periodoActivo).Error; err != nil {
+		return nil, errors.New("No hay un periodo lectivo activo para registrar el caso")
+	}
+
+	year := time.Now().Year()
+	var count int64
+	likeStr := fmt.Sprintf("CASO-%d-%%", year)
+	tx.Model(&tracking.CasoSensible{}).Where("codigo_caso LIKE ?", likeStr).Count(&count)
+
+	caso := tracking.CasoSensible{
+		EstudianteID:             input.EstudianteID,
+		PeriodoID:                periodoActivo.ID,
+		CodigoCaso:               fmt.Sprintf("CASO-%d-%03d", year, count+1),
+		TipoCaso:                 input.TipoCaso,
+		FechaDeteccion:           input.FechaDeteccion,
+		EntidadDerivacion:        input.EntidadDerivacion,
+		EntidadDerivacionDetalle: input.EntidadDerivacionDetalle,
+		Descripcion:              input.Descripcion,
+		Estado:                   tracking.EstadoCasoAbierto,
+		RutasDocumentos:          common.JSONMap[[]tracking.Evidencia]{Data: []tracking.Evidencia{}},
+	}
+
+	if err := tx.Create(&caso).Error; err != nil {
+		return nil, err
+	}
+	apertura := tracking.CambioEstadoCaso{
+		CasoID:      caso.ID,
+		EstadoNuevo: tracking.EstadoCasoAbierto,
+		Fecha:       caso.FechaDeteccion,
+		Nota:        "Apertura del caso",
+	}
+	if err := s.registrarCambioEstado(tx, &apertura); err != nil {
+		return nil, err
+	}
+	return &caso, nil
+}
+
 func (s *TrackingService) SubirEvidenciaCaso(casoID uint, rutaOrigen string, nombre string) (string, error) {
 	var caso tracking.CasoSensible
 
@@ -462,7 +562,14 @@ func (s *TrackingService) SubirEvidenciaCaso(casoID uint, rutaOrigen string, nom
 
 	caso.RutasDocumentos = common.JSONMap[[]tracking.Evidencia]{Data: listaActual}
 
-	if err := s.db.Save(&caso).Error; err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&caso).Error; err != nil {
+			return err
+		}
+		_, err := s.versiones.Registrar(tx, documents.EntidadCasoSensible, caso.ID, "evidencia", nombre, rutaDestinoCompleta, false)
+		return err
+	})
+	if err != nil {
 		return "", fmt.Errorf("Evidencia guardada pero error al actualizar BD: %v", err)
 	}
 
@@ -476,14 +583,6 @@ func (s *TrackingService) EliminarEvidenciaCaso(casoID uint, ruta string) error
 		return errors.New("Caso sensible no encontrado")
 	}
 
-	if ruta != "" {
-		if _, err := os.Stat(ruta); err == nil {
-			if err := os.Remove(ruta); err != nil {
-				return fmt.Errorf("No se pudo eliminar el archivo: %v", err)
-			}
-		}
-	}
-
 	listaActual := caso.RutasDocumentos.Data
 	if listaActual == nil {
 		listaActual = []tracking.Evidencia{}
@@ -498,7 +597,17 @@ func (s *TrackingService) EliminarEvidenciaCaso(casoID uint, ruta string) error
 
 	caso.RutasDocumentos = common.JSONMap[[]tracking.Evidencia]{Data: nuevaLista}
 
-	if err := s.db.Save(&caso).Error; err != nil {
+	// La evidencia sale del caso pero su archivo se conserva en el historial de versiones
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.versiones.Adoptar(tx, documents.EntidadCasoSensible, caso.ID, "evidencia", ruta); err != nil {
+			return err
+		}
+		if err := s.versiones.Retirar(tx, documents.EntidadCasoSensible, caso.ID, ruta); err != nil {
+			return err
+		}
+		return tx.Save(&caso).Error
+	})
+	if err != nil {
 		return fmt.Errorf("Error al actualizar BD: %v", err)
 	}
 
@@ -0,0 +1,522 @@
+package services
+
+import (
+	dto "dece/internal/application/dtos/tracking"
+	securitySvc "dece/internal/application/services/security"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/tracking"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ProtocolService lleva el seguimiento de los protocolos de embarazo, maternidad y paternidad.
+// La ficha DECE los abre al marcar la condición; aquí se registran los contactos, se cumplen
+// los hitos y se cierran.
+type ProtocolService struct {
+	db   *gorm.DB
+	auth *securitySvc.AuthService
+}
+
+func NewProtocolService(db *gorm.DB, auth *securitySvc.AuthService) *ProtocolService {
+	return &ProtocolService{db: db, auth: auth}
+}
+
+type filaProtocolo struct {
+	tracking.ProtocoloMaternidad
+	EstudianteID   uint
+	Cedula         string
+	Estudiante     string
+	Curso          string
+	PeriodoLectivo string
+}
+
+var consultaProtocolos = `
+	SELECT p.*, e.id as estudiante_id, e.cedula, e.apellidos || ' ' || e.nombres as estudiante,
+		ne.nombre || ' ' || c.paralelo as curso, pl.nombre as periodo_lectivo
+	FROM protocolos_maternidad p
+	JOIN matriculas m ON p.matricula_id = m.id
+	JOIN estudiantes e ON m.estudiante_id = e.id
+	JOIN cursos c ON m.curso_id = c.id
+	JOIN nivel_educativos ne ON c.nivel_id = ne.id
+	JOIN periodo_lectivos pl ON c.periodo_id = pl.id`
+
+func (s *ProtocolService) ListarCatalogosProtocolo() dto.CatalogosProtocoloDTO {
+	return dto.CatalogosProtocoloDTO{
+		Tipos:       tracking.TiposProtocolo,
+		TiposHito:   tracking.TiposHito,
+		Modalidades: tracking.ModalidadesContacto,
+	}
+}
+
+// ListarProtocolos devuelve los protocolos con el avance de su plan. estado vacío lista todos;
+// los abiertos con hitos vencidos van primero.
+func (s *ProtocolService) ListarProtocolos(estado string) ([]dto.ProtocoloResumenDTO, error) {
+	if err := s.exigirCoordinacion(); err != nil {
+		return nil, err
+	}
+	if estado == "" {
+		return s.listar("1 = 1")
+	}
+	return s.listar("p.estado = ?", estado)
+}
+
+func (s *ProtocolService) ListarProtocolosEstudiante(estudianteID uint) ([]dto.ProtocoloResumenDTO, error) {
+	if err := s.exigirCoordinacion(); err != nil {
+		return nil, err
+	}
+	return s.listar("m.estudiante_id = ?", estudianteID)
+}
+
+func (s *ProtocolService) ObtenerProtocolo(id uint) (*dto.ProtocoloDetalleDTO, error) {
+	if err := s.exigirCoordinacion(); err != nil {
+		return nil, err
+	}
+	var fila filaProtocolo
+	if err := s.db.Raw(consultaProtocolos+" WHERE p.id = ?", id).Scan(&fila).Error; err != nil {
+		return nil, fmt.Errorf("Error al obtener el protocolo: %v", err)
+	}
+	if fila.ID == 0 {
+		return nil, errors.New("Protocolo no encontrado")
+	}
+
+	hitos, contactos, err := s.cargarPlan([]uint{id})
+	if err != nil {
+		return nil, err
+	}
+
+	hoy := time.Now().Format("2006-01-02")
+	detalle := &dto.ProtocoloDetalleDTO{
+		ProtocoloResumenDTO: resumenProtocolo(fila, hitos[id], contactos[id], hoy),
+		MotivoCierre:        fila.MotivoCierre,
+		NombreUsuario:       fila.NombreUsuario,
+		Hitos:               []dto.HitoProtocoloDTO{},
+		Contactos:           []dto.ContactoProtocoloDTO{},
+	}
+
+	nombreHito := map[uint]string{}
+	for _, h := range hitos[id] {
+		nombreHito[h.ID] = fmt.Sprintf("%s (%s)", h.Tipo, h.FechaProgramada)
+		detalle.Hitos = append(detalle.Hitos, dto.HitoProtocoloDTO{
+			ID:              h.ID,
+			Tipo:            h.Tipo,
+			Descripcion:     h.Descripcion,
+			FechaProgramada: h.FechaProgramada,
+			FechaCumplida:   h.FechaCumplida,
+			Observacion:     h.Observacion,
+			Estado:          h.Estado(hoy),
+		})
+	}
+	for i := len(contactos[id]) - 1; i >= 0; i-- {
+		c := contactos[id][i]
+		contacto := dto.ContactoProtocoloDTO{
+			ID:            c.ID,
+			Fecha:         c.Fecha,
+			Modalidad:     c.Modalidad,
+			Participantes: c.Participantes,
+			Resumen:       c.Resumen,
+			Acuerdos:      c.Acuerdos,
+			NombreUsuario: c.NombreUsuario,
+			FechaRegistro: c.FechaRegistro,
+		}
+		if c.HitoID != nil {
+			contacto.HitoID = *c.HitoID
+			contacto.Hito = nombreHito[*c.HitoID]
+		}
+		detalle.Contactos = append(detalle.Contactos, contacto)
+	}
+	return detalle, nil
+}
+
+// AbrirProtocolo abre a mano un protocolo que la ficha no marcó. El plan usa los datos que la
+// ficha tenga de la condición.
+func (s *ProtocolService) AbrirProtocolo(matriculaID uint, tipo string) (*dto.ProtocoloDetalleDTO, error) {
+	if err := s.exigirCoordinacion(); err != nil {
+		return nil, err
+	}
+	valido := false
+	for _, t := range tracking.TiposProtocolo {
+		if t == tipo {
+			valido = true
+		}
+	}
+	if !valido {
+		return nil, errors.New("Seleccione un tipo de protocolo válido")
+	}
+
+	var mat enrollment.Matricula
+	if err := s.db.First(&mat, matriculaID).Error; err != nil {
+		return nil, errors.New("Matrícula no encontrada")
+	}
+
+	var abiertos int64
+	s.db.Model(&tracking.ProtocoloMaternidad{}).
+		Joins("JOIN matriculas m ON m.id = protocolos_maternidad.matricula_id").
+		Where("m.estudiante_id = ? AND protocolos_maternidad.tipo = ? AND protocolos_maternidad.estado = ?", mat.EstudianteID, tipo, tracking.EstadoProtocoloAbierto).
+		Count(&abiertos)
+	if abiertos > 0 {
+		return nil, fmt.Errorf("El estudiante ya tiene abierto un protocolo de %s", strings.ToLower(tipo))
+	}
+
+	ahora := time.Now()
+	protocolo := tracking.ProtocoloMaternidad{
+		MatriculaID:   mat.ID,
+		Tipo:          tipo,
+		Estado:        tracking.EstadoProtocoloAbierto,
+		Origen:        "Registro manual",
+		FechaApertura: ahora.Format("2006-01-02"),
+		Hitos:         tracking.PlanProtocolo(tipo, mat.CondicionGenero.Data, ahora),
+	}
+	protocolo.UsuarioID, protocolo.NombreUsuario = s.usuarioSesion()
+	if err := s.db.Create(&protocolo).Error; err != nil {
+		return nil, fmt.Errorf("Error al abrir el protocolo: %v", err)
+	}
+	return s.ObtenerProtocolo(protocolo.ID)
+}
+
+func (s *ProtocolService) CerrarProtocolo(id uint, motivo string) error {
+	if err := s.exigirCoordinacion(); err != nil {
+		return err
+	}
+	motivo = strings.TrimSpace(motivo)
+	if motivo == "" {
+		return errors.New("Indique el motivo del cierre del protocolo")
+	}
+	protocolo, err := s.protocoloAbierto(id)
+	if err != nil {
+		return err
+	}
+	return s.db.Model(protocolo).Updates(map[string]interface{}{
+		"estado":        tracking.EstadoProtocoloCerrado,
+		"fecha_cierre":  time.Now().Format("2006-01-02"),
+		"motivo_cierre": motivo,
+	}).Error
+}
+
+func (s *ProtocolService) GuardarHito(input dto.GuardarHitoDTO) error {
+	if err := s.exigirCoordinacion(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(input.Tipo) == "" {
+		return errors.New("Indique el tipo de hito")
+	}
+	if _, err := time.Parse("2006-01-02", input.FechaProgramada); err != nil {
+		return errors.New("La fecha programada es inválida, use el formato AAAA-MM-DD")
+	}
+	if _, err := s.protocoloAbierto(input.ProtocoloID); err != nil {
+		return err
+	}
+
+	hito := tracking.HitoProtocolo{ProtocoloID: input.ProtocoloID}
+	if input.ID > 0 {
+		if err := s.db.Where("id = ? AND protocolo_id = ?", input.ID, input.ProtocoloID).First(&hito).Error; err != nil {
+			return errors.New("Hito no encontrado")
+		}
+		if hito.FechaCumplida != "" {
+			return errors.New("El hito ya se cumplió y no puede reprogramarse")
+		}
+	}
+	hito.Tipo = strings.TrimSpace(input.Tipo)
+	hito.Descripcion = strings.TrimSpace(input.Descripcion)
+	hito.FechaProgramada = input.FechaProgramada
+	if err := s.db.Save(&hito).Error; err != nil {
+		return fmt.Errorf("Error al guardar el hito: %v", err)
+	}
+	return nil
+}
+
+// CumplirHito da por cumplido el hito sin un contacto, por ejemplo al revisar el carné de
+// control prenatal.
+func (s *ProtocolService) CumplirHito(id uint, fecha string, observacion string) error {
+	if err := s.exigirCoordinacion(); err != nil {
+		return err
+	}
+	hito, err := s.hitoPendiente(id)
+	if err != nil {
+		return err
+	}
+	if err := validarFechaContacto(fecha); err != nil {
+		return err
+	}
+	return s.db.Model(hito).Updates(map[string]interface{}{
+		"fecha_cumplida": fecha,
+		"observacion":    strings.TrimSpace(observacion),
+	}).Error
+}
+
+func (s *ProtocolService) EliminarHito(id uint) error {
+	if err := s.exigirCoordinacion(); err != nil {
+		return err
+	}
+	hito, err := s.hitoPendiente(id)
+	if err != nil {
+		return err
+	}
+	var contactos int64
+	s.db.Model(&tracking.ContactoProtocolo{}).Where("hito_id = ?", id).Count(&contactos)
+	if contactos > 0 {
+		return errors.New("El hito tiene contactos registrados")
+	}
+	return s.db.Delete(hito).Error
+}
+
+func (s *ProtocolService) RegistrarContacto(input dto.GuardarContactoDTO) (*dto.ContactoProtocoloDTO, error) {
+	if err := s.exigirCoordinacion(); err != nil {
+		return nil, err
+	}
+	if err := validarFechaContacto(input.Fecha); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Modalidad) == "" {
+		return nil, errors.New("Indique la modalidad del contacto")
+	}
+	if strings.TrimSpace(input.Resumen) == "" {
+		return nil, errors.New("El resumen del contacto es obligatorio")
+	}
+	if _, err := s.protocoloAbierto(input.ProtocoloID); err != nil {
+		return nil, err
+	}
+
+	contacto := tracking.ContactoProtocolo{
+		ProtocoloID:   input.ProtocoloID,
+		Fecha:         input.Fecha,
+		Modalidad:     strings.TrimSpace(input.Modalidad),
+		Participantes: strings.TrimSpace(input.Participantes),
+		Resumen:       strings.TrimSpace(input.Resumen),
+		Acuerdos:      strings.TrimSpace(input.Acuerdos),
+		FechaRegistro: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	contacto.UsuarioID, contacto.NombreUsuario = s.usuarioSesion()
+
+	var hito tracking.HitoProtocolo
+	if input.HitoID > 0 {
+		if err := s.db.Where("id = ? AND protocolo_id = ?", input.HitoID, input.ProtocoloID).First(&hito).Error; err != nil {
+			return nil, errors.New("El hito no pertenece a este protocolo")
+		}
+		contacto.HitoID = &hito.ID
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&contacto).Error; err != nil {
+			return fmt.Errorf("Error al registrar el contacto: %v", err)
+		}
+		if input.CumpleHito && hito.ID > 0 && hito.FechaCumplida == "" {
+			return tx.Model(&hito).Updates(map[string]interface{}{
+				"fecha_cumplida": contacto.Fecha,
+				"observacion":    fmt.Sprintf("%s: %s", contacto.Modalidad, contacto.Resumen),
+			}).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ContactoProtocoloDTO{
+		ID:            contacto.ID,
+		Fecha:         contacto.Fecha,
+		Modalidad:     contacto.Modalidad,
+		Participantes: contacto.Participantes,
+		Resumen:       contacto.Resumen,
+		Acuerdos:      contacto.Acuerdos,
+		NombreUsuario: contacto.NombreUsuario,
+		FechaRegistro: contacto.FechaRegistro,
+	}
+	if hito.ID > 0 {
+		response.HitoID = hito.ID
+		response.Hito = fmt.Sprintf("%s (%s)", hito.Tipo, hito.FechaProgramada)
+	}
+	return response, nil
+}
+
+// EliminarContacto quita un contacto registrado por error. Si había cumplido un hito en su
+// fecha, el hito vuelve a quedar pendiente.
+func (s *ProtocolService) EliminarContacto(id uint) error {
+	if err := s.exigirCoordinacion(); err != nil {
+		return err
+	}
+	var contacto tracking.ContactoProtocolo
+	if err := s.db.First(&contacto, id).Error; err != nil {
+		return errors.New("Contacto no encontrado")
+	}
+	if _, err := s.protocoloAbierto(contacto.ProtocoloID); err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if contacto.HitoID != nil {
+			err := tx.Model(&tracking.HitoProtocolo{}).
+				Where("id = ? AND fecha_cumplida = ?", *contacto.HitoID, contacto.Fecha).
+				Updates(map[string]interface{}{"fecha_cumplida": "", "observacion": ""}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if err := tx.Delete(&contacto).Error; err != nil {
+			return fmt.Errorf("Error al eliminar el contacto: %v", err)
+		}
+		return nil
+	})
+}
+
+func (s *ProtocolService) listar(condicion string, valores ...any) ([]dto.ProtocoloResumenDTO, error) {
+	var filas []filaProtocolo
+	err := s.db.Raw(consultaProtocolos+" WHERE "+condicion+" ORDER BY p.fecha_apertura DESC, p.id DESC", valores...).Scan(&filas).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error al obtener los protocolos: %v", err)
+	}
+
+	ids := make([]uint, len(filas))
+	for i, f := range filas {
+		ids[i] = f.ID
+	}
+	hitos, contactos, err := s.cargarPlan(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	hoy := time.Now().Format("2006-01-02")
+	response := make([]dto.ProtocoloResumenDTO, len(filas))
+	for i, f := range filas {
+		response[i] = resumenProtocolo(f, hitos[f.ID], contactos[f.ID], hoy)
+	}
+
+	// Los abiertos con hitos vencidos primero; el orden por apertura se conserva dentro de cada grupo
+	prioridad := func(r dto.ProtocoloResumenDTO) int {
+		switch {
+		case r.Estado == tracking.EstadoProtocoloAbierto && r.HitosVencidos > 0:
+			return 0
+		case r.Estado == tracking.EstadoProtocoloAbierto:
+			return 1
+		}
+		return 2
+	}
+	ordenados := make([]dto.ProtocoloResumenDTO, 0, len(response))
+	for p := 0; p <= 2; p++ {
+		for _, r := range response {
+			if prioridad(r) == p {
+				ordenados = append(ordenados, r)
+			}
+		}
+	}
+	return ordenados, nil
+}
+
+// cargarPlan trae los hitos por fecha programada y los contactos por fecha de los protocolos.
+func (s *ProtocolService) cargarPlan(ids []uint) (map[uint][]tracking.HitoProtocolo, map[uint][]tracking.ContactoProtocolo, error) {
+	hitos := map[uint][]tracking.HitoProtocolo{}
+	contactos := map[uint][]tracking.ContactoProtocolo{}
+	if len(ids) == 0 {
+		return hitos, contactos, nil
+	}
+
+	var listaHitos []tracking.HitoProtocolo
+	if err := s.db.Where("protocolo_id IN ?", ids).Order("fecha_programada, id").Find(&listaHitos).Error; err != nil {
+		return nil, nil, fmt.Errorf("Error al obtener los hitos: %v", err)
+	}
+	for _, h := range listaHitos {
+		hitos[h.ProtocoloID] = append(hitos[h.ProtocoloID], h)
+	}
+
+	var listaContactos []tracking.ContactoProtocolo
+	if err := s.db.Where("protocolo_id IN ?", ids).Order("fecha, id").Find(&listaContactos).Error; err != nil {
+		return nil, nil, fmt.Errorf("Error al obtener los contactos: %v", err)
+	}
+	for _, c := range listaContactos {
+		contactos[c.ProtocoloID] = append(contactos[c.ProtocoloID], c)
+	}
+	return hitos, contactos, nil
+}
+
+func resumenProtocolo(f filaProtocolo, hitos []tracking.HitoProtocolo, contactos []tracking.ContactoProtocolo, hoy string) dto.ProtocoloResumenDTO {
+	r := dto.ProtocoloResumenDTO{
+		ID:             f.ID,
+		MatriculaID:    f.MatriculaID,
+		EstudianteID:   f.EstudianteID,
+		Cedula:         f.Cedula,
+		Estudiante:     f.Estudiante,
+		Curso:          f.Curso,
+		PeriodoLectivo: f.PeriodoLectivo,
+		Tipo:           f.Tipo,
+		Estado:         f.Estado,
+		Origen:         f.Origen,
+		FechaApertura:  f.FechaApertura,
+		FechaCierre:    f.FechaCierre,
+		HitosTotal:     len(hitos),
+		TotalContactos: len(contactos),
+	}
+	for _, h := range hitos {
+		switch h.Estado(hoy) {
+		case tracking.EstadoHitoCumplido:
+			r.HitosCumplidos++
+			continue
+		case tracking.EstadoHitoVencido:
+			r.HitosVencidos++
+		}
+		if r.ProximoHito == "" {
+			r.ProximoHito = h.Tipo
+			r.FechaProximoHito = h.FechaProgramada
+		}
+	}
+	if len(contactos) > 0 {
+		r.UltimoContacto = contactos[len(contactos)-1].Fecha
+	}
+	return r
+}
+
+func (s *ProtocolService) protocoloAbierto(id uint) (*tracking.ProtocoloMaternidad, error) {
+	var protocolo tracking.ProtocoloMaternidad
+	if err := s.db.First(&protocolo, id).Error; err != nil {
+		return nil, errors.New("Protocolo no encontrado")
+	}
+	if protocolo.Estado != tracking.EstadoProtocoloAbierto {
+		return nil, errors.New("El protocolo está cerrado")
+	}
+	return &protocolo, nil
+}
+
+func (s *ProtocolService) hitoPendiente(id uint) (*tracking.HitoProtocolo, error) {
+	var hito tracking.HitoProtocolo
+	if err := s.db.First(&hito, id).Error; err != nil {
+		return nil, errors.New("Hito no encontrado")
+	}
+	if _, err := s.protocoloAbierto(hito.ProtocoloID); err != nil {
+		return nil, err
+	}
+	if hito.FechaCumplida != "" {
+		return nil, errors.New("El hito ya está cumplido")
+	}
+	return &hito, nil
+}
+
+// exigirCoordinacion restringe los protocolos a la coordinación del DECE, igual que su informe:
+// contienen datos de embarazo y paternidad de menores.
+func (s *ProtocolService) exigirCoordinacion() error {
+	usuario, err := s.auth.ObtenerUsuarioSesion()
+	if err != nil || usuario.Rol != "admin" {
+		return errors.New("Solo la coordinación del DECE puede ver y gestionar los protocolos de maternidad y paternidad")
+	}
+	return nil
+}
+
+func (s *ProtocolService) usuarioSesion() (uint, string) {
+	usuario, err := s.auth.ObtenerUsuarioSesion()
+	if err != nil {
+		return 0, ""
+	}
+	return usuario.ID, usuario.NombreCompleto
+}
+
+func validarFechaContacto(fecha string) error {
+	f, err := time.Parse("2006-01-02", fecha)
+	if err != nil {
+		return errors.New("La fecha es inválida, use el formato AAAA-MM-DD")
+	}
+	if f.After(time.Now()) {
+		return errors.New("La fecha no puede ser futura")
+	}
+	return nil
+}
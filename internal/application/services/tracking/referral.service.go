@@ -0,0 +1,423 @@
+package services
+
+import (
+	managementDto "dece/internal/application/dtos/management"
+	dto "dece/internal/application/dtos/tracking"
+	managementSvc "dece/internal/application/services/management"
+	"dece/internal/application/services/sync"
+	"dece/internal/domain/common"
+	"dece/internal/domain/documents"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/faculty"
+	"dece/internal/domain/management"
+	"dece/internal/domain/tracking"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ReferralService struct {
+	db       *gorm.DB
+	tracking *TrackingService
+	sync     *sync.TelegramSyncService
+}
+
+func NewReferralService(db *gorm.DB, trackingService *TrackingService, sync *sync.TelegramSyncService) *ReferralService {
+	return &ReferralService{
+		db:       db,
+		tracking: trackingService,
+		sync:     sync,
+	}
+}
+
+func (s *ReferralService) RegistrarRemision(input dto.GuardarRemisionDTO) (*tracking.Remision, error) {
+	if input.DocenteID == 0 || input.MatriculaID == 0 {
+		return nil, errors.New("Debe indicar el docente que remite y el estudiante")
+	}
+	if strings.TrimSpace(input.Descripcion) == "" {
+		return nil, errors.New("La descripción de la remisión es obligatoria")
+	}
+
+	var docente faculty.Docente
+	if err := s.db.First(&docente, input.DocenteID).Error; err != nil {
+		return nil, errors.New("Docente no encontrado")
+	}
+	var matricula enrollment.Matricula
+	if err := s.db.First(&matricula, input.MatriculaID).Error; err != nil {
+		return nil, errors.New("Matrícula no encontrada")
+	}
+
+	ahora := time.Now().Format("2006-01-02 15:04:05")
+
+	if input.ID > 0 {
+		var remision tracking.Remision
+		if err := s.db.First(&remision, input.ID).Error; err != nil {
+			return nil, errors.New("Remisión no encontrada")
+		}
+		if remision.Estado != tracking.EstadoRemisionRecibida {
+			return nil, errors.New("Solo se pueden editar remisiones que aún no han sido evaluadas")
+		}
+
+		remision.DocenteID = input.DocenteID
+		remision.MatriculaID = input.MatriculaID
+		remision.FechaRemision = input.FechaRemision
+		remision.Categoria = input.Categoria
+		remision.Descripcion = input.Descripcion
+		remision.Urgencia = input.Urgencia
+		remision.FechaActualizacion = ahora
+
+		if err := s.db.Save(&remision).Error; err != nil {
+			return nil, fmt.Errorf("Error al actualizar remisión: %v", err)
+		}
+		return &remision, nil
+	}
+
+	year := time.Now().Year()
+	var count int64
+	s.db.Model(&tracking.Remision{}).Where("codigo_remision LIKE ?", fmt.Sprintf("REM-%d-%%", year)).Count(&count)
+
+	remision := tracking.Remision{
+		DocenteID:          input.DocenteID,
+		MatriculaID:        input.MatriculaID,
+		CodigoRemision:     fmt.Sprintf("REM-%d-%03d", year, count+1),
+		FechaRemision:      input.FechaRemision,
+		Categoria:          input.Categoria,
+		Descripcion:        input.Descripcion,
+		Urgencia:           input.Urgencia,
+		Estado:             tracking.EstadoRemisionRecibida,
+		FechaActualizacion: ahora,
+		Adjuntos:           common.JSONMap[[]tracking.Evidencia]{Data: []tracking.Evidencia{}},
+	}
+
+	if err := s.db.Create(&remision).Error; err != nil {
+		return nil, fmt.Errorf("Error al registrar remisión: %v", err)
+	}
+
+	return &remision, nil
+}
+
+func (s *ReferralService) ListarRemisiones(filtro dto.FiltroRemisionesDTO) ([]dto.RemisionResumenDTO, error) {
+	var remisiones []tracking.Remision
+
+	query := s.db.Model(&tracking.Remision{}).
+		Preload("Docente").
+		Preload("Matricula.Estudiante").
+		Preload("Matricula.Curso.Nivel").
+		Order("fecha_remision DESC, id DESC")
+
+	if filtro.Estado != "" {
+		query = query.Where("estado = ?", filtro.Estado)
+	}
+	if filtro.DocenteID > 0 {
+		query = query.Where("docente_id = ?", filtro.DocenteID)
+	}
+	if filtro.CursoID > 0 {
+		query = query.Where("matricula_id IN (?)", s.db.Table("matriculas").Select("id").Where("curso_id = ?", filtro.CursoID))
+	}
+
+	if err := query.Find(&remisiones).Error; err != nil {
+		return nil, fmt.Errorf("Error al listar remisiones: %v", err)
+	}
+
+	response := make([]dto.RemisionResumenDTO, len(remisiones))
+	for i, r := range remisiones {
+		response[i] = dto.RemisionResumenDTO{
+			ID:             r.ID,
+			CodigoRemision: r.CodigoRemision,
+			FechaRemision:  r.FechaRemision,
+			Categoria:      r.Categoria,
+			Urgencia:       r.Urgencia,
+			Estado:         r.Estado,
+			Docente:        r.Docente.NombresCompletos,
+			Estudiante:     r.Matricula.Estudiante.Apellidos + " " + r.Matricula.Estudiante.Nombres,
+			Curso:          r.Matricula.Curso.Nivel.Nombre + " " + r.Matricula.Curso.Paralelo,
+			CasoID:         r.CasoID,
+			ConvocatoriaID: r.ConvocatoriaID,
+			TotalAdjuntos:  len(r.Adjuntos.Data),
+		}
+	}
+
+	return response, nil
+}
+
+func (s *ReferralService) ObtenerRemision(id uint) (*dto.RemisionDetalleDTO, error) {
+	var r tracking.Remision
+
+	if err := s.db.Preload("Docente").
+		Preload("Matricula.Estudiante").
+		Preload("Matricula.Curso.Nivel").
+		First(&r, id).Error; err != nil {
+		return nil, errors.New("Remisión no encontrada")
+	}
+
+	adjuntos := make([]dto.EvidenciaDTO, len(r.Adjuntos.Data))
+	for i, a := range r.Adjuntos.Data {
+		adjuntos[i] = dto.EvidenciaDTO{Nombre: a.Nombre, Ruta: a.Ruta}
+	}
+
+	return &dto.RemisionDetalleDTO{
+		ID:                 r.ID,
+		CodigoRemision:     r.CodigoRemision,
+		DocenteID:          r.DocenteID,
+		MatriculaID:        r.MatriculaID,
+		FechaRemision:      r.FechaRemision,
+		Categoria:          r.Categoria,
+		Descripcion:        r.Descripcion,
+		Urgencia:           r.Urgencia,
+		Estado:             r.Estado,
+		ObservacionTriaje:  r.ObservacionTriaje,
+		FechaActualizacion: r.FechaActualizacion,
+		Docente:            r.Docente.NombresCompletos,
+		Estudiante:         r.Matricula.Estudiante.Apellidos + " " + r.Matricula.Estudiante.Nombres,
+		Cedula:             r.Matricula.Estudiante.Cedula,
+		Curso:              r.Matricula.Curso.Nivel.Nombre + " " + r.Matricula.Curso.Paralelo,
+		CasoID:             r.CasoID,
+		ConvocatoriaID:     r.ConvocatoriaID,
+		Adjuntos:           adjuntos,
+	}, nil
+}
+
+// TriarRemision mueve la remisión a evaluación o la descarta. Las conversiones
+// a caso o cita tienen sus propios métodos porque crean el registro asociado.
+func (s *ReferralService) TriarRemision(input dto.TriajeRemisionDTO) error {
+	remision, err := s.remisionAbierta(input.RemisionID)
+	if err != nil {
+		return err
+	}
+
+	switch input.Estado {
+	case tracking.EstadoRemisionEnEvaluacion:
+		if remision.Estado != tracking.EstadoRemisionRecibida {
+			return errors.New("La remisión ya se encuentra en evaluación")
+		}
+	case tracking.EstadoRemisionDescartada:
+		if strings.TrimSpace(input.Observacion) == "" {
+			return errors.New("Debe indicar el motivo por el que se descarta la remisión")
+		}
+	default:
+		return fmt.Errorf("Estado de triaje no válido: %s", input.Estado)
+	}
+
+	remision.Estado = input.Estado
+	if input.Observacion != "" {
+		remision.ObservacionTriaje = input.Observacion
+	}
+	remision.FechaActualizacion = time.Now().Format("2006-01-02 15:04:05")
+
+	if err := s.db.Save(remision).Error; err != nil {
+		return fmt.Errorf("Error al actualizar remisión: %v", err)
+	}
+
+	return nil
+}
+
+func (s *ReferralService) ConvertirRemisionEnCaso(remisionID uint, input dto.GuardarCasoDTO) (*tracking.CasoSensible, error) {
+	remision, err := s.remisionAbierta(remisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matricula enrollment.Matricula
+	if err := s.db.First(&matricula, remision.MatriculaID).Error; err != nil {
+		return nil, errors.New("Matrícula de la remisión no encontrada")
+	}
+
+	input.ID = 0
+	input.EstudianteID = matricula.EstudianteID
+	if input.FechaDeteccion == "" {
+		input.FechaDeteccion = remision.FechaRemision
+	}
+	if input.Descripcion == "" {
+		input.Descripcion = remision.Descripcion
+	}
+
+	var caso *tracking.CasoSensible
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		caso, err = s.tracking.abrirCaso(tx, input)
+		if err != nil {
+			return err
+		}
+
+		remision.Estado = tracking.EstadoRemisionConvertidaCaso
+		remision.CasoID = &caso.ID
+		remision.FechaActualizacion = time.Now().Format("2006-01-02 15:04:05")
+
+		if err := tx.Save(remision).Error; err != nil {
+			return fmt.Errorf("Error al actualizar la remisión: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return caso, nil
+}
+
+func (s *ReferralService) ConvertirRemisionEnCita(remisionID uint, input managementDto.AgendarCitaDTO) (*management.Convocatoria, error) {
+	remision, err := s.remisionAbierta(remisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	input.MatriculaID = remision.MatriculaID
+	if input.Motivo == "" {
+		input.Motivo = fmt.Sprintf("Remisión %s: %s", remision.CodigoRemision, remision.Categoria)
+	}
+
+	var cita *management.Convocatoria
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		cita, err = managementSvc.RegistrarCita(tx, input)
+		if err != nil {
+			return err
+		}
+
+		remision.Estado = tracking.EstadoRemisionConvertidaCita
+		remision.ConvocatoriaID = &cita.ID
+		remision.FechaActualizacion = time.Now().Format("2006-01-02 15:04:05")
+
+		if err := tx.Save(remision).Error; err != nil {
+			return fmt.Errorf("Error al actualizar la remisión: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.sync != nil {
+		go s.sync.SyncNuevaCita(cita.ID)
+	}
+
+	return cita, nil
+}
+
+func (s *ReferralService) SubirAdjuntoRemision(remisionID uint, rutaOrigen string, nombre string) (string, error) {
+	var remision tracking.Remision
+
+	if err := s.db.First(&remision, remisionID).Error; err != nil {
+		return "", errors.New("Remisión no encontrada")
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.New("Error de sistema de archivos")
+	}
+
+	destinoDir := filepath.Join(homeDir, "Documents", "SistemaDECE", "Remisiones", remision.CodigoRemision)
+	if err := os.MkdirAll(destinoDir, 0755); err != nil {
+		return "", fmt.Errorf("Error al crear carpeta: %v", err)
+	}
+
+	ext := filepath.Ext(rutaOrigen)
+	if ext == "" {
+		ext = ".pdf"
+	}
+
+	safeName := nombre
+	if safeName == "" {
+		safeName = fmt.Sprintf("ADJ_%d", time.Now().UnixMilli())
+	}
+
+	replacer := strings.NewReplacer("<", "", ">", "", ":", "", "\"", "", "/", "", "\\", "", "|", "", "?", "", "*", "")
+	safeName = replacer.Replace(safeName)
+
+	rutaDestinoCompleta := filepath.Join(destinoDir, safeName+ext)
+	if _, err := os.Stat(rutaDestinoCompleta); err == nil {
+		rutaDestinoCompleta = filepath.Join(destinoDir, fmt.Sprintf("%s_%d%s", safeName, time.Now().UnixMilli(), ext))
+	}
+
+	src, err := os.Open(rutaOrigen)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(rutaDestinoCompleta)
+	if err != nil {
+		return "", err
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return "", err
+	}
+
+	lista := remision.Adjuntos.Data
+	if lista == nil {
+		lista = []tracking.Evidencia{}
+	}
+
+	if nombre == "" {
+		nombre = fmt.Sprintf("Adjunto %d", len(lista)+1)
+	}
+
+	lista = append(lista, tracking.Evidencia{Nombre: nombre, Ruta: rutaDestinoCompleta})
+	remision.Adjuntos = common.JSONMap[[]tracking.Evidencia]{Data: lista}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&remision).Error; err != nil {
+			return err
+		}
+		_, err := s.tracking.versiones.Registrar(tx, documents.EntidadRemision, remision.ID, "adjunto", nombre, rutaDestinoCompleta, false)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("Adjunto guardado pero error al actualizar BD: %v", err)
+	}
+
+	return rutaDestinoCompleta, nil
+}
+
+func (s *ReferralService) EliminarAdjuntoRemision(remisionID uint, ruta string) error {
+	var remision tracking.Remision
+
+	if err := s.db.First(&remision, remisionID).Error; err != nil {
+		return errors.New("Remisión no encontrada")
+	}
+
+	nuevaLista := make([]tracking.Evidencia, 0, len(remision.Adjuntos.Data))
+	for _, a := range remision.Adjuntos.Data {
+		if a.Ruta != ruta {
+			nuevaLista = append(nuevaLista, a)
+		}
+	}
+
+	remision.Adjuntos = common.JSONMap[[]tracking.Evidencia]{Data: nuevaLista}
+
+	// El archivo se conserva en el historial de versiones
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.tracking.versiones.Adoptar(tx, documents.EntidadRemision, remision.ID, "adjunto", ruta); err != nil {
+			return err
+		}
+		if err := s.tracking.versiones.Retirar(tx, documents.EntidadRemision, remision.ID, ruta); err != nil {
+			return err
+		}
+		return tx.Save(&remision).Error
+	})
+	if err != nil {
+		return fmt.Errorf("Error al actualizar BD: %v", err)
+	}
+
+	return nil
+}
+
+func (s *ReferralService) remisionAbierta(id uint) (*tracking.Remision, error) {
+	var remision tracking.Remision
+
+	if err := s.db.First(&remision, id).Error; err != nil {
+		return nil, errors.New("Remisión no encontrada")
+	}
+
+	if remision.Estado != tracking.EstadoRemisionRecibida && remision.Estado != tracking.EstadoRemisionEnEvaluacion {
+		return nil, fmt.Errorf("La remisión ya fue cerrada (%s)", remision.Estado)
+	}
+
+	return &remision, nil
+}
@@ -0,0 +1,606 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	transferDTO "dece/internal/application/dtos/transfer"
+	dossier "dece/internal/application/helpers/dossier"
+	identity "dece/internal/application/helpers/identity"
+	photo "dece/internal/application/helpers/photo"
+	"dece/internal/domain/common"
+	"dece/internal/domain/documents"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/faculty"
+	"dece/internal/domain/student"
+	"dece/internal/domain/transfer"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"gorm.io/gorm"
+)
+
+const (
+	tamanoMaximoExpediente = 200 << 20
+	tamanoMaximoManifiesto = 5 << 20
+)
+
+// PrevisualizarExpediente abre un archivo .sigdece, verifica la firma, lo descifra con la clave
+// de transferencia y muestra su contenido junto con las colisiones detectadas, sin tocar aún
+// las fichas locales.
+func (s *TransferService) PrevisualizarExpediente(claveTransferencia string) (*transferDTO.VistaPreviaExpedienteDTO, error) {
+	ruta, err := runtime.OpenFileDialog(s.ctx, runtime.OpenDialogOptions{
+		Title: "Seleccionar Expediente de Transferencia",
+		Filters: []runtime.FileFilter{
+			{DisplayName: "Expediente SIGDECE", Pattern: "*.sigdece"},
+		},
+	})
+	if err != nil || ruta == "" {
+		return nil, nil
+	}
+
+	return s.previsualizarArchivo(ruta, claveTransferencia)
+}
+
+func (s *TransferService) previsualizarArchivo(ruta string, claveTransferencia string) (*transferDTO.VistaPreviaExpedienteDTO, error) {
+	info, err := os.Stat(ruta)
+	if err != nil {
+		return nil, errors.New("No se pudo leer el archivo")
+	}
+	if info.Size() > tamanoMaximoExpediente {
+		return nil, errors.New("El archivo supera el tamaño máximo permitido")
+	}
+	data, err := os.ReadFile(ruta)
+	if err != nil {
+		return nil, errors.New("No se pudo leer el archivo")
+	}
+
+	sobre, err := dossier.LeerSobre(data)
+	if err != nil {
+		return nil, err
+	}
+	contenido, err := dossier.Abrir(sobre, claveTransferencia)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(contenido), int64(len(contenido)))
+	if err != nil {
+		return nil, errors.New("El contenido del expediente está dañado")
+	}
+	archivos := map[string]*zip.File{}
+	for _, f := range zr.File {
+		archivos[f.Name] = f
+	}
+
+	manifiesto, ok := archivos["expediente.json"]
+	if !ok {
+		return nil, errors.New("El expediente no contiene expediente.json")
+	}
+	raw, err := leerZip(manifiesto, tamanoMaximoManifiesto)
+	if err != nil {
+		return nil, err
+	}
+	var exp transfer.Expediente
+	if err := json.Unmarshal(raw, &exp); err != nil {
+		return nil, fmt.Errorf("expediente.json es inválido: %v", err)
+	}
+	if strings.TrimSpace(exp.Estudiante.Cedula) == "" || exp.CodigoExpediente == "" {
+		return nil, errors.New("El expediente no identifica al estudiante")
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, errors.New("No se pudo acceder a la carpeta del usuario")
+	}
+	carpeta := filepath.Join(homeDir, "Documents", "SistemaDECE", "Transferencias",
+		fmt.Sprintf("%s_%d", filepath.Base(exp.CodigoExpediente), time.Now().UnixNano()))
+	if err := os.MkdirAll(carpeta, 0755); err != nil {
+		return nil, fmt.Errorf("Error al crear carpeta temporal: %v", err)
+	}
+
+	preview := &transferDTO.VistaPreviaExpedienteDTO{
+		CodigoExpediente:     exp.CodigoExpediente,
+		FechaExportacion:     exp.FechaExportacion,
+		InstitucionOrigen:    sobre.Emisor.Institucion,
+		CodigoAMIEOrigen:     sobre.Emisor.CodigoAMIE,
+		HuellaEmisor:         sobre.Emisor.Huella,
+		Estudiante:           exp.Estudiante,
+		Familiares:           exp.Familiares,
+		Matricula:            exp.Matricula,
+		Retiro:               exp.Retiro,
+		Disciplina:           exp.Disciplina,
+		Casos:                exp.Casos,
+		Documentos:           []transferDTO.DocumentoPreviaDTO{},
+		ColisionesFamiliares: []transferDTO.ColisionFamiliarDTO{},
+		Cursos:               []transferDTO.CursoDestinoDTO{},
+		Advertencias:         []string{},
+	}
+
+	// Solo se extraen los documentos declarados en el manifiesto y con nombre plano, para que
+	// un archivo manipulado no pueda escribir fuera de la carpeta temporal
+	documentosValidos := []transfer.DocumentoExpediente{}
+	for _, d := range exp.Documentos {
+		f, ok := archivos[d.Archivo]
+		nombre := path.Base(d.Archivo)
+		if !ok || d.Archivo != "documentos/"+nombre || nombre == "." || nombre == ".." {
+			preview.Advertencias = append(preview.Advertencias, fmt.Sprintf("El documento '%s' no está en el expediente", d.Nombre))
+			continue
+		}
+		datos, err := leerZip(f, tamanoMaximoExpediente)
+		if err != nil {
+			os.RemoveAll(carpeta)
+			return nil, err
+		}
+		sum := sha256.Sum256(datos)
+		integro := hex.EncodeToString(sum[:]) == d.HashSHA256
+		if !integro {
+			preview.Advertencias = append(preview.Advertencias, fmt.Sprintf("El documento '%s' no coincide con su hash y no se importará", d.Nombre))
+		} else {
+			if err := os.WriteFile(filepath.Join(carpeta, nombre), datos, 0644); err != nil {
+				os.RemoveAll(carpeta)
+				return nil, fmt.Errorf("Error al extraer documentos: %v", err)
+			}
+			documentosValidos = append(documentosValidos, d)
+		}
+		preview.Documentos = append(preview.Documentos, transferDTO.DocumentoPreviaDTO{
+			Tipo:        d.Tipo,
+			Nombre:      d.Nombre,
+			TamanoBytes: int64(len(datos)),
+			Integro:     integro,
+		})
+	}
+	exp.Documentos = documentosValidos
+
+	if !exp.Estudiante.InfoNacionalidad.EsExtranjero {
+		if err := identity.ValidarIdentificacion(exp.Estudiante.Cedula); err != nil {
+			preview.Advertencias = append(preview.Advertencias, fmt.Sprintf("Cédula del estudiante: %v", err))
+		}
+	}
+
+	var importado transfer.ExpedienteImportado
+	if err := s.db.Where("codigo_expediente = ? AND estado = ?", exp.CodigoExpediente, transfer.EstadoExpedienteImportado).First(&importado).Error; err == nil {
+		preview.Advertencias = append(preview.Advertencias, fmt.Sprintf("Este expediente ya fue importado el %s", importado.FechaImportacion))
+	}
+
+	var conocidos int64
+	s.db.Model(&transfer.ExpedienteImportado{}).
+		Where("huella_emisor = ? AND estado = ?", sobre.Emisor.Huella, transfer.EstadoExpedienteImportado).
+		Count(&conocidos)
+	preview.EmisorConocido = conocidos > 0
+	if !preview.EmisorConocido {
+		preview.Advertencias = append(preview.Advertencias, "Es el primer expediente recibido de esta instalación: confirme la huella con la institución de origen")
+	}
+
+	preview.Colision = s.colisionEstudiante(exp, importado.ID != 0)
+	preview.ColisionesFamiliares = s.colisionesFamiliares(exp)
+	preview.Cursos = s.cursosDestino(exp.Matricula)
+	if len(preview.Cursos) == 0 {
+		preview.Advertencias = append(preview.Advertencias, "No hay cursos en el periodo lectivo activo para asignar al estudiante")
+	}
+
+	// Una vista previa anterior del mismo expediente queda reemplazada por esta
+	var anteriores []transfer.ExpedienteImportado
+	s.db.Where("codigo_expediente = ? AND estado = ?", exp.CodigoExpediente, transfer.EstadoExpedientePrevisualizado).Find(&anteriores)
+	for _, a := range anteriores {
+		s.descartar(&a)
+	}
+
+	registro := transfer.ExpedienteImportado{
+		CodigoExpediente:  exp.CodigoExpediente,
+		InstitucionOrigen: sobre.Emisor.Institucion,
+		CodigoAMIEOrigen:  sobre.Emisor.CodigoAMIE,
+		HuellaEmisor:      sobre.Emisor.Huella,
+		FechaExportacion:  exp.FechaExportacion,
+		Estado:            transfer.EstadoExpedientePrevisualizado,
+		CarpetaTemporal:   carpeta,
+		Contenido:         common.JSONMap[transfer.Expediente]{Data: exp},
+		FechaPrevia:       time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if err := s.db.Create(&registro).Error; err != nil {
+		os.RemoveAll(carpeta)
+		return nil, fmt.Errorf("Error al guardar la vista previa: %v", err)
+	}
+	preview.ExpedienteID = registro.ID
+
+	return preview, nil
+}
+
+func (s *TransferService) colisionEstudiante(exp transfer.Expediente, yaImportado bool) *transferDTO.ColisionCedulaDTO {
+	var local student.Estudiante
+	if err := s.db.Where("cedula = ?", strings.TrimSpace(exp.Estudiante.Cedula)).First(&local).Error; err != nil {
+		return nil
+	}
+
+	colision := &transferDTO.ColisionCedulaDTO{
+		EstudianteID:          local.ID,
+		Cedula:                local.Cedula,
+		NombreLocal:           nombreCompleto(local),
+		Diferencias:           []student.CambioCampo{},
+		ExpedienteYaImportado: yaImportado,
+	}
+
+	comparar := func(campo, anterior, nuevo string) {
+		if !strings.EqualFold(strings.TrimSpace(anterior), strings.TrimSpace(nuevo)) {
+			colision.Diferencias = append(colision.Diferencias, student.CambioCampo{Campo: campo, Anterior: anterior, Nuevo: nuevo})
+		}
+	}
+	comparar("apellidos", local.Apellidos, exp.Estudiante.Apellidos)
+	comparar("nombres", local.Nombres, exp.Estudiante.Nombres)
+	comparar("fecha_nacimiento", local.FechaNacimiento, exp.Estudiante.FechaNacimiento)
+	comparar("genero_nacimiento", local.GeneroNacimiento, exp.Estudiante.GeneroNacimiento)
+
+	s.db.Raw(`
+		SELECT ne.nombre || ' ' || c.paralelo
+		FROM matriculas m
+		JOIN cursos c ON m.curso_id = c.id
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE m.estudiante_id = ? AND pl.es_activo = 1
+		LIMIT 1`, local.ID).Scan(&colision.CursoActual)
+	colision.MatriculadoEnActivo = colision.CursoActual != ""
+
+	return colision
+}
+
+// colisionesFamiliares marca las cédulas de familiares que ya existen aquí con otro nombre,
+// que suelen ser errores de digitación en una de las dos instituciones.
+func (s *TransferService) colisionesFamiliares(exp transfer.Expediente) []transferDTO.ColisionFamiliarDTO {
+	colisiones := []transferDTO.ColisionFamiliarDTO{}
+	for _, f := range exp.Familiares {
+		cedula := strings.TrimSpace(f.Cedula)
+		if cedula == "" {
+			continue
+		}
+		var locales []struct {
+			NombresCompletos string
+			Estudiante       string
+		}
+		s.db.Raw(`
+			SELECT f.nombres_completos, e.apellidos || ' ' || e.nombres as estudiante
+			FROM familiars f
+			JOIN estudiantes e ON f.estudiante_id = e.id
+			WHERE f.cedula = ?`, cedula).Scan(&locales)
+		for _, l := range locales {
+			if !strings.EqualFold(strings.TrimSpace(l.NombresCompletos), strings.TrimSpace(f.NombresCompletos)) {
+				colisiones = append(colisiones, transferDTO.ColisionFamiliarDTO{
+					Cedula:           cedula,
+					NombreExpediente: f.NombresCompletos,
+					NombreLocal:      l.NombresCompletos,
+					Estudiante:       l.Estudiante,
+				})
+			}
+		}
+	}
+	return colisiones
+}
+
+// cursosDestino lista los cursos del periodo activo, sugiriendo los del mismo nivel (y jornada)
+// que tenía el estudiante en la institución de origen.
+func (s *TransferService) cursosDestino(origen *transfer.MatriculaExpediente) []transferDTO.CursoDestinoDTO {
+	var filas []struct {
+		ID       uint
+		Nivel    string
+		Paralelo string
+		Jornada  string
+	}
+	s.db.Raw(`
+		SELECT c.id, ne.nombre as nivel, c.paralelo, c.jornada
+		FROM cursos c
+		JOIN nivel_educativos ne ON c.nivel_id = ne.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE pl.es_activo = 1
+		ORDER BY ne.orden, c.paralelo`).Scan(&filas)
+
+	sugeridos := []transferDTO.CursoDestinoDTO{}
+	resto := []transferDTO.CursoDestinoDTO{}
+	for _, f := range filas {
+		curso := transferDTO.CursoDestinoDTO{
+			CursoID: f.ID,
+			Nombre:  f.Nivel + " " + f.Paralelo,
+			Jornada: f.Jornada,
+		}
+		if origen != nil && strings.EqualFold(strings.TrimSpace(f.Nivel), strings.TrimSpace(origen.Nivel)) {
+			curso.Sugerido = true
+			if strings.EqualFold(f.Jornada, origen.Jornada) {
+				sugeridos = append([]transferDTO.CursoDestinoDTO{curso}, sugeridos...)
+				continue
+			}
+			sugeridos = append(sugeridos, curso)
+			continue
+		}
+		resto = append(resto, curso)
+	}
+	return append(sugeridos, resto...)
+}
+
+// ConfirmarExpediente crea (o vincula) al estudiante, sus familiares, la matrícula en el curso
+// elegido con la ficha DECE del expediente y copia los documentos.
+func (s *TransferService) ConfirmarExpediente(input transferDTO.ConfirmarExpedienteDTO) (*transferDTO.ResultadoImportacionExpedienteDTO, error) {
+	var registro transfer.ExpedienteImportado
+	if err := s.db.First(&registro, input.ExpedienteID).Error; err != nil {
+		return nil, errors.New("Vista previa de expediente no encontrada")
+	}
+	if registro.Estado != transfer.EstadoExpedientePrevisualizado {
+		return nil, fmt.Errorf("El expediente ya fue procesado (%s)", registro.Estado)
+	}
+
+	var duplicado int64
+	s.db.Model(&transfer.ExpedienteImportado{}).
+		Where("codigo_expediente = ? AND estado = ?", registro.CodigoExpediente, transfer.EstadoExpedienteImportado).
+		Count(&duplicado)
+	if duplicado > 0 {
+		return nil, errors.New("Este expediente ya fue importado")
+	}
+
+	var curso faculty.Curso
+	if err := s.db.First(&curso, input.CursoID).Error; err != nil {
+		return nil, errors.New("Debe seleccionar un curso de destino válido")
+	}
+
+	exp := registro.Contenido.Data
+	resultado := &transferDTO.ResultadoImportacionExpedienteDTO{}
+	ahora := time.Now().Format("2006-01-02 15:04:05")
+	var copiados []string
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var est student.Estudiante
+		existe := tx.Where("cedula = ?", strings.TrimSpace(exp.Estudiante.Cedula)).First(&est).Error == nil
+
+		if existe && !input.VincularExistente {
+			return fmt.Errorf("Ya existe un estudiante con la cédula %s (%s). Confirme si desea vincular el expediente a esa ficha", est.Cedula, nombreCompleto(est))
+		}
+
+		if existe {
+			// Solo se completan los campos vacíos; los datos locales prevalecen
+			completar := map[string]interface{}{}
+			if est.FechaNacimiento == "" && exp.Estudiante.FechaNacimiento != "" {
+				completar["fecha_nacimiento"] = exp.Estudiante.FechaNacimiento
+			}
+			if est.GeneroNacimiento == "" && exp.Estudiante.GeneroNacimiento != "" {
+				completar["genero_nacimiento"] = exp.Estudiante.GeneroNacimiento
+			}
+			if est.CorreoElectronico == "" && exp.Estudiante.CorreoElectronico != "" {
+				completar["correo_electronico"] = exp.Estudiante.CorreoElectronico
+			}
+			if len(completar) > 0 {
+				if err := tx.Model(&est).Updates(completar).Error; err != nil {
+					return err
+				}
+			}
+		} else {
+			est = student.Estudiante{
+				Cedula:            strings.TrimSpace(exp.Estudiante.Cedula),
+				Apellidos:         exp.Estudiante.Apellidos,
+				Nombres:           exp.Estudiante.Nombres,
+				FechaNacimiento:   exp.Estudiante.FechaNacimiento,
+				GeneroNacimiento:  exp.Estudiante.GeneroNacimiento,
+				CorreoElectronico: exp.Estudiante.CorreoElectronico,
+				InfoNacionalidad:  common.JSONMap[student.InfoNacionalidad]{Data: exp.Estudiante.InfoNacionalidad},
+				FechaCreacion:     ahora,
+			}
+			if err := tx.Create(&est).Error; err != nil {
+				return fmt.Errorf("Error al crear estudiante: %v", err)
+			}
+			resultado.EstudianteCreado = true
+		}
+		resultado.EstudianteID = est.ID
+
+		var enPeriodo int64
+		tx.Table("matriculas").
+			Joins("JOIN cursos c ON c.id = matriculas.curso_id").
+			Where("matriculas.estudiante_id = ? AND c.periodo_id = ?", est.ID, curso.PeriodoID).
+			Count(&enPeriodo)
+		if enPeriodo > 0 {
+			return errors.New("El estudiante ya se encuentra matriculado en este periodo lectivo")
+		}
+
+		var familiaresLocales []student.Familiar
+		tx.Where("estudiante_id = ?", est.ID).Find(&familiaresLocales)
+		for _, f := range exp.Familiares {
+			if familiarRegistrado(familiaresLocales, f) {
+				continue
+			}
+			nuevo := student.Familiar{
+				EstudianteID:         est.ID,
+				Cedula:               strings.TrimSpace(f.Cedula),
+				NombresCompletos:     f.NombresCompletos,
+				Parentesco:           f.Parentesco,
+				EsRepresentanteLegal: f.EsRepresentanteLegal,
+				ViveConEstudiante:    f.ViveConEstudiante,
+				TelefonoPersonal:     f.TelefonoPersonal,
+				Fallecido:            f.Fallecido,
+				DatosExtendidos:      common.JSONMap[student.DatosFamiliar]{Data: f.DatosExtendidos},
+			}
+			if err := tx.Create(&nuevo).Error; err != nil {
+				return fmt.Errorf("Error al registrar familiar: %v", err)
+			}
+			resultado.FamiliaresAgregados++
+		}
+
+		mat := enrollment.Matricula{
+			EstudianteID:  est.ID,
+			CursoID:       curso.ID,
+			Estado:        enrollment.EstadoMatriculado,
+			FechaRegistro: ahora,
+		}
+		if exp.Matricula != nil {
+			historial := exp.Matricula.HistorialAcademico
+			historial.EsNuevoEstudiante = true
+			historial.InstitucionAnterior = exp.Origen.Nombre
+			historial.ProvinciaAnterior = exp.Origen.Provincia
+			historial.CantonAnterior = exp.Origen.Canton
+
+			mat.DireccionActual = exp.Matricula.DireccionActual
+			mat.Ubicacion = common.JSONMap[enrollment.UbicacionDomicilio]{Data: exp.Matricula.Ubicacion}
+			mat.Antropometria = common.JSONMap[enrollment.Antropometria]{Data: exp.Matricula.Antropometria}
+			mat.HistorialAcademico = common.JSONMap[enrollment.HistorialAcademico]{Data: historial}
+			mat.DatosSalud = common.JSONMap[enrollment.DatosSalud]{Data: exp.Matricula.DatosSalud}
+			mat.DatosSociales = common.JSONMap[enrollment.DatosSociales]{Data: exp.Matricula.DatosSociales}
+			mat.CondicionGenero = common.JSONMap[enrollment.CondicionGenero]{Data: exp.Matricula.CondicionGenero}
+		}
+
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return errors.New("No se pudo acceder a la carpeta del usuario")
+		}
+		docsDir := filepath.Join(homeDir, "Documents", "SistemaDECE", "DocumentosEstudiantes")
+		if err := os.MkdirAll(docsDir, 0755); err != nil {
+			return fmt.Errorf("Error al crear carpeta de documentos: %v", err)
+		}
+
+		actualizarEstudiante := map[string]interface{}{}
+		for _, d := range exp.Documentos {
+			origen := filepath.Join(registro.CarpetaTemporal, path.Base(d.Archivo))
+			datos, err := os.ReadFile(origen)
+			if err != nil {
+				continue
+			}
+
+			if d.Tipo == transfer.DocumentoFoto {
+				if est.RutaFoto != "" {
+					continue
+				}
+				ruta, err := photo.GuardarFoto(datos, photo.FormatoFotoEstudiante,
+					filepath.Join(homeDir, "Documents", "SistemaDECE", "FotosEstudiantes"),
+					fmt.Sprintf("%s_%d", est.Cedula, time.Now().UnixNano()))
+				if err != nil {
+					continue
+				}
+				copiados = append(copiados, ruta, photo.RutaMiniatura(ruta))
+				actualizarEstudiante["ruta_foto"] = ruta
+				resultado.DocumentosImportados++
+				continue
+			}
+
+			// La cédula y la partida locales, si existen, no se reemplazan
+			if (d.Tipo == transfer.DocumentoCedula && est.RutaCedula != "") ||
+				(d.Tipo == transfer.DocumentoPartida && est.RutaPartidaNacimiento != "") {
+				continue
+			}
+
+			nombre := fmt.Sprintf("%s_%s_%d%s", est.Cedula, d.Tipo, time.Now().UnixNano(), filepath.Ext(d.Archivo))
+			ruta := filepath.Join(docsDir, nombre)
+			if err := os.WriteFile(ruta, datos, 0644); err != nil {
+				return fmt.Errorf("Error al copiar documento: %v", err)
+			}
+			copiados = append(copiados, ruta)
+			resultado.DocumentosImportados++
+
+			switch d.Tipo {
+			case transfer.DocumentoCedula:
+				actualizarEstudiante["ruta_cedula"] = ruta
+			case transfer.DocumentoPartida:
+				actualizarEstudiante["ruta_partida_nacimiento"] = ruta
+			case transfer.DocumentoEvalPsicopedagogica:
+				salud := mat.DatosSalud.Data
+				salud.RutaEvalPsicopedagogica = ruta
+				mat.DatosSalud = common.JSONMap[enrollment.DatosSalud]{Data: salud}
+			case transfer.DocumentoCroquis:
+				mat.RutaCroquis = ruta
+			case transfer.DocumentoConsentimiento:
+				mat.RutaConsentimiento = ruta
+			}
+
+			if d.Tipo == transfer.DocumentoCedula || d.Tipo == transfer.DocumentoPartida {
+				if _, err := s.versiones.Registrar(tx, documents.EntidadEstudiante, est.ID, d.Tipo, nombre, ruta, true); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(actualizarEstudiante) > 0 {
+			if err := tx.Model(&est).Updates(actualizarEstudiante).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Create(&mat).Error; err != nil {
+			return fmt.Errorf("Error al crear la matrícula: %v", err)
+		}
+		if err := s.ciclo.Alta(tx, &mat, fmt.Sprintf("Traslado desde %s", exp.Origen.Nombre)); err != nil {
+			return err
+		}
+		resultado.MatriculaID = mat.ID
+
+		registro.Estado = transfer.EstadoExpedienteImportado
+		registro.EstudianteID = est.ID
+		registro.MatriculaID = mat.ID
+		registro.FechaImportacion = ahora
+		if usuario, err := s.auth.ObtenerUsuarioSesion(); err == nil {
+			registro.UsuarioID = usuario.ID
+		}
+		return tx.Save(&registro).Error
+	})
+
+	if err != nil {
+		for _, ruta := range copiados {
+			os.Remove(ruta)
+		}
+		return nil, err
+	}
+
+	os.RemoveAll(registro.CarpetaTemporal)
+	return resultado, nil
+}
+
+// DescartarExpediente cancela una vista previa y borra los archivos extraídos.
+func (s *TransferService) DescartarExpediente(expedienteID uint) error {
+	var registro transfer.ExpedienteImportado
+	if err := s.db.First(&registro, expedienteID).Error; err != nil {
+		return errors.New("Vista previa de expediente no encontrada")
+	}
+	if registro.Estado != transfer.EstadoExpedientePrevisualizado {
+		return fmt.Errorf("El expediente ya fue procesado (%s)", registro.Estado)
+	}
+	return s.descartar(&registro)
+}
+
+func (s *TransferService) descartar(registro *transfer.ExpedienteImportado) error {
+	if registro.CarpetaTemporal != "" {
+		os.RemoveAll(registro.CarpetaTemporal)
+	}
+	return s.db.Model(registro).Updates(map[string]interface{}{
+		"estado":           transfer.EstadoExpedienteDescartado,
+		"carpeta_temporal": "",
+	}).Error
+}
+
+func familiarRegistrado(locales []student.Familiar, f transfer.FamiliarExpediente) bool {
+	cedula := strings.TrimSpace(f.Cedula)
+	for _, l := range locales {
+		if cedula != "" && strings.TrimSpace(l.Cedula) == cedula {
+			return true
+		}
+		if cedula == "" && strings.EqualFold(strings.TrimSpace(l.NombresCompletos), strings.TrimSpace(f.NombresCompletos)) {
+			return true
+		}
+	}
+	return false
+}
+
+func leerZip(f *zip.File, limite int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("Error al leer %s: %v", f.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, limite+1))
+	if err != nil {
+		return nil, fmt.Errorf("Error al leer %s: %v", f.Name, err)
+	}
+	if int64(len(data)) > limite {
+		return nil, fmt.Errorf("%s supera el tamaño permitido", f.Name)
+	}
+	return data, nil
+}
@@ -0,0 +1,324 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	transferDTO "dece/internal/application/dtos/transfer"
+	dossier "dece/internal/application/helpers/dossier"
+	documentSvc "dece/internal/application/services/documents"
+	enrollmentSvc "dece/internal/application/services/enrollment"
+	securitySvc "dece/internal/application/services/security"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/security"
+	"dece/internal/domain/student"
+	"dece/internal/domain/transfer"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"gorm.io/gorm"
+)
+
+type TransferService struct {
+	db        *gorm.DB
+	ctx       context.Context
+	auth      *securitySvc.AuthService
+	versiones *documentSvc.Versionador
+	ciclo     *enrollmentSvc.CicloMatricula
+}
+
+func NewTransferService(db *gorm.DB, auth *securitySvc.AuthService, versiones *documentSvc.Versionador, ciclo *enrollmentSvc.CicloMatricula) *TransferService {
+	return &TransferService{db: db, auth: auth, versiones: versiones, ciclo: ciclo}
+}
+
+func (s *TransferService) SetContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+// identidad devuelve el par de claves de la instalación, creándolo la primera vez.
+func (s *TransferService) identidad() (*transfer.IdentidadInstalacion, error) {
+	var id transfer.IdentidadInstalacion
+	if err := s.db.Order("id ASC").First(&id).Error; err == nil {
+		return &id, nil
+	}
+
+	pub, priv, huella, err := dossier.GenerarParClaves()
+	if err != nil {
+		return nil, fmt.Errorf("Error al generar la identidad de la instalación: %v", err)
+	}
+	id = transfer.IdentidadInstalacion{
+		ClavePublica:  pub,
+		ClavePrivada:  priv,
+		Huella:        huella,
+		FechaCreacion: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if err := s.db.Create(&id).Error; err != nil {
+		return nil, fmt.Errorf("Error al guardar la identidad de la instalación: %v", err)
+	}
+	return &id, nil
+}
+
+func (s *TransferService) institucion() security.ConfiguracionInstitucional {
+	var conf security.ConfiguracionInstitucional
+	s.db.Order("id ASC").First(&conf)
+	return conf
+}
+
+// ObtenerIdentidadInstalacion muestra la huella que la institución destino puede confirmar por
+// teléfono antes de importar.
+func (s *TransferService) ObtenerIdentidadInstalacion() (*transferDTO.IdentidadInstalacionDTO, error) {
+	id, err := s.identidad()
+	if err != nil {
+		return nil, err
+	}
+	conf := s.institucion()
+	return &transferDTO.IdentidadInstalacionDTO{
+		Institucion: conf.Nombre,
+		CodigoAMIE:  conf.CodigoAMIE,
+		Huella:      id.Huella,
+	}, nil
+}
+
+// ExportarExpediente genera el archivo de transferencia de una matrícula (normalmente la que se
+// acaba de retirar) y devuelve la clave con la que se cifró.
+func (s *TransferService) ExportarExpediente(matriculaID uint) (*transferDTO.ResultadoExportacionDTO, error) {
+	var m enrollment.Matricula
+	if err := s.db.Preload("Estudiante").First(&m, matriculaID).Error; err != nil {
+		return nil, errors.New("Matrícula no encontrada")
+	}
+
+	destino, err := runtime.SaveFileDialog(s.ctx, runtime.SaveDialogOptions{
+		Title:           "Guardar Expediente de Transferencia",
+		DefaultFilename: fmt.Sprintf("EXPEDIENTE_%s_%s.sigdece", m.Estudiante.Cedula, time.Now().Format("20060102")),
+		Filters: []runtime.FileFilter{
+			{DisplayName: "Expediente SIGDECE", Pattern: "*.sigdece"},
+		},
+	})
+	if err != nil || destino == "" {
+		return nil, nil
+	}
+
+	return s.exportarExpediente(matriculaID, destino)
+}
+
+func (s *TransferService) exportarExpediente(matriculaID uint, destino string) (*transferDTO.ResultadoExportacionDTO, error) {
+	var m enrollment.Matricula
+	if err := s.db.Preload("Curso.Nivel").Preload("Curso.Periodo").Preload("Estudiante.Familiares").First(&m, matriculaID).Error; err != nil {
+		return nil, errors.New("Matrícula no encontrada")
+	}
+	est := m.Estudiante
+
+	id, err := s.identidad()
+	if err != nil {
+		return nil, err
+	}
+	conf := s.institucion()
+
+	codigo := make([]byte, 8)
+	if _, err := rand.Read(codigo); err != nil {
+		return nil, err
+	}
+
+	exp := transfer.Expediente{
+		CodigoExpediente: "EXP-" + strings.ToUpper(hex.EncodeToString(codigo)),
+		FechaExportacion: time.Now().Format("2006-01-02 15:04:05"),
+		Origen: transfer.InstitucionExpediente{
+			Nombre:     conf.Nombre,
+			CodigoAMIE: conf.CodigoAMIE,
+			Distrito:   conf.Distrito,
+			Provincia:  conf.DetalleUbicacion.Data.Provincia,
+			Canton:     conf.DetalleUbicacion.Data.Canton,
+		},
+		Estudiante: transfer.EstudianteExpediente{
+			Cedula:            est.Cedula,
+			Apellidos:         est.Apellidos,
+			Nombres:           est.Nombres,
+			FechaNacimiento:   est.FechaNacimiento,
+			GeneroNacimiento:  est.GeneroNacimiento,
+			CorreoElectronico: est.CorreoElectronico,
+			InfoNacionalidad:  est.InfoNacionalidad.Data,
+		},
+		Familiares: []transfer.FamiliarExpediente{},
+		Disciplina: []transfer.DisciplinaExpediente{},
+		Casos:      []transfer.CasoExpediente{},
+		Documentos: []transfer.DocumentoExpediente{},
+	}
+
+	for _, f := range est.Familiares {
+		exp.Familiares = append(exp.Familiares, transfer.FamiliarExpediente{
+			Cedula:               f.Cedula,
+			NombresCompletos:     f.NombresCompletos,
+			Parentesco:           f.Parentesco,
+			EsRepresentanteLegal: f.EsRepresentanteLegal,
+			ViveConEstudiante:    f.ViveConEstudiante,
+			TelefonoPersonal:     f.TelefonoPersonal,
+			Fallecido:            f.Fallecido,
+			DatosExtendidos:      f.DatosExtendidos.Data,
+		})
+	}
+
+	// Las rutas e IDs locales no significan nada en otra instalación
+	salud := m.DatosSalud.Data
+	rutaEval := salud.RutaEvalPsicopedagogica
+	salud.RutaEvalPsicopedagogica = ""
+	genero := m.CondicionGenero.Data
+	genero.ParejaID = 0
+
+	exp.Matricula = &transfer.MatriculaExpediente{
+		PeriodoLectivo:     m.Curso.Periodo.Nombre,
+		Nivel:              m.Curso.Nivel.Nombre,
+		Paralelo:           m.Curso.Paralelo,
+		Jornada:            m.Curso.Jornada,
+		Estado:             m.Estado,
+		EsRepetidor:        m.EsRepetidor,
+		DireccionActual:    m.DireccionActual,
+		Ubicacion:          m.Ubicacion.Data,
+		Antropometria:      m.Antropometria.Data,
+		HistorialAcademico: m.HistorialAcademico.Data,
+		DatosSalud:         salud,
+		DatosSociales:      m.DatosSociales.Data,
+		CondicionGenero:    genero,
+	}
+
+	var retiro enrollment.RetiroEstudiante
+	if err := s.db.Where("matricula_id = ?", m.ID).Order("id DESC").First(&retiro).Error; err == nil {
+		exp.Retiro = &transfer.RetiroExpediente{
+			FechaRetiro:      retiro.FechaRetiro,
+			Motivo:           retiro.Motivo,
+			NuevaInstitucion: retiro.NuevaInstitucion,
+			ProvinciaDestino: retiro.ProvinciaDestino,
+		}
+		exp.InstitucionDestino = retiro.NuevaInstitucion
+	}
+
+	err = s.db.Raw(`
+		SELECT la.fecha, pl.nombre as periodo_lectivo, la.motivo,
+			COALESCE(json_extract(la.detalle_sancion, '$.medida_disciplinaria'), '') as medida,
+			COALESCE(json_extract(la.detalle_sancion, '$.cumplio_medida'), 0) as cumplio_medida,
+			la.representante_firmo
+		FROM llamados_atencion la
+		JOIN matriculas m ON la.matricula_id = m.id
+		JOIN cursos c ON m.curso_id = c.id
+		JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE m.estudiante_id = ?
+		ORDER BY la.fecha ASC`, est.ID).Scan(&exp.Disciplina).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo historial disciplinario: %v", err)
+	}
+
+	err = s.db.Raw(`
+		SELECT cs.codigo_caso, cs.tipo_caso, cs.fecha_deteccion, cs.estado, cs.entidad_derivacion,
+			COALESCE(pl.nombre, '') as periodo_lectivo
+		FROM casos_sensibles cs
+		LEFT JOIN periodo_lectivos pl ON cs.periodo_id = pl.id
+		WHERE cs.estudiante_id = ?
+		ORDER BY cs.fecha_deteccion ASC`, est.ID).Scan(&exp.Casos).Error
+	if err != nil {
+		return nil, fmt.Errorf("Error obteniendo casos: %v", err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	documentos := []struct{ tipo, ruta string }{
+		{transfer.DocumentoCedula, est.RutaCedula},
+		{transfer.DocumentoPartida, est.RutaPartidaNacimiento},
+		{transfer.DocumentoFoto, est.RutaFoto},
+		{transfer.DocumentoEvalPsicopedagogica, rutaEval},
+		{transfer.DocumentoCroquis, m.RutaCroquis},
+		{transfer.DocumentoConsentimiento, m.RutaConsentimiento},
+	}
+	for _, d := range documentos {
+		if d.ruta == "" {
+			continue
+		}
+		data, err := os.ReadFile(d.ruta)
+		if err != nil {
+			// Un archivo que ya no existe en disco no impide transferir el resto
+			continue
+		}
+		archivo := "documentos/" + d.tipo + strings.ToLower(filepath.Ext(d.ruta))
+		w, err := zw.Create(archivo)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(data)
+		exp.Documentos = append(exp.Documentos, transfer.DocumentoExpediente{
+			Tipo:       d.tipo,
+			Nombre:     filepath.Base(d.ruta),
+			Archivo:    archivo,
+			HashSHA256: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	manifiesto, err := json.MarshalIndent(exp, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	w, err := zw.Create("expediente.json")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(manifiesto); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	clave, err := dossier.GenerarClaveTransferencia()
+	if err != nil {
+		return nil, err
+	}
+	sellado, err := dossier.Sellar(buf.Bytes(), clave, dossier.Emisor{
+		Institucion:  conf.Nombre,
+		CodigoAMIE:   conf.CodigoAMIE,
+		ClavePublica: id.ClavePublica,
+		Huella:       id.Huella,
+	}, id.ClavePrivada)
+	if err != nil {
+		return nil, fmt.Errorf("Error al cifrar el expediente: %v", err)
+	}
+
+	if err := os.WriteFile(destino, sellado, 0644); err != nil {
+		return nil, fmt.Errorf("Error al guardar el expediente: %v", err)
+	}
+
+	registro := transfer.ExpedienteExportado{
+		CodigoExpediente:   exp.CodigoExpediente,
+		EstudianteID:       est.ID,
+		MatriculaID:        m.ID,
+		InstitucionDestino: exp.InstitucionDestino,
+		RutaArchivo:        destino,
+		FechaExportacion:   exp.FechaExportacion,
+	}
+	if usuario, err := s.auth.ObtenerUsuarioSesion(); err == nil {
+		registro.UsuarioID = usuario.ID
+		registro.NombreUsuario = usuario.NombreCompleto
+	}
+	s.db.Create(&registro)
+
+	return &transferDTO.ResultadoExportacionDTO{
+		RutaArchivo:        destino,
+		CodigoExpediente:   exp.CodigoExpediente,
+		ClaveTransferencia: clave,
+		HuellaEmisor:       id.Huella,
+		TotalDocumentos:    len(exp.Documentos),
+	}, nil
+}
+
+func nombreCompleto(e student.Estudiante) string {
+	return strings.TrimSpace(e.Apellidos + " " + e.Nombres)
+}
@@ -14,6 +14,13 @@ type NivelEducativo struct {
 	Nombre         string `gorm:"unique;not null" json:"nombre"`
 	NombreCompleto string `gorm:"not null" json:"nombre_completo"`
 	Orden          int    `json:"orden"`
+
+	// Rango de edad esperado (en años cumplidos) para cursar el nivel; 0 si no está configurado.
+	EdadMinima int `json:"edad_minima"`
+	EdadMaxima int `json:"edad_maxima"`
+
+	// Cupo máximo por defecto de cada curso del nivel; 0 sin límite.
+	CapacidadPorCurso int `json:"capacidad_por_curso"`
 }
 
 type Materia struct {
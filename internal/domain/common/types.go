@@ -15,6 +15,12 @@ func (j JSONMap[T]) Value() (driver.Value, error) {
 }
 
 func (j *JSONMap[T]) Scan(value any) error {
+	// Las columnas agregadas a tablas existentes quedan en NULL en los registros anteriores
+	if value == nil {
+		var vacio T
+		j.Data = vacio
+		return nil
+	}
 	bytes, ok := value.([]byte)
 	if !ok {
 		asString, okString := value.(string)
@@ -0,0 +1,35 @@
+package documents
+
+const (
+	EntidadEstudiante      = "estudiante"
+	EntidadLlamadoAtencion = "llamado_atencion"
+	EntidadCasoSensible    = "caso_sensible"
+	EntidadRemision        = "remision"
+	EntidadIncidente       = "incidente_enfermeria"
+	EntidadConsentimiento  = "consentimiento"
+)
+
+// VersionDocumento registra cada archivo subido a una ficha. Al reemplazar un documento la
+// versión anterior deja de estar vigente pero su archivo se conserva en disco.
+type VersionDocumento struct {
+	ID            uint   `gorm:"primaryKey" json:"id"`
+	Entidad       string `gorm:"index:idx_versiones_documento;not null" json:"entidad"`
+	EntidadID     uint   `gorm:"index:idx_versiones_documento;not null" json:"entidad_id"`
+	TipoDocumento string `gorm:"index:idx_versiones_documento;not null" json:"tipo_documento"`
+	Version       int    `json:"version"`
+	Vigente       bool   `json:"vigente"`
+
+	Nombre      string `json:"nombre"`
+	Ruta        string `json:"ruta"`
+	TamanoBytes int64  `json:"tamano_bytes"`
+	HashSHA256  string `json:"hash_sha256"`
+
+	UsuarioID     uint   `json:"usuario_id"`
+	NombreUsuario string `json:"nombre_usuario"`
+	FechaSubida   string `json:"fecha_subida"`
+	FechaRetiro   string `json:"fecha_retiro"`
+}
+
+func (VersionDocumento) TableName() string {
+	return "versiones_documentos"
+}
@@ -0,0 +1,32 @@
+package enrollment
+
+import "fmt"
+
+// CambioCurso registra el paso de una matrícula a otro curso del mismo periodo. Desde Fecha el
+// estudiante pertenece a CursoNuevoID; lo ocurrido antes se atribuye a CursoAnteriorID.
+type CambioCurso struct {
+	ID              uint   `gorm:"primaryKey" json:"id"`
+	MatriculaID     uint   `gorm:"index:idx_cambio_curso_matricula_fecha,priority:1;not null" json:"matricula_id"`
+	CursoAnteriorID uint   `gorm:"not null" json:"curso_anterior_id"`
+	CursoNuevoID    uint   `gorm:"not null" json:"curso_nuevo_id"`
+	Fecha           string `gorm:"index:idx_cambio_curso_matricula_fecha,priority:2;not null" json:"fecha"`
+	Motivo          string `json:"motivo"`
+	UsuarioID       uint   `json:"usuario_id"`
+	NombreUsuario   string `json:"nombre_usuario"`
+	FechaRegistro   string `json:"fecha_registro"`
+}
+
+func (CambioCurso) TableName() string {
+	return "cambios_curso"
+}
+
+// CursoEnFechaSQL arma la expresión SQL del curso en que estaba la matrícula (alias de la tabla
+// matriculas) en la fecha dada (columna o expresión): el curso anterior del primer cambio
+// posterior a esa fecha o, si no lo hubo, el curso actual. Los reportes por curso la usan en
+// lugar de matriculas.curso_id para no mover el historial con el estudiante.
+func CursoEnFechaSQL(matricula, fecha string) string {
+	return fmt.Sprintf(`COALESCE((
+		SELECT cc.curso_anterior_id FROM cambios_curso cc
+		WHERE cc.matricula_id = %[1]s.id AND cc.fecha > substr(%[2]s, 1, 10)
+		ORDER BY cc.fecha ASC, cc.id ASC LIMIT 1), %[1]s.curso_id)`, matricula, fecha)
+}
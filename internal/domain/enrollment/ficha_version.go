@@ -0,0 +1,164 @@
+package enrollment
+
+import (
+	"dece/internal/domain/common"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Bloques JSON de la ficha DECE que se versionan. El nombre coincide con la columna de matriculas.
+const (
+	BloqueAntropometria      = "antropometria"
+	BloqueHistorialAcademico = "historial_academico"
+	BloqueDatosSalud         = "datos_salud"
+	BloqueDatosSociales      = "datos_sociales"
+	BloqueCondicionGenero    = "condicion_genero"
+)
+
+var BloquesFicha = []string{
+	BloqueAntropometria,
+	BloqueHistorialAcademico,
+	BloqueDatosSalud,
+	BloqueDatosSociales,
+	BloqueCondicionGenero,
+}
+
+func EsBloqueFicha(bloque string) bool {
+	for _, b := range BloquesFicha {
+		if b == bloque {
+			return true
+		}
+	}
+	return false
+}
+
+// CambioCampo es una diferencia entre dos versiones de un bloque. Campo es la ruta del dato
+// ("materias_favoritas[0].nombre"); Anterior o Nuevo son nil si el dato no existía.
+type CambioCampo struct {
+	Campo    string `json:"campo"`
+	Anterior any    `json:"anterior"`
+	Nuevo    any    `json:"nuevo"`
+}
+
+// VersionFicha guarda el contenido completo de un bloque de la ficha cada vez que cambia, con
+// las diferencias respecto a la versión anterior del mismo bloque.
+type VersionFicha struct {
+	ID            uint                          `gorm:"primaryKey" json:"id"`
+	MatriculaID   uint                          `gorm:"index:idx_version_ficha_matricula_bloque,priority:1;not null" json:"matricula_id"`
+	Bloque        string                        `gorm:"index:idx_version_ficha_matricula_bloque,priority:2;not null" json:"bloque"`
+	Version       int                           `gorm:"not null" json:"version"`
+	Contenido     string                        `gorm:"type:text" json:"contenido"`
+	Cambios       common.JSONMap[[]CambioCampo] `gorm:"type:text" json:"cambios"`
+	UsuarioID     uint                          `json:"usuario_id"`
+	NombreUsuario string                        `json:"nombre_usuario"`
+	FechaRegistro string                        `gorm:"not null" json:"fecha_registro"`
+}
+
+func (VersionFicha) TableName() string {
+	return "versiones_ficha"
+}
+
+// ContenidoBloques serializa los bloques de la ficha de la matrícula, por nombre de bloque.
+func (m Matricula) ContenidoBloques() (map[string]string, error) {
+	datos := map[string]any{
+		BloqueAntropometria:      m.Antropometria.Data,
+		BloqueHistorialAcademico: m.HistorialAcademico.Data,
+		BloqueDatosSalud:         m.DatosSalud.Data,
+		BloqueDatosSociales:      m.DatosSociales.Data,
+		BloqueCondicionGenero:    m.CondicionGenero.Data,
+	}
+	contenido := make(map[string]string, len(datos))
+	for bloque, d := range datos {
+		b, err := json.Marshal(d)
+		if err != nil {
+			return nil, fmt.Errorf("Error al serializar %s: %v", bloque, err)
+		}
+		contenido[bloque] = string(b)
+	}
+	return contenido, nil
+}
+
+// DiferenciasBloque compara dos contenidos JSON de un bloque campo por campo. Un contenido
+// vacío equivale a un bloque inexistente, así la primera versión lista todos sus datos.
+func DiferenciasBloque(anterior, nuevo string) ([]CambioCampo, error) {
+	antes, err := aplanarJSON(anterior)
+	if err != nil {
+		return nil, err
+	}
+	despues, err := aplanarJSON(nuevo)
+	if err != nil {
+		return nil, err
+	}
+
+	campos := map[string]bool{}
+	for c := range antes {
+		campos[c] = true
+	}
+	for c := range despues {
+		campos[c] = true
+	}
+
+	cambios := []CambioCampo{}
+	for c := range campos {
+		a, okA := antes[c]
+		n, okN := despues[c]
+		if okA && okN && reflect.DeepEqual(a, n) {
+			continue
+		}
+		// Un dato vacío que aparece o desaparece no es un cambio que interese mostrar
+		if (!okA && esVacio(n)) || (!okN && esVacio(a)) {
+			continue
+		}
+		cambios = append(cambios, CambioCampo{Campo: c, Anterior: a, Nuevo: n})
+	}
+	sort.Slice(cambios, func(i, j int) bool { return cambios[i].Campo < cambios[j].Campo })
+	return cambios, nil
+}
+
+func esVacio(v any) bool {
+	switch x := v.(type) {
+	case string:
+		return x == ""
+	case bool:
+		return !x
+	case float64:
+		return x == 0
+	}
+	return false
+}
+
+func aplanarJSON(contenido string) (map[string]any, error) {
+	valores := map[string]any{}
+	if contenido == "" {
+		return valores, nil
+	}
+	var raiz any
+	if err := json.Unmarshal([]byte(contenido), &raiz); err != nil {
+		return nil, fmt.Errorf("Contenido de la ficha inválido: %v", err)
+	}
+	aplanar("", raiz, valores)
+	return valores, nil
+}
+
+func aplanar(ruta string, valor any, valores map[string]any) {
+	switch v := valor.(type) {
+	case map[string]any:
+		for k, hijo := range v {
+			if ruta == "" {
+				aplanar(k, hijo, valores)
+			} else {
+				aplanar(ruta+"."+k, hijo, valores)
+			}
+		}
+	case []any:
+		for i, hijo := range v {
+			aplanar(fmt.Sprintf("%s[%d]", ruta, i), hijo, valores)
+		}
+	case nil:
+		// Un dato nulo equivale a uno ausente, igual que una lista vacía
+	default:
+		valores[ruta] = v
+	}
+}
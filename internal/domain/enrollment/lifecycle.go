@@ -0,0 +1,68 @@
+package enrollment
+
+const (
+	EstadoMatriculado = "Matriculado"
+	EstadoRetirado    = "Retirado"
+	EstadoTrasladado  = "Trasladado"
+	EstadoDesertor    = "Desertor"
+	EstadoPromovido   = "Promovido"
+	EstadoNoPromovido = "No Promovido"
+	EstadoReingreso   = "Reingreso"
+)
+
+// transicionesMatricula lista, por estado actual, los estados a los que puede pasar una
+// matrícula. El reingreso se comporta como una matrícula vigente; la promoción es el cierre
+// del año y solo admite rectificar un "No Promovido" tras los exámenes supletorios.
+var transicionesMatricula = map[string][]string{
+	EstadoMatriculado: {EstadoRetirado, EstadoTrasladado, EstadoDesertor, EstadoPromovido, EstadoNoPromovido},
+	EstadoReingreso:   {EstadoRetirado, EstadoTrasladado, EstadoDesertor, EstadoPromovido, EstadoNoPromovido},
+	EstadoRetirado:    {EstadoReingreso},
+	EstadoTrasladado:  {EstadoReingreso},
+	EstadoDesertor:    {EstadoReingreso},
+	EstadoNoPromovido: {EstadoPromovido},
+	EstadoPromovido:   {},
+}
+
+// EstadosVigentes son los estados en los que el estudiante asiste a clases.
+var EstadosVigentes = []string{EstadoMatriculado, EstadoReingreso}
+
+// EstadosConcluidos son los estados que cuentan como matriculado hasta el fin del periodo: el
+// estudiante terminó el año aunque ya tenga resultado.
+var EstadosConcluidos = []string{EstadoPromovido, EstadoNoPromovido}
+
+func EsEstadoMatricula(estado string) bool {
+	_, ok := transicionesMatricula[estado]
+	return ok
+}
+
+func PuedeTransicionar(desde, hacia string) bool {
+	for _, e := range transicionesMatricula[desde] {
+		if e == hacia {
+			return true
+		}
+	}
+	return false
+}
+
+func TransicionesPermitidas(desde string) []string {
+	return append([]string{}, transicionesMatricula[desde]...)
+}
+
+// TransicionMatricula registra cada cambio de estado. EstadoAnterior vacío marca el alta de la
+// matrícula. Fecha es la fecha efectiva del cambio, que puede ser anterior al registro.
+type TransicionMatricula struct {
+	ID             uint   `gorm:"primaryKey" json:"id"`
+	MatriculaID    uint   `gorm:"index:idx_transicion_matricula_fecha,priority:1;not null" json:"matricula_id"`
+	EstadoAnterior string `json:"estado_anterior"`
+	EstadoNuevo    string `gorm:"not null" json:"estado_nuevo"`
+	Fecha          string `gorm:"index:idx_transicion_matricula_fecha,priority:2;not null" json:"fecha"`
+	Motivo         string `json:"motivo"`
+	RutaDocumento  string `json:"ruta_documento"`
+	UsuarioID      uint   `json:"usuario_id"`
+	NombreUsuario  string `json:"nombre_usuario"`
+	FechaRegistro  string `json:"fecha_registro"`
+}
+
+func (TransicionMatricula) TableName() string {
+	return "transiciones_matricula"
+}
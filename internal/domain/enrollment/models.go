@@ -48,6 +48,68 @@ type DatosSalud struct {
 
 	TieneEnfermedad   bool   `json:"tiene_enfermedad"`
 	DetalleEnfermedad string `json:"detalle_enfermedad"`
+
+	// Datos estructurados para emergencias. Los Detalle* anteriores quedan como texto libre de
+	// las fichas que aún no se han completado con estas listas.
+	Alergias            []Alergia            `json:"alergias"`
+	CondicionesCronicas []CondicionCronica   `json:"condiciones_cronicas"`
+	Medicaciones        []Medicacion         `json:"medicaciones"`
+	ContactosEmergencia []ContactoEmergencia `json:"contactos_emergencia"`
+	SeguroMedico        SeguroMedico         `json:"seguro_medico"`
+}
+
+const (
+	SeveridadLeve       = "Leve"
+	SeveridadModerada   = "Moderada"
+	SeveridadGrave      = "Grave"
+	SeveridadAnafilaxia = "Anafilaxia"
+)
+
+var SeveridadesAlergia = []string{SeveridadLeve, SeveridadModerada, SeveridadGrave, SeveridadAnafilaxia}
+
+type Alergia struct {
+	Alergeno  string `json:"alergeno"`
+	Severidad string `json:"severidad"`
+	Reaccion  string `json:"reaccion"`
+	// Qué hacer ante una exposición (p. ej. "aplicar epinefrina y llamar al 911")
+	Actuacion string `json:"actuacion"`
+}
+
+// EsCritica indica si la alergia puede poner en riesgo la vida.
+func (a Alergia) EsCritica() bool {
+	return a.Severidad == SeveridadGrave || a.Severidad == SeveridadAnafilaxia
+}
+
+type CondicionCronica struct {
+	Nombre    string `json:"nombre"`
+	Actuacion string `json:"actuacion"`
+}
+
+type Medicacion struct {
+	Medicamento string `json:"medicamento"`
+	Dosis       string `json:"dosis"`
+	Frecuencia  string `json:"frecuencia"`
+	// La institución debe administrarla o tenerla disponible durante la jornada
+	EnInstitucion bool `json:"en_institucion"`
+}
+
+type ContactoEmergencia struct {
+	Nombres    string `json:"nombres"`
+	Parentesco string `json:"parentesco"`
+	Telefono   string `json:"telefono"`
+}
+
+type SeguroMedico struct {
+	TieneSeguro  bool   `json:"tiene_seguro"`
+	Aseguradora  string `json:"aseguradora"`
+	NumeroPoliza string `json:"numero_poliza"`
+	Telefono     string `json:"telefono"`
+}
+
+// EsNEE indica si el estudiante tiene necesidades educativas especiales registradas: una
+// discapacidad o una evaluación psicopedagógica.
+func (d DatosSalud) EsNEE() bool {
+	return d.TieneDiscapacidad || d.TieneEvalPsicopedagogica
 }
 
 type DatosSociales struct {
@@ -55,6 +117,19 @@ type DatosSociales struct {
 	PracticaActividad bool     `json:"practica_actividad"`
 }
 
+// UbicacionDomicilio complementa DireccionActual con la parroquia y el barrio (como la
+// ubicación de la institución) y, si se conocen, las coordenadas de la vivienda.
+type UbicacionDomicilio struct {
+	Parroquia     string   `json:"parroquia"`
+	BarrioRecinto string   `json:"barrio_recinto"`
+	Latitud       *float64 `json:"latitud"`
+	Longitud      *float64 `json:"longitud"`
+}
+
+func (u UbicacionDomicilio) TieneCoordenadas() bool {
+	return u.Latitud != nil && u.Longitud != nil
+}
+
 type InfoPadresPareja struct {
 	Nombres    string `json:"nombres"`
 	Apellidos  string `json:"apellidos"`
@@ -98,8 +173,8 @@ type CondicionGenero struct {
 
 type Matricula struct {
 	ID           uint `gorm:"primaryKey" json:"id"`
-	EstudianteID uint `json:"estudiante_id"`
-	CursoID      uint `json:"curso_id"`
+	EstudianteID uint `gorm:"index" json:"estudiante_id"`
+	CursoID      uint `gorm:"index" json:"curso_id"`
 
 	Estado      string `gorm:"default:'Matriculado'" json:"estado"`
 	EsRepetidor bool   `json:"es_repetidor"`
@@ -110,10 +185,11 @@ type Matricula struct {
 	DatosSociales      common.JSONMap[DatosSociales]      `gorm:"type:text" json:"datos_sociales"`
 	CondicionGenero    common.JSONMap[CondicionGenero]    `gorm:"type:text" json:"condicion_genero"`
 
-	DireccionActual    string `json:"direccion_actual"`
-	RutaCroquis        string `json:"ruta_croquis"`
-	RutaConsentimiento string `json:"ruta_consentimiento"`
-	FechaRegistro      string `json:"fecha_registro"`
+	DireccionActual    string                             `json:"direccion_actual"`
+	Ubicacion          common.JSONMap[UbicacionDomicilio] `gorm:"type:text" json:"ubicacion"`
+	RutaCroquis        string                             `json:"ruta_croquis"`
+	RutaConsentimiento string                             `json:"ruta_consentimiento"`
+	FechaRegistro      string                             `json:"fecha_registro"`
 
 	Estudiante student.Estudiante `gorm:"foreignKey:EstudianteID" json:"estudiante,omitempty"`
 	Curso      faculty.Curso      `gorm:"foreignKey:CursoID" json:"curso,omitempty"`
@@ -121,7 +197,7 @@ type Matricula struct {
 
 type RetiroEstudiante struct {
 	ID               uint   `gorm:"primaryKey" json:"id"`
-	MatriculaID      uint   `json:"matricula_id"`
+	MatriculaID      uint   `gorm:"index" json:"matricula_id"`
 	FechaRetiro      string `json:"fecha_retiro"`
 	Motivo           string `json:"motivo"`
 	NuevaInstitucion string `json:"nueva_institucion"`
@@ -130,3 +206,27 @@ type RetiroEstudiante struct {
 
 	Matricula Matricula `gorm:"foreignKey:MatriculaID" json:"matricula,omitempty"`
 }
+
+// Reincorporacion documenta el regreso de un estudiante retirado. El retiro se conserva como
+// historial; MatriculaID es la misma matrícula reabierta o, si regresa en otro periodo, la nueva.
+type Reincorporacion struct {
+	ID                  uint   `gorm:"primaryKey" json:"id"`
+	MatriculaAnteriorID uint   `gorm:"index;not null" json:"matricula_anterior_id"`
+	MatriculaID         uint   `gorm:"index;not null" json:"matricula_id"`
+	RetiroID            *uint  `json:"retiro_id"`
+	CursoAnteriorID     uint   `json:"curso_anterior_id"`
+	CursoID             uint   `json:"curso_id"`
+	EstadoAnterior      string `json:"estado_anterior"`
+	Fecha               string `json:"fecha"`
+	Motivo              string `json:"motivo"`
+	Compromisos         string `json:"compromisos"`
+	RutaDocumento       string `json:"ruta_documento"`
+	ConvocatoriaID      *uint  `json:"convocatoria_id"`
+	UsuarioID           uint   `json:"usuario_id"`
+	NombreUsuario       string `json:"nombre_usuario"`
+	FechaRegistro       string `json:"fecha_registro"`
+}
+
+func (Reincorporacion) TableName() string {
+	return "reincorporaciones"
+}
@@ -13,15 +13,27 @@ type Docente struct {
 	Activo           bool   `gorm:"default:true" json:"activo"`
 }
 
+// CapacidadEfectiva es el cupo que rige para el curso: el propio o, si no tiene, el del nivel
+// (requiere Nivel cargado). 0 significa sin límite.
+func (c Curso) CapacidadEfectiva() int {
+	if c.Capacidad > 0 {
+		return c.Capacidad
+	}
+	return c.Nivel.CapacidadPorCurso
+}
+
 type Curso struct {
 	ID        uint  `gorm:"primaryKey" json:"id"`
-	PeriodoID uint  `json:"periodo_id"`
-	NivelID   uint  `json:"nivel_id"`
-	TutorID   *uint `json:"tutor_id"`
+	PeriodoID uint  `gorm:"index" json:"periodo_id"`
+	NivelID   uint  `gorm:"index" json:"nivel_id"`
+	TutorID   *uint `gorm:"index" json:"tutor_id"`
 
 	Paralelo string `json:"paralelo"`
 	Jornada  string `json:"jornada"`
 
+	// Cupo máximo de estudiantes vigentes; 0 usa el del nivel.
+	Capacidad int `json:"capacidad"`
+
 	Periodo academic.PeriodoLectivo `gorm:"foreignKey:PeriodoID" json:"periodo,omitempty"`
 	Nivel   academic.NivelEducativo `gorm:"foreignKey:NivelID" json:"nivel,omitempty"`
 	Tutor   *Docente                `gorm:"foreignKey:TutorID" json:"tutor,omitempty"`
@@ -29,9 +41,9 @@ type Curso struct {
 
 type DistributivoMateria struct {
 	ID        uint `gorm:"primaryKey" json:"id"`
-	CursoID   uint `json:"curso_id"`
-	MateriaID uint `json:"materia_id"`
-	DocenteID uint `json:"docente_id"`
+	CursoID   uint `gorm:"index" json:"curso_id"`
+	MateriaID uint `gorm:"index" json:"materia_id"`
+	DocenteID uint `gorm:"index" json:"docente_id"`
 
 	Curso   Curso            `gorm:"foreignKey:CursoID" json:"curso,omitempty"`
 	Materia academic.Materia `gorm:"foreignKey:MateriaID" json:"materia,omitempty"`
@@ -0,0 +1,67 @@
+package health
+
+import (
+	"dece/internal/domain/common"
+	"dece/internal/domain/tracking"
+)
+
+// Tipos de incidente atendidos en la enfermería o por el DECE
+const (
+	TipoIncidenteAccidente = "Accidente"
+	TipoIncidenteAgresion  = "Agresión / pelea"
+	TipoIncidenteMalestar  = "Malestar o enfermedad"
+	TipoIncidenteCrisis    = "Crisis emocional"
+	TipoIncidenteOtro      = "Otro"
+)
+
+const (
+	GravedadIncidenteLeve     = "Leve"
+	GravedadIncidenteModerada = "Moderada"
+	GravedadIncidenteGrave    = "Grave"
+)
+
+var TiposIncidente = []string{TipoIncidenteAccidente, TipoIncidenteAgresion, TipoIncidenteMalestar, TipoIncidenteCrisis, TipoIncidenteOtro}
+
+var GravedadesIncidente = []string{GravedadIncidenteLeve, GravedadIncidenteModerada, GravedadIncidenteGrave}
+
+// LugaresIncidente y MediosNotificacion son sugerencias para el formulario; se guardan como
+// texto libre.
+var LugaresIncidente = []string{"Aula", "Patio", "Canchas", "Baños", "Escaleras y pasillos", "Laboratorio", "Bar", "Entrada o salida", "Transporte escolar", "Salida pedagógica", "Otro"}
+
+var MediosNotificacion = []string{"Llamada telefónica", "Mensaje", "Comunicado escrito", "En persona", "Otro"}
+
+// IncidenteEnfermeria es una atención de enfermería o primeros auxilios. Pertenece a la
+// matrícula vigente y puede vincularse al llamado de atención cuando surge de una pelea.
+type IncidenteEnfermeria struct {
+	ID                uint  `gorm:"primaryKey" json:"id"`
+	MatriculaID       uint  `gorm:"index;not null" json:"matricula_id"`
+	LlamadoAtencionID *uint `gorm:"index" json:"llamado_atencion_id"`
+
+	Fecha            string `gorm:"index;not null" json:"fecha"`
+	Hora             string `json:"hora"`
+	Lugar            string `json:"lugar"`
+	Tipo             string `json:"tipo"`
+	Gravedad         string `json:"gravedad"`
+	Descripcion      string `json:"descripcion"`
+	PrimerosAuxilios string `json:"primeros_auxilios"`
+	AtendidoPor      string `json:"atendido_por"`
+
+	RepresentanteNotificado bool   `json:"representante_notificado"`
+	MedioNotificacion       string `json:"medio_notificacion"`
+	HoraNotificacion        string `json:"hora_notificacion"`
+	PersonaNotificada       string `json:"persona_notificada"`
+
+	DerivadoCentroSalud bool   `json:"derivado_centro_salud"`
+	CentroSalud         string `json:"centro_salud"`
+	Traslado            string `json:"traslado"`
+
+	Adjuntos common.JSONMap[[]tracking.Evidencia] `gorm:"type:text" json:"adjuntos"`
+
+	UsuarioID     uint   `json:"usuario_id"`
+	NombreUsuario string `json:"nombre_usuario"`
+	FechaRegistro string `json:"fecha_registro"`
+}
+
+func (IncidenteEnfermeria) TableName() string {
+	return "incidentes_enfermeria"
+}
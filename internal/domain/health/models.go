@@ -0,0 +1,20 @@
+package health
+
+// MedicionAntropometrica es una toma de peso y talla con su fecha. La edad, el puntaje z y la
+// clasificación no se guardan: se calculan al consultar con la referencia de la OMS vigente.
+type MedicionAntropometrica struct {
+	ID            uint    `gorm:"primaryKey" json:"id"`
+	MatriculaID   uint    `gorm:"index:idx_medicion_matricula_fecha,priority:1;not null" json:"matricula_id"`
+	Fecha         string  `gorm:"index:idx_medicion_matricula_fecha,priority:2;not null" json:"fecha"`
+	PesoKg        float64 `gorm:"not null" json:"peso_kg"`
+	TallaCm       float64 `gorm:"not null" json:"talla_cm"`
+	IMC           float64 `gorm:"column:imc" json:"imc"`
+	Observacion   string  `json:"observacion"`
+	UsuarioID     uint    `json:"usuario_id"`
+	NombreUsuario string  `json:"nombre_usuario"`
+	FechaRegistro string  `json:"fecha_registro"`
+}
+
+func (MedicionAntropometrica) TableName() string {
+	return "mediciones_antropometricas"
+}
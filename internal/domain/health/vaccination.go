@@ -0,0 +1,207 @@
+package health
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Origen del registro de una dosis o un control
+const (
+	OrigenManual      = "Manual"
+	OrigenImportacion = "Importación"
+)
+
+// Estado de una dosis del esquema para la edad del estudiante
+const (
+	EstadoDosisAplicada  = "Aplicada"
+	EstadoDosisPendiente = "Pendiente"
+	EstadoDosisProxima   = "Próxima"
+)
+
+// Vacuna del catálogo. Alias guarda otros nombres o abreviaturas separados por coma, para
+// reconocer la vacuna en las hojas de las brigadas de salud.
+type Vacuna struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	Nombre      string `gorm:"unique;not null" json:"nombre"`
+	Alias       string `json:"alias"`
+	Descripcion string `json:"descripcion"`
+	Activa      bool   `gorm:"not null" json:"activa"`
+}
+
+func (Vacuna) TableName() string {
+	return "vacunas"
+}
+
+// EsquemaVacuna es una dosis exigible desde una edad. Sexo vacío aplica a todos; "M" o "F"
+// limita la dosis a ese sexo.
+type EsquemaVacuna struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	VacunaID    uint   `gorm:"uniqueIndex:idx_esquema_vacuna_dosis,priority:1;not null" json:"vacuna_id"`
+	NumeroDosis int    `gorm:"uniqueIndex:idx_esquema_vacuna_dosis,priority:2;not null" json:"numero_dosis"`
+	EdadMeses   int    `gorm:"not null" json:"edad_meses"`
+	Sexo        string `json:"sexo"`
+	Descripcion string `json:"descripcion"`
+
+	Vacuna Vacuna `gorm:"foreignKey:VacunaID" json:"vacuna,omitempty"`
+}
+
+func (EsquemaVacuna) TableName() string {
+	return "esquema_vacunas"
+}
+
+// AplicaA indica si la dosis corresponde al sexo del estudiante.
+func (e EsquemaVacuna) AplicaA(sexo string) bool {
+	return e.Sexo == "" || strings.EqualFold(e.Sexo, sexo)
+}
+
+// EsRefuerzo reconoce las dosis del esquema descritas como refuerzo.
+func (e EsquemaVacuna) EsRefuerzo() bool {
+	return strings.Contains(strings.ToLower(e.Descripcion), "refuerzo")
+}
+
+// Etiqueta nombra la dosis en listados y reportes, p. ej. "DPT dosis 2 (Refuerzo 2)". Requiere
+// la vacuna precargada.
+func (e EsquemaVacuna) Etiqueta() string {
+	etiqueta := e.Vacuna.Nombre + " dosis " + strconv.Itoa(e.NumeroDosis)
+	if e.Descripcion != "" {
+		etiqueta += " (" + e.Descripcion + ")"
+	}
+	return etiqueta
+}
+
+// DosisVacuna es una dosis aplicada al estudiante. Las vacunas no dependen de la matrícula:
+// acompañan al estudiante en todos los periodos.
+type DosisVacuna struct {
+	ID              uint   `gorm:"primaryKey" json:"id"`
+	EstudianteID    uint   `gorm:"uniqueIndex:idx_dosis_estudiante_vacuna,priority:1;not null" json:"estudiante_id"`
+	VacunaID        uint   `gorm:"uniqueIndex:idx_dosis_estudiante_vacuna,priority:2;not null" json:"vacuna_id"`
+	NumeroDosis     int    `gorm:"uniqueIndex:idx_dosis_estudiante_vacuna,priority:3;not null" json:"numero_dosis"`
+	Fecha           string `gorm:"not null" json:"fecha"`
+	Lote            string `json:"lote"`
+	Establecimiento string `json:"establecimiento"`
+	Observacion     string `json:"observacion"`
+	Origen          string `json:"origen"`
+	UsuarioID       uint   `json:"usuario_id"`
+	NombreUsuario   string `json:"nombre_usuario"`
+	FechaRegistro   string `json:"fecha_registro"`
+}
+
+func (DosisVacuna) TableName() string {
+	return "dosis_vacunas"
+}
+
+// TipoControlMedico es un control periódico exigido por los programas de salud escolar. Se
+// considera al día si el último control tiene menos de PeriodicidadMeses.
+type TipoControlMedico struct {
+	ID                uint   `gorm:"primaryKey" json:"id"`
+	Nombre            string `gorm:"unique;not null" json:"nombre"`
+	Alias             string `json:"alias"`
+	PeriodicidadMeses int    `gorm:"not null" json:"periodicidad_meses"`
+	Activo            bool   `gorm:"not null" json:"activo"`
+}
+
+func (TipoControlMedico) TableName() string {
+	return "tipos_control_medico"
+}
+
+// AlDia indica si un control con la fecha dada (AAAA-MM-DD) sigue vigente a la fecha de corte.
+// Sin periodicidad basta con un control registrado.
+func (t TipoControlMedico) AlDia(ultimaFecha, fechaCorte string) bool {
+	ultima, err := time.Parse("2006-01-02", ultimaFecha)
+	if err != nil {
+		return false
+	}
+	if t.PeriodicidadMeses <= 0 {
+		return true
+	}
+	corte, err := time.Parse("2006-01-02", fechaCorte)
+	if err != nil {
+		return false
+	}
+	return ultima.After(corte.AddDate(0, -t.PeriodicidadMeses, 0))
+}
+
+type ControlMedico struct {
+	ID              uint   `gorm:"primaryKey" json:"id"`
+	EstudianteID    uint   `gorm:"index:idx_control_estudiante_tipo,priority:1;not null" json:"estudiante_id"`
+	TipoControlID   uint   `gorm:"index:idx_control_estudiante_tipo,priority:2;not null" json:"tipo_control_id"`
+	Fecha           string `gorm:"not null" json:"fecha"`
+	Profesional     string `json:"profesional"`
+	Establecimiento string `json:"establecimiento"`
+	Resultado       string `json:"resultado"`
+	Observacion     string `json:"observacion"`
+	Origen          string `json:"origen"`
+	UsuarioID       uint   `json:"usuario_id"`
+	NombreUsuario   string `json:"nombre_usuario"`
+	FechaRegistro   string `json:"fecha_registro"`
+
+	TipoControl TipoControlMedico `gorm:"foreignKey:TipoControlID" json:"tipo_control,omitempty"`
+}
+
+func (ControlMedico) TableName() string {
+	return "controles_medicos"
+}
+
+// DosisEvaluada es una dosis del esquema con su estado para el estudiante. Aplicada es nil si
+// la dosis no está registrada.
+type DosisEvaluada struct {
+	Esquema  EsquemaVacuna
+	Estado   string
+	Aplicada *DosisVacuna
+}
+
+// EvaluarEsquema compara las dosis aplicadas con el esquema que corresponde al sexo del
+// estudiante: las exigibles a su edad que no están registradas quedan pendientes.
+func EvaluarEsquema(esquema []EsquemaVacuna, aplicadas []DosisVacuna, edadMeses float64, sexo string) []DosisEvaluada {
+	type clave struct {
+		vacuna uint
+		dosis  int
+	}
+	porDosis := make(map[clave]*DosisVacuna, len(aplicadas))
+	for i := range aplicadas {
+		porDosis[clave{aplicadas[i].VacunaID, aplicadas[i].NumeroDosis}] = &aplicadas[i]
+	}
+
+	evaluadas := make([]DosisEvaluada, 0, len(esquema))
+	for _, e := range esquema {
+		if !e.AplicaA(sexo) {
+			continue
+		}
+		d := DosisEvaluada{Esquema: e, Aplicada: porDosis[clave{e.VacunaID, e.NumeroDosis}]}
+		switch {
+		case d.Aplicada != nil:
+			d.Estado = EstadoDosisAplicada
+		case float64(e.EdadMeses) <= edadMeses:
+			d.Estado = EstadoDosisPendiente
+		default:
+			d.Estado = EstadoDosisProxima
+		}
+		evaluadas = append(evaluadas, d)
+	}
+	return evaluadas
+}
+
+// CoincideNombre compara un texto de una hoja importada con el nombre o los alias, sin
+// distinguir mayúsculas ni tildes.
+func CoincideNombre(texto, nombre, alias string) bool {
+	buscado := normalizarNombre(texto)
+	if buscado == "" {
+		return false
+	}
+	if buscado == normalizarNombre(nombre) {
+		return true
+	}
+	for _, a := range strings.Split(alias, ",") {
+		if buscado == normalizarNombre(a) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizarNombre(valor string) string {
+	valor = strings.ToLower(strings.TrimSpace(valor))
+	valor = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ñ", "n").Replace(valor)
+	return strings.Join(strings.Fields(valor), " ")
+}
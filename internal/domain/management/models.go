@@ -8,14 +8,14 @@ import (
 
 type Convocatoria struct {
 	ID          uint   `gorm:"primaryKey" json:"id"`
-	MatriculaID uint   `json:"matricula_id"`
+	MatriculaID uint   `gorm:"index" json:"matricula_id"`
 	Entidad     string `json:"entidad"`
 
 	Motivo string `json:"motivo"`
 
-	FechaCita      string `json:"fecha_cita"`
+	FechaCita      string `gorm:"index:idx_convocatoria_pendientes,priority:2" json:"fecha_cita"`
 	DiasAlerta     int    `json:"dias_alerta"`
-	CitaCompletada bool   `json:"cita_completada"`
+	CitaCompletada bool   `gorm:"index:idx_convocatoria_pendientes,priority:1" json:"cita_completada"`
 	TelegramSynced bool   `json:"telegram_synced" gorm:"default:false"`
 	TelegramID     int    `json:"telegram_id" gorm:"default:0"`
 
@@ -46,7 +46,7 @@ type AudienciaCapacitacion struct {
 
 type Capacitacion struct {
 	ID        uint   `gorm:"primaryKey" json:"id"`
-	PeriodoID uint   `json:"periodo_id"`
+	PeriodoID uint   `gorm:"index" json:"periodo_id"`
 	Tema      string `json:"tema"`
 	Fecha     string `json:"fecha"`
 
@@ -17,3 +17,17 @@ type Plantilla struct {
 	FechaCreacion     string                        `json:"fecha_creacion"`
 	FechaModificacion string                        `json:"fecha_modificacion"`
 }
+
+// CertificadoEmitido deja constancia de cada certificado generado a partir de una plantilla.
+type CertificadoEmitido struct {
+	ID              uint   `gorm:"primaryKey" json:"id"`
+	PlantillaID     uint   `json:"plantilla_id"`
+	EstudianteID    uint   `gorm:"index" json:"estudiante_id"`
+	NombrePlantilla string `json:"nombre_plantilla"`
+	RutaArchivo     string `json:"ruta_archivo"`
+	FechaEmision    string `json:"fecha_emision"`
+}
+
+func (CertificadoEmitido) TableName() string {
+	return "certificados_emitidos"
+}
@@ -14,9 +14,21 @@ type CitaAlertaItem struct {
 	Curso          string `json:"curso"`
 }
 
+// HitoAlertaItem es un hito pendiente de un protocolo de embarazo, maternidad o paternidad.
+// No lleva el tipo de hito: la notificación la ve todo el rol destino.
+type HitoAlertaItem struct {
+	HitoID          uint   `json:"hito_id"`
+	ProtocoloID     uint   `json:"protocolo_id"`
+	FechaProgramada string `json:"fecha_programada"`
+	Vencido         bool   `json:"vencido"`
+	Estudiante      string `json:"estudiante"`
+	Curso           string `json:"curso"`
+}
+
 type NotificacionMetadata struct {
 	Total int              `json:"total"`
 	Items []CitaAlertaItem `json:"items"`
+	Hitos []HitoAlertaItem `json:"hitos,omitempty"`
 }
 
 type Notificacion struct {
@@ -0,0 +1,115 @@
+package student
+
+import "time"
+
+// Tipos de consentimiento informado que firma el representante (o el estudiante mayor de edad)
+const (
+	ConsentimientoDatos      = "Tratamiento de datos personales"
+	ConsentimientoImagen     = "Uso de imagen"
+	ConsentimientoEvaluacion = "Evaluación psicológica"
+	ConsentimientoDerivacion = "Derivación externa"
+)
+
+const (
+	EstadoConsentimientoVigente   = "Vigente"
+	EstadoConsentimientoPorVencer = "Por vencer"
+	EstadoConsentimientoVencido   = "Vencido"
+	EstadoConsentimientoRevocado  = "Revocado"
+	EstadoConsentimientoFalta     = "Sin consentimiento"
+)
+
+// DiasAvisoConsentimiento es la anticipación con la que un consentimiento vigente se marca por
+// vencer.
+const DiasAvisoConsentimiento = 30
+
+var TiposConsentimiento = []string{ConsentimientoDatos, ConsentimientoImagen, ConsentimientoEvaluacion, ConsentimientoDerivacion}
+
+// ConsentimientosBloqueantes impiden la acción que autorizan si faltan o están vencidos; los
+// demás solo se advierten.
+var ConsentimientosBloqueantes = []string{ConsentimientoEvaluacion, ConsentimientoDerivacion}
+
+// DerivacionesObligatorias son las entidades a las que el DECE debe derivar por ley aunque el
+// representante no lo autorice (vulneración de derechos o presunto delito).
+var DerivacionesObligatorias = []string{"Fiscalía", "Junta Cantonal de Protección de Derechos"}
+
+// Consentimiento es una autorización firmada con su alcance y vigencia. Los datos del firmante
+// se copian del familiar para que el registro no cambie si la ficha familiar se edita o se borra.
+type Consentimiento struct {
+	ID           uint  `gorm:"primaryKey" json:"id"`
+	EstudianteID uint  `gorm:"index;not null" json:"estudiante_id"`
+	FamiliarID   *uint `gorm:"index" json:"familiar_id"`
+
+	Tipo             string `gorm:"index;not null" json:"tipo"`
+	Alcance          string `json:"alcance"`
+	FechaFirma       string `json:"fecha_firma"`
+	FechaVencimiento string `json:"fecha_vencimiento"`
+	RutaDocumento    string `json:"ruta_documento"`
+
+	FirmanteNombre     string `json:"firmante_nombre"`
+	FirmanteCedula     string `json:"firmante_cedula"`
+	FirmanteParentesco string `json:"firmante_parentesco"`
+
+	FechaRevocacion  string `json:"fecha_revocacion"`
+	MotivoRevocacion string `json:"motivo_revocacion"`
+
+	UsuarioID     uint   `json:"usuario_id"`
+	NombreUsuario string `json:"nombre_usuario"`
+	FechaRegistro string `json:"fecha_registro"`
+}
+
+func (Consentimiento) TableName() string {
+	return "consentimientos"
+}
+
+// Estado clasifica el consentimiento a la fecha de corte (AAAA-MM-DD). Sin fecha de
+// vencimiento vale hasta que se revoque.
+func (c Consentimiento) Estado(fechaCorte string) string {
+	switch {
+	case c.FechaRevocacion != "" && c.FechaRevocacion <= fechaCorte:
+		return EstadoConsentimientoRevocado
+	case c.FechaVencimiento == "":
+		return EstadoConsentimientoVigente
+	case c.FechaVencimiento < fechaCorte:
+		return EstadoConsentimientoVencido
+	}
+	corte, err := time.Parse("2006-01-02", fechaCorte)
+	if err == nil && c.FechaVencimiento <= corte.AddDate(0, 0, DiasAvisoConsentimiento).Format("2006-01-02") {
+		return EstadoConsentimientoPorVencer
+	}
+	return EstadoConsentimientoVigente
+}
+
+// ConsentimientoVigente devuelve, entre los consentimientos del estudiante, el que autoriza el
+// tipo a la fecha de corte, o el más reciente de ese tipo con su estado si ninguno está vigente.
+func ConsentimientoVigente(lista []Consentimiento, tipo, fechaCorte string) (*Consentimiento, string) {
+	// Gana el que autoriza, prefiriendo el que no está por vencer; a igualdad, el firmado después
+	rango := func(c Consentimiento) int {
+		switch c.Estado(fechaCorte) {
+		case EstadoConsentimientoVigente:
+			return 2
+		case EstadoConsentimientoPorVencer:
+			return 1
+		}
+		return 0
+	}
+
+	var elegido *Consentimiento
+	for i := range lista {
+		c := &lista[i]
+		if c.Tipo != tipo || c.FechaFirma > fechaCorte {
+			continue
+		}
+		if elegido == nil || rango(*c) > rango(*elegido) || (rango(*c) == rango(*elegido) && c.FechaFirma > elegido.FechaFirma) {
+			elegido = c
+		}
+	}
+	if elegido == nil {
+		return nil, EstadoConsentimientoFalta
+	}
+	return elegido, elegido.Estado(fechaCorte)
+}
+
+// Autoriza indica si el estado permite la acción que cubre el consentimiento.
+func Autoriza(estado string) bool {
+	return estado == EstadoConsentimientoVigente || estado == EstadoConsentimientoPorVencer
+}
@@ -13,7 +13,7 @@ type InfoNacionalidad struct {
 type Estudiante struct {
 	ID        uint   `gorm:"primaryKey" json:"id"`
 	Cedula    string `gorm:"unique;not null" json:"cedula"`
-	Apellidos string `gorm:"not null" json:"apellidos"`
+	Apellidos string `gorm:"not null;index" json:"apellidos"`
 	Nombres   string `gorm:"not null" json:"nombres"`
 
 	FechaNacimiento string `json:"fecha_nacimiento"`
@@ -28,6 +28,8 @@ type Estudiante struct {
 	RutaCedula            string `json:"ruta_cedula"`
 	RutaPartidaNacimiento string `json:"ruta_partida_nacimiento"`
 
+	HogarID *uint `gorm:"index" json:"hogar_id"`
+
 	Familiares []Familiar `gorm:"foreignKey:EstudianteID" json:"familiares"`
 
 	FechaCreacion string `gorm:"default:CURRENT_TIMESTAMP" json:"fecha_creacion"`
@@ -41,7 +43,7 @@ type DatosFamiliar struct {
 
 type Familiar struct {
 	ID                   uint   `gorm:"primaryKey" json:"id"`
-	EstudianteID         uint   `json:"estudiante_id"`
+	EstudianteID         uint   `gorm:"index" json:"estudiante_id"`
 	Cedula               string `json:"cedula"`
 	NombresCompletos     string `gorm:"not null" json:"nombres_completos"`
 	Parentesco           string `json:"parentesco"`
@@ -53,3 +55,155 @@ type Familiar struct {
 	TelefonoPersonal string `json:"telefono_personal"`
 	Fallecido        bool   `json:"fallecido"`
 }
+
+// Hogar agrupa a hermanos (o a una pareja de estudiantes) que comparten familia, para que los
+// datos de contacto de los padres se mantengan iguales en todas sus fichas.
+type Hogar struct {
+	ID            uint   `gorm:"primaryKey" json:"id"`
+	Nombre        string `json:"nombre"`
+	Observacion   string `json:"observacion"`
+	FechaCreacion string `json:"fecha_creacion"`
+}
+
+func (Hogar) TableName() string {
+	return "hogares"
+}
+
+type DetalleFusion struct {
+	EstudianteEliminado   Estudiante        `json:"estudiante_eliminado"`
+	MatriculaIDs          []uint            `json:"matricula_ids"`
+	FamiliarIDs           []uint            `json:"familiar_ids"`
+	FamiliaresDescartados []Familiar        `json:"familiares_descartados"`
+	CasoIDs               []uint            `json:"caso_ids"`
+	ConsentimientoIDs     []uint            `json:"consentimiento_ids"`
+	CertificadoIDs        []uint            `json:"certificado_ids"`
+	DosisVacunaIDs        []uint            `json:"dosis_vacuna_ids"`
+	ControlMedicoIDs      []uint            `json:"control_medico_ids"`
+	CamposHeredados       map[string]string `json:"campos_heredados"`
+
+	// VersionDocumentoIDs son las versiones de documentos de la ficha eliminada. Las que estaban
+	// vigentes para un documento que la ficha conservada ya tenía se retiran (VersionesRetiradasIDs).
+	VersionDocumentoIDs   []uint `json:"version_documento_ids"`
+	VersionesRetiradasIDs []uint `json:"versiones_retiradas_ids"`
+
+	// ParejaMatriculaIDs son las matrículas cuya pareja apuntaba a la ficha eliminada.
+	ParejaMatriculaIDs []uint `json:"pareja_matricula_ids"`
+}
+
+// FusionEstudiante registra la unión de dos fichas duplicadas y guarda lo necesario para deshacerla.
+type FusionEstudiante struct {
+	ID                     uint   `gorm:"primaryKey" json:"id"`
+	EstudianteConservadoID uint   `gorm:"index" json:"estudiante_conservado_id"`
+	EstudianteEliminadoID  uint   `json:"estudiante_eliminado_id"`
+	CedulaEliminada        string `json:"cedula_eliminada"`
+	UsuarioID              uint   `json:"usuario_id"`
+	Motivo                 string `json:"motivo"`
+	FechaFusion            string `json:"fecha_fusion"`
+
+	Detalle common.JSONMap[DetalleFusion] `gorm:"type:text" json:"detalle"`
+
+	Revertida      bool   `gorm:"default:false" json:"revertida"`
+	FechaReversion string `json:"fecha_reversion"`
+}
+
+func (FusionEstudiante) TableName() string {
+	return "fusiones_estudiantes"
+}
+
+const (
+	EstadoLotePrevisualizado = "Previsualizado"
+	EstadoLoteAplicado       = "Aplicado"
+	EstadoLoteRevertido      = "Revertido"
+
+	AccionImportCrear      = "crear"
+	AccionImportActualizar = "actualizar"
+	AccionImportSinCambios = "sin_cambios"
+	AccionImportError      = "error"
+)
+
+type CambioCampo struct {
+	Campo    string `json:"campo"`
+	Anterior string `json:"anterior"`
+	Nuevo    string `json:"nuevo"`
+}
+
+type FilaImportacion struct {
+	Hoja      string `json:"hoja"`
+	Fila      int    `json:"fila"`
+	Cedula    string `json:"cedula"`
+	Apellidos string `json:"apellidos"`
+	Nombres   string `json:"nombres"`
+	Correo    string `json:"correo"`
+
+	FechaNacimiento string `json:"fecha_nacimiento"`
+	Genero          string `json:"genero"`
+	Nacionalidad    string `json:"nacionalidad"`
+	PasaporteOrDNI  string `json:"pasaporte_odni"`
+	Direccion       string `json:"direccion"`
+
+	RepresentanteCedula     string `json:"representante_cedula"`
+	RepresentanteNombres    string `json:"representante_nombres"`
+	RepresentanteParentesco string `json:"representante_parentesco"`
+	RepresentanteTelefono   string `json:"representante_telefono"`
+
+	Accion       string        `json:"accion"`
+	EstudianteID uint          `json:"estudiante_id"`
+	Cambios      []CambioCampo `json:"cambios"`
+	Matricular   bool          `json:"matricular"`
+	MatriculaID  uint          `json:"matricula_id"`
+	Observacion  string        `json:"observacion"`
+	Error        string        `json:"error"`
+
+	FamiliarID      uint          `json:"familiar_id"`
+	FamiliarCreado  bool          `json:"familiar_creado"`
+	CambiosFamiliar []CambioCampo `json:"cambios_familiar"`
+
+	DireccionMatriculaID uint   `json:"direccion_matricula_id"`
+	DireccionAnterior    string `json:"direccion_anterior"`
+}
+
+// LoteImportacion guarda una importación desde Excel: primero como vista previa y, una vez
+// aplicada, con los valores anteriores necesarios para revertirla completa.
+type LoteImportacion struct {
+	ID       uint   `gorm:"primaryKey" json:"id"`
+	CursoID  uint   `json:"curso_id"`
+	PerfilID uint   `json:"perfil_id"`
+	Archivo  string `json:"archivo"`
+	Estado   string `gorm:"default:'Previsualizado'" json:"estado"`
+
+	TotalFilas   int `json:"total_filas"`
+	Creados      int `json:"creados"`
+	Actualizados int `json:"actualizados"`
+	Matriculados int `json:"matriculados"`
+	Errores      int `json:"errores"`
+
+	Filas common.JSONMap[[]FilaImportacion] `gorm:"type:text" json:"filas"`
+
+	FechaCreacion   string `json:"fecha_creacion"`
+	FechaAplicacion string `json:"fecha_aplicacion"`
+	FechaReversion  string `json:"fecha_reversion"`
+}
+
+func (LoteImportacion) TableName() string {
+	return "lotes_importacion"
+}
+
+// PerfilImportacion asocia los encabezados de un formato de origen (por ejemplo la exportación
+// del Ministerio) con los campos del sistema. Columnas: campo del sistema -> encabezado.
+type PerfilImportacion struct {
+	ID             uint   `gorm:"primaryKey" json:"id"`
+	Nombre         string `gorm:"unique;not null" json:"nombre"`
+	Descripcion    string `json:"descripcion"`
+	Delimitador    string `json:"delimitador"`
+	FilaEncabezado int    `json:"fila_encabezado"`
+	FormatoFecha   string `json:"formato_fecha"`
+
+	Hojas    common.JSONMap[[]string]          `gorm:"type:text" json:"hojas"`
+	Columnas common.JSONMap[map[string]string] `gorm:"type:text" json:"columnas"`
+
+	FechaActualizacion string `json:"fecha_actualizacion"`
+}
+
+func (PerfilImportacion) TableName() string {
+	return "perfiles_importacion"
+}
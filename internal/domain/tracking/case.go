@@ -0,0 +1,98 @@
+package tracking
+
+// Estados del flujo de un caso sensible
+const (
+	EstadoCasoAbierto        = "Abierto"
+	EstadoCasoEnIntervencion = "En intervención"
+	EstadoCasoDerivado       = "Derivado"
+	EstadoCasoEnSeguimiento  = "En seguimiento"
+	EstadoCasoCerrado        = "Cerrado"
+	EstadoCasoReabierto      = "Reabierto"
+)
+
+var EstadosCaso = []string{EstadoCasoAbierto, EstadoCasoEnIntervencion, EstadoCasoDerivado, EstadoCasoEnSeguimiento, EstadoCasoCerrado, EstadoCasoReabierto}
+
+// TransicionesCaso indica a qué estados puede pasar un caso desde cada estado. Un caso cerrado
+// solo se puede reabrir.
+var TransicionesCaso = map[string][]string{
+	EstadoCasoAbierto:        {EstadoCasoEnIntervencion, EstadoCasoDerivado, EstadoCasoCerrado},
+	EstadoCasoEnIntervencion: {EstadoCasoDerivado, EstadoCasoEnSeguimiento, EstadoCasoCerrado},
+	EstadoCasoDerivado:       {EstadoCasoEnIntervencion, EstadoCasoEnSeguimiento, EstadoCasoCerrado},
+	EstadoCasoEnSeguimiento:  {EstadoCasoEnIntervencion, EstadoCasoDerivado, EstadoCasoCerrado},
+	EstadoCasoCerrado:        {EstadoCasoReabierto},
+	EstadoCasoReabierto:      {EstadoCasoEnIntervencion, EstadoCasoDerivado, EstadoCasoEnSeguimiento, EstadoCasoCerrado},
+}
+
+var MotivosCierreCaso = []string{
+	"Situación superada",
+	"Derivación concluida por la entidad externa",
+	"Retiro o traslado del estudiante",
+	"Culminación de estudios",
+	"Otro",
+}
+
+// EstadoCaso devuelve el estado del flujo que corresponde a lo registrado. Los casos anteriores
+// al flujo pueden tener un estado escrito a mano; se tratan como abiertos.
+func EstadoCaso(estado string) string {
+	if _, ok := TransicionesCaso[estado]; ok {
+		return estado
+	}
+	return EstadoCasoAbierto
+}
+
+// EstadosSiguientesCaso devuelve los estados a los que puede pasar un caso en el estado dado.
+func EstadosSiguientesCaso(estado string) []string {
+	return TransicionesCaso[EstadoCaso(estado)]
+}
+
+func TransicionCasoPermitida(desde, hacia string) bool {
+	for _, e := range EstadosSiguientesCaso(desde) {
+		if e == hacia {
+			return true
+		}
+	}
+	return false
+}
+
+// CambioEstadoCaso es el historial del flujo del caso. La apertura se registra con
+// EstadoAnterior vacío; los cierres guardan su motivo y resumen aunque el caso se reabra.
+type CambioEstadoCaso struct {
+	ID     uint `gorm:"primaryKey" json:"id"`
+	CasoID uint `gorm:"index;not null" json:"caso_id"`
+
+	EstadoAnterior string `json:"estado_anterior"`
+	EstadoNuevo    string `json:"estado_nuevo"`
+	Fecha          string `json:"fecha"`
+	Nota           string `json:"nota"`
+	MotivoCierre   string `json:"motivo_cierre"`
+	ResumenCierre  string `json:"resumen_cierre"`
+
+	UsuarioID     uint   `json:"usuario_id"`
+	NombreUsuario string `json:"nombre_usuario"`
+	FechaRegistro string `json:"fecha_registro"`
+}
+
+func (CambioEstadoCaso) TableName() string {
+	return "cambios_estado_caso"
+}
+
+// IntervencionCaso es una entrada de la bitácora de intervenciones del caso: qué se hizo, con
+// quién, qué se acordó y cuáles son los próximos pasos.
+type IntervencionCaso struct {
+	ID     uint `gorm:"primaryKey" json:"id"`
+	CasoID uint `gorm:"index;not null" json:"caso_id"`
+
+	Fecha         string `gorm:"index" json:"fecha"`
+	Participantes string `json:"participantes"`
+	Accion        string `json:"accion"`
+	Acuerdos      string `json:"acuerdos"`
+	ProximosPasos string `json:"proximos_pasos"`
+
+	UsuarioID     uint   `json:"usuario_id"`
+	NombreUsuario string `json:"nombre_usuario"`
+	FechaRegistro string `json:"fecha_registro"`
+}
+
+func (IntervencionCaso) TableName() string {
+	return "intervenciones_caso"
+}
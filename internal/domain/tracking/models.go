@@ -4,6 +4,7 @@ import (
 	"dece/internal/domain/academic"
 	"dece/internal/domain/common"
 	"dece/internal/domain/enrollment"
+	"dece/internal/domain/faculty"
 	"dece/internal/domain/student"
 )
 
@@ -16,7 +17,7 @@ type DetalleSancion struct {
 
 type LlamadoAtencion struct {
 	ID          uint `gorm:"primaryKey" json:"id"`
-	MatriculaID uint `json:"matricula_id"`
+	MatriculaID uint `gorm:"index" json:"matricula_id"`
 
 	Fecha  string `json:"fecha"`
 	Motivo string `json:"motivo"`
@@ -38,8 +39,8 @@ type Evidencia struct {
 
 type CasoSensible struct {
 	ID           uint `gorm:"primaryKey" json:"id"`
-	EstudianteID uint `json:"estudiante_id"`
-	PeriodoID    uint `json:"periodo_id"`
+	EstudianteID uint `gorm:"index" json:"estudiante_id"`
+	PeriodoID    uint `gorm:"index" json:"periodo_id"`
 
 	CodigoCaso               string `json:"codigo_caso"`
 	TipoCaso                 string `json:"tipo_caso"`
@@ -49,6 +50,10 @@ type CasoSensible struct {
 	Descripcion              string `json:"descripcion"`
 	Estado                   string `json:"estado"`
 
+	FechaCierre   string `json:"fecha_cierre"`
+	MotivoCierre  string `json:"motivo_cierre"`
+	ResumenCierre string `json:"resumen_cierre"`
+
 	RutasDocumentos common.JSONMap[[]Evidencia] `gorm:"type:text" json:"rutas_documentos"`
 
 	Estudiante student.Estudiante      `gorm:"foreignKey:EstudianteID" json:"estudiante"`
@@ -62,3 +67,41 @@ func (LlamadoAtencion) TableName() string {
 func (CasoSensible) TableName() string {
 	return "casos_sensibles"
 }
+
+const (
+	EstadoRemisionRecibida       = "Recibida"
+	EstadoRemisionEnEvaluacion   = "En Evaluación"
+	EstadoRemisionConvertidaCaso = "Convertida en Caso"
+	EstadoRemisionConvertidaCita = "Convertida en Cita"
+	EstadoRemisionDescartada     = "Descartada"
+)
+
+// Remision es el reporte que un docente o tutor hace al DECE sobre un estudiante.
+// Pasa por el triaje del DECE y puede terminar en un caso sensible o en una convocatoria.
+type Remision struct {
+	ID          uint `gorm:"primaryKey" json:"id"`
+	DocenteID   uint `gorm:"index" json:"docente_id"`
+	MatriculaID uint `gorm:"index" json:"matricula_id"`
+
+	CodigoRemision string `json:"codigo_remision"`
+	FechaRemision  string `json:"fecha_remision"`
+	Categoria      string `json:"categoria"`
+	Descripcion    string `json:"descripcion"`
+	Urgencia       string `json:"urgencia"`
+
+	Estado             string `gorm:"default:'Recibida'" json:"estado"`
+	ObservacionTriaje  string `json:"observacion_triaje"`
+	FechaActualizacion string `json:"fecha_actualizacion"`
+
+	CasoID         *uint `json:"caso_id"`
+	ConvocatoriaID *uint `json:"convocatoria_id"`
+
+	Adjuntos common.JSONMap[[]Evidencia] `gorm:"type:text" json:"adjuntos"`
+
+	Docente   faculty.Docente      `gorm:"foreignKey:DocenteID" json:"docente,omitempty"`
+	Matricula enrollment.Matricula `gorm:"foreignKey:MatriculaID" json:"matricula,omitempty"`
+}
+
+func (Remision) TableName() string {
+	return "remisiones"
+}
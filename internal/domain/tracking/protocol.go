@@ -0,0 +1,199 @@
+package tracking
+
+import (
+	"dece/internal/domain/enrollment"
+	"fmt"
+	"time"
+)
+
+// Tipos de protocolo de embarazo, maternidad y paternidad en el sistema educativo
+const (
+	TipoProtocoloEmbarazo   = "Embarazo"
+	TipoProtocoloMaternidad = "Maternidad y lactancia"
+	TipoProtocoloPaternidad = "Paternidad"
+)
+
+const (
+	EstadoProtocoloAbierto = "Abierto"
+	EstadoProtocoloCerrado = "Cerrado"
+)
+
+// Hitos que programa el plan de cada protocolo
+const (
+	HitoEntrevistaInicial = "Entrevista inicial"
+	HitoControlPrenatal   = "Verificación de control prenatal"
+	HitoPlanAcademico     = "Plan de adaptación académica"
+	HitoHorarioLactancia  = "Horario de lactancia"
+	HitoSeguimiento       = "Seguimiento"
+)
+
+// La hora diaria de lactancia dura hasta que el bebé cumple un año
+const diasLactancia = 365
+
+var TiposProtocolo = []string{TipoProtocoloEmbarazo, TipoProtocoloMaternidad, TipoProtocoloPaternidad}
+
+var TiposHito = []string{HitoEntrevistaInicial, HitoControlPrenatal, HitoPlanAcademico, HitoHorarioLactancia, HitoSeguimiento}
+
+var ModalidadesContacto = []string{"Entrevista con el estudiante", "Entrevista con el representante", "Llamada telefónica", "Visita domiciliaria", "Reunión con docentes", "Coordinación con centro de salud", "Otro"}
+
+// ProtocoloMaternidad es el plan de seguimiento que se abre cuando la ficha registra un
+// embarazo, una maternidad o lactancia, o una paternidad. Su contenido es confidencial.
+type ProtocoloMaternidad struct {
+	ID          uint `gorm:"primaryKey" json:"id"`
+	MatriculaID uint `gorm:"index;not null" json:"matricula_id"`
+
+	Tipo          string `gorm:"index;not null" json:"tipo"`
+	Estado        string `gorm:"index;default:'Abierto'" json:"estado"`
+	Origen        string `json:"origen"`
+	FechaApertura string `json:"fecha_apertura"`
+	FechaCierre   string `json:"fecha_cierre"`
+	MotivoCierre  string `json:"motivo_cierre"`
+
+	UsuarioID     uint   `json:"usuario_id"`
+	NombreUsuario string `json:"nombre_usuario"`
+
+	Hitos     []HitoProtocolo     `gorm:"foreignKey:ProtocoloID" json:"hitos,omitempty"`
+	Contactos []ContactoProtocolo `gorm:"foreignKey:ProtocoloID" json:"contactos,omitempty"`
+}
+
+func (ProtocoloMaternidad) TableName() string {
+	return "protocolos_maternidad"
+}
+
+// HitoProtocolo es un punto de control programado del plan. FechaCumplida vacía significa
+// pendiente.
+type HitoProtocolo struct {
+	ID          uint `gorm:"primaryKey" json:"id"`
+	ProtocoloID uint `gorm:"index;not null" json:"protocolo_id"`
+
+	Tipo            string `json:"tipo"`
+	Descripcion     string `json:"descripcion"`
+	FechaProgramada string `gorm:"index" json:"fecha_programada"`
+	FechaCumplida   string `json:"fecha_cumplida"`
+	Observacion     string `json:"observacion"`
+}
+
+func (HitoProtocolo) TableName() string {
+	return "hitos_protocolo"
+}
+
+// ContactoProtocolo registra cada entrevista, llamada o coordinación del seguimiento. Puede
+// dar por cumplido un hito.
+type ContactoProtocolo struct {
+	ID          uint  `gorm:"primaryKey" json:"id"`
+	ProtocoloID uint  `gorm:"index;not null" json:"protocolo_id"`
+	HitoID      *uint `gorm:"index" json:"hito_id"`
+
+	Fecha         string `json:"fecha"`
+	Modalidad     string `json:"modalidad"`
+	Participantes string `json:"participantes"`
+	Resumen       string `json:"resumen"`
+	Acuerdos      string `json:"acuerdos"`
+
+	UsuarioID     uint   `json:"usuario_id"`
+	NombreUsuario string `json:"nombre_usuario"`
+	FechaRegistro string `json:"fecha_registro"`
+}
+
+func (ContactoProtocolo) TableName() string {
+	return "contactos_protocolo"
+}
+
+// ProtocolosRequeridos devuelve los protocolos que corresponden a la condición registrada en
+// la ficha.
+func ProtocolosRequeridos(g enrollment.CondicionGenero) []string {
+	var tipos []string
+	if g.EstaEmbarazada {
+		tipos = append(tipos, TipoProtocoloEmbarazo)
+	}
+	if g.EstaLactando || g.EsMaternidad {
+		tipos = append(tipos, TipoProtocoloMaternidad)
+	}
+	if g.EsPadre {
+		tipos = append(tipos, TipoProtocoloPaternidad)
+	}
+	return tipos
+}
+
+// PlanProtocolo programa los hitos del protocolo desde la fecha de apertura según los datos
+// de la ficha: los controles prenatales hasta la fecha probable de parto (cada quince días si
+// el embarazo es de alto riesgo) y la revisión del horario de lactancia cada tres meses
+// mientras dura.
+func PlanProtocolo(tipo string, g enrollment.CondicionGenero, apertura time.Time) []HitoProtocolo {
+	hito := func(t string, fecha time.Time, descripcion string) HitoProtocolo {
+		return HitoProtocolo{Tipo: t, FechaProgramada: fecha.Format("2006-01-02"), Descripcion: descripcion}
+	}
+
+	plan := []HitoProtocolo{
+		hito(HitoEntrevistaInicial, apertura.AddDate(0, 0, 3), "Entrevista con el estudiante y su representante para acordar el acompañamiento"),
+	}
+
+	switch tipo {
+	case TipoProtocoloEmbarazo:
+		plan = append(plan, hito(HitoPlanAcademico, apertura.AddDate(0, 0, 15), "Adaptaciones de horario, evaluaciones y actividad física durante el embarazo"))
+
+		mesesRestantes := 9 - g.MesesEmbarazo
+		if mesesRestantes < 1 {
+			mesesRestantes = 1
+		}
+		parto := apertura.AddDate(0, mesesRestantes, 0)
+		intervalo := func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+		if g.EsAltoRiesgo {
+			intervalo = func(t time.Time) time.Time { return t.AddDate(0, 0, 15) }
+		}
+		n := 1
+		for fecha := intervalo(apertura); !fecha.After(parto); fecha = intervalo(fecha) {
+			plan = append(plan, hito(HitoControlPrenatal, fecha, fmt.Sprintf("Verificar asistencia al control prenatal %d", n)))
+			n++
+		}
+
+	case TipoProtocoloMaternidad:
+		plan = append(plan,
+			hito(HitoHorarioLactancia, apertura.AddDate(0, 0, 7), "Acordar con la institución la hora diaria de lactancia"),
+			hito(HitoPlanAcademico, apertura.AddDate(0, 0, 15), "Adaptaciones académicas para la maternidad"),
+		)
+
+		fin := apertura.AddDate(0, 0, diasLactancia-g.DiasNacido)
+		if g.DiasNacido <= 0 || g.DiasNacido >= diasLactancia {
+			fin = apertura.AddDate(1, 0, 0)
+		}
+		for fecha := apertura.AddDate(0, 3, 0); fecha.Before(fin); fecha = fecha.AddDate(0, 3, 0) {
+			plan = append(plan, hito(HitoHorarioLactancia, fecha, "Revisar el cumplimiento del horario de lactancia"))
+		}
+
+	case TipoProtocoloPaternidad:
+		plan = append(plan,
+			hito(HitoPlanAcademico, apertura.AddDate(0, 0, 15), "Adaptaciones académicas para la paternidad"),
+			hito(HitoSeguimiento, apertura.AddDate(0, 1, 0), "Seguimiento de la corresponsabilidad y la permanencia escolar"),
+			hito(HitoSeguimiento, apertura.AddDate(0, 3, 0), "Seguimiento de la corresponsabilidad y la permanencia escolar"),
+		)
+	}
+
+	return plan
+}
+
+const (
+	EstadoHitoCumplido   = "Cumplido"
+	EstadoHitoVencido    = "Vencido"
+	EstadoHitoProximo    = "Próximo"
+	EstadoHitoProgramado = "Programado"
+)
+
+// DiasAvisoHito es la anticipación con la que un hito pendiente pasa a próximo y entra en los
+// recordatorios.
+const DiasAvisoHito = 7
+
+// Estado clasifica el hito a la fecha de corte (AAAA-MM-DD).
+func (h HitoProtocolo) Estado(fechaCorte string) string {
+	switch {
+	case h.FechaCumplida != "":
+		return EstadoHitoCumplido
+	case h.FechaProgramada < fechaCorte:
+		return EstadoHitoVencido
+	}
+	corte, err := time.Parse("2006-01-02", fechaCorte)
+	if err == nil && h.FechaProgramada <= corte.AddDate(0, 0, DiasAvisoHito).Format("2006-01-02") {
+		return EstadoHitoProximo
+	}
+	return EstadoHitoProgramado
+}
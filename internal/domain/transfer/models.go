@@ -0,0 +1,172 @@
+package transfer
+
+import (
+	"dece/internal/domain/common"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/student"
+)
+
+const (
+	EstadoExpedientePrevisualizado = "Previsualizado"
+	EstadoExpedienteImportado      = "Importado"
+	EstadoExpedienteDescartado     = "Descartado"
+
+	DocumentoCedula              = "cedula"
+	DocumentoPartida             = "partida"
+	DocumentoFoto                = "foto"
+	DocumentoEvalPsicopedagogica = "eval_psicopedagogica"
+	DocumentoCroquis             = "croquis"
+	DocumentoConsentimiento      = "consentimiento"
+)
+
+// IdentidadInstalacion es el par de claves Ed25519 con el que esta instalación firma los
+// expedientes que exporta. Se crea la primera vez que se exporta.
+type IdentidadInstalacion struct {
+	ID            uint   `gorm:"primaryKey" json:"id"`
+	ClavePublica  string `json:"clave_publica"`
+	ClavePrivada  string `json:"-"`
+	Huella        string `json:"huella"`
+	FechaCreacion string `json:"fecha_creacion"`
+}
+
+func (IdentidadInstalacion) TableName() string {
+	return "identidad_instalacion"
+}
+
+type InstitucionExpediente struct {
+	Nombre     string `json:"nombre"`
+	CodigoAMIE string `json:"codigo_amie"`
+	Distrito   string `json:"distrito"`
+	Provincia  string `json:"provincia"`
+	Canton     string `json:"canton"`
+}
+
+type EstudianteExpediente struct {
+	Cedula            string                   `json:"cedula"`
+	Apellidos         string                   `json:"apellidos"`
+	Nombres           string                   `json:"nombres"`
+	FechaNacimiento   string                   `json:"fecha_nacimiento"`
+	GeneroNacimiento  string                   `json:"genero_nacimiento"`
+	CorreoElectronico string                   `json:"correo_electronico"`
+	InfoNacionalidad  student.InfoNacionalidad `json:"info_nacionalidad"`
+}
+
+type FamiliarExpediente struct {
+	Cedula               string                `json:"cedula"`
+	NombresCompletos     string                `json:"nombres_completos"`
+	Parentesco           string                `json:"parentesco"`
+	EsRepresentanteLegal bool                  `json:"es_representante_legal"`
+	ViveConEstudiante    bool                  `json:"vive_con_estudiante"`
+	TelefonoPersonal     string                `json:"telefono_personal"`
+	Fallecido            bool                  `json:"fallecido"`
+	DatosExtendidos      student.DatosFamiliar `json:"datos_extendidos"`
+}
+
+// MatriculaExpediente es la ficha DECE de la última matrícula en la institución de origen.
+type MatriculaExpediente struct {
+	PeriodoLectivo  string                        `json:"periodo_lectivo"`
+	Nivel           string                        `json:"nivel"`
+	Paralelo        string                        `json:"paralelo"`
+	Jornada         string                        `json:"jornada"`
+	Estado          string                        `json:"estado"`
+	EsRepetidor     bool                          `json:"es_repetidor"`
+	DireccionActual string                        `json:"direccion_actual"`
+	Ubicacion       enrollment.UbicacionDomicilio `json:"ubicacion"`
+
+	Antropometria      enrollment.Antropometria      `json:"antropometria"`
+	HistorialAcademico enrollment.HistorialAcademico `json:"historial_academico"`
+	DatosSalud         enrollment.DatosSalud         `json:"datos_salud"`
+	DatosSociales      enrollment.DatosSociales      `json:"datos_sociales"`
+	CondicionGenero    enrollment.CondicionGenero    `json:"condicion_genero"`
+}
+
+type RetiroExpediente struct {
+	FechaRetiro      string `json:"fecha_retiro"`
+	Motivo           string `json:"motivo"`
+	NuevaInstitucion string `json:"nueva_institucion"`
+	ProvinciaDestino string `json:"provincia_destino"`
+}
+
+type DisciplinaExpediente struct {
+	Fecha              string `json:"fecha"`
+	PeriodoLectivo     string `json:"periodo_lectivo"`
+	Motivo             string `json:"motivo"`
+	Medida             string `json:"medida"`
+	CumplioMedida      bool   `json:"cumplio_medida"`
+	RepresentanteFirmo bool   `json:"representante_firmo"`
+}
+
+// CasoExpediente resume un caso sensible sin la descripción ni las evidencias, que no salen de
+// la institución de origen.
+type CasoExpediente struct {
+	CodigoCaso        string `json:"codigo_caso"`
+	TipoCaso          string `json:"tipo_caso"`
+	FechaDeteccion    string `json:"fecha_deteccion"`
+	Estado            string `json:"estado"`
+	EntidadDerivacion string `json:"entidad_derivacion"`
+	PeriodoLectivo    string `json:"periodo_lectivo"`
+}
+
+type DocumentoExpediente struct {
+	Tipo       string `json:"tipo"`
+	Nombre     string `json:"nombre"`
+	Archivo    string `json:"archivo"`
+	HashSHA256 string `json:"hash_sha256"`
+}
+
+// Expediente es el contenido cifrado del archivo de transferencia (expediente.json).
+type Expediente struct {
+	CodigoExpediente   string                `json:"codigo_expediente"`
+	FechaExportacion   string                `json:"fecha_exportacion"`
+	Origen             InstitucionExpediente `json:"origen"`
+	InstitucionDestino string                `json:"institucion_destino"`
+
+	Estudiante EstudianteExpediente   `json:"estudiante"`
+	Familiares []FamiliarExpediente   `json:"familiares"`
+	Matricula  *MatriculaExpediente   `json:"matricula"`
+	Retiro     *RetiroExpediente      `json:"retiro"`
+	Disciplina []DisciplinaExpediente `json:"disciplina"`
+	Casos      []CasoExpediente       `json:"casos"`
+	Documentos []DocumentoExpediente  `json:"documentos"`
+}
+
+type ExpedienteExportado struct {
+	ID                 uint   `gorm:"primaryKey" json:"id"`
+	CodigoExpediente   string `gorm:"index" json:"codigo_expediente"`
+	EstudianteID       uint   `gorm:"index" json:"estudiante_id"`
+	MatriculaID        uint   `json:"matricula_id"`
+	InstitucionDestino string `json:"institucion_destino"`
+	RutaArchivo        string `json:"ruta_archivo"`
+	UsuarioID          uint   `json:"usuario_id"`
+	NombreUsuario      string `json:"nombre_usuario"`
+	FechaExportacion   string `json:"fecha_exportacion"`
+}
+
+func (ExpedienteExportado) TableName() string {
+	return "expedientes_exportados"
+}
+
+// ExpedienteImportado guarda la vista previa de un expediente recibido mientras se decide a qué
+// curso asignarlo, y queda como constancia una vez importado.
+type ExpedienteImportado struct {
+	ID                uint   `gorm:"primaryKey" json:"id"`
+	CodigoExpediente  string `gorm:"index" json:"codigo_expediente"`
+	InstitucionOrigen string `json:"institucion_origen"`
+	CodigoAMIEOrigen  string `json:"codigo_amie_origen"`
+	HuellaEmisor      string `gorm:"index" json:"huella_emisor"`
+	FechaExportacion  string `json:"fecha_exportacion"`
+	Estado            string `json:"estado"`
+	CarpetaTemporal   string `json:"-"`
+
+	Contenido common.JSONMap[Expediente] `gorm:"type:text" json:"contenido"`
+
+	EstudianteID     uint   `json:"estudiante_id"`
+	MatriculaID      uint   `json:"matricula_id"`
+	UsuarioID        uint   `json:"usuario_id"`
+	FechaPrevia      string `json:"fecha_previa"`
+	FechaImportacion string `json:"fecha_importacion"`
+}
+
+func (ExpedienteImportado) TableName() string {
+	return "expedientes_importados"
+}
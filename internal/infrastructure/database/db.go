@@ -6,13 +6,16 @@ import (
 	"path/filepath"
 
 	"dece/internal/domain/academic"
+	"dece/internal/domain/documents"
 	"dece/internal/domain/enrollment"
 	"dece/internal/domain/faculty"
+	"dece/internal/domain/health"
 	"dece/internal/domain/management"
 	"dece/internal/domain/notifications"
 	"dece/internal/domain/security"
 	"dece/internal/domain/student"
 	"dece/internal/domain/tracking"
+	"dece/internal/domain/transfer"
 
 	"github.com/glebarez/sqlite"
 	"gorm.io/gorm"
@@ -55,16 +58,43 @@ func InitDB() *gorm.DB {
 		&faculty.Docente{},
 		&student.Estudiante{},
 		&student.Familiar{},
+		&student.Hogar{},
+		&student.Consentimiento{},
+		&student.FusionEstudiante{},
+		&student.LoteImportacion{},
+		&student.PerfilImportacion{},
 		&faculty.Curso{},
 		&faculty.DistributivoMateria{},
 		&enrollment.Matricula{},
 		&enrollment.RetiroEstudiante{},
+		&enrollment.TransicionMatricula{},
+		&enrollment.CambioCurso{},
+		&enrollment.Reincorporacion{},
+		&enrollment.VersionFicha{},
+		&health.MedicionAntropometrica{},
+		&health.Vacuna{},
+		&health.EsquemaVacuna{},
+		&health.DosisVacuna{},
+		&health.TipoControlMedico{},
+		&health.ControlMedico{},
+		&health.IncidenteEnfermeria{},
 		&tracking.LlamadoAtencion{},
 		&tracking.CasoSensible{},
+		&tracking.CambioEstadoCaso{},
+		&tracking.IntervencionCaso{},
+		&tracking.Remision{},
+		&tracking.ProtocoloMaternidad{},
+		&tracking.HitoProtocolo{},
+		&tracking.ContactoProtocolo{},
+		&documents.VersionDocumento{},
+		&transfer.IdentidadInstalacion{},
+		&transfer.ExpedienteExportado{},
+		&transfer.ExpedienteImportado{},
 		&management.Convocatoria{},
 		&management.SyncPendiente{},
 		&management.Capacitacion{},
 		&management.Plantilla{},
+		&management.CertificadoEmitido{},
 		&notifications.Notificacion{},
 		&security.ConfiguracionSeguridad{},
 	)
@@ -4,6 +4,8 @@ import (
 	"dece/internal/config"
 	"dece/internal/domain/academic"
 	"dece/internal/domain/common"
+	"dece/internal/domain/enrollment"
+	"dece/internal/domain/health"
 	"dece/internal/domain/security"
 	"errors"
 	"fmt"
@@ -35,6 +37,18 @@ func SeedAll(db *gorm.DB) error {
 		return fmt.Errorf("Error seeding config: %w", err)
 	}
 
+	if err := seedTransicionesMatricula(db); err != nil {
+		return fmt.Errorf("Error seeding transiciones de matrícula: %w", err)
+	}
+
+	if err := seedEsquemaVacunacion(db); err != nil {
+		return fmt.Errorf("Error seeding esquema de vacunación: %w", err)
+	}
+
+	if err := seedTiposControlMedico(db); err != nil {
+		return fmt.Errorf("Error seeding controles médicos: %w", err)
+	}
+
 	log.Println("Base de datos poblada exitosamente (Seeding completado)")
 	return nil
 }
@@ -71,22 +85,29 @@ func seedAdminUser(db *gorm.DB) error {
 
 func seedNivelesEducativos(db *gorm.DB) error {
 	niveles := []academic.NivelEducativo{
-		{Nombre: "1ro EGB", NombreCompleto: "Primero de Educación General Básica", Orden: 1},
-		{Nombre: "2do EGB", NombreCompleto: "Segundo de Educación General Básica", Orden: 2},
-		{Nombre: "3ro EGB", NombreCompleto: "Tercero de Educación General Básica", Orden: 3},
-		{Nombre: "4to EGB", NombreCompleto: "Cuarto de Educación General Básica", Orden: 4},
-		{Nombre: "5to EGB", NombreCompleto: "Quinto de Educación General Básica", Orden: 5},
-		{Nombre: "6to EGB", NombreCompleto: "Sexto de Educación General Básica", Orden: 6},
-		{Nombre: "7mo EGB", NombreCompleto: "Séptimo de Educación General Básica", Orden: 7},
-		{Nombre: "8vo EGB", NombreCompleto: "Octavo de Educación General Básica", Orden: 8},
-		{Nombre: "9no EGB", NombreCompleto: "Noveno de Educación General Básica", Orden: 9},
-		{Nombre: "10mo EGB", NombreCompleto: "Décimo de Educación General Básica", Orden: 10},
+		{Nombre: "1ro EGB", NombreCompleto: "Primero de Educación General Básica", Orden: 1, EdadMinima: 5, EdadMaxima: 6},
+		{Nombre: "2do EGB", NombreCompleto: "Segundo de Educación General Básica", Orden: 2, EdadMinima: 6, EdadMaxima: 7},
+		{Nombre: "3ro EGB", NombreCompleto: "Tercero de Educación General Básica", Orden: 3, EdadMinima: 7, EdadMaxima: 8},
+		{Nombre: "4to EGB", NombreCompleto: "Cuarto de Educación General Básica", Orden: 4, EdadMinima: 8, EdadMaxima: 9},
+		{Nombre: "5to EGB", NombreCompleto: "Quinto de Educación General Básica", Orden: 5, EdadMinima: 9, EdadMaxima: 10},
+		{Nombre: "6to EGB", NombreCompleto: "Sexto de Educación General Básica", Orden: 6, EdadMinima: 10, EdadMaxima: 11},
+		{Nombre: "7mo EGB", NombreCompleto: "Séptimo de Educación General Básica", Orden: 7, EdadMinima: 11, EdadMaxima: 12},
+		{Nombre: "8vo EGB", NombreCompleto: "Octavo de Educación General Básica", Orden: 8, EdadMinima: 12, EdadMaxima: 13},
+		{Nombre: "9no EGB", NombreCompleto: "Noveno de Educación General Básica", Orden: 9, EdadMinima: 13, EdadMaxima: 14},
+		{Nombre: "10mo EGB", NombreCompleto: "Décimo de Educación General Básica", Orden: 10, EdadMinima: 14, EdadMaxima: 15},
 	}
 
 	for _, nivel := range niveles {
+		edadMinima, edadMaxima := nivel.EdadMinima, nivel.EdadMaxima
 		if err := db.Where(academic.NivelEducativo{Nombre: nivel.Nombre}).FirstOrCreate(&nivel).Error; err != nil {
 			return err
 		}
+		// Niveles creados antes de que existiera el rango de edad esperado
+		if nivel.EdadMaxima == 0 {
+			if err := db.Model(&nivel).Updates(map[string]interface{}{"edad_minima": edadMinima, "edad_maxima": edadMaxima}).Error; err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -139,3 +160,115 @@ func seedConfiguracion(db *gorm.DB) error {
 	}
 	return nil
 }
+
+// seedTransicionesMatricula reconstruye el historial de estados de las matrículas creadas antes
+// de que existiera: el alta se fecha al inicio del periodo (o al registro, si fue antes) y el
+// estado actual, si no es Matriculado, a la fecha del retiro.
+func seedTransicionesMatricula(db *gorm.DB) error {
+	ahora := time.Now().Format("2006-01-02 15:04:05")
+
+	if err := db.Exec(`
+		INSERT INTO transiciones_matricula (matricula_id, estado_anterior, estado_nuevo, fecha, motivo, fecha_registro)
+		SELECT m.id, '', ?,
+			CASE
+				WHEN COALESCE(pl.fecha_inicio, '') <> '' AND (COALESCE(m.fecha_registro, '') = '' OR substr(m.fecha_registro, 1, 10) > pl.fecha_inicio)
+					THEN pl.fecha_inicio
+				WHEN COALESCE(m.fecha_registro, '') <> '' THEN substr(m.fecha_registro, 1, 10)
+				ELSE date('now', 'localtime')
+			END,
+			'Matrícula registrada antes del historial de estados', ?
+		FROM matriculas m
+		LEFT JOIN cursos c ON m.curso_id = c.id
+		LEFT JOIN periodo_lectivos pl ON c.periodo_id = pl.id
+		WHERE NOT EXISTS (SELECT 1 FROM transiciones_matricula t WHERE t.matricula_id = m.id)`,
+		enrollment.EstadoMatriculado, ahora).Error; err != nil {
+		return err
+	}
+
+	return db.Exec(`
+		INSERT INTO transiciones_matricula (matricula_id, estado_anterior, estado_nuevo, fecha, motivo, fecha_registro)
+		SELECT m.id, t.estado_nuevo, m.estado,
+			max(t.fecha, COALESCE(
+				(SELECT substr(r.fecha_retiro, 1, 10) FROM retiro_estudiantes r WHERE r.matricula_id = m.id ORDER BY r.id DESC LIMIT 1),
+				t.fecha)),
+			COALESCE((SELECT r.motivo FROM retiro_estudiantes r WHERE r.matricula_id = m.id ORDER BY r.id DESC LIMIT 1), ''),
+			?
+		FROM matriculas m
+		JOIN transiciones_matricula t ON t.matricula_id = m.id
+		WHERE m.estado <> t.estado_nuevo
+			AND (SELECT COUNT(*) FROM transiciones_matricula t2 WHERE t2.matricula_id = m.id) = 1`,
+		ahora).Error
+}
+
+// esquemaBase es el esquema regular del MSP con el que arranca el catálogo. Solo se carga en una
+// base sin vacunas: el DECE lo ajusta al esquema vigente desde la configuración.
+var esquemaBase = []struct {
+	Vacuna health.Vacuna
+	Dosis  []health.EsquemaVacuna
+}{
+	{health.Vacuna{Nombre: "BCG", Alias: "Tuberculosis"}, []health.EsquemaVacuna{{NumeroDosis: 1, EdadMeses: 0, Descripcion: "Dosis única"}}},
+	{health.Vacuna{Nombre: "Hepatitis B", Alias: "HB,HBV"}, []health.EsquemaVacuna{{NumeroDosis: 1, EdadMeses: 0, Descripcion: "Recién nacido"}}},
+	{health.Vacuna{Nombre: "Rotavirus", Alias: "Rota,RV"}, []health.EsquemaVacuna{
+		{NumeroDosis: 1, EdadMeses: 2}, {NumeroDosis: 2, EdadMeses: 4},
+	}},
+	{health.Vacuna{Nombre: "Pentavalente", Alias: "Penta,DPT-HB-Hib"}, []health.EsquemaVacuna{
+		{NumeroDosis: 1, EdadMeses: 2}, {NumeroDosis: 2, EdadMeses: 4}, {NumeroDosis: 3, EdadMeses: 6},
+	}},
+	{health.Vacuna{Nombre: "Poliomielitis", Alias: "Polio,IPV,fIPV,bOPV,OPV"}, []health.EsquemaVacuna{
+		{NumeroDosis: 1, EdadMeses: 2}, {NumeroDosis: 2, EdadMeses: 4}, {NumeroDosis: 3, EdadMeses: 6},
+		{NumeroDosis: 4, EdadMeses: 18, Descripcion: "Refuerzo"},
+	}},
+	{health.Vacuna{Nombre: "Neumococo conjugada", Alias: "Neumococo,Neumo,PCV"}, []health.EsquemaVacuna{
+		{NumeroDosis: 1, EdadMeses: 2}, {NumeroDosis: 2, EdadMeses: 4}, {NumeroDosis: 3, EdadMeses: 6},
+	}},
+	{health.Vacuna{Nombre: "Sarampión, Rubéola y Parotiditis", Alias: "SRP,SPR,Triple viral"}, []health.EsquemaVacuna{
+		{NumeroDosis: 1, EdadMeses: 12}, {NumeroDosis: 2, EdadMeses: 18},
+	}},
+	{health.Vacuna{Nombre: "Fiebre amarilla", Alias: "FA"}, []health.EsquemaVacuna{{NumeroDosis: 1, EdadMeses: 12, Descripcion: "Dosis única"}}},
+	{health.Vacuna{Nombre: "Varicela", Alias: "VAR"}, []health.EsquemaVacuna{{NumeroDosis: 1, EdadMeses: 15}}},
+	{health.Vacuna{Nombre: "DPT", Alias: "Triple bacteriana,DTP"}, []health.EsquemaVacuna{
+		{NumeroDosis: 1, EdadMeses: 18, Descripcion: "Refuerzo 1"}, {NumeroDosis: 2, EdadMeses: 60, Descripcion: "Refuerzo 2"},
+	}},
+	{health.Vacuna{Nombre: "Virus del Papiloma Humano", Alias: "VPH,HPV"}, []health.EsquemaVacuna{{NumeroDosis: 1, EdadMeses: 108, Sexo: "F"}}},
+	{health.Vacuna{Nombre: "Difteria y tétanos (adultos)", Alias: "dT,Td"}, []health.EsquemaVacuna{{NumeroDosis: 1, EdadMeses: 180, Descripcion: "Refuerzo"}}},
+}
+
+func seedEsquemaVacunacion(db *gorm.DB) error {
+	var count int64
+	db.Model(&health.Vacuna{}).Count(&count)
+	if count > 0 {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, base := range esquemaBase {
+			vacuna := base.Vacuna
+			vacuna.Activa = true
+			if err := tx.Create(&vacuna).Error; err != nil {
+				return err
+			}
+			for _, dosis := range base.Dosis {
+				dosis.VacunaID = vacuna.ID
+				if err := tx.Create(&dosis).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func seedTiposControlMedico(db *gorm.DB) error {
+	var count int64
+	db.Model(&health.TipoControlMedico{}).Count(&count)
+	if count > 0 {
+		return nil
+	}
+
+	tipos := []health.TipoControlMedico{
+		{Nombre: "Médico general", Alias: "Medicina general,Control médico,Medico", PeriodicidadMeses: 12, Activo: true},
+		{Nombre: "Odontológico", Alias: "Odontologia,Dental,Control dental", PeriodicidadMeses: 12, Activo: true},
+		{Nombre: "Agudeza visual", Alias: "Visual,Optometria,Tamizaje visual", PeriodicidadMeses: 12, Activo: false},
+	}
+	return db.Create(&tipos).Error
+}
@@ -11,9 +11,12 @@ import (
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 
 	academic "dece/internal/application/services/academic"
+	consent "dece/internal/application/services/consent"
 	dashboard "dece/internal/application/services/dashboard"
+	documents "dece/internal/application/services/documents"
 	enrollment "dece/internal/application/services/enrollment"
 	faculty "dece/internal/application/services/faculty"
+	health "dece/internal/application/services/health"
 	management "dece/internal/application/services/management"
 	notifications "dece/internal/application/services/notifications"
 	reports "dece/internal/application/services/reports"
@@ -23,6 +26,7 @@ import (
 	telegramSync "dece/internal/application/services/sync"
 	system "dece/internal/application/services/system"
 	tracking "dece/internal/application/services/tracking"
+	transfer "dece/internal/application/services/transfer"
 )
 
 //go:embed all:frontend/dist
@@ -49,22 +53,33 @@ func main() {
 	courseService := faculty.NewCourseService(db)
 	teachingLoadService := faculty.NewDistributivoService(db)
 
-	studentService := student.NewStudentService(db)
+	documentService := documents.NewDocumentService(db, authService)
+	versionador := documents.NewVersionador(authService)
+	cicloMatricula := enrollment.NewCicloMatricula(authService)
 
-	enrollmentService := enrollment.NewEnrollmentService(db)
+	studentService := student.NewStudentService(db, versionador, cicloMatricula)
+	duplicateService := student.NewDuplicateService(db, cicloMatricula)
+	householdService := student.NewHouseholdService(db)
+	consentService := consent.NewConsentService(db, authService, versionador)
 
-	trackingService := tracking.NewTrackingService(db)
+	trackingService := tracking.NewTrackingService(db, authService, versionador)
+
+	transferService := transfer.NewTransferService(db, authService, versionador, cicloMatricula)
 
 	telegramSyncService := telegramSync.NewTelegramSyncService(db)
 	managementService := management.NewManagementService(db, telegramSyncService)
+	enrollmentService := enrollment.NewEnrollmentService(db, cicloMatricula, managementService)
+	healthService := health.NewHealthService(db, authService, versionador)
 	templateService := management.NewTemplateService(db)
 	dashboardService := dashboard.NewDashboardService(db)
 	notificationsService := notifications.NewNotificationsService(db)
-	reportService := reports.NewReportService(db, institutionService, teacherService)
+	reportService := reports.NewReportService(db, institutionService, teacherService, authService)
+	referralService := tracking.NewReferralService(db, trackingService, telegramSyncService)
+	protocolService := tracking.NewProtocolService(db, authService)
 	searchService := search.NewSearchService(db)
 	maintenanceService := system.NewMaintenanceService(db)
 
-	app := NewApp(enrollmentService, trackingService, notificationsService, telegramSyncService, studentService, searchService, maintenanceService, templateService, userService)
+	app := NewApp(enrollmentService, trackingService, notificationsService, telegramSyncService, studentService, searchService, maintenanceService, templateService, userService, transferService, healthService)
 
 	err := wails.Run(&options.App{
 		Title:            "SIGDECE",
@@ -92,10 +107,18 @@ func main() {
 			teachingLoadService,
 
 			studentService,
+			duplicateService,
+			householdService,
+			consentService,
+			documentService,
+			transferService,
 
 			enrollmentService,
+			healthService,
 
 			trackingService,
+			referralService,
+			protocolService,
 
 			managementService,
 			dashboardService,